@@ -15,18 +15,36 @@ import (
 
 	"github.com/Haevnen/audit-logging-api/internal/config"
 	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/service"
 	"github.com/Haevnen/audit-logging-api/internal/worker"
 	"github.com/Haevnen/audit-logging-api/pkg/gormdb"
+	"github.com/Haevnen/audit-logging-api/pkg/health"
 	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
+const serviceName = "async-task"
+
 func start() int {
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		panic(err)
 	}
 
-	logger := logger.InitLogger(cfg.Mode, cfg.LogFile)
+	logger := logger.InitLogger(cfg.GetLoggerConfig())
+	metrics.SetMaxTenantLabels(cfg.MetricsMaxTenants)
+
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.GetTracingConfig(serviceName))
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to set up tracing")
+		return 1
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithField("error", err).Error("Failed to flush traces")
+		}
+	}()
 
 	// Connect to database
 	gormCfg := cfg.GetGORMConfig()
@@ -53,18 +71,14 @@ func start() int {
 		return 1
 	}
 
-	r := registry.NewRegistry(
-		db,
-		cfg.TokenSymmetricKey,
-		sqsClient,
-		s3Client,
-		cfg.SqsLogArchivalQueueURL,
-		cfg.SqsLogCleanupQueueURL,
-		cfg.SqsIndexQueueURL,
-		cfg.S3ArchiveLogBucketName,
-		cfg.OpenSearchURL,
-		cfg.RedisAddr,
-	)
+	r := registry.NewRegistry(db, cfg, sqsClient, s3Client)
+
+	// the index template, the first index and the aliases must exist before anything is indexed
+	indexes := r.SearchIndexManager()
+	if err := indexes.Bootstrap(context.Background()); err != nil {
+		logger.WithField("error", err).Fatal("Failed to bootstrap the search indices")
+		return 1
+	}
 
 	archWorker := worker.NewArchiveWorker(
 		r.QueuePublisher(),
@@ -92,6 +106,59 @@ func start() int {
 		cfg.SqsIndexQueueURL,
 	)
 
+	tenantWorker := worker.NewTenantDeletionWorker(
+		r.QueuePublisher(),
+		r.AsyncTaskRepository(),
+		r.TenantRepository(),
+		r.LogRepository(),
+		r.S3Publisher(),
+		r.OpenSearchPublisher(),
+		r.PubSub(),
+		r.TxManager(),
+		cfg.SqsTenantDeletionQueueURL,
+	)
+
+	reindexWorker := worker.NewReindexWorker(
+		r.QueuePublisher(),
+		r.AsyncTaskRepository(),
+		r.LogRepository(),
+		r.LogSearchRepository(),
+		r.OpenSearchPublisher(),
+		cfg.SqsReindexQueueURL,
+	)
+
+	// the index workers look for a rebuild every RebuildCheckInterval, the backfill waits for all of them
+	rebuildWorker := worker.NewIndexRebuildWorker(
+		r.QueuePublisher(),
+		r.AsyncTaskRepository(),
+		r.LogRepository(),
+		indexes,
+		r.RebuildPublisher(),
+		cfg.SqsIndexRebuildQueueURL,
+		cfg.SearchIndexRebuildConcurrency,
+		cfg.SearchIndexRetention,
+		2*service.RebuildCheckInterval,
+	)
+
+	metricsServer, err := metrics.Serve(cfg.MetricsAddr)
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to serve the metrics")
+		return 1
+	}
+	defer metricsServer.Close()
+
+	// Liveness fails when a worker loop is stuck, readiness probes the dependencies
+	checks, err := r.HealthChecks()
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to set up health checks")
+		return 1
+	}
+	readiness := health.NewChecker(cfg.HealthCheckTimeout, checks...)
+	healthServer := health.Serve(cfg.HealthAddr, func(context.Context) health.Report {
+		return worker.Liveness(cfg.WorkerStaleAfter)
+	}, readiness.Run)
+	defer healthServer.Close()
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
@@ -110,6 +177,30 @@ func start() int {
 		indexWorker.Start(ctx)
 	}()
 
+	go func() {
+		tenantWorker.Start(ctx)
+	}()
+
+	go func() {
+		reindexWorker.Start(ctx)
+	}()
+
+	go func() {
+		rebuildWorker.Start(ctx)
+	}()
+
+	maintenance := worker.NewIndexMaintenance(indexes, cfg.SearchIndexRolloverAge, cfg.SearchIndexRetention, cfg.SearchIndexMaintenanceInterval)
+	go func() {
+		maintenance.Start(ctx)
+	}()
+
+	if cfg.ReindexScheduleHour >= 0 {
+		scheduler := worker.NewReindexScheduler(r.StartReindexUseCase(), cfg.ReindexScheduleHour, cfg.ReindexScheduleWindow)
+		go func() {
+			scheduler.Start(ctx)
+		}()
+	}
+
 	<-sigChan
 	logger.Info("Shutting down gracefully...")
 	cancel() // signal worker to stop
@@ -15,23 +15,42 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
 	handler "github.com/Haevnen/audit-logging-api/internal/adapter/http"
 	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
 	"github.com/Haevnen/audit-logging-api/internal/config"
 	"github.com/Haevnen/audit-logging-api/internal/infra/middleware"
 	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/quota"
 	"github.com/Haevnen/audit-logging-api/pkg/gormdb"
+	"github.com/Haevnen/audit-logging-api/pkg/health"
 	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
+const serviceName = "audit-logging-api"
+
 func start() int {
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		panic(err)
 	}
 
-	logger := logger.InitLogger(cfg.Mode, cfg.LogFile)
+	logger := logger.InitLogger(cfg.GetLoggerConfig())
+	metrics.SetMaxTenantLabels(cfg.MetricsMaxTenants)
+
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.GetTracingConfig(serviceName))
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to set up tracing")
+		return 1
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithField("error", err).Error("Failed to flush traces")
+		}
+	}()
 
 	// Connect to database
 	gormCfg := cfg.GetGORMConfig()
@@ -46,9 +65,10 @@ func start() int {
 		}
 	}()
 
-	// Setup router
-	r := gin.Default()
+	// Setup router, requests are logged by the access log below
 	gin.SetMode(cfg.Mode)
+	r := gin.New()
+	r.Use(gin.Recovery())
 
 	sqsClient, err := NewSQSClient(cfg)
 	if err != nil {
@@ -62,36 +82,61 @@ func start() int {
 		return 1
 	}
 
-	registry := registry.NewRegistry(
-		db,
-		cfg.TokenSymmetricKey,
-		sqsClient,
-		s3Client,
-		cfg.SqsLogArchivalQueueURL,
-		cfg.SqsLogCleanupQueueURL,
-		cfg.SqsIndexQueueURL,
-		cfg.S3ArchiveLogBucketName,
-		cfg.OpenSearchURL,
-		cfg.RedisAddr,
-	)
+	registry := registry.NewRegistry(db, cfg, sqsClient, s3Client)
 	handler := handler.New(registry)
 	jwt := registry.Manager()
 
-	// Register health check
-	r.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{"status": "ok"})
-	})
+	// Continue the trace of the caller, or start one, for every request
+	r.Use(otelgin.Middleware(serviceName))
+
+	// Tag the request with an id shared by its log lines and the tasks it queues
+	r.Use(middleware.RequestID())
+	r.Use(middleware.AccessLog())
+
+	// Request latency and status by route
+	r.Use(middleware.Metrics())
+
+	// Liveness only tells the process serves, readiness probes every dependency
+	checks, err := registry.HealthChecks()
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to set up health checks")
+		return 1
+	}
+	readiness := health.NewChecker(cfg.HealthCheckTimeout, checks...)
+	r.GET("/health", gin.WrapF(health.Handler(health.Alive)))
+	r.GET("/livez", gin.WrapF(health.Handler(health.Alive)))
+	r.GET("/readyz", gin.WrapF(health.Handler(readiness.Run)))
+
+	// The metrics name every tenant and route, they are only served to the scraper on an internal port
+	metricsServer, err := metrics.Serve(cfg.APIMetricsAddr)
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to serve the metrics")
+		return 1
+	}
+	defer metricsServer.Close()
+
+	// Record the API calls in the service's own audit trail
+	r.Use(middleware.AuditTrail(registry.RecordAuditEntryUseCase()))
 
 	// Register handlers
 	api_service.RegisterHandlersWithOptions(r, handler, api_service.GinServerOptions{
 		BaseURL: "/api/v1",
 		Middlewares: []api_service.MiddlewareFunc{
-			middleware.RequireAuth(jwt),
-			middleware.RequireRole(),
-			middleware.RequireRateLimit(cfg.RateLimitRPS, cfg.RateLimitBurst),
+			middleware.RequireAuth(jwt, registry.AuthenticateAPIKeyUseCase(), registry.CheckRevocationUseCase()),
+			middleware.RequireActiveTenant(registry.CheckTenantUseCase()),
+			middleware.RequireRole(registry.ResolvePermissionsUseCase()),
+			middleware.RequireRateLimit(registry.ResolveLimitsUseCase(), registry.RateLimiter()),
 		},
 	})
 
+	// Write the metered searches and exports in batches, the last one on shutdown
+	meterCtx, stopMeter := context.WithCancel(context.Background())
+	meterDone := make(chan struct{})
+	go func() {
+		defer close(meterDone)
+		registry.RecordActivityUseCase().Run(meterCtx, quota.ActivityFlushInterval)
+	}()
+
 	// Start server
 	s := &http.Server{
 		Addr:    cfg.GetURLBase(),
@@ -116,6 +161,8 @@ func start() int {
 		logger.Fatal("Server Shutdown:", err)
 		return 1
 	}
+	stopMeter()
+	<-meterDone
 
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/grant"
+)
+
+type AccessGrantHandler struct {
+	CreateGrantUC grant.CreateGrantUseCaseInterface
+	ListGrantsUC  grant.ListGrantsUseCaseInterface
+	RevokeGrantUC grant.RevokeGrantUseCaseInterface
+}
+
+func newAccessGrantHandler(r *registry.Registry) AccessGrantHandler {
+	return AccessGrantHandler{
+		CreateGrantUC: r.CreateGrantUseCase(),
+		ListGrantsUC:  r.ListGrantsUseCase(),
+		RevokeGrantUC: r.RevokeGrantUseCase(),
+	}
+}
+
+// ListAccessGrants implements (GET /access-grants)
+func (h AccessGrantHandler) ListAccessGrants(c *gin.Context, params api_service.ListAccessGrantsParams) {
+	grants, err := h.ListGrantsUC.Execute(c.Request.Context(), optionalString(params.GranteeId), optionalString(params.TenantId))
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp := make([]api_service.AccessGrant, 0, len(grants))
+	for _, g := range grants {
+		resp = append(resp, ToAccessGrantResponse(g))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateAccessGrant implements (POST /access-grants)
+// Let the grantee read the logs of the tenants until the grant expires.
+func (h AccessGrantHandler) CreateAccessGrant(c *gin.Context) {
+	var body api_service.CreateAccessGrantRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	g := access_grant.AccessGrant{
+		GranteeTenantID: body.GranteeTenantId,
+		GranteeID:       body.GranteeId,
+		TenantIDs:       body.TenantIds,
+		Reason:          body.Reason,
+		ExpiresAt:       body.ExpiresAt.UTC(),
+	}
+	if body.StartsAt != nil {
+		g.StartsAt = body.StartsAt.UTC()
+	}
+
+	created, err := h.CreateGrantUC.Execute(c.Request.Context(), c.GetString(constant.UserID), g)
+	if err != nil {
+		if errors.Is(err, grant.ErrInvalidGrant) {
+			SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+			return
+		}
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.JSON(http.StatusCreated, ToAccessGrantResponse(*created))
+}
+
+// RevokeAccessGrant implements (DELETE /access-grants/{id})
+func (h AccessGrantHandler) RevokeAccessGrant(c *gin.Context, id string) {
+	if _, err := h.RevokeGrantUC.Execute(c.Request.Context(), id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			SendError(c, err.Error(), apperror.ErrRecordNotFound)
+			return
+		}
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
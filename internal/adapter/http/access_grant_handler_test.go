@@ -0,0 +1,117 @@
+package handler_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/grant"
+
+	grantMocks "github.com/Haevnen/audit-logging-api/internal/usecase/grant/mocks"
+)
+
+func TestAccessGrantHandler_CreateAccessGrant_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := grantMocks.NewMockCreateGrantUseCaseInterface(ctrl)
+	handler := h.AccessGrantHandler{CreateGrantUC: mockUC}
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	data := []byte(fmt.Sprintf(`{"grantee_tenant_id":"tenant-9","grantee_id":"auditor-1","tenant_ids":["tenant-1","tenant-2"],"reason":"SOC2 audit","expires_at":%q}`, expiresAt))
+	c, w := setupContext(http.MethodPost, "/access-grants", data)
+	c.Set(constant.UserID, "admin-1")
+	c.Set(constant.Role, auth.RoleAdmin)
+
+	mockUC.EXPECT().Execute(gomock.Any(), "admin-1", gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ string, g access_grant.AccessGrant) (*access_grant.AccessGrant, error) {
+			assert.Equal(t, "tenant-9", g.GranteeTenantID)
+			assert.Equal(t, "auditor-1", g.GranteeID)
+			assert.Equal(t, []string{"tenant-1", "tenant-2"}, []string(g.TenantIDs))
+			assert.True(t, g.StartsAt.IsZero())
+			g.ID = "grant-1"
+			g.StartsAt = time.Now()
+			return &g, nil
+		})
+
+	handler.CreateAccessGrant(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"active":true`)
+}
+
+func TestAccessGrantHandler_CreateAccessGrant_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := grantMocks.NewMockCreateGrantUseCaseInterface(ctrl)
+	handler := h.AccessGrantHandler{CreateGrantUC: mockUC}
+
+	data := []byte(`{"grantee_tenant_id":"tenant-9","grantee_id":"auditor-1","tenant_ids":[],"reason":"audit","expires_at":"2030-01-01T00:00:00Z"}`)
+	c, w := setupContext(http.MethodPost, "/access-grants", data)
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, grant.ErrInvalidGrant)
+
+	handler.CreateAccessGrant(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAccessGrantHandler_ListAccessGrants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := grantMocks.NewMockListGrantsUseCaseInterface(ctrl)
+	handler := h.AccessGrantHandler{ListGrantsUC: mockUC}
+
+	revokedAt := time.Now()
+	c, w := setupContext(http.MethodGet, "/access-grants?grantee_id=auditor-1", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "auditor-1", "").
+		Return([]access_grant.AccessGrant{{ID: "grant-1", GranteeID: "auditor-1", TenantIDs: []string{"tenant-1"}, RevokedAt: &revokedAt}}, nil)
+
+	granteeId := "auditor-1"
+	handler.ListAccessGrants(c, api_service.ListAccessGrantsParams{GranteeId: &granteeId})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"active":false`)
+	assert.Contains(t, w.Body.String(), `"revoked_at"`)
+}
+
+func TestAccessGrantHandler_RevokeAccessGrant(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"Not Found", gorm.ErrRecordNotFound, http.StatusNotFound},
+		{"Error", errors.New("db error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := grantMocks.NewMockRevokeGrantUseCaseInterface(ctrl)
+			handler := h.AccessGrantHandler{RevokeGrantUC: mockUC}
+
+			c, _ := setupContext(http.MethodDelete, "/access-grants/grant-1", nil)
+			mockUC.EXPECT().Execute(gomock.Any(), "grant-1").Return(&access_grant.AccessGrant{ID: "grant-1"}, tt.err)
+
+			handler.RevokeAccessGrant(c, "grant-1")
+
+			assert.Equal(t, tt.wantStatus, c.Writer.Status())
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+)
+
+type APIKeyHandler struct {
+	IssueKeyUC  apikey.IssueAPIKeyUseCaseInterface
+	ListKeyUC   apikey.ListAPIKeysUseCaseInterface
+	RotateKeyUC apikey.RotateAPIKeyUseCaseInterface
+	RevokeKeyUC apikey.RevokeAPIKeyUseCaseInterface
+}
+
+func newAPIKeyHandler(r *registry.Registry) APIKeyHandler {
+	return APIKeyHandler{
+		IssueKeyUC:  r.IssueAPIKeyUseCase(),
+		ListKeyUC:   r.ListAPIKeysUseCase(),
+		RotateKeyUC: r.RotateAPIKeyUseCase(),
+		RevokeKeyUC: r.RevokeAPIKeyUseCase(),
+	}
+}
+
+// ListApiKeys implements (GET /api-keys)
+// List the keys of every tenant, or of the tenant given in the query.
+func (h APIKeyHandler) ListApiKeys(c *gin.Context, params api_service.ListApiKeysParams) {
+	tenantId := ""
+	if params.TenantId != nil {
+		tenantId = *params.TenantId
+	}
+
+	keys, err := h.ListKeyUC.Execute(c.Request.Context(), tenantId)
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp := make([]api_service.ApiKey, 0, len(keys))
+	for _, k := range keys {
+		resp = append(resp, ToAPIKeyResponse(k))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// IssueApiKey implements (POST /api-keys)
+// Issue a key for a machine producer of the tenant. The raw key is only part of this response.
+func (h APIKeyHandler) IssueApiKey(c *gin.Context) {
+	var body api_service.IssueApiKeyRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	scopes := make([]api_key.Scope, 0, len(body.Scopes))
+	for _, s := range body.Scopes {
+		scopes = append(scopes, api_key.Scope(s))
+	}
+
+	key := api_key.APIKey{
+		TenantID:  body.TenantId,
+		Name:      body.Name,
+		Role:      string(body.Role),
+		Scopes:    scopes,
+		ExpiresAt: body.ExpiresAt,
+	}
+
+	created, raw, err := h.IssueKeyUC.Execute(c.Request.Context(), c.GetString(constant.UserID), key)
+	if err != nil {
+		sendAPIKeyError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, api_service.ApiKeySecretResponse{ApiKey: ToAPIKeyResponse(*created), Key: raw})
+}
+
+// RotateApiKey implements (POST /api-keys/{id}/rotate)
+// Replace the secret of a key, the previous one stops working immediately.
+func (h APIKeyHandler) RotateApiKey(c *gin.Context, id string) {
+	key, raw, err := h.RotateKeyUC.Execute(c.Request.Context(), c.GetString(constant.UserID), id, getClaimTenant(c))
+	if err != nil {
+		sendAPIKeyError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, api_service.ApiKeySecretResponse{ApiKey: ToAPIKeyResponse(*key), Key: raw})
+}
+
+// RevokeApiKey implements (DELETE /api-keys/{id})
+func (h APIKeyHandler) RevokeApiKey(c *gin.Context, id string) {
+	if err := h.RevokeKeyUC.Execute(c.Request.Context(), c.GetString(constant.UserID), id, getClaimTenant(c)); err != nil {
+		sendAPIKeyError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func sendAPIKeyError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, apikey.ErrInvalidKeyRequest):
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		SendError(c, err.Error(), apperror.ErrRecordNotFound)
+	default:
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+	}
+}
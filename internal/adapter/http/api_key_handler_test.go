@@ -0,0 +1,117 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+
+	apiKeyMocks "github.com/Haevnen/audit-logging-api/internal/usecase/apikey/mocks"
+)
+
+func TestAPIKeyHandler_IssueApiKey_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := apiKeyMocks.NewMockIssueAPIKeyUseCaseInterface(ctrl)
+	handler := h.APIKeyHandler{IssueKeyUC: mockUC}
+
+	data := []byte(`{"tenant_id":"tenant-1","name":"billing","role":"user","scopes":["logs:write"]}`)
+	c, w := setupContext(http.MethodPost, "/api-keys", data)
+	c.Set(constant.Role, auth.RoleAdmin)
+
+	mockUC.EXPECT().Execute(gomock.Any(), "user-1", gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ string, k api_key.APIKey) (*api_key.APIKey, string, error) {
+			assert.Equal(t, "tenant-1", k.TenantID)
+			assert.Equal(t, []api_key.Scope{api_key.ScopeLogsWrite}, []api_key.Scope(k.Scopes))
+			k.ID = "key-1"
+			k.Prefix = "abcdefghijkl"
+			k.KeyHash = "stored-hash"
+			k.CreatedAt = time.Now().UTC()
+			return &k, "alk_abcdefghijklsecret", nil
+		})
+
+	handler.IssueApiKey(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), "alk_abcdefghijklsecret")
+	assert.NotContains(t, w.Body.String(), "stored-hash")
+}
+
+func TestAPIKeyHandler_IssueApiKey_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := apiKeyMocks.NewMockIssueAPIKeyUseCaseInterface(ctrl)
+	handler := h.APIKeyHandler{IssueKeyUC: mockUC}
+
+	data := []byte(`{"tenant_id":"tenant-1","name":"billing","role":"user","scopes":[]}`)
+	c, w := setupContext(http.MethodPost, "/api-keys", data)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, "", apikey.ErrInvalidKeyRequest)
+
+	handler.IssueApiKey(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAPIKeyHandler_ListApiKeys_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := apiKeyMocks.NewMockListAPIKeysUseCaseInterface(ctrl)
+	handler := h.APIKeyHandler{ListKeyUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/api-keys", nil)
+	tenantId := "tenant-1"
+	mockUC.EXPECT().Execute(gomock.Any(), "tenant-1").
+		Return([]api_key.APIKey{{ID: "key-1", TenantID: "tenant-1", Prefix: "abcdefghijkl", KeyHash: "stored-hash", Role: "user"}}, nil)
+
+	handler.ListApiKeys(c, api_service.ListApiKeysParams{TenantId: &tenantId})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "abcdefghijkl")
+	assert.NotContains(t, w.Body.String(), "stored-hash")
+}
+
+func TestAPIKeyHandler_RotateApiKey_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := apiKeyMocks.NewMockRotateAPIKeyUseCaseInterface(ctrl)
+	handler := h.APIKeyHandler{RotateKeyUC: mockUC}
+
+	c, w := setupContext(http.MethodPost, "/api-keys/key-1/rotate", nil)
+	c.Set(constant.Role, auth.RoleAdmin)
+	mockUC.EXPECT().Execute(gomock.Any(), "user-1", "key-1", "").Return(nil, "", gorm.ErrRecordNotFound)
+
+	handler.RotateApiKey(c, "key-1")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestAPIKeyHandler_RevokeApiKey_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := apiKeyMocks.NewMockRevokeAPIKeyUseCaseInterface(ctrl)
+	handler := h.APIKeyHandler{RevokeKeyUC: mockUC}
+
+	c, _ := setupContext(http.MethodDelete, "/api-keys/key-1", nil)
+	c.Set(constant.Role, auth.RoleAdmin)
+	mockUC.EXPECT().Execute(gomock.Any(), "user-1", "key-1", "").Return(nil)
+
+	handler.RevokeApiKey(c, "key-1")
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+}
@@ -2,10 +2,23 @@ package handler
 
 import (
 	"encoding/json"
+	"time"
 
 	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
 	entity_log "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	"github.com/Haevnen/audit-logging-api/internal/entity/ops"
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"gorm.io/datatypes"
 )
 
@@ -66,20 +79,116 @@ func JSONToMap(j *datatypes.JSON) (*map[string]interface{}, error) {
 	return &m, nil
 }
 
-func ToLogStatsResponse(stats []log.LogStats) []api_service.LogStat {
-	var resp []api_service.LogStat
-	for _, s := range stats {
-		resp = append(resp, api_service.LogStat{
-			Day:      s.Day.Format("2006-01-02"),
-			Total:    s.Total,
-			CREATE:   s.ActionCreate,
-			UPDATE:   s.ActionUpdate,
-			DELETE:   s.ActionDelete,
-			VIEW:     s.ActionView,
-			INFO:     s.SeverityInfo,
-			ERROR:    s.SeverityError,
-			WARNING:  s.SeverityWarning,
-			CRITICAL: s.SeverityCritical,
+func ToLogStatsResponse(q log.StatsQuery, stats log.Stats) api_service.LogStats {
+	resp := api_service.LogStats{
+		Interval:  api_service.LogStatsInterval(q.Interval),
+		StartDate: q.StartTime,
+		EndDate:   q.EndTime,
+		Series:    make([]api_service.LogStatSeries, 0, len(stats.Series)),
+	}
+	if stats.Freshness != nil {
+		resp.Freshness = utils.Ptr(stats.Freshness.UTC())
+	}
+	if q.GroupBy != log.GroupByNone {
+		resp.GroupBy = utils.Ptr(api_service.LogStatsGroupBy(q.GroupBy))
+	}
+
+	for _, s := range stats.Series {
+		points := make([]api_service.LogStatPoint, 0, len(s.Points))
+		for _, p := range s.Points {
+			points = append(points, api_service.LogStatPoint{Time: p.Bucket.UTC(), Count: p.Count})
+		}
+		resp.Series = append(resp.Series, api_service.LogStatSeries{Key: s.Key, Total: s.Total, Points: points})
+	}
+	return resp
+}
+
+func ToLogTopInsightsResponse(q log.TopQuery, entries []log.TopEntry) api_service.LogTopInsights {
+	resp := api_service.LogTopInsights{
+		Dimension: api_service.LogInsightDimension(q.Dimension),
+		StartDate: q.StartTime,
+		EndDate:   q.EndTime,
+		Items:     make([]api_service.LogTopEntry, 0, len(entries)),
+	}
+	if len(q.Action) > 0 {
+		resp.Action = utils.Ptr(api_service.Action(q.Action))
+	}
+	for _, e := range entries {
+		resp.Items = append(resp.Items, api_service.LogTopEntry{Key: e.Key, Count: e.Count})
+	}
+	return resp
+}
+
+func ToOpsDashboardResponse(d ops.Dashboard) api_service.OpsDashboard {
+	resp := api_service.OpsDashboard{
+		GeneratedAt: d.GeneratedAt,
+		Queues:      make([]api_service.QueueStatus, 0, len(d.Queues)),
+		Tasks:       make([]api_service.TaskCount, 0, len(d.Tasks)),
+		IndexLag: api_service.IndexLag{
+			Since:   d.Since,
+			Tenants: make([]api_service.TenantIndexLag, 0, len(d.IndexLag)),
+		},
+	}
+	for _, q := range d.Queues {
+		queue := api_service.QueueStatus{
+			Name:              q.Name,
+			TaskType:          string(q.TaskType),
+			Messages:          q.Messages,
+			InFlight:          q.InFlight,
+			Delayed:           q.Delayed,
+			OldestPendingTask: q.OldestPendingTask,
+		}
+		if q.OldestPendingTask != nil {
+			queue.OldestPendingAgeSeconds = utils.Ptr(int64(d.GeneratedAt.Sub(*q.OldestPendingTask).Seconds()))
+		}
+		resp.Queues = append(resp.Queues, queue)
+	}
+	for _, t := range d.Tasks {
+		resp.Tasks = append(resp.Tasks, api_service.TaskCount{
+			Type:            string(t.TaskType),
+			Status:          api_service.TaskCountStatus(t.Status),
+			Count:           t.Count,
+			OldestCreatedAt: t.OldestCreatedAt,
+		})
+	}
+	for _, l := range d.IndexLag {
+		resp.IndexLag.Tenants = append(resp.IndexLag.Tenants, api_service.TenantIndexLag{
+			TenantId: l.TenantID,
+			Stored:   l.Stored,
+			Indexed:  l.Indexed,
+			Missing:  l.Missing(),
+		})
+	}
+	return resp
+}
+
+func ToLogAnomaliesResponse(a log.Anomalies) api_service.LogAnomalies {
+	q := a.Query
+	resp := api_service.LogAnomalies{
+		StartDate:    q.StartTime,
+		EndDate:      q.EndTime,
+		BaselineDays: q.BaselineDays,
+		Threshold:    q.Threshold,
+		Spikes:       make([]api_service.ActivitySpike, 0, len(a.Spikes)),
+		NewIps:       make([]api_service.NewUserIP, 0, len(a.NewIPs)),
+	}
+	for _, s := range a.Spikes {
+		resp.Spikes = append(resp.Spikes, api_service.ActivitySpike{
+			UserId: s.UserID,
+			Day:    s.Day.UTC(),
+			Count:  s.Count,
+			Mean:   s.Mean,
+			Stddev: s.StdDev,
+			ZScore: s.ZScore,
+		})
+	}
+	for _, ip := range a.NewIPs {
+		resp.NewIps = append(resp.NewIps, api_service.NewUserIP{
+			UserId:    ip.UserID,
+			IpAddress: ip.IPAddress,
+			FirstSeen: ip.FirstSeen.UTC(),
+			LastSeen:  ip.LastSeen.UTC(),
+			Count:     ip.Count,
 		})
 	}
 	return resp
@@ -100,21 +209,329 @@ func ToSingleLogResponse(l entity_log.Log) (api_service.GetSingleLogResponse, er
 		return api_service.GetSingleLogResponse{}, err
 	}
 
+	var violations *[]string
+	if l.SchemaViolations != nil && len(*l.SchemaViolations) > 0 {
+		var v []string
+		if err := json.Unmarshal(*l.SchemaViolations, &v); err != nil {
+			return api_service.GetSingleLogResponse{}, err
+		}
+		violations = &v
+	}
+
+	diff, err := ToLogDiffResponse(l.Diff)
+	if err != nil {
+		return api_service.GetSingleLogResponse{}, err
+	}
+
+	var redactions *[]api_service.RedactionSummary
+	if l.Redactions != nil && len(*l.Redactions) > 0 {
+		var r []api_service.RedactionSummary
+		if err := json.Unmarshal(*l.Redactions, &r); err != nil {
+			return api_service.GetSingleLogResponse{}, err
+		}
+		redactions = &r
+	}
+
+	var system *bool
+	if l.System {
+		system = &l.System
+	}
+
 	return api_service.GetSingleLogResponse{
-		Id:             l.ID,
-		UserId:         l.UserID,
-		TenantId:       l.TenantID,
-		Action:         api_service.Action(l.Action),
-		Severity:       api_service.Severity(l.Severity),
-		EventTimestamp: l.EventTimestamp.Format(DateTimeFormat),
-		Message:        l.Message,
-		SessionId:      l.SessionID,
-		Resource:       l.Resource,
-		ResourceId:     l.ResourceID,
-		IpAddress:      l.IPAddress,
-		UserAgent:      l.UserAgent,
-		BeforeState:    before,
-		AfterState:     after,
-		Metadata:       metadata,
+		Id:               l.ID,
+		UserId:           l.UserID,
+		TenantId:         l.TenantID,
+		Action:           api_service.Action(l.Action),
+		Severity:         api_service.Severity(l.Severity),
+		EventTimestamp:   l.EventTimestamp.Format(DateTimeFormat),
+		Message:          l.Message,
+		SessionId:        l.SessionID,
+		Resource:         l.Resource,
+		ResourceId:       l.ResourceID,
+		IpAddress:        l.IPAddress,
+		UserAgent:        l.UserAgent,
+		BeforeState:      before,
+		AfterState:       after,
+		Metadata:         metadata,
+		SchemaId:         l.SchemaID,
+		SchemaViolations: violations,
+		Diff:             diff,
+		Redactions:       redactions,
+		System:           system,
+	}, nil
+}
+
+func ToRedactionRuleResponse(r redaction_rule.RedactionRule) api_service.RedactionRule {
+	return api_service.RedactionRule{
+		Id:        r.ID,
+		TenantId:  r.TenantID,
+		Name:      r.Name,
+		Kind:      api_service.RedactionKind(r.Kind),
+		Pattern:   r.Pattern,
+		Path:      r.Path,
+		Action:    api_service.RedactionAction(r.Action),
+		CreatedAt: r.CreatedAt.Format(DateTimeFormat),
+	}
+}
+
+// ToAPIKeyResponse never exposes the key hash
+func ToAPIKeyResponse(k api_key.APIKey) api_service.ApiKey {
+	scopes := make([]api_service.ApiKeyScope, 0, len(k.Scopes))
+	for _, s := range k.Scopes {
+		scopes = append(scopes, api_service.ApiKeyScope(s))
+	}
+
+	return api_service.ApiKey{
+		Id:         k.ID,
+		TenantId:   k.TenantID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Role:       api_service.ApiKeyRole(k.Role),
+		Scopes:     scopes,
+		ExpiresAt:  formatOptionalTime(k.ExpiresAt),
+		LastUsedAt: formatOptionalTime(k.LastUsedAt),
+		RevokedAt:  formatOptionalTime(k.RevokedAt),
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  k.CreatedAt.Format(DateTimeFormat),
+	}
+}
+
+func ToRoleResponse(r role.Role) api_service.Role {
+	perms := make([]api_service.Permission, 0, len(r.Permissions))
+	for _, p := range r.Permissions {
+		perms = append(perms, api_service.Permission(p))
+	}
+
+	return api_service.Role{
+		Id:          r.ID,
+		TenantId:    r.TenantID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: perms,
+		BuiltIn:     r.BuiltIn,
+		CreatedAt:   r.CreatedAt.Format(DateTimeFormat),
+		UpdatedAt:   r.UpdatedAt.Format(DateTimeFormat),
+	}
+}
+
+func ToRoleBindingResponse(b role.Binding) api_service.RoleBinding {
+	return api_service.RoleBinding{
+		Id:        b.ID,
+		TenantId:  b.TenantID,
+		UserId:    b.UserID,
+		RoleId:    b.RoleID,
+		CreatedBy: b.CreatedBy,
+		CreatedAt: b.CreatedAt.Format(DateTimeFormat),
+	}
+}
+
+func ToAccessGrantResponse(g access_grant.AccessGrant) api_service.AccessGrant {
+	return api_service.AccessGrant{
+		Id:              g.ID,
+		GranteeTenantId: g.GranteeTenantID,
+		GranteeId:       g.GranteeID,
+		TenantIds:       append([]string{}, g.TenantIDs...),
+		Reason:          g.Reason,
+		StartsAt:        g.StartsAt.Format(DateTimeFormat),
+		ExpiresAt:       g.ExpiresAt.Format(DateTimeFormat),
+		RevokedAt:       formatOptionalTime(g.RevokedAt),
+		Active:          g.IsActive(time.Now()),
+		CreatedBy:       g.CreatedBy,
+		CreatedAt:       g.CreatedAt.Format(DateTimeFormat),
+	}
+}
+
+func ToTenantResponse(t tenant.Tenant) api_service.Tenant {
+	settings := map[string]interface{}{}
+	for k, v := range t.Settings {
+		settings[k] = v
+	}
+
+	return api_service.Tenant{
+		Id:        t.ID,
+		Name:      t.Name,
+		Status:    api_service.TenantStatus(t.Status),
+		Settings:  settings,
+		CreatedAt: t.CreatedAt.Format(DateTimeFormat),
+		UpdatedAt: t.UpdatedAt.Format(DateTimeFormat),
+	}
+}
+
+func ToAsyncTaskResponse(t async_task.AsyncTask) (api_service.AsyncTask, error) {
+	progress, err := JSONToMap(t.Progress)
+	if err != nil {
+		return api_service.AsyncTask{}, err
+	}
+
+	return api_service.AsyncTask{
+		Id:        t.TaskID,
+		Type:      string(t.TaskType),
+		Status:    api_service.AsyncTaskStatus(t.Status),
+		TenantId:  t.TenantUID,
+		Progress:  progress,
+		Error:     t.ErrorMsg,
+		CreatedAt: t.CreatedAt.Format(DateTimeFormat),
+		UpdatedAt: t.UpdatedAt.Format(DateTimeFormat),
+	}, nil
+}
+
+func ToTenantLimitsResponse(l tenant_limit.Limits) api_service.TenantLimits {
+	toRate := func(r tenant_limit.Rate) api_service.RateLimit {
+		return api_service.RateLimit{Rps: r.RPS, Burst: r.Burst}
+	}
+
+	return api_service.TenantLimits{
+		Read:            toRate(l.Read),
+		Write:           toRate(l.Write),
+		Export:          toRate(l.Export),
+		DailyEventQuota: l.DailyEvents,
+		DailyByteQuota:  l.DailyBytes,
+	}
+}
+
+func ToTenantUsageResponse(u quota.Usage) api_service.TenantUsage {
+	days := make([]api_service.TenantDailyUsage, 0, len(u.Days))
+	for _, d := range u.Days {
+		days = append(days, api_service.TenantDailyUsage{
+			Day:         openapi_types.Date{Time: d.Day},
+			Events:      d.Events,
+			Bytes:       d.Bytes,
+			StoredBytes: d.StoredBytes,
+			Searches:    d.Searches,
+			Exports:     d.Exports,
+		})
+	}
+	return api_service.TenantUsage{
+		TenantId:        u.TenantID,
+		From:            openapi_types.Date{Time: u.From},
+		To:              openapi_types.Date{Time: u.To},
+		Days:            days,
+		DailyEventQuota: u.Limits.DailyEvents,
+		DailyByteQuota:  u.Limits.DailyBytes,
+		ResetAt:         u.ResetAt,
+	}
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(DateTimeFormat)
+	return &s
+}
+
+// ToLogDiffResponse decodes the stored diff, its values are kept JSON encoded in the entity
+func ToLogDiffResponse(j *datatypes.JSON) (*[]api_service.LogDiffEntry, error) {
+	if j == nil || len(*j) == 0 {
+		return nil, nil
+	}
+
+	var entries []entity_log.DiffEntry
+	if err := json.Unmarshal(*j, &entries); err != nil {
+		return nil, err
+	}
+
+	resp := make([]api_service.LogDiffEntry, 0, len(entries))
+	for _, e := range entries {
+		oldValue, err := decodeDiffValue(e.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := decodeDiffValue(e.NewValue)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, api_service.LogDiffEntry{
+			Path:     e.Path,
+			Op:       api_service.LogDiffEntryOp(e.Op),
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+	return &resp, nil
+}
+
+func decodeDiffValue(v *string) (*interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(*v), &value); err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// ApplyFieldVisibility removes the fields hidden from the caller's role.
+// Hiding a state also hides the matching side of the diff, which would otherwise leak it.
+func ApplyFieldVisibility(resp *api_service.GetSingleLogResponse, hidden map[string]bool) {
+	if len(hidden) == 0 {
+		return
+	}
+
+	fields := map[string]func(){
+		"session_id":        func() { resp.SessionId = nil },
+		"resource":          func() { resp.Resource = nil },
+		"resource_id":       func() { resp.ResourceId = nil },
+		"ip_address":        func() { resp.IpAddress = nil },
+		"user_agent":        func() { resp.UserAgent = nil },
+		"before_state":      func() { resp.BeforeState = nil },
+		"after_state":       func() { resp.AfterState = nil },
+		"metadata":          func() { resp.Metadata = nil },
+		"schema_id":         func() { resp.SchemaId = nil },
+		"schema_violations": func() { resp.SchemaViolations = nil },
+		"diff":              func() { resp.Diff = nil },
+		"redactions":        func() { resp.Redactions = nil },
+	}
+	for f := range hidden {
+		if clear, ok := fields[f]; ok {
+			clear()
+		}
+	}
+
+	if resp.Diff != nil && (hidden["before_state"] || hidden["after_state"]) {
+		diff := make([]api_service.LogDiffEntry, 0, len(*resp.Diff))
+		for _, e := range *resp.Diff {
+			if hidden["before_state"] {
+				e.OldValue = nil
+			}
+			if hidden["after_state"] {
+				e.NewValue = nil
+			}
+			diff = append(diff, e)
+		}
+		resp.Diff = &diff
+	}
+}
+
+func ToLogSchemaResponse(s log_schema.LogSchema) (api_service.LogSchema, error) {
+	metadata, err := JSONToMap(s.MetadataSchema)
+	if err != nil {
+		return api_service.LogSchema{}, err
+	}
+
+	before, err := JSONToMap(s.BeforeStateSchema)
+	if err != nil {
+		return api_service.LogSchema{}, err
+	}
+
+	after, err := JSONToMap(s.AfterStateSchema)
+	if err != nil {
+		return api_service.LogSchema{}, err
+	}
+
+	return api_service.LogSchema{
+		Id:                s.ID,
+		TenantId:          s.TenantID,
+		Resource:          s.Resource,
+		Version:           s.Version,
+		Enforcement:       api_service.SchemaEnforcement(s.Enforcement),
+		IsActive:          s.IsActive,
+		MetadataSchema:    metadata,
+		BeforeStateSchema: before,
+		AfterStateSchema:  after,
+		CreatedAt:         s.CreatedAt.Format(DateTimeFormat),
 	}, nil
 }
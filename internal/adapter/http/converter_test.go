@@ -55,26 +55,26 @@ func TestMarshallDataAndJSONToMap(t *testing.T) {
 
 func TestToLogStatsResponse(t *testing.T) {
 	now := time.Now()
-	stats := []entitylog.LogStats{
-		{
-			Day:              now,
-			Total:            10,
-			ActionCreate:     1,
-			ActionUpdate:     2,
-			ActionDelete:     3,
-			ActionView:       4,
-			SeverityInfo:     5,
-			SeverityError:    6,
-			SeverityWarning:  7,
-			SeverityCritical: 8,
-		},
+	q := entitylog.StatsQuery{StartTime: now.Add(-time.Hour), EndTime: now, Interval: entitylog.IntervalMinute, GroupBy: entitylog.GroupBySeverity}
+	series := []entitylog.StatSeries{
+		{Key: "ERROR", Total: 3, Points: []entitylog.StatPoint{{Bucket: now.Truncate(time.Minute), Group: "ERROR", Count: 3}}},
 	}
-	resp := h.ToLogStatsResponse(stats)
-	assert.Len(t, resp, 1)
-	assert.Equal(t, now.Format("2006-01-02"), resp[0].Day)
-	assert.Equal(t, int64(10), resp[0].Total)
-	assert.Equal(t, int64(1), resp[0].CREATE)
-	assert.Equal(t, int64(8), resp[0].CRITICAL)
+
+	freshness := now.Add(-time.Hour)
+	resp := h.ToLogStatsResponse(q, entitylog.Stats{Series: series, Freshness: &freshness})
+	assert.Equal(t, api_service.IntervalMinute, resp.Interval)
+	assert.Equal(t, api_service.GroupBySeverity, *resp.GroupBy)
+	assert.Len(t, resp.Series, 1)
+	assert.Equal(t, "ERROR", resp.Series[0].Key)
+	assert.Equal(t, int64(3), resp.Series[0].Total)
+	assert.Equal(t, int64(3), resp.Series[0].Points[0].Count)
+	assert.Equal(t, freshness.UTC(), *resp.Freshness)
+
+	// a total series isn't grouped
+	resp = h.ToLogStatsResponse(entitylog.StatsQuery{Interval: entitylog.IntervalDay}, entitylog.Stats{Series: []entitylog.StatSeries{}})
+	assert.Nil(t, resp.GroupBy)
+	assert.Nil(t, resp.Freshness)
+	assert.NotNil(t, resp.Series)
 }
 
 func TestToSingleLogResponse(t *testing.T) {
@@ -115,3 +115,69 @@ func TestToSingleLogResponse(t *testing.T) {
 	assert.Equal(t, "ua", *resp.UserAgent)        // ✅ dereference
 	assert.Equal(t, "b", (*resp.Metadata)["a"])
 }
+
+func TestToLogDiffResponse(t *testing.T) {
+	resp, err := h.ToLogDiffResponse(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+
+	diff := datatypes.JSON(`[{"path":"plan.tier","op":"changed","old_value":"\"basic\"","new_value":"\"pro\""},{"path":"seats","op":"added","new_value":"5"}]`)
+	resp, err = h.ToLogDiffResponse(&diff)
+	assert.NoError(t, err)
+	assert.Len(t, *resp, 2)
+
+	assert.Equal(t, "plan.tier", (*resp)[0].Path)
+	assert.Equal(t, api_service.Changed, (*resp)[0].Op)
+	assert.Equal(t, "basic", *(*resp)[0].OldValue)
+	assert.Equal(t, "pro", *(*resp)[0].NewValue)
+
+	assert.Equal(t, api_service.Added, (*resp)[1].Op)
+	assert.Nil(t, (*resp)[1].OldValue)
+	assert.Equal(t, float64(5), *(*resp)[1].NewValue)
+}
+
+func TestApplyFieldVisibility(t *testing.T) {
+	ip := "10.0.0.1"
+	before := map[string]interface{}{"status": "draft"}
+	after := map[string]interface{}{"status": "published"}
+	var oldValue, newValue interface{} = "draft", "published"
+	resp := api_service.GetSingleLogResponse{
+		Id:          "log-1",
+		IpAddress:   &ip,
+		BeforeState: &before,
+		AfterState:  &after,
+		Diff:        &[]api_service.LogDiffEntry{{Path: "status", Op: api_service.Changed, OldValue: &oldValue, NewValue: &newValue}},
+	}
+
+	h.ApplyFieldVisibility(&resp, map[string]bool{"ip_address": true, "before_state": true, "unknown": true})
+
+	assert.Equal(t, "log-1", resp.Id)
+	assert.Nil(t, resp.IpAddress)
+	assert.Nil(t, resp.BeforeState)
+	assert.NotNil(t, resp.AfterState)
+	assert.Len(t, *resp.Diff, 1)
+	assert.Nil(t, (*resp.Diff)[0].OldValue)
+	assert.Equal(t, "published", *(*resp.Diff)[0].NewValue)
+}
+
+func TestApplyFieldVisibility_NothingHidden(t *testing.T) {
+	ip := "10.0.0.1"
+	resp := api_service.GetSingleLogResponse{Id: "log-1", IpAddress: &ip}
+
+	h.ApplyFieldVisibility(&resp, nil)
+
+	assert.Equal(t, &ip, resp.IpAddress)
+}
+
+func TestToLogTopInsightsResponse(t *testing.T) {
+	q := entitylog.TopQuery{Dimension: entitylog.InsightResource, Action: "DELETE"}
+	resp := h.ToLogTopInsightsResponse(q, []entitylog.TopEntry{{Key: "invoice", Count: 9}})
+
+	assert.Equal(t, api_service.DimensionResource, resp.Dimension)
+	assert.Equal(t, api_service.DELETE, *resp.Action)
+	assert.Equal(t, []api_service.LogTopEntry{{Key: "invoice", Count: 9}}, resp.Items)
+
+	resp = h.ToLogTopInsightsResponse(entitylog.TopQuery{Dimension: entitylog.InsightUser}, nil)
+	assert.Nil(t, resp.Action)
+	assert.NotNil(t, resp.Items)
+}
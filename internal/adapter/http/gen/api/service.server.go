@@ -20,6 +20,42 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List access grants
+	// (GET /access-grants)
+	ListAccessGrants(c *gin.Context, params ListAccessGrantsParams)
+	// Create an access grant
+	// (POST /access-grants)
+	CreateAccessGrant(c *gin.Context)
+	// Revoke an access grant
+	// (DELETE /access-grants/{id})
+	RevokeAccessGrant(c *gin.Context, id string)
+	// Rebuild the search index
+	// (POST /admin/index-rebuild)
+	StartIndexRebuild(c *gin.Context)
+	// Get the operations dashboard
+	// (GET /admin/ops)
+	GetOpsDashboard(c *gin.Context, params GetOpsDashboardParams)
+	// Reindex logs
+	// (POST /admin/reindex)
+	StartReindex(c *gin.Context)
+	// List API keys
+	// (GET /api-keys)
+	ListApiKeys(c *gin.Context, params ListApiKeysParams)
+	// Issue an API key
+	// (POST /api-keys)
+	IssueApiKey(c *gin.Context)
+	// Revoke an API key
+	// (DELETE /api-keys/{id})
+	RevokeApiKey(c *gin.Context, id string)
+	// Rotate an API key
+	// (POST /api-keys/{id}/rotate)
+	RotateApiKey(c *gin.Context, id string)
+	// Revoke the current token
+	// (POST /auth/revoke)
+	RevokeToken(c *gin.Context)
+	// Revoke all tokens of a user or tenant
+	// (POST /auth/revoke-all)
+	RevokeSessions(c *gin.Context)
 	// Generate auth token
 	// (POST /auth/token)
 	GenerateToken(c *gin.Context)
@@ -38,6 +74,12 @@ type ServerInterface interface {
 	// Export logs
 	// (GET /logs/export)
 	ExportLogs(c *gin.Context, params ExportLogsParams)
+	// Get activity anomalies
+	// (GET /logs/insights/anomalies)
+	GetLogsAnomalies(c *gin.Context, params GetLogsAnomaliesParams)
+	// Get top activity
+	// (GET /logs/insights/top)
+	GetLogsTopInsights(c *gin.Context, params GetLogsTopInsightsParams)
 	// Get logs stat
 	// (GET /logs/stats)
 	GetLogsStat(c *gin.Context, params GetLogsStatParams)
@@ -46,16 +88,85 @@ type ServerInterface interface {
 	StreamLogs(c *gin.Context, params StreamLogsParams)
 	// Get a log by id
 	// (GET /logs/{id})
-	GetLog(c *gin.Context, id string)
+	GetLog(c *gin.Context, id string, params GetLogParams)
 
 	// (GET /ping)
 	GetPing(c *gin.Context)
+	// List redaction rules
+	// (GET /redaction-rules)
+	ListRedactionRules(c *gin.Context)
+	// Create a redaction rule
+	// (POST /redaction-rules)
+	CreateRedactionRule(c *gin.Context)
+	// Delete a redaction rule
+	// (DELETE /redaction-rules/{id})
+	DeleteRedactionRule(c *gin.Context, id string)
+	// List role bindings
+	// (GET /role-bindings)
+	ListRoleBindings(c *gin.Context, params ListRoleBindingsParams)
+	// Grant a role
+	// (POST /role-bindings)
+	CreateRoleBinding(c *gin.Context)
+	// Remove a role binding
+	// (DELETE /role-bindings/{id})
+	DeleteRoleBinding(c *gin.Context, id string)
+	// List roles
+	// (GET /roles)
+	ListRoles(c *gin.Context, params ListRolesParams)
+	// Create a role
+	// (POST /roles)
+	CreateRole(c *gin.Context)
+	// Delete a role
+	// (DELETE /roles/{id})
+	DeleteRole(c *gin.Context, id string)
+	// Update a role
+	// (PUT /roles/{id})
+	UpdateRole(c *gin.Context, id string)
+	// List log schemas
+	// (GET /schemas)
+	ListLogSchemas(c *gin.Context, params ListLogSchemasParams)
+	// Create a log schema
+	// (POST /schemas)
+	CreateLogSchema(c *gin.Context)
+	// Delete a log schema
+	// (DELETE /schemas/{id})
+	DeleteLogSchema(c *gin.Context, id string)
+	// Get a log schema
+	// (GET /schemas/{id})
+	GetLogSchema(c *gin.Context, id string)
+	// Update a log schema
+	// (PUT /schemas/{id})
+	UpdateLogSchema(c *gin.Context, id string)
+	// Get an async task
+	// (GET /tasks/{id})
+	GetTask(c *gin.Context, id string)
 	// List all tenants
 	// (GET /tenants)
 	ListTenants(c *gin.Context)
 	// Create a new tenant
 	// (POST /tenants)
 	CreateTenant(c *gin.Context)
+	// Delete a tenant
+	// (DELETE /tenants/{id})
+	DeleteTenant(c *gin.Context, id string)
+	// Get a tenant
+	// (GET /tenants/{id})
+	GetTenant(c *gin.Context, id string)
+	// Update a tenant
+	// (PATCH /tenants/{id})
+	UpdateTenant(c *gin.Context, id string)
+	// Get the limits of a tenant
+	// (GET /tenants/{id}/limits)
+	GetTenantLimits(c *gin.Context, id string)
+	// Set the limits of a tenant
+	// (PUT /tenants/{id}/limits)
+	SetTenantLimits(c *gin.Context, id string)
+	// Get the usage of a tenant
+	// (GET /tenants/{id}/usage)
+	GetTenantUsage(c *gin.Context, id string, params GetTenantUsageParams)
+	// Get the monthly usage report
+	// (GET /usage/report)
+	GetUsageReport(c *gin.Context, params GetUsageReportParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -67,6 +178,274 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// ListAccessGrants operation middleware
+func (siw *ServerInterfaceWrapper) ListAccessGrants(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAccessGrantsParams
+
+	// ------------- Optional query parameter "grantee_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "grantee_id", c.Request.URL.Query(), &params.GranteeId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grantee_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAccessGrants(c, params)
+}
+
+// CreateAccessGrant operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccessGrant(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateAccessGrant(c)
+}
+
+// RevokeAccessGrant operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAccessGrant(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeAccessGrant(c, id)
+}
+
+// StartIndexRebuild operation middleware
+func (siw *ServerInterfaceWrapper) StartIndexRebuild(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StartIndexRebuild(c)
+}
+
+// GetOpsDashboard operation middleware
+func (siw *ServerInterfaceWrapper) GetOpsDashboard(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOpsDashboardParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", c.Request.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOpsDashboard(c, params)
+}
+
+// StartReindex operation middleware
+func (siw *ServerInterfaceWrapper) StartReindex(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StartReindex(c)
+}
+
+// ListApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListApiKeys(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApiKeysParams
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListApiKeys(c, params)
+}
+
+// IssueApiKey operation middleware
+func (siw *ServerInterfaceWrapper) IssueApiKey(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.IssueApiKey(c)
+}
+
+// RevokeApiKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeApiKey(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeApiKey(c, id)
+}
+
+// RotateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateApiKey(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RotateApiKey(c, id)
+}
+
+// RevokeToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeToken(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeToken(c)
+}
+
+// RevokeSessions operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSessions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeSessions(c)
+}
+
 // GenerateToken operation middleware
 func (siw *ServerInterfaceWrapper) GenerateToken(c *gin.Context) {
 
@@ -87,9 +466,19 @@ func (siw *ServerInterfaceWrapper) SearchLogs(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params SearchLogsParams
 
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "user_id" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
@@ -98,6 +487,14 @@ func (siw *ServerInterfaceWrapper) SearchLogs(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "system" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "system", c.Request.URL.Query(), &params.System)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "action" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
@@ -146,6 +543,14 @@ func (siw *ServerInterfaceWrapper) SearchLogs(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "changed_path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "changed_path", c.Request.URL.Query(), &params.ChangedPath)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter changed_path: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "pageNumber" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "pageNumber", c.Request.URL.Query(), &params.PageNumber)
@@ -177,6 +582,8 @@ func (siw *ServerInterfaceWrapper) CreateLog(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -192,6 +599,8 @@ func (siw *ServerInterfaceWrapper) CreateBulkLogs(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -244,6 +653,8 @@ func (siw *ServerInterfaceWrapper) ExportLogs(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ExportLogsParams
 
@@ -255,6 +666,14 @@ func (siw *ServerInterfaceWrapper) ExportLogs(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "system" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "system", c.Request.URL.Query(), &params.System)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "user_id" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
@@ -303,6 +722,14 @@ func (siw *ServerInterfaceWrapper) ExportLogs(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "changed_path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "changed_path", c.Request.URL.Query(), &params.ChangedPath)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter changed_path: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Required query parameter "format" -------------
 
 	if paramValue := c.Query("format"); paramValue != "" {
@@ -328,15 +755,163 @@ func (siw *ServerInterfaceWrapper) ExportLogs(c *gin.Context) {
 	siw.Handler.ExportLogs(c, params)
 }
 
-// GetLogsStat operation middleware
-func (siw *ServerInterfaceWrapper) GetLogsStat(c *gin.Context) {
+// GetLogsAnomalies operation middleware
+func (siw *ServerInterfaceWrapper) GetLogsAnomalies(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLogsStatParams
+	var params GetLogsAnomaliesParams
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "baseline_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "baseline_days", c.Request.URL.Query(), &params.BaselineDays)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter baseline_days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "threshold" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "threshold", c.Request.URL.Query(), &params.Threshold)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter threshold: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLogsAnomalies(c, params)
+}
+
+// GetLogsTopInsights operation middleware
+func (siw *ServerInterfaceWrapper) GetLogsTopInsights(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogsTopInsightsParams
+
+	// ------------- Required query parameter "dimension" -------------
+
+	if paramValue := c.Query("dimension"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument dimension is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "dimension", c.Request.URL.Query(), &params.Dimension)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dimension: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "start_date" -------------
+
+	if paramValue := c.Query("start_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument start_date is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start_date", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLogsTopInsights(c, params)
+}
+
+// GetLogsStat operation middleware
+func (siw *ServerInterfaceWrapper) GetLogsStat(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogsStatParams
 
 	// ------------- Required query parameter "start_date" -------------
 
@@ -361,6 +936,62 @@ func (siw *ServerInterfaceWrapper) GetLogsStat(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", c.Request.URL.Query(), &params.Interval)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter interval: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", c.Request.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "severity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "severity", c.Request.URL.Query(), &params.Severity)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter severity: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource", c.Request.URL.Query(), &params.Resource)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -413,6 +1044,19 @@ func (siw *ServerInterfaceWrapper) GetLog(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogParams
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -420,7 +1064,7 @@ func (siw *ServerInterfaceWrapper) GetLog(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLog(c, id)
+	siw.Handler.GetLog(c, id, params)
 }
 
 // GetPing operation middleware
@@ -436,8 +1080,8 @@ func (siw *ServerInterfaceWrapper) GetPing(c *gin.Context) {
 	siw.Handler.GetPing(c)
 }
 
-// ListTenants operation middleware
-func (siw *ServerInterfaceWrapper) ListTenants(c *gin.Context) {
+// ListRedactionRules operation middleware
+func (siw *ServerInterfaceWrapper) ListRedactionRules(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -448,11 +1092,11 @@ func (siw *ServerInterfaceWrapper) ListTenants(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListTenants(c)
+	siw.Handler.ListRedactionRules(c)
 }
 
-// CreateTenant operation middleware
-func (siw *ServerInterfaceWrapper) CreateTenant(c *gin.Context) {
+// CreateRedactionRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateRedactionRule(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -463,7 +1107,600 @@ func (siw *ServerInterfaceWrapper) CreateTenant(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateTenant(c)
+	siw.Handler.CreateRedactionRule(c)
+}
+
+// DeleteRedactionRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRedactionRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteRedactionRule(c, id)
+}
+
+// ListRoleBindings operation middleware
+func (siw *ServerInterfaceWrapper) ListRoleBindings(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRoleBindingsParams
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListRoleBindings(c, params)
+}
+
+// CreateRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) CreateRoleBinding(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateRoleBinding(c)
+}
+
+// DeleteRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRoleBinding(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteRoleBinding(c, id)
+}
+
+// ListRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListRoles(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRolesParams
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", c.Request.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tenant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListRoles(c, params)
+}
+
+// CreateRole operation middleware
+func (siw *ServerInterfaceWrapper) CreateRole(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateRole(c)
+}
+
+// DeleteRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteRole(c, id)
+}
+
+// UpdateRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateRole(c, id)
+}
+
+// ListLogSchemas operation middleware
+func (siw *ServerInterfaceWrapper) ListLogSchemas(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLogSchemasParams
+
+	// ------------- Optional query parameter "resource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource", c.Request.URL.Query(), &params.Resource)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "all_versions" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all_versions", c.Request.URL.Query(), &params.AllVersions)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter all_versions: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLogSchemas(c, params)
+}
+
+// CreateLogSchema operation middleware
+func (siw *ServerInterfaceWrapper) CreateLogSchema(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateLogSchema(c)
+}
+
+// DeleteLogSchema operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLogSchema(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteLogSchema(c, id)
+}
+
+// GetLogSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetLogSchema(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLogSchema(c, id)
+}
+
+// UpdateLogSchema operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLogSchema(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateLogSchema(c, id)
+}
+
+// GetTask operation middleware
+func (siw *ServerInterfaceWrapper) GetTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTask(c, id)
+}
+
+// ListTenants operation middleware
+func (siw *ServerInterfaceWrapper) ListTenants(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListTenants(c)
+}
+
+// CreateTenant operation middleware
+func (siw *ServerInterfaceWrapper) CreateTenant(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTenant(c)
+}
+
+// DeleteTenant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTenant(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTenant(c, id)
+}
+
+// GetTenant operation middleware
+func (siw *ServerInterfaceWrapper) GetTenant(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTenant(c, id)
+}
+
+// UpdateTenant operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTenant(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTenant(c, id)
+}
+
+// GetTenantLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetTenantLimits(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTenantLimits(c, id)
+}
+
+// SetTenantLimits operation middleware
+func (siw *ServerInterfaceWrapper) SetTenantLimits(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetTenantLimits(c, id)
+}
+
+// GetTenantUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetTenantUsage(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenantUsageParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTenantUsage(c, id, params)
+}
+
+// GetUsageReport operation middleware
+func (siw *ServerInterfaceWrapper) GetUsageReport(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsageReportParams
+
+	// ------------- Required query parameter "month" -------------
+
+	if paramValue := c.Query("month"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument month is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "month", c.Request.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsageReport(c, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -493,66 +1730,245 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/access-grants", wrapper.ListAccessGrants)
+	router.POST(options.BaseURL+"/access-grants", wrapper.CreateAccessGrant)
+	router.DELETE(options.BaseURL+"/access-grants/:id", wrapper.RevokeAccessGrant)
+	router.POST(options.BaseURL+"/admin/index-rebuild", wrapper.StartIndexRebuild)
+	router.GET(options.BaseURL+"/admin/ops", wrapper.GetOpsDashboard)
+	router.POST(options.BaseURL+"/admin/reindex", wrapper.StartReindex)
+	router.GET(options.BaseURL+"/api-keys", wrapper.ListApiKeys)
+	router.POST(options.BaseURL+"/api-keys", wrapper.IssueApiKey)
+	router.DELETE(options.BaseURL+"/api-keys/:id", wrapper.RevokeApiKey)
+	router.POST(options.BaseURL+"/api-keys/:id/rotate", wrapper.RotateApiKey)
+	router.POST(options.BaseURL+"/auth/revoke", wrapper.RevokeToken)
+	router.POST(options.BaseURL+"/auth/revoke-all", wrapper.RevokeSessions)
 	router.POST(options.BaseURL+"/auth/token", wrapper.GenerateToken)
 	router.GET(options.BaseURL+"/logs", wrapper.SearchLogs)
 	router.POST(options.BaseURL+"/logs", wrapper.CreateLog)
 	router.POST(options.BaseURL+"/logs/bulk", wrapper.CreateBulkLogs)
 	router.DELETE(options.BaseURL+"/logs/cleanup", wrapper.CleanupLogs)
 	router.GET(options.BaseURL+"/logs/export", wrapper.ExportLogs)
+	router.GET(options.BaseURL+"/logs/insights/anomalies", wrapper.GetLogsAnomalies)
+	router.GET(options.BaseURL+"/logs/insights/top", wrapper.GetLogsTopInsights)
 	router.GET(options.BaseURL+"/logs/stats", wrapper.GetLogsStat)
 	router.GET(options.BaseURL+"/logs/stream", wrapper.StreamLogs)
 	router.GET(options.BaseURL+"/logs/:id", wrapper.GetLog)
 	router.GET(options.BaseURL+"/ping", wrapper.GetPing)
+	router.GET(options.BaseURL+"/redaction-rules", wrapper.ListRedactionRules)
+	router.POST(options.BaseURL+"/redaction-rules", wrapper.CreateRedactionRule)
+	router.DELETE(options.BaseURL+"/redaction-rules/:id", wrapper.DeleteRedactionRule)
+	router.GET(options.BaseURL+"/role-bindings", wrapper.ListRoleBindings)
+	router.POST(options.BaseURL+"/role-bindings", wrapper.CreateRoleBinding)
+	router.DELETE(options.BaseURL+"/role-bindings/:id", wrapper.DeleteRoleBinding)
+	router.GET(options.BaseURL+"/roles", wrapper.ListRoles)
+	router.POST(options.BaseURL+"/roles", wrapper.CreateRole)
+	router.DELETE(options.BaseURL+"/roles/:id", wrapper.DeleteRole)
+	router.PUT(options.BaseURL+"/roles/:id", wrapper.UpdateRole)
+	router.GET(options.BaseURL+"/schemas", wrapper.ListLogSchemas)
+	router.POST(options.BaseURL+"/schemas", wrapper.CreateLogSchema)
+	router.DELETE(options.BaseURL+"/schemas/:id", wrapper.DeleteLogSchema)
+	router.GET(options.BaseURL+"/schemas/:id", wrapper.GetLogSchema)
+	router.PUT(options.BaseURL+"/schemas/:id", wrapper.UpdateLogSchema)
+	router.GET(options.BaseURL+"/tasks/:id", wrapper.GetTask)
 	router.GET(options.BaseURL+"/tenants", wrapper.ListTenants)
 	router.POST(options.BaseURL+"/tenants", wrapper.CreateTenant)
+	router.DELETE(options.BaseURL+"/tenants/:id", wrapper.DeleteTenant)
+	router.GET(options.BaseURL+"/tenants/:id", wrapper.GetTenant)
+	router.PATCH(options.BaseURL+"/tenants/:id", wrapper.UpdateTenant)
+	router.GET(options.BaseURL+"/tenants/:id/limits", wrapper.GetTenantLimits)
+	router.PUT(options.BaseURL+"/tenants/:id/limits", wrapper.SetTenantLimits)
+	router.GET(options.BaseURL+"/tenants/:id/usage", wrapper.GetTenantUsage)
+	router.GET(options.BaseURL+"/usage/report", wrapper.GetUsageReport)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xb62/bOBL/VwjefdjFyfUjj231LU3TXha5tIjTLbBFYNDS2OZGFlWSytUb+H8/8CGJ",
-	"tihbTuLe3cJfWskkZ4YzP85LzCOO2DxjKaRS4PARi2gGc6IfzyJJWaqeIM3nOPyKz28uzm4vcIA/f3pn",
-	"Ht5dXF3oh98uL77guwDLRQY4xEJymk7xMsDnHIiEKza9gW85CPmWxQtN8zuZZwmoRzKRwEdCEqlf72GB",
-	"Q/y4VKvHMGEc/GPwAKkcSToHIck8wyEe9Hq9Tq/fGRzd9o7Dk9Ow98vvOMA0G5E45iAEDt2XAM9BCDJV",
-	"AhdP6jdJYiLJOjsOguU8UpPLx+rXEY2dAfUWYAFCUJaaIeclwBJSkkozUD0HOBfAR2QKqcSh+2JH9Pzi",
-	"aRngjLMMuKSg7UVKe/2dwwSH+G/dyrZda9iuteoyWFc7iWOqhkjyySEreQ6lVdn4D4ikzy47La4Z7hFP",
-	"GJ8TtemYSOioIezBkmtIZw3NHo5900vrPvrGKivvJHwFAw/VFTR4xl1EeIcfgFO52GbDYTFvuQIlD0UX",
-	"UE3D3qV6M99yyiFWJ99iq267wDk5pfhBHdQ0drxDpU3HO4iMpQLWXEP9jNcF0KfCdyA8OItBRJxm5qDg",
-	"W4dKHWxxfcHnz5fv6nPXdOWVsHnzt1pVzd4xJXO17F8LZGbW92lmOIucyduE1Wt9wl1wzriiusorYjHU",
-	"9XKeC8nmCNQapKd4FBqDJDSpL36nf4fYLncHPVQklYlHAi0uGubzOeEL7zr9g1o2IXmi3UYqgackGWm+",
-	"Cts2zD2QhMZEER5NtGQ4wBnwOTWHN4aU6t+4sdkoZXI0YXmqflsjerdN/1ZZVjfF9uwqn10+QApcwYbd",
-	"Q9qMGs40EEg8p2lXncAuyWMq9T7d6NMfHMHxyekvHXj9ZtzpD+KjDjk+Oe0cD05PT06Oj3u9Xm8l/PQH",
-	"R+qljkLD0UWhn3nNMK4Dqxa3FGyTS1shpoXeZg29h/bea80WXg8m1ZgK8/r/mtbs8Db/a6b5ZZBDmk6T",
-	"DU70xfKrZt97SLL+D5KsvQS/Q1b2P5mVaaQ/KzW7YtOhJHLNl9gSMDwN8PnN5e3l+dkVDo/KQjA8CfA7",
-	"os6z+jfAFzc3H29w+EuAL6/ff8ThIMC3TJIEh72yigz7tnxUi7+c3VxfXn/A4Zva0StYr+PyOp+PgSM2",
-	"QWYGSthU+Z0Kiak8daCoQvTURLBqC5uImjk7kC2U0UzUzNiFJFl4jvAMUEwWiqCcASLTKYcpkRAj5TOE",
-	"7/RZgzRLpifsIJixazM9Nb4DOYuO2k7Vz5oMmjCudxtrgLUgWcCsWUYzYwcpDVybCarxHciVoG+maKe0",
-	"JrrmKcrOTYl4p3fjHlVr0MIQTqtH77mS1ecyPrF0uuYvMoW7EGfMwG/1RJtBN1HT87ZlaXqZj//Q8dRF",
-	"Mm+3U4hd7bPUhK9rZaun1b1Eul6LR1rxg97gpNN73em/uR30wsHrsD/4vUiIWuautcouwHkWb2FRU6Ir",
-	"1aYYXym5QfRWSUCeU+Vd9JTgBdL1nWrXVf285GbXq7JKpzaUajlX+PsASNOEpjDiNg8fDXq9NQxRCXOB",
-	"w6+HrPxZWflBfc9R312AMzKFUarDi07m9Lugf4JOx6TN0WrOxsLXediUEXtr07IXgwnnRGfLK8I8egKk",
-	"I51vWBZJw65h0exiVQCXW0G6ftZ1XRDlKtoM1W6Net4C4cDPcjlTb2P99r6Q6dcvt7rT4/osswCZ8j4w",
-	"X18UG7O02sBMygwvl9rFTJjWgumD4bM8phJdsemUplN09ukSB/gBuDD0+696r3pKRSyDlGQUh/joVe/V",
-	"kd6lnGmhuySXs27ZiMiY8DjXotWB1GQjL/pJgpCKa5bzjAn4GWs+XLfOLmNn1a3dH1/tV0UslbYIIlmW",
-	"0Eiv7P4hTIVtYLQdZA0NseWqtVXlaA6jxqHeuvXP+5CjQPtyuW7zYR5FIMQkT1CpLWWi4xcUxnRvPczf",
-	"khhZJRkQ246p18IKf2SqwhX+KGfA8Z1a0tU5aPiIp+AByhAIj2YmVf+p3gFEHWTcFhIRyyCuY8YQuDJ5",
-	"bkY4mYMEbmLmKqv3NJHA0XiBbHMPvmeJ7k+bLgFVk77loBvCNtkqfGDgKLJe8cuFPlvKnehg08TXFOBI",
-	"E2jFvqzY25mx6iO1FqkIJDsI5QSll1CK02towdyZ3U4nbpulJlMbhpJwE+9XWLb7AvkUhpDGL8ZuTed5",
-	"knQkfJdImFNHIs6EQDYlQf9AZUrSyhbfdkRAC5Iqll4XgbWiXX6F6fvq4acyGpqY7WPT287nbo/BwVcY",
-	"+EKDMSMHkSdSmKDQ339Q+Jwqn884/RNiw/Ro/0zPdBRE7xkf0ziGdCWn0t7ezaa+3in7VNHqLH4gaQRx",
-	"gXzbFynClQ4fdypz9GYz5ssrIiiFf6ulbqTaHqHKj9Z7ymi8V2Z+cDZT/zC/UyZzAK0PtOuwqwO2SK+6",
-	"4zy5b87GLSE1qZZptcXv2zy5t1nWU0Hcqgj0o3m9CDyg+6+C7hKUG9AdJUDS3H6UTED6rpSYKS68EUuT",
-	"hQfOZqa/YmiROdh2kUrE8DoIn5uyNeQUB6A9C2gVNDZgDL5njMvGOvVCDxt40RT9Ovx4jRhH58PfkDF0",
-	"4VNbF66G4m6Fqyw63S1w6rb7XjhL/8tXxYea9L9Vk+6n8vSeZSt/K4bl3GZ/X3xB1D4vwJF48H4qfNki",
-	"8gX76voaynfZVYKv8CjtPKap97Zk3WErr4bAqpkmcAhPzeHJiSwbwpO5odEUnW5A5jx1b3SoKlWtoULS",
-	"yNyCMG5TIJLGpYNDP3GYcBAzpH5YoBM0p6n4GXWe1oz9ADqg6YtAT8mtjGPbS2rV3tFZ9nvJ5F72YBd3",
-	"rrzl0SFpfM6p/AA22RMGy83nkgOZN6eNQpJxQsUMBCLoC4yHLLoHiSKWpmDzHoYMkTK55EASpGD2xC8i",
-	"mtqT65sn5Y3rcO8bWK0qo9p+nk05iQGJEpXOF63VRZepvl+PuPcr1LBBcxss9kjjZaO9lNmJdp7jBaLx",
-	"c7zgBuVPSCIK7WdEzirl03ij42u0gqD6xshem9L+NOLganyuRjE+3j/jaybRe/2nJDs7Nwfl/sNS3Laz",
-	"56QG8U/mTtfe4KZvB3r2PAOSyBmKZhDdI17hsNrEP/UMuw1zVptTpysqJCLagHScgD3bW3pIatGtJfwj",
-	"An31p1yHOL/t8K1abqdzYbCQJAUInINRWLvlRyIbITY3Ip2/59vrp6H6nwz+4P55Ad8DXF8Urh7EeRFr",
-	"iPKHIg/JeYJD/DhjQi67JKPdhz4O8APhlIwTY/1ZCfHizx9nUmZht5uwiCRqNDx63Xvdd/4MsmGCYn9X",
-	"SlW7hGvOibmHZnOgQvB64+aKTVen6nBVn2duH5WzzOvybvmfAAAA//+3llbCvkAAAA==",
+	"H4sIAAAAAAAC/+x9e3PbNr7oV8Hw3pkm99K2nKTZrmbuH25e67Npk2M727vbZLywCEmoKYAFQDuqx9/9",
+	"DH54ECRBipKf6Wqm01gk8f69X7hKJnxRcEaYksn4KpGTOVlg+PNgMiFSvhOYKf2TfMWLIif6TzxR9IIk",
+	"YyVKkiYTQbAi2SlWyTj8Ub05WwZvzpZJmpCvBRVEmjbBjzSZ6fEIOaVZMg5/VG8UYZip+gfVszSBF/CX",
+	"IFhylozdH/rJBT93cw1+pIlUWCg7oervNPE9y2T8a/ir9urLdZoUghdEKEpkuEdXiVoWJBknZ5znBLPk",
+	"ur5jV0lG5ETQQlGY6gldEKnwokhS11IqQdksua5v6FX7dbira/Ubbnqz4UdB2YQWOEc4z/klyZDiSBCc",
+	"ITUnyOyBTBFGpSQC8Sk8nvOFe9c3XnCUzWH/VvXgOi3cTGJdxvr49OnwdexbBxdXsVcVgKy1hQH8rNUu",
+	"hK+rhCqykNGJ2QdYCLxMrmGmv5dUkEyDpQW2tBP90k4kS7twKK2wpgMfvvhZ8bPfyETpaR5MzKKvEsLK",
+	"hZ7bq6M3BydvkjT59PG1+eP1m/dv4I9/HL75JeilWqzu5YKq5XFBz0mD+Ex4yVQyHqVJhpfJOHk2Go12",
+	"Rvs7z56fjF6Mv385Hv3lX0maLDSujUe7P4xGPzz7Yf+voxcv90f7+9/r1WQZuYi/0zBsKIv7K03+OJUT",
+	"LkisQQvr7eSukikXC6AllKmXL6qDp0yRGRHJtZ1+E1aO9VY7gM/wEj35dPLqqXsgYT/SqvcMK7Kj6ILE",
+	"QMtsQXOEnwhmKMM0XyKYrev7DEuSU1bvnpdnedA3KxdnZvJuEyPzZxkWGcrIBcX6YbUYP6TcbEx/OBH0",
+	"8Id0tbqnBvKYMzPnYTfNry+NwEEU7Av6d7JsQuqtMsaKreVYqtNSup5rP9OE4QUsFgNQFIJM6ddk7P7o",
+	"Y4EhY63+bsP4Y+Ne65D++t6tNYzZ2MjE3R63OGd5ltMJKgKMPifLFM1JXkjDRSd8xqgkiKo4n9qQGQme",
+	"k5AGayBO0gSXGVVcREmunPCC1HnQ/xZkmoyT/7VXiYh7Vj7cMwB/rBu1uVMNlFob1kS/TpYFwF6HY7s0",
+	"P91wpG68NNMM9iPnMznWMkySmr8vBVXE/SBfCy5Ue5fS5OuO7mHnAgs9LZAI3/OZPDI96T9/sR3pv9/Y",
+	"fqp5kIkg6ojIgjPZ5Gu4oKfnhob8aQgHrAf+35aPq+WuhjPfVxMLjvClximNTJKwDFEGaPb/dw4+Hu78",
+	"nSzRnOCMiBRRhahEnOVLJIgqBSMZ4mwS4ZtN0crO00wgCmNyySYnWJ4PJv9ECC6Ssf03PKFC8JkgEnbI",
+	"bN7VdXLdtcN+MvZRRnICG5MmZZFVgwc/bo+a20Vc3YwehwvGWUb15zj/GEzRKJlNKYMUEmlwyYkiGZIc",
+	"TbFIEdmd7QIAYDGZ0wuSIY3QCDMtj5hPMz4pF8TKINhpGG7nUsQFdKDbfWZ6BCxIliLKMvKVZNCVfs9F",
+	"Mccs6M31f7ZEGAkC3/veNAuApUl0hifnU5rn9lP2mcGnSJCzkubZZ5ZEQEwqrEoZUrCCsMzQJFEyZv6S",
+	"5WRCSEY0YEwxzUkWpfR9tNl9HgByBLhajUJoWwOI+jgBgLddt21YGyeGia+gg8BucUR+L4lUP/KsKZvV",
+	"yGWHCnFzU4Q3QBx/ePUMAftFz0bPXjbsDR3jb2p6qAtSw3SFugVgE4X9pKarK35OvOhvW/er4hXAtTdr",
+	"HW37NZniMlcgYDF+OVhbuhVFfD0t2yvYK5RqA9fv+awbnvFUEXGq8YU0eccZmXJB4u/IBWHqVHkU7YRF",
+	"WpziLDOUOvyRJgsiJZ6RZOz/0s8UzrDCzeEEkbwUE5KMqz+rpwaPwl9pIomUlDPzKvjRzRJBYcMzwlQy",
+	"Dn/ElPuo1Y6zlUKJ+eo6bW57HwdrnWrzXNZq3Dq4oYgeHmTQhhYXL+I2BHu6V7F31SmvNfkKDKIWuAAa",
+	"Iu9DiIi+viCCqpWC5bH7biVHDAGq6/UQRcfCVvvs0gBz/PTTNlDTbBV1iCoWbRxvT8BJoG1e0oazO9HM",
+	"m3Q0NsPexR/DuQ4ikKcGBvroZMcnDuI7XsfJ20CzSnyCG1OUTfsgbMrFhCwsuPeiEPz7JmgQ36Lbow1r",
+	"mBXCdayyEhggOiKZQdGjMifdgNRQk7GaJ2O/6l2NqLtkgWluXioiWDL2fw2HhkGMyE+54kgZUWSiuGgj",
+	"3o8lzdUOZchOBk25QILMtPJR5kSm2oOTocs5qT6hEpFFAeTIaR5ucRNBMqpOJ1hkiQY9LIg4BbEvSZPf",
+	"LlVU9zinLBu8qL/rj3sNb7D5LQGQKyRJgQXW+pj+BoG4SNkMXVI1R5bapsidWopCzNFKW4CM6MmUkjwz",
+	"e/Q0SStY6Dj12DQNGLSsF2RW5lgg8rUQhquhJ8GBPE3SG6GAZziw696UNggXeE5+pKBidmOCNsVZmc3+",
+	"tUIq65e9fHerEL++ie8JviAGSrXIf0ZZhjCa5fwM50j3qZ9iVORYaWkHWVvo5ny8Wuxqrqz3sXsD6wsJ",
+	"fzWtcQOpRq3Dq3VM2EQsKEDgcBPwR99mpQW4+8Q0Daqd1krRwBHeYMbd+2+U0pXE/KclMl+2t9RtmW8U",
+	"fDxoqrHJvXG2s6b3MIvAxatSKr5AYG9D8EkEejOiNPWJaMMKLEG2eR3G2mhGVQwyYbrouFwssFhG21mj",
+	"UWZ0b+vnFAznp87G6djHBc5pBo7BU2ujCs/yNCOMEqMbw5mdMq5Op7wEAtbo9MtKg5LZLLs3bnm2Vexc",
+	"3hFGNNs40Wysn/Al4wRnC8r2NBHYc56VOqbuP3tOXnz/8i875Ie/nu3sP8ue7+AX37/cefHs5cvvv3/x",
+	"YjQajWq0cf/Zc/0jThvrUBgfvJduVo0HTqyPRtY6i9PUCN1cQ61pnEVUtTHixtj+29o1+3oVQTefxeeg",
+	"jimb5T3a1YaGl4xOp8n41ysnQBY5ZruKwtnHHn5J19Ljbs9gY2UxCZOtYi9AJkrG9l9thQZYgH/MLysZ",
+	"2L+u0xu1/rKR7QgYlXlT/e2fX1CeY7e2yMPYh1/6LFJyKRVZeM3mP9o+ZeC7yUje6vPeyckFydFkjtmM",
+	"SHRG1CUhrC6CazdLOPd0mEjyns9e0+n0DVNiGRNK7sua8VjMbBXytlUP9w7hosipdm0pRPWJWOFg0Ib7",
+	"bpxwENn0m1n7KhyObb0PjILP0AURoEVZDx66xBJZgUMvcIYpk9FIiwhJaEU22SH8JxC+ITKSWSF2muPZ",
+	"zLgcw+1b4UC4E4Omo0QtQU4jht8zIi7ohHwnEb9k1t+iRE2HDWJWH9xGCsTzRobSQ+1ofY9nDQ4uKZuQ",
+	"bs+H6d3wP+sFBh4GEiubwd9ScWEfd2lqN2ncdvHZObc0K+3tBhsHZgi2EPktRFPBF/rgGeIMYUGQ826v",
+	"6SKL4MZ7LDThQDNcSDSlQqoQA/qg1mhS/mRW+dfMuquZRI9ZypKYuJGb+X83UsE3cb52quXfUhyX3ab1",
+	"4rPe89kB4wuc+93zJ+RiQ08zvJSAHYRlpxkII50nRi5PadGWVYVUp5IQ1t2yW1zO8crGMcHtoSegJVUd",
+	"LdzcjMcQNP3I5vPFxhOsAC41F0TOeZ5FO2nSgQb4XkUCwCt4HkwoHHwPxO6fyeUnScThx6jcYcGjlXlB",
+	"Z3NNzP/YgS1bj57XI/djowY7PXTZwc6vH95dP4hg16vt9HtRm144bgftqpSNOu2KKPVNsyK5PL3AeRlh",
+	"4v/Qj3UkY80Jgc+kZufgnRFkwS9I9lR3y/NsZUc11arWE84y108RMhp4Duo1jJSkiVHX4hFlcT/MK9MC",
+	"PDApMvsm0W+cMpIZIeVzsvs5MbqeBg8EEOZC7Ww0n99DiKXDM/nr6EvNCeM/WGmA4oVz2HUc5yGTdDZX",
+	"r+mCMNlIY6lISWB9COj0sGhh3zWgpe7NPzmquvXPDosD172Z4bF3qt62a7s7WjWwKcnTeurfLfrD08Qq",
+	"b8l49Fh945vHyt7Qqd6v/lLZ0H9jUwjOrtnTBx0VDVGz8IHXoqlEduI6VhoxcglhsVH18Dbd/k1LhXkT",
+	"6vnGZAEhfk+MH0lLClKrPEIHeNsWLhYRgGxtb2oAkS3KanfIsBwdHTwRcFhmp8w0TLBqJPWrL+y1HrbQ",
+	"wLo0ii8dxOxYYfWR01bybiV6AbvtEHdulNlmOu5NbTsrJ+dEDdQ8OxK24OOe1R8T0VYrqpSENCn09jQF",
+	"5P5dGfylnhdXOI+Rs2gqg+HWPmKWl4W2LFGSO05oEilNTLnu2XBwxpX7Ohp7YJfYDgbR+2+NBRqrU8Tz",
+	"jEi1nrhXg7OY/mi2YADUNM64dkCun56zbh7zal1xqmU7ZvStjk+kBaBfHwhsHmbYQepQC6bXV2aCA4gx",
+	"U1QWmr5fzulkDjhhU0cviSBogRURFOf0D5NHod/j2UyQGVZEpvA7199YQiOtwasEEp3TC/KZOXsYgP8u",
+	"ek+mCvHSCsaGhJvGmhG6pvVGkK0xNMCel4VNfRyAUfKd/v5HQCJwe1/gfGjTQ/f9dQXCV2vhs6Wct6K+",
+	"teLyvPrl1+WnWeu/D9vd7oQqizMdB5bhQOpsG4b75HTb/4Hr0/4+rrq2TwKp3T6xkv2XYLaHwQG66S4o",
+	"K2EX5rwUPvv4kpDzgVN0nf7kOnIP/mY6dD9f42Xw6xcYwMzthBcx5bUiGT3pe+uIA1F2p7cJ0SwNpDWB",
+	"Dj+iyu41TAjoysoz67Ma3frcwWLLr13b0fF8M9q5rlc3CxXUFejc0mk3Mjx54tGoJcClMil260oNHvbu",
+	"gsZU+1Mz95i5raQxldGsCy8exKB8AwQM57tZnkg8j369Hgc7AR1qB9NOu7aw3+P3oZCvsZyfcSyyxmnO",
+	"bHhR1usCAlvUaW58ht+Go/D3kpRWaM1Ijpe2BWWn01xTAtOt8aMav4r1c8GwO9A8AbsikerU5plq3+6p",
+	"JBPOMtOm8VpB+nPnzmB5furBMiNfYZmPeHZfzNMmA7Dd1kxlXZ2a/gSp1nsr/bRdwXVAHozeIWD30erQ",
+	"NetAa6Aw+d/682OTwhvTDc0Ot6pmmEMDs7DNbUbwaeqTr+EnMuGjCLASHsvQwHBJWcYvU1AOloWJKPLp",
+	"xMNc01iev4IzW+WVrh1BuLd+y9xqY0QqiKPuSqrVTlVbvUDhc2IVdjtRs1MuEAeFgdHpehUv7K+JNuqV",
+	"hQ+A8w3dT9fWD+o+qB64TyxRHC8wM3Ebtp5C8AQWV/204TBybEpM6CaQ0H0KGbTBh7zwE+OFfz5MeK72",
+	"PKjfUX/oKnnUn75xO1V//MpvWfXcWHLbndvn7f6rBKRmE/+m3chAiPzJ7Ur1xrj2Y290VkLs+bHd+iO3",
+	"80FnVVJ9rOWHor3OD4X7UhOtj5w1A28KYGxJwX0piICsFbTxvflulegHzWJYFhKjZhbG4+WSrfwOO9VB",
+	"Yl+wmCZdOSgKwb/SBVbEGrE13XTr1ZFthF7YEhXokotzIoDMaGujq3SxrNtvu+dRbeOa07jEFLK2dFoP",
+	"8ZMaNmg7b6N+ci2O2HeUrXnPvKXWNEO2GbAmzagQ9ksLaqDhVrsqoG3AmqLg1HK7CmLG1Ky/MZpugaSi",
+	"ee4nPLUlSmBfUhQ6hxlnBFE5PDysAuDWvq/W2Sxoh2AbgE6VveYHiWH5kU4GpgvadHqclUIanBaFjJnk",
+	"7Qdt5xMEkElf+xKrRtmg4HhEIXt6EMSWfSmIQAayVpvBzbRM19H1NhJAQwOTho40mWM5T9IkE7yI+u7r",
+	"2ZZBe0hETFysfm/TozIng4sfVe7kR55Ju7G3d5048ftPiDXZrCYo4/9oQ/pkTrQMuYRCWjqS2cdi/AlS",
+	"X9s1hdZJhm2Ft7fW8cscKyj4ZD+E6bsAnZq3wDgluKAzynCOLkxwjgSWek4KFW7vzTJtbg0BnImpvuSf",
+	"PaM2qyaZg6IO4xPJs64EBAiXn2BTegu8nJbia0AcCGOijOUsapqE3CHHmqwJR94mFZxC1VEceIDz9cQg",
+	"s+y0zyvnLJX93wwkg9VgraSAr5O8lPRCc3+FFtqk+3xf156VJgINVbPQZZ71v67O3rRUpQDFm2USRIl1",
+	"Ki6Fq7tat0rTykRwazKxnjw1LGPX71JtdvHT1TpSpTHVDrk+MWuhREahla5UNmV2VvrdEy4QhaJV54QZ",
+	"t7z2RfoCdSCQVZ9Tiaxl8GkaPMa5FtncOFXsS5223E2K6kOnmrZPiLekEl1iT51Strp0fV9qfpcE05nx",
+	"N7QWYzW9ztodxhL0BLJ/U2QTAaByh3iKJph9p7Sq5II109ssPr+quMA6Ys/jKETwxpcgCAoQyLsrcOjP",
+	"NyaXROoarCx4GJTpuIXa0xVc33Jlj7uqGL0OxG1YXaQCkVoBEXkzB1d/yeNhBUbagaI15Q0+SxOdlBhV",
+	"3o6DtELX6vDntx+SNPnl4Ojnw5/fJWny5ujow1GSJq+ODk8OXx28j/ZU2cm7/KU38ZvcwO0ZGfZquGgy",
+	"oOBqV5HVqMnZb5MxQn70/TVeHPnuGy/e2tGiRVrdhg0Nm2jvTbPaagzmbN2THlLzbPTs+53RDzv7fz15",
+	"Nho/+2G8/+xfjrQMFAVaNVnSRBKlDYGtssg1zhodemNaVG1tx5IGUaOypBmyn6S3IBWtVY2mvm9rhWNX",
+	"8L86ebPy8A3lkuvu7co6wZZ3+uUGsLyCg5olvNZ3U3xyGfihwLhU1vxv8tQyCKaCzFrz2HjOzN+S6LLT",
+	"RAbO+lPXQVvWMy9aQdL0D2+0/a/jDz+7vyHIxlnAU3SpzQ7VrRq6M/R7yRW2UZLD7MnRG0hO5gR9OnkF",
+	"l4/YqMemRhcDNbcngyiz37VBX1f7Ouzz2s6vtcFYItM6BSuvN3AM2c6mrAfDp3WIqVYerKox424o7chf",
+	"v0GsSQMmfU9tC6Irc04Z+lAQdgyTH+j+cXOKZqtr85cdV3uUUsTIDEMaCqi8Qbn2Uum4XX1YVCDBLwcC",
+	"uduG6OiUoY9cqpkgA3tbwwbpdrPaAT+ZVXZHc97gyJBxDM3hnZ4/mU7JRHk/julYG2oMPeBTNNLmgpJB",
+	"Exg8dH5q+gGQdwrfW1qnH5oSDNVTA7od3hRwxMdfmVCA2Lu2f7M1m4E3KbXmuwYZWmkZ9T6lYJ2DG/jV",
+	"D2zRdIs1dyS2Wr8SOz83ajdoVY7wBnUspRZ07UUHpuS/9mpCI2kNDLhUc8IUnYDblgtbNsYlh4UB2ZC0",
+	"JF2nevK2y7gmAcPE+PAaYGryrX+9Vd59m5190ZkPfJGMzT/6yCRRwy4CaN7+wfUDnjw0HrkU94aTXBNp",
+	"8HZqUQJcIf/W//+3ts7+W/F/p+gPIjiy9Xp0J97uWVqn+Bo1RAIZLmIFMlt+tVqOqU6j7eohDCme4eV3",
+	"Lh9ESBd0dgFWyQ1s2O23fMA8N6MSNgO+CXdpE6hidOMTyNGPotD2tnR2bPye+tfd57lpfdq1K8/etl23",
+	"sdpV1WDNakOxqtdjc0SKHE+sC0XjtqAZkfV8YuPPBX+gRLlLHptxuOFHkziTs8amdFYKkiFbG1XeUP46",
+	"raQo+8AIU4b1B2/hp3sH8kDw0vzuiIWJcY/6/tQlSrMT0ipWGCqvUVl7qGVVrW0N1UsjfKhvBsO6rW/g",
+	"VfcXNpCn/T7c4s73na1rh9D9QUf76xVgPbRifdyW1ln3eGObUvPWUTEjGaLMIUYpBGEKud60wsLK3MVF",
+	"mAAK6e5M7L8Tq60decHVfOSvfpNEuWzR4DqrHoH1yyBPI2VQYEbYCrGnz0ajpo7u08m29WK39WLvsF7s",
+	"FsC2AHaXAPYlTQodGm2LbY1H9rdm9PCrs+iFF/kGyX7RytsRva42mRhPDWYXe71xgQqXTBpOIBytu2YF",
+	"8NBJqZ2voAaY7THZGgdlLIDTZgEdfDw0FwhbqccJmVreErxUpApTPluCyOWrMFLdjbkLtfKw+VtSq9Vi",
+	"f93qj3Chi5uPud7lrduj//rlpBUjYxogdwMMHKTu0jSthpgrVSTX18A2p6Dr2lsHkgMoQfuez2aadR98",
+	"PAyLQiX7u6PdkalURhguaDJOnu+Odp/b0GHYxD2TKrRjUoUSyI6LaPLvqVTIfGruJpQpmtJcEWG2Dp4R",
+	"kzNm46+exJKQnkLqERGAi4eZ7TlMlYHJCbwgighDscjXIueZr6AFB/N7ScSyOpfavYGVLhhxUi9h3zTo",
+	"Aj0b0HeI+IO7NsQOcBA21co3E86U1XWhIJOJ49z7zV7oWHU/sHKg37aIunfdugK2hO+nZY78Eeh2L0b7",
+	"a82tb0rmmo7I4J+YtoFyocuPmEGf3/2gZoPQWy7OaJYRVqMkAFshzv76RR+bdHHLEZiHZIoZ8BrbtYVZ",
+	"yDHlsXSI90SHJBaCsgktdLwUwVnlPQPlTxKTgmktxiVTNK8uAUW2PG7qS3VhCGb0pbRtYKlp/p00HQ9E",
+	"vtb1r9U1Ik4rupUT6r1m9rrOKDQmXrfQ5/ZAtIY162DJ6O4B9kecIbs1W8zsxkwDTpAzFqBnD3Zepw1G",
+	"t3dFs2ubH0hULMSbZc3+bVFQRD1ODkUzE/lcR7MuJjfFuXScCHSIKo8yS5p4MogjSQo6dYQnvYi5s7ZM",
+	"Qg/84u4H/pkr9BbuaVoL+A0wrQv8cPeRyee096brecd5FiT+AlOxn1b3H2gvnQk+QE+qFPKnlZXKvHP1",
+	"MG07V5RAkJxgSVKbJYE/MxN2rb9b4EJnIkM6FcP58g8i5C46gBqaplMqkY1jqrKEFFnoKFMjeAYXxcMH",
+	"Lk4h1SNZqRTDXXfavQZZSCZF43JOc+KrdULhM21YVOC9QmdcwZrphMhddGw9leZeAG1zc0qFn6m+oB5x",
+	"RpBUpKjuv88z1wuMoPMLC5LtopM5EcQY3EyhBvAA2i0cQ6oUcAQ7Tar0fmoBQJWCyc+MQuyCre3AIfkE",
+	"9sDkopo0VXuVxy56y7W+ozuBOobv3pygPT2moYimPFudeEERykOTl2MAp0VHnt0ec5ZLNtFRnDGEsePb",
+	"Fd0bUz5pQj6VCKuaTdbBO861eLbc8u4+8mUOsUlPAgL2wcFfnXrxols/9U1wjuyVpLUrWwy10ofzdAzP",
+	"cZBHr+mGtgjwqZWxj//72ACZQZIgD50q+ZlFE9j1R/ZJ6gmeqTMyIUzlS4ehphSLLbJiKrRofLf0yasG",
+	"JggCSImjY+46HvgoCwLMTCxYLdAMfHAI29FtbZcYer8jqlbzqSWZ9JSkdRVjNKXRRfkQ1s68pXPcGWJr",
+	"E+T+YvPjZjxJK2mnW+1295ZUwDo0Dr2h4scMQ5p6ar9VpT4ZzDYVaAbMDuxJtdnZNSfj73VQIf5KF9pF",
+	"8v1I/6LM/NqPR9vdpuGgD6Nr5/zYVJ9DBtdOWZjSfCx3AWFbUhonpe+IIQX+sCTKAjxeQU5d/sMKMRD7",
+	"tFAQTWpSn7ZBsAnNNakLSA8QTU+0HCFygl7jkiVtSOQikNC4qCWggnxkxra3LxkfJ83AfALCGdBKnZFv",
+	"qVAl2cHMiazIqg0stbVsg0n76D0iG/S1yizN+WxjCerIp5vchYElkjk9yKxyb5KbAaF7ltwcTQHY22qx",
+	"dyMdG6zVQenTTZRZy3jtLQmdJKugO7oGWr+Dwrp7ZIqYJiEuFgIQeiFJfuETgSG4ocMZYYqAbeSHeMS+",
+	"Au+m2roJ7sBN4CAvNMNYOOr2DcC1e5pr2db2VswwWE7gS3jjwnGM0u/EVnADGMjph+vggr87YkAdVwje",
+	"t23f3M1HJoKoyge+NfJ/i3jVxI4oaoW8YaVNv7KcOoTrRRprtXdYszXYbw32NzTYrwXJe4K7qLM4+7Ah",
+	"19bMpUke+JNZFfWi3xSCXFBeSoB3qXghoTqlVtnoYkEyihXJV2ECzORRYcJoyzO2yP0YkJvb2/dXI3ep",
+	"5nu2QnMPTgOtgPu+gugw70izniAbrPaboqk2RIAcqEPlnOeLaQsa6+BoJzbe7Ca85T7cLrDwOdYJz3ql",
+	"W1lpJYsJXVIuqtADo27YgsQdnOcrodGa4xwg2mJsfOp1FcTrP6mU2uVorqPSsDhAznJ14e7MQNZVfG6Q",
+	"ivLYkGOrQ6wleuW5KxMYwK8IShx2IAk06saPd/YWA8jvtgjyRBEJiTRFKQouydNd9MEk0ogLo7dn5AIt",
+	"eEbQk6NPP5/+9OH1m/+XkbNy9tTVkEYfDl+/MkgkzE1qLiVuN+K+M1OoiPrt405tjLVRZ3RX8/hWRLUX",
+	"98UrCcvgxkOUUYnPcmJFmcBf1ALX0Oaq5kRY4AdzbJep1TpMbJwp+JI0Qu3Z0opox7EBiKXP2gTfdPDe",
+	"mHx7vc3WuAy1SXGmneVcz7JylmPjvZ3oiBfxnUSMkEya2CioiNIIkborE27LzfwWYuO1nGarbw4Yubov",
+	"e/Nx7dkEkQ/fScQvmal7iZTANNdlo3EmU2QLMKTIcGebJaSDuaQiOKsn71aRxTVWPmBdNqcmsqygxubg",
+	"/TSzhOCJYcNXtxwODA921GLwlPx9fMMnFaQ/3QaQBRc4DjmQ6uthe+IrH26YQhEUBr6FgI4BA4ZFiW83",
+	"fuRtmec7inxVLngJTwSX0hfh/r++Bvews/j9RhAAkgWg5eWcS4J0SqIWFxSmzLixTdV6qBMeXvs/YGa2",
+	"Iu6pteTcclZNgWfkZ5cDFomiGRAqM3ygY5NeFhtm9LAhObE86JhAY2BNEAn1ELYSfyjxp/VUwKYOcJBd",
+	"YDYhmUPYhqsZxJBuH51LNXDRwaHEs1rSMa3f89md5tVAwueDSeXBHDaRyLdgPAyMm4DYBmEnuO+dlfl5",
+	"t9JqO9IftWT4oRD9Y5mfW/l9U7AeFLUQh+9YDMMW3v+c8O7BtAfe3e2LPa5fe9tgVIVpALj5Mq6dDpA3",
+	"bMUIW4Ss2+e1iTTaIYlsQe9GyYQVaPTAWFX4MmoTMZdcGvCizJbJFejV8T+QOWhHZQcbSUyPf2ojid20",
+	"fmPFozBG3JP15lFbObY2hoeyMTweS8KdWw6iBMJu6qCx/bfdnNcVLwPukyYTeREtrfsAQbrDSvjoB+Sr",
+	"2tMTr43hge+MMiyWbchrs04o6U3sNtOcbAWFdWTUgOv3iA6USX03q9zDjC9w7i+hj4gRb6lNFtbcxKMi",
+	"XFgAvJqqJZIFPSeZS4ZxJY2oMPxSex7PsCQ5ZQQ9+WNHTrjwyZBBId7PrJZMCEPAC1+z0/Xy1GcwH35E",
+	"tgQZkeicaU5t5gkX4ALxgOgTV1h9SoVUnxlcfzfEUWRSfQoiKK+Kf/qSTVgq9OwFZPiYC9wnOrNIugTD",
+	"z8xewdeR5KhP5cAfwCaSveEwVrC/Lw5zW8P9SUTG6iZNyCa1FTlUBTb6T48qVIZoMmyeDuxPbennmNH4",
+	"RZDh+XLNBM/Wkv5lcRRQ53JOtU9Br07PHhtsH7jDc0HknOdZfNbPw2LbvDzLA8Cx1dju1fT9ns8qfHys",
+	"2agOGlLkd1frdf+RCWU345U6WdUjJg4I8Uq2qXjRyTCPMDuvGGbqXbFSn1KNX/lMfshGN9W3XI4YHGeK",
+	"eGEqCOQmE4cz4vQwrWCmnxm40QL2XOvSlceVA3ndkdZqAenxbCbIDCsCV55rMNOJ/ybq7nu0oKxURKZ2",
+	"UFNSwK2yIMLmvLKsvl57i0APNzzhxaHd4434YUYXhEmjdXZL2ytIgJ3Ba9/XjdS/O7G6bZn1bTp+b89Q",
+	"cbMyDfthmYb9x1Sm4T2fhaj5WFmjR39bXXXLFzfmi4oXnjf2cESpcE+JVrj3sip0oOm/YR0pcIgUZEou",
+	"0CUh5zVeJ4ucqsromHpLX8VOdTvwD2KWfWa+8Ku5h2YxkOH9zc9CMys9DXRWTs6JMvWwRIQffmaeIaIG",
+	"P4ROFqZGPjBiuOqsrsLm7rq0BVZEUJzTP9ydaaaXagJ6XZbB5pzNTJcVn8XC9h0WH9Pd9/BXfWPUzRXN",
+	"LS97FIrnLzRTc28aMVCTWkWtqni0i36qw9XEVD+xNZCc+WJ32MwpU0Rc4DxZQ57SQCcPXcMh9n2SZ4YE",
+	"mHvL5h6JKEOSCAr185AEuySCCt32sQlXd/e4DFvSTPCyMBc3r7ekd7rhj8tvQuq4d9/HhtGkd+PVumPR",
+	"CKDh0QpFDmVTBJBuC1puxaKNxCJbBw+rXplIELzo9stLhc9yKueah6NfyNkx14RZe7YYsT5Wjkwn3nsv",
+	"CM7RYKt1pNyT7m3jAJJbqSGzP9pvb0a1/LKYCZwRJD3OBJgSh2zhUlpqKSXHHTvXc2KuSkP0vMBKpLuD",
+	"FNdsswMw8teDJKp/swLPlzvN24q5NbdBc99EtvsQs26FsHG8L+xl1hblW9j6kbJZcocA+JHHvd9zgnM1",
+	"R5M5mZz7SkqwI24Rf4Mv7DL8TUo7oszJilpk/mMEH29GyXRPR66jIxj0PuIQakNua4bdUc2wBoQEqOP3",
+	"vycx4YjMqFS2xm/YEYLVmsAAX9J8gxjvOhDcZf5CbaQHrB7WAPttCZhv+W6QBlZ0YFeErK8sI/YanrdG",
+	"SA2m2RLwtn43GA61BdLGxK2HiGakJiJuC5FtpbeNahV1AG4favCc7JxRqGq/St7hOUHu0/rlcMHVGwD3",
+	"T/S3/TfC6Vu2f3Tj3psW/WhtYsOktmrPtjLbXclsIZSHiKMhukdag8t4EDbtFbc1aEzAo34m0ZnG5+oV",
+	"EkF1v+Cudl8JTHdEmQv9NKVn+vHKClsBlNypUFeN85AiXYgTW4FuyycH3asQIGsEx1uMcUDhWbgnyqK/",
+	"8XEPYoVWAKyh7Fb824L1hnVoQyg88wDVBd8rBD7jCKe52qEMZLtZzs/0Dae6aeqTBuBnPXsPzegFYe7m",
+	"HZCuhsqEf7ay/HpNW2HtDoW1dYQ0bzqYlFLxBbRPHVjryCmq5hrW/Q3XQ+WtOxe0HljC2opW37qtrF/S",
+	"WcMmFqAOsACqpNeXhks736KYswXrrWWtC5HSpChXVPBv6Ph1TFqBOJ+KDD804tw+e6tW9YDVrbbsbUsH",
+	"htMBA7L9DNWOvSp6wHh3SQaxFbbN5kEEOnDSjruimky7rOk9VjQ9ZJO8zFzFeXerclACBi7BMungikiF",
+	"OBs4PZznp7a/jqxeSx9XVYO5F7XQH9dWN7wj3TDAqgBPHY4MCruAIk+mD8gXdYVfzV30kJm+Zy66NzdS",
+	"b1Y50sLBHdePNKM8oBoZQPyW2X7TumSFWVHECljgSq3yWPECETblYgK3qQd96+rl1Fy04Mg6xFqckwIK",
+	"BlWv149/MtJ8iHtbm/tWILyZYrgCK9JV4fCmrReJbhwd/6hAe/TQbGSLLo/E80rUEFyJGlM+lpDoYysk",
+	"O0Th01qHKeJ5RsTt8wyj+T0CxLorO8zmQuJoKyRuKcqDW2QGiKUKy/MB+WlQr1ZhVZoaBIXgM6H3xFy+",
+	"iuWSTZDuCj3BbAl3LRGm9G6QzKZ5pQN49AmW53++u1b15sDKHj97fuRcMoS0AKL15np4BiBbYWk0+YX0",
+	"LHfRGSvqo+tGJ7bj+zCFmbG2drAhxLh+cusbxeCeRH+2HqTsk4GXpLjgiN4i+9DgxF2/eHemLTPEA4os",
+	"Dny34Hqr4BqBuCjEBmRwpbXpPZ+ch1FqvDQ5C7+XpCQQyOBK/KEn5hPvkTWB3MD0sZjM6QWRkWr1iqPj",
+	"56muaaQD8SR0+aEgzN7ulPFJudA7maJJzqX9AMoamQIFRt4w0zcvBb+Uu+gtz3N+iagyVYvevTlBgSyT",
+	"fmZm3mBBs2uD4r9IECWonapbW6ygkbFieHR9DDLJs/uRSV67EwcgyLay/aM0rvUQgF7DmmNVDWyOiuOP",
+	"Cfi37GkL/nUY7hLXsJrMY05MDZopkqUsCMtSJAhUG9EslYvqvuk1UMTo2Q+NJXdl/Pomxch7LXtVlCpF",
+	"tu6/hRmq67NrqcNILFvm+TgNY2tIz3tQ6FWutI7BJePmWyOywvUSv5dcYSgTRaZTMjEm9w2Y8HsziT8n",
+	"K7aL2zLkb5QhQ2yagXxwPfWz55gf68MFEYJmZDUm8Wk3+pj68jJFl4K6arn2vnfweUGfusy8JBqNFMmX",
+	"Rok9+HiICjo5N1ohFJAxw5eFS4B5PkKSTDiD7qmag6KMBIG6tTH98fixoO7dygdmdQ8uJWxCQu5bVtgS",
+	"rsdEuI7XI1wtsaCUeEZ6pYLLOQ4UbspmRGp3mLnMAkMlcYInc1OjXA9vr+Rwl1SB9S2kfXELHLTSgqfi",
+	"uqeS5VA7Uaebpr4K8/OXL81FUd0ixidY0KMoIPmWCqnQp5NXes4pwhL985///OfOTz/tvH6d1m/J0mse",
+	"eEue4PU7MMlXDOOPtWnt5c7+aGe0fzIajeG/f21Q6Ps9rmaNqAnrzm5r+oqvmPz+X9eZ/N1LdQaeHitF",
+	"/o8sT/xtiJJAWQcRZPhyT5Dea4p/4kzN82XVrUm0cE4BqS8sTkEvO6M53CXYprMH2usA10vYZv4aJHKh",
+	"98TcpXC2BB9FSOgV3POrXR0NeXSBM1JdxrTQcwSTVCbhVgrtIwKhlX2n3CUMHdcuAJ4dmT1YkeeiaRMM",
+	"hcye1anTMDoE7XvpfYs03QY1urXbP2G77PrvneqYzdtG1K+kAYsa0goH3REyAL2LCwfwpciTcXI151Jd",
+	"7+GC7l3sJ2lygQXFZ7a8x9z7820WVDJXqhjv7eV8gnP9dvz8h9EPup27NrfjAz38Fz+rjjLYBx8PK+xx",
+	"E48ID3xW/xRqCce/M4dQ/9yFdbVbHNVLpdZa+XeRdlorPifLegNTFzk2zAnU4xLkghuAarQr1TzS6Mjl",
+	"zFepwI0JQhZhu+ErwaXccUQ8qBfeGNa8gepGsW4Oqsi1+jlBSFHkymhHfXFuM5xslRfwlcoUujKUnrKM",
+	"fDXVZmynvnG0ZzUnIvgWfl5/uf6fAQBMzl91e0EBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -5,9 +5,12 @@ package api_service
 
 import (
 	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
@@ -19,6 +22,35 @@ const (
 	VIEW   Action = "VIEW"
 )
 
+// Defines values for ApiKeyRole.
+const (
+	ApiKeyRoleAuditor ApiKeyRole = "auditor"
+	ApiKeyRoleUser    ApiKeyRole = "user"
+)
+
+// Defines values for ApiKeyScope.
+const (
+	LogsExport ApiKeyScope = "logs:export"
+	LogsRead   ApiKeyScope = "logs:read"
+	LogsWrite  ApiKeyScope = "logs:write"
+)
+
+// Defines values for AsyncTaskStatus.
+const (
+	Failed    AsyncTaskStatus = "failed"
+	Pending   AsyncTaskStatus = "pending"
+	Running   AsyncTaskStatus = "running"
+	Succeeded AsyncTaskStatus = "succeeded"
+)
+
+// Defines values for CreateRedactionRuleRequestBodyDetector.
+const (
+	BearerToken CreateRedactionRuleRequestBodyDetector = "bearer_token"
+	CreditCard  CreateRedactionRuleRequestBodyDetector = "credit_card"
+	Email       CreateRedactionRuleRequestBodyDetector = "email"
+	Jwt         CreateRedactionRuleRequestBodyDetector = "jwt"
+)
+
 // Defines values for ErrorType.
 const (
 	InternalError    ErrorType = "internal_error"
@@ -27,6 +59,80 @@ const (
 	ValidationFailed ErrorType = "validation_failed"
 )
 
+// Defines values for IssueApiKeyRequestBodyRole.
+const (
+	IssueApiKeyRequestBodyRoleAuditor IssueApiKeyRequestBodyRole = "auditor"
+	IssueApiKeyRequestBodyRoleUser    IssueApiKeyRequestBodyRole = "user"
+)
+
+// Defines values for LogDiffEntryOp.
+const (
+	Added   LogDiffEntryOp = "added"
+	Changed LogDiffEntryOp = "changed"
+	Removed LogDiffEntryOp = "removed"
+)
+
+// Defines values for LogInsightDimension.
+const (
+	DimensionIpAddress LogInsightDimension = "ip_address"
+	DimensionResource  LogInsightDimension = "resource"
+	DimensionUserId    LogInsightDimension = "user_id"
+)
+
+// Defines values for LogStatsGroupBy.
+const (
+	GroupByAction   LogStatsGroupBy = "action"
+	GroupByResource LogStatsGroupBy = "resource"
+	GroupBySeverity LogStatsGroupBy = "severity"
+	GroupByUserId   LogStatsGroupBy = "user_id"
+)
+
+// Defines values for LogStatsInterval.
+const (
+	IntervalDay    LogStatsInterval = "day"
+	IntervalHour   LogStatsInterval = "hour"
+	IntervalMinute LogStatsInterval = "minute"
+	IntervalWeek   LogStatsInterval = "week"
+)
+
+// Defines values for Permission.
+const (
+	PermissionAccessGrantsManage Permission = "access_grants:manage"
+	PermissionApiKeysManage      Permission = "api_keys:manage"
+	PermissionLogsCleanup        Permission = "logs:cleanup"
+	PermissionLogsExport         Permission = "logs:export"
+	PermissionLogsRead           Permission = "logs:read"
+	PermissionLogsWrite          Permission = "logs:write"
+	PermissionOpsManage          Permission = "ops:manage"
+	PermissionOpsRead            Permission = "ops:read"
+	PermissionRedactionRead      Permission = "redaction:read"
+	PermissionRedactionWrite     Permission = "redaction:write"
+	PermissionRolesManage        Permission = "roles:manage"
+	PermissionSchemasRead        Permission = "schemas:read"
+	PermissionSchemasWrite       Permission = "schemas:write"
+	PermissionSessionsRevoke     Permission = "sessions:revoke"
+	PermissionTenantsManage      Permission = "tenants:manage"
+)
+
+// Defines values for RedactionAction.
+const (
+	Drop RedactionAction = "drop"
+	Hash RedactionAction = "hash"
+	Mask RedactionAction = "mask"
+)
+
+// Defines values for RedactionKind.
+const (
+	Field RedactionKind = "field"
+	Regex RedactionKind = "regex"
+)
+
+// Defines values for SchemaEnforcement.
+const (
+	Flag   SchemaEnforcement = "flag"
+	Reject SchemaEnforcement = "reject"
+)
+
 // Defines values for Severity.
 const (
 	CRITICAL Severity = "CRITICAL"
@@ -35,15 +141,158 @@ const (
 	WARNING  Severity = "WARNING"
 )
 
+// Defines values for TaskCountStatus.
+const (
+	TaskCountStatusFailed  TaskCountStatus = "failed"
+	TaskCountStatusPending TaskCountStatus = "pending"
+	TaskCountStatusRunning TaskCountStatus = "running"
+)
+
+// Defines values for TenantStatus.
+const (
+	TenantStatusActive    TenantStatus = "active"
+	TenantStatusDeleting  TenantStatus = "deleting"
+	TenantStatusSuspended TenantStatus = "suspended"
+)
+
+// Defines values for UpdateTenantRequestBodyStatus.
+const (
+	UpdateTenantRequestBodyStatusActive    UpdateTenantRequestBodyStatus = "active"
+	UpdateTenantRequestBodyStatusSuspended UpdateTenantRequestBodyStatus = "suspended"
+)
+
 // Defines values for ExportLogsParamsFormat.
 const (
 	Csv  ExportLogsParamsFormat = "csv"
 	Json ExportLogsParamsFormat = "json"
 )
 
+// AccessGrant defines model for AccessGrant.
+type AccessGrant struct {
+	Active bool `json:"active"`
+
+	// CreatedAt Timestamp
+	CreatedAt string `json:"created_at"`
+	CreatedBy string `json:"created_by"`
+
+	// ExpiresAt Timestamp
+	ExpiresAt string `json:"expires_at"`
+
+	// GranteeId Principal allowed to read the tenants, a user of the home tenant
+	GranteeId string `json:"grantee_id"`
+
+	// GranteeTenantId Home tenant of the principal
+	GranteeTenantId string `json:"grantee_tenant_id"`
+
+	// Id UUID
+	Id     string `json:"id"`
+	Reason string `json:"reason"`
+
+	// RevokedAt Timestamp
+	RevokedAt *string `json:"revoked_at,omitempty"`
+
+	// StartsAt Timestamp
+	StartsAt  string   `json:"starts_at"`
+	TenantIds []string `json:"tenant_ids"`
+}
+
 // Action defines model for Action.
 type Action string
 
+// ActivitySpike defines model for ActivitySpike.
+type ActivitySpike struct {
+	Count int64 `json:"count"`
+
+	// Day Start of the day (UTC) of the spike
+	Day time.Time `json:"day"`
+
+	// Mean Mean daily count of the baseline
+	Mean float64 `json:"mean"`
+
+	// Stddev Standard deviation of the daily counts of the baseline
+	Stddev float64 `json:"stddev"`
+	UserId string  `json:"user_id"`
+	ZScore float64 `json:"z_score"`
+}
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	// CreatedAt Timestamp
+	CreatedAt string `json:"created_at"`
+	CreatedBy string `json:"created_by"`
+
+	// ExpiresAt Timestamp
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
+	// Id UUID
+	Id string `json:"id"`
+
+	// LastUsedAt Timestamp
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+	Name       string  `json:"name"`
+
+	// Prefix Public part of the key, helps to recognise it
+	Prefix string `json:"prefix"`
+
+	// RevokedAt Timestamp
+	RevokedAt *string       `json:"revoked_at,omitempty"`
+	Role      ApiKeyRole    `json:"role"`
+	Scopes    []ApiKeyScope `json:"scopes"`
+	TenantId  string        `json:"tenant_id"`
+}
+
+// ApiKeyRole defines model for ApiKey.Role.
+type ApiKeyRole string
+
+// ApiKeyScope defines model for ApiKeyScope.
+type ApiKeyScope string
+
+// ApiKeySecretResponse defines model for ApiKeySecretResponse.
+type ApiKeySecretResponse struct {
+	ApiKey ApiKey `json:"api_key"`
+
+	// Key Raw key to send in the X-API-Key header, it is only returned once
+	Key string `json:"key"`
+}
+
+// AsyncTask defines model for AsyncTask.
+type AsyncTask struct {
+	// CreatedAt Timestamp
+	CreatedAt string  `json:"created_at"`
+	Error     *string `json:"error,omitempty"`
+
+	// Id UUID
+	Id string `json:"id"`
+
+	// Progress Steps completed so far, e.g. the archived logs and deleted documents of a tenant deletion, or the logs
+	// compared, indexed and the orphan documents deleted by a reindex, or the partitions backfilled by an
+	// index rebuild
+	Progress *map[string]interface{} `json:"progress,omitempty"`
+	Status   AsyncTaskStatus         `json:"status"`
+	TenantId *string                 `json:"tenant_id,omitempty"`
+	Type     string                  `json:"type"`
+
+	// UpdatedAt Timestamp
+	UpdatedAt string `json:"updated_at"`
+}
+
+// AsyncTaskStatus defines model for AsyncTask.Status.
+type AsyncTaskStatus string
+
+// CreateAccessGrantRequestBody defines model for CreateAccessGrantRequestBody.
+type CreateAccessGrantRequestBody struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	GranteeId string    `json:"grantee_id"`
+
+	// GranteeTenantId Tenant of the token of the grantee
+	GranteeTenantId string `json:"grantee_tenant_id"`
+	Reason          string `json:"reason"`
+
+	// StartsAt Defaults to now
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+	TenantIds []string   `json:"tenant_ids"`
+}
+
 // CreateLogRequestBody defines model for CreateLogRequestBody.
 type CreateLogRequestBody struct {
 	Action         Action                  `json:"action"`
@@ -71,6 +320,55 @@ type CreateLogResponse struct {
 	Id string `json:"id"`
 }
 
+// CreateLogSchemaRequestBody defines model for CreateLogSchemaRequestBody.
+type CreateLogSchemaRequestBody struct {
+	AfterStateSchema  *map[string]interface{} `json:"after_state_schema,omitempty"`
+	BeforeStateSchema *map[string]interface{} `json:"before_state_schema,omitempty"`
+	Enforcement       SchemaEnforcement       `json:"enforcement"`
+	MetadataSchema    *map[string]interface{} `json:"metadata_schema,omitempty"`
+	Resource          *string                 `json:"resource,omitempty"`
+	TenantId          string                  `json:"tenant_id"`
+}
+
+// CreateRedactionRuleRequestBody defines model for CreateRedactionRuleRequestBody.
+type CreateRedactionRuleRequestBody struct {
+	Action RedactionAction `json:"action"`
+
+	// Detector Built-in pattern for regex rules, used when pattern is empty
+	Detector *CreateRedactionRuleRequestBodyDetector `json:"detector,omitempty"`
+	Kind     RedactionKind                           `json:"kind"`
+	Name     string                                  `json:"name"`
+
+	// Path Dot separated path starting with message, metadata, before_state or after_state (field rules)
+	Path *string `json:"path,omitempty"`
+
+	// Pattern Regular expression (regex rules)
+	Pattern  *string `json:"pattern,omitempty"`
+	TenantId string  `json:"tenant_id"`
+}
+
+// CreateRedactionRuleRequestBodyDetector Built-in pattern for regex rules, used when pattern is empty
+type CreateRedactionRuleRequestBodyDetector string
+
+// CreateRoleBindingRequestBody defines model for CreateRoleBindingRequestBody.
+type CreateRoleBindingRequestBody struct {
+	RoleId string `json:"role_id"`
+
+	// TenantId Leave empty to bind a global role to a platform user
+	TenantId *string `json:"tenant_id,omitempty"`
+	UserId   string  `json:"user_id"`
+}
+
+// CreateRoleRequestBody defines model for CreateRoleRequestBody.
+type CreateRoleRequestBody struct {
+	Description *string      `json:"description,omitempty"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+
+	// TenantId Leave empty for a global role
+	TenantId *string `json:"tenant_id,omitempty"`
+}
+
 // CreateTenantRequestBody defines model for CreateTenantRequestBody.
 type CreateTenantRequestBody struct {
 	Name string `json:"name"`
@@ -110,77 +408,450 @@ type GetSingleLogResponse struct {
 	AfterState  *map[string]interface{} `json:"after_state,omitempty"`
 	BeforeState *map[string]interface{} `json:"before_state,omitempty"`
 
+	// Diff Field-level changes between before_state and after_state
+	Diff *[]LogDiffEntry `json:"diff,omitempty"`
+
 	// EventTimestamp Timestamp
 	EventTimestamp string `json:"event_timestamp"`
 
 	// Id UUID
-	Id         string                  `json:"id"`
-	IpAddress  *string                 `json:"ip_address,omitempty"`
-	Message    string                  `json:"message"`
-	Metadata   *map[string]interface{} `json:"metadata,omitempty"`
-	Resource   *string                 `json:"resource,omitempty"`
-	ResourceId *string                 `json:"resource_id,omitempty"`
-	SessionId  *string                 `json:"session_id,omitempty"`
-	Severity   Severity                `json:"severity"`
-	TenantId   string                  `json:"tenant_id"`
-	UserAgent  *string                 `json:"user_agent,omitempty"`
-	UserId     string                  `json:"user_id"`
+	Id        string                  `json:"id"`
+	IpAddress *string                 `json:"ip_address,omitempty"`
+	Message   string                  `json:"message"`
+	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
+
+	// Redactions Redactions applied at ingestion
+	Redactions *[]RedactionSummary `json:"redactions,omitempty"`
+	Resource   *string             `json:"resource,omitempty"`
+	ResourceId *string             `json:"resource_id,omitempty"`
+
+	// SchemaId UUID of the schema version the log was validated against
+	SchemaId *string `json:"schema_id,omitempty"`
+
+	// SchemaViolations Schema violations recorded for a flagged log
+	SchemaViolations *[]string `json:"schema_violations,omitempty"`
+	SessionId        *string   `json:"session_id,omitempty"`
+	Severity         Severity  `json:"severity"`
+
+	// System Entry of the service's own audit trail
+	System    *bool   `json:"system,omitempty"`
+	TenantId  string  `json:"tenant_id"`
+	UserAgent *string `json:"user_agent,omitempty"`
+	UserId    string  `json:"user_id"`
+}
+
+// IndexLag defines model for IndexLag.
+type IndexLag struct {
+	// Since Logs with an event timestamp from then on are compared
+	Since time.Time `json:"since"`
+
+	// Tenants Largest gaps first
+	Tenants []TenantIndexLag `json:"tenants"`
+}
+
+// IssueApiKeyRequestBody defines model for IssueApiKeyRequestBody.
+type IssueApiKeyRequestBody struct {
+	ExpiresAt *time.Time                 `json:"expires_at,omitempty"`
+	Name      string                     `json:"name"`
+	Role      IssueApiKeyRequestBodyRole `json:"role"`
+	Scopes    []ApiKeyScope              `json:"scopes"`
+	TenantId  string                     `json:"tenant_id"`
 }
 
-// LogStat defines model for LogStat.
-type LogStat struct {
-	// CREATE Number of CREATE logs
-	CREATE int64 `json:"CREATE"`
+// IssueApiKeyRequestBodyRole defines model for IssueApiKeyRequestBody.Role.
+type IssueApiKeyRequestBodyRole string
+
+// LogAnomalies defines model for LogAnomalies.
+type LogAnomalies struct {
+	BaselineDays int         `json:"baseline_days"`
+	EndDate      time.Time   `json:"end_date"`
+	NewIps       []NewUserIP `json:"new_ips"`
+
+	// Spikes Highest z-score first
+	Spikes    []ActivitySpike `json:"spikes"`
+	StartDate time.Time       `json:"start_date"`
+	Threshold float64         `json:"threshold"`
+}
+
+// LogDiffEntry defines model for LogDiffEntry.
+type LogDiffEntry struct {
+	// NewValue Value in after_state (absent when removed)
+	NewValue *interface{} `json:"new_value,omitempty"`
+
+	// OldValue Value in before_state (absent when added)
+	OldValue *interface{}   `json:"old_value,omitempty"`
+	Op       LogDiffEntryOp `json:"op"`
+
+	// Path Changed path, objects joined with "." and array items indexed, e.g. plan.tier or tags[0]
+	Path string `json:"path"`
+}
+
+// LogDiffEntryOp defines model for LogDiffEntry.Op.
+type LogDiffEntryOp string
+
+// LogInsightDimension defines model for LogInsightDimension.
+type LogInsightDimension string
+
+// LogSchema defines model for LogSchema.
+type LogSchema struct {
+	AfterStateSchema  *map[string]interface{} `json:"after_state_schema,omitempty"`
+	BeforeStateSchema *map[string]interface{} `json:"before_state_schema,omitempty"`
+
+	// CreatedAt Timestamp
+	CreatedAt   string            `json:"created_at"`
+	Enforcement SchemaEnforcement `json:"enforcement"`
+
+	// Id UUID of this schema version
+	Id string `json:"id"`
+
+	// IsActive Only the active version is enforced on new logs
+	IsActive       bool                    `json:"is_active"`
+	MetadataSchema *map[string]interface{} `json:"metadata_schema,omitempty"`
+
+	// Resource Resource the schema applies to (empty means every resource of the tenant)
+	Resource *string `json:"resource,omitempty"`
+	TenantId string  `json:"tenant_id"`
+
+	// Version Version number, incremented on every update
+	Version int `json:"version"`
+}
+
+// LogStatPoint defines model for LogStatPoint.
+type LogStatPoint struct {
+	Count int64 `json:"count"`
+
+	// Time Start of the bucket
+	Time time.Time `json:"time"`
+}
+
+// LogStatSeries defines model for LogStatSeries.
+type LogStatSeries struct {
+	// Key Value of the grouped field, e.g. CREATE, or total when not grouped
+	Key string `json:"key"`
+
+	// Points Buckets with logs, oldest first
+	Points []LogStatPoint `json:"points"`
+	Total  int64          `json:"total"`
+}
+
+// LogStats defines model for LogStats.
+type LogStats struct {
+	EndDate time.Time `json:"end_date"`
+
+	// Freshness Time up to which the counts were materialized by the aggregates, the later buckets are counted live
+	// from the logs. Left out when every bucket is counted from the logs.
+	Freshness *time.Time       `json:"freshness,omitempty"`
+	GroupBy   *LogStatsGroupBy `json:"group_by,omitempty"`
+	Interval  LogStatsInterval `json:"interval"`
+	Series    []LogStatSeries  `json:"series"`
+	StartDate time.Time        `json:"start_date"`
+}
 
-	// CRITICAL Number of CRITICAL logs
-	CRITICAL int64 `json:"CRITICAL"`
+// LogStatsGroupBy defines model for LogStatsGroupBy.
+type LogStatsGroupBy string
 
-	// DELETE Number of DELETE logs
-	DELETE int64 `json:"DELETE"`
+// LogStatsInterval defines model for LogStatsInterval.
+type LogStatsInterval string
 
-	// Day The day of the aggregated stats
-	Day string `json:"Day"`
+// LogTopEntry defines model for LogTopEntry.
+type LogTopEntry struct {
+	Count int64 `json:"count"`
 
-	// ERROR Number of ERROR logs
-	ERROR int64 `json:"ERROR"`
+	// Key User id, resource or IP address
+	Key string `json:"key"`
+}
 
-	// INFO Number of INFO logs
-	INFO int64 `json:"INFO"`
+// LogTopInsights defines model for LogTopInsights.
+type LogTopInsights struct {
+	Action    *Action             `json:"action,omitempty"`
+	Dimension LogInsightDimension `json:"dimension"`
+	EndDate   time.Time           `json:"end_date"`
 
-	// Total Total logs for the day
-	Total int64 `json:"Total"`
+	// Items Most logs first
+	Items     []LogTopEntry `json:"items"`
+	StartDate time.Time     `json:"start_date"`
+}
 
-	// UPDATE Number of UPDATE logs
-	UPDATE int64 `json:"UPDATE"`
+// NewUserIP defines model for NewUserIP.
+type NewUserIP struct {
+	Count     int64     `json:"count"`
+	FirstSeen time.Time `json:"first_seen"`
+	IpAddress string    `json:"ip_address"`
+	LastSeen  time.Time `json:"last_seen"`
+	UserId    string    `json:"user_id"`
+}
 
-	// VIEW Number of VIEW logs
-	VIEW int64 `json:"VIEW"`
+// OpsDashboard defines model for OpsDashboard.
+type OpsDashboard struct {
+	GeneratedAt time.Time     `json:"generated_at"`
+	IndexLag    IndexLag      `json:"index_lag"`
+	Queues      []QueueStatus `json:"queues"`
 
-	// WARNING Number of WARNING logs
-	WARNING int64 `json:"WARNING"`
+	// Tasks Pending and running tasks, and the tasks failed since the start of the window, by type and status
+	Tasks []TaskCount `json:"tasks"`
 }
 
+// Permission Tenant roles only take logs, schemas and redaction permissions
+type Permission string
+
 // Pong defines model for Pong.
 type Pong struct {
 	Ping string `json:"ping"`
 }
 
+// QueueStatus defines model for QueueStatus.
+type QueueStatus struct {
+	Delayed int64 `json:"delayed"`
+
+	// InFlight Approximate number of messages received by a worker and not deleted yet
+	InFlight int64 `json:"in_flight"`
+
+	// Messages Approximate number of messages waiting to be received
+	Messages int64  `json:"messages"`
+	Name     string `json:"name"`
+
+	// OldestPendingAgeSeconds Age of the oldest pending task, an approximation of the age of the oldest message
+	OldestPendingAgeSeconds *int64 `json:"oldest_pending_age_seconds,omitempty"`
+
+	// OldestPendingTask Creation time of the oldest task still pending for the queue, absent when none is
+	OldestPendingTask *time.Time `json:"oldest_pending_task,omitempty"`
+	TaskType          string     `json:"task_type"`
+}
+
+// RateLimit defines model for RateLimit.
+type RateLimit struct {
+	// Burst Requests allowed at once
+	Burst int `json:"burst"`
+
+	// Rps Requests refilled per second
+	Rps int `json:"rps"`
+}
+
+// RedactionAction defines model for RedactionAction.
+type RedactionAction string
+
+// RedactionKind defines model for RedactionKind.
+type RedactionKind string
+
+// RedactionRule defines model for RedactionRule.
+type RedactionRule struct {
+	Action RedactionAction `json:"action"`
+
+	// CreatedAt Timestamp
+	CreatedAt string `json:"created_at"`
+
+	// Id UUID
+	Id   string        `json:"id"`
+	Kind RedactionKind `json:"kind"`
+	Name string        `json:"name"`
+
+	// Path Dot separated field path, * matches any key or array item (field rules)
+	Path *string `json:"path,omitempty"`
+
+	// Pattern Regular expression (regex rules)
+	Pattern  *string `json:"pattern,omitempty"`
+	TenantId string  `json:"tenant_id"`
+}
+
+// RedactionSummary What a redaction rule removed from the log, the original value is not kept
+type RedactionSummary struct {
+	Action RedactionAction `json:"action"`
+
+	// Count Number of redacted matches
+	Count int `json:"count"`
+
+	// Field Redacted location, e.g. message or metadata.user.email
+	Field string `json:"field"`
+
+	// Rule Rule name
+	Rule   string `json:"rule"`
+	RuleId string `json:"rule_id"`
+}
+
+// ReindexRequestBody defines model for ReindexRequestBody.
+type ReindexRequestBody struct {
+	// EndTime Exclusive, at most 31 days after start_time, a time in the future stands for now
+	EndTime   time.Time `json:"end_time"`
+	StartTime time.Time `json:"start_time"`
+
+	// TenantId Leave empty to reindex every tenant
+	TenantId *string `json:"tenant_id,omitempty"`
+}
+
+// RevokeSessionsRequestBody user_id revokes a user in tenant_id (or its tokens without a tenant when tenant_id is missing), tenant_id alone revokes the tenant
+type RevokeSessionsRequestBody struct {
+	TenantId *string `json:"tenant_id,omitempty"`
+	UserId   *string `json:"user_id,omitempty"`
+}
+
+// Role defines model for Role.
+type Role struct {
+	// BuiltIn Built-in roles (admin, auditor, user) can't be changed
+	BuiltIn bool `json:"built_in"`
+
+	// CreatedAt Timestamp
+	CreatedAt   string  `json:"created_at"`
+	Description *string `json:"description,omitempty"`
+
+	// Id UUID
+	Id          string       `json:"id"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+
+	// TenantId Empty for global roles
+	TenantId *string `json:"tenant_id,omitempty"`
+
+	// UpdatedAt Timestamp
+	UpdatedAt string `json:"updated_at"`
+}
+
+// RoleBinding defines model for RoleBinding.
+type RoleBinding struct {
+	// CreatedAt Timestamp
+	CreatedAt string `json:"created_at"`
+	CreatedBy string `json:"created_by"`
+
+	// Id UUID
+	Id     string `json:"id"`
+	RoleId string `json:"role_id"`
+
+	// TenantId Empty for platform users
+	TenantId *string `json:"tenant_id,omitempty"`
+	UserId   string  `json:"user_id"`
+}
+
+// SchemaEnforcement defines model for SchemaEnforcement.
+type SchemaEnforcement string
+
 // Severity defines model for Severity.
 type Severity string
 
+// TaskCount defines model for TaskCount.
+type TaskCount struct {
+	Count           int64           `json:"count"`
+	OldestCreatedAt time.Time       `json:"oldest_created_at"`
+	Status          TaskCountStatus `json:"status"`
+	Type            string          `json:"type"`
+}
+
+// TaskCountStatus defines model for TaskCount.Status.
+type TaskCountStatus string
+
 // Tenant defines model for Tenant.
 type Tenant struct {
 	// CreatedAt Timestamp
 	CreatedAt string `json:"created_at"`
 
 	// Id uuid string
-	Id   string `json:"id"`
-	Name string `json:"name"`
+	Id       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Settings map[string]interface{} `json:"settings"`
+
+	// Status Suspended and deleting tenants can't authenticate nor ingest logs
+	Status TenantStatus `json:"status"`
 
 	// UpdatedAt Timestamp
 	UpdatedAt string `json:"updated_at"`
 }
 
+// TenantDailyUsage defines model for TenantDailyUsage.
+type TenantDailyUsage struct {
+	// Bytes Size of the JSON of the logs received, what the daily byte quota counts
+	Bytes int64 `json:"bytes"`
+
+	// Day The UTC day counted
+	Day      openapi_types.Date `json:"day"`
+	Events   int64              `json:"events"`
+	Exports  int64              `json:"exports"`
+	Searches int64              `json:"searches"`
+
+	// StoredBytes Size of the JSON of the logs as stored, once redacted
+	StoredBytes int64 `json:"stored_bytes"`
+}
+
+// TenantIndexLag defines model for TenantIndexLag.
+type TenantIndexLag struct {
+	// Indexed Documents in OpenSearch
+	Indexed int64 `json:"indexed"`
+
+	// Missing Logs not indexed yet, negative when documents outlive their rows
+	Missing int64 `json:"missing"`
+
+	// Stored Logs in Postgres
+	Stored   int64  `json:"stored"`
+	TenantId string `json:"tenant_id"`
+}
+
+// TenantLimits The limits in effect for the tenant, a quota of 0 is unlimited
+type TenantLimits struct {
+	DailyByteQuota  int64     `json:"daily_byte_quota"`
+	DailyEventQuota int64     `json:"daily_event_quota"`
+	Export          RateLimit `json:"export"`
+	Read            RateLimit `json:"read"`
+	Write           RateLimit `json:"write"`
+}
+
+// TenantStatus Suspended and deleting tenants can't authenticate nor ingest logs
+type TenantStatus string
+
+// TenantUsage defines model for TenantUsage.
+type TenantUsage struct {
+	DailyByteQuota  int64 `json:"daily_byte_quota"`
+	DailyEventQuota int64 `json:"daily_event_quota"`
+
+	// Days A row per day from `from` to `to`, zero on the days without usage
+	Days []TenantDailyUsage `json:"days"`
+	From openapi_types.Date `json:"from"`
+
+	// ResetAt When today's counters start over
+	ResetAt  time.Time          `json:"reset_at"`
+	TenantId string             `json:"tenant_id"`
+	To       openapi_types.Date `json:"to"`
+}
+
+// UpdateLogSchemaRequestBody defines model for UpdateLogSchemaRequestBody.
+type UpdateLogSchemaRequestBody struct {
+	AfterStateSchema  *map[string]interface{} `json:"after_state_schema,omitempty"`
+	BeforeStateSchema *map[string]interface{} `json:"before_state_schema,omitempty"`
+	Enforcement       SchemaEnforcement       `json:"enforcement"`
+	MetadataSchema    *map[string]interface{} `json:"metadata_schema,omitempty"`
+}
+
+// UpdateRoleRequestBody defines model for UpdateRoleRequestBody.
+type UpdateRoleRequestBody struct {
+	Description *string      `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// UpdateTenantLimitsRequestBody Replaces the overrides of the tenant, the fields left out go back to the configured defaults
+type UpdateTenantLimitsRequestBody struct {
+	// DailyByteQuota 0 is unlimited, the size of a log is the size of its JSON
+	DailyByteQuota *int64 `json:"daily_byte_quota,omitempty"`
+
+	// DailyEventQuota 0 is unlimited
+	DailyEventQuota *int64 `json:"daily_event_quota,omitempty"`
+	ExportBurst     *int   `json:"export_burst,omitempty"`
+	ExportRps       *int   `json:"export_rps,omitempty"`
+	ReadBurst       *int   `json:"read_burst,omitempty"`
+	ReadRps         *int   `json:"read_rps,omitempty"`
+	WriteBurst      *int   `json:"write_burst,omitempty"`
+	WriteRps        *int   `json:"write_rps,omitempty"`
+}
+
+// UpdateTenantRequestBody defines model for UpdateTenantRequestBody.
+type UpdateTenantRequestBody struct {
+	Name *string `json:"name,omitempty"`
+
+	// Settings Merged into the current settings, a null value removes the key
+	Settings *map[string]interface{} `json:"settings,omitempty"`
+
+	// Status The deleting status is only set by the deletion
+	Status *UpdateTenantRequestBodyStatus `json:"status,omitempty"`
+}
+
+// UpdateTenantRequestBodyStatus The deleting status is only set by the deletion
+type UpdateTenantRequestBodyStatus string
+
 // InlineResponse200 defines model for inline_response_200.
 type InlineResponse200 struct {
 	Items      []GetSingleLogResponse `json:"items"`
@@ -189,11 +860,37 @@ type InlineResponse200 struct {
 	Total      int64                  `json:"total"`
 }
 
+// ListAccessGrantsParams defines parameters for ListAccessGrants.
+type ListAccessGrantsParams struct {
+	GranteeId *string `form:"grantee_id,omitempty" json:"grantee_id,omitempty"`
+	TenantId  *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+}
+
+// GetOpsDashboardParams defines parameters for GetOpsDashboard.
+type GetOpsDashboardParams struct {
+	// Since Start of the window, one hour ago by default and at most 7 days ago
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Tenants reported in the index lag
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListApiKeysParams defines parameters for ListApiKeys.
+type ListApiKeysParams struct {
+	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+}
+
 // SearchLogsParams defines parameters for SearchLogs.
 type SearchLogsParams struct {
+	// TenantId Tenant to read, another tenant than the caller's needs an active access grant
+	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+
 	// UserId Filter by user
 	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
 
+	// System Search the service's own audit trail (reads, exports, admin actions) instead of the tenant's logs (admin only)
+	System *bool `form:"system,omitempty" json:"system,omitempty"`
+
 	// Action Filter by action type
 	Action *Action `form:"action,omitempty" json:"action,omitempty"`
 
@@ -206,9 +903,12 @@ type SearchLogsParams struct {
 	EndTime   *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
 
 	// Q Full-text search across message + metadata
-	Q          *string `form:"q,omitempty" json:"q,omitempty"`
-	PageNumber *int    `form:"pageNumber,omitempty" json:"pageNumber,omitempty"`
-	PageSize   *int    `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// ChangedPath Only logs whose diff contains the path, e.g. plan.tier
+	ChangedPath *string `form:"changed_path,omitempty" json:"changed_path,omitempty"`
+	PageNumber  *int    `form:"pageNumber,omitempty" json:"pageNumber,omitempty"`
+	PageSize    *int    `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
 // CreateBulkLogsJSONBody defines parameters for CreateBulkLogs.
@@ -221,9 +921,12 @@ type CleanupLogsParams struct {
 
 // ExportLogsParams defines parameters for ExportLogs.
 type ExportLogsParams struct {
-	// TenantId Filter by tenant
+	// TenantId Tenant to read, another tenant than the caller's needs an active access grant
 	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
-	UserId   *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// System Export the service's own audit trail instead of the tenant's logs (admin only)
+	System *bool   `form:"system,omitempty" json:"system,omitempty"`
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
 
 	// Action Filter by action type
 	Action *Action `form:"action,omitempty" json:"action,omitempty"`
@@ -236,6 +939,9 @@ type ExportLogsParams struct {
 	// Q Full-text search
 	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
+	// ChangedPath Only logs whose diff contains the path
+	ChangedPath *string `form:"changed_path,omitempty" json:"changed_path,omitempty"`
+
 	// Format Export format
 	Format ExportLogsParamsFormat `form:"format" json:"format"`
 }
@@ -243,10 +949,50 @@ type ExportLogsParams struct {
 // ExportLogsParamsFormat defines parameters for ExportLogs.
 type ExportLogsParamsFormat string
 
+// GetLogsAnomaliesParams defines parameters for GetLogsAnomalies.
+type GetLogsAnomaliesParams struct {
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate   *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// TenantId Tenant to read, another tenant than the caller's needs an active access grant
+	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+
+	// BaselineDays Number of days before the period the activity is compared to
+	BaselineDays *int `form:"baseline_days,omitempty" json:"baseline_days,omitempty"`
+
+	// Threshold Z-score from which a day is a spike
+	Threshold *float64 `form:"threshold,omitempty" json:"threshold,omitempty"`
+}
+
+// GetLogsTopInsightsParams defines parameters for GetLogsTopInsights.
+type GetLogsTopInsightsParams struct {
+	Dimension LogInsightDimension `form:"dimension" json:"dimension"`
+	StartDate time.Time           `form:"start_date" json:"start_date"`
+	EndDate   *time.Time          `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// TenantId Tenant to read, another tenant than the caller's needs an active access grant
+	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+	Action   *Action `form:"action,omitempty" json:"action,omitempty"`
+	Limit    *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetLogsStatParams defines parameters for GetLogsStat.
 type GetLogsStatParams struct {
 	StartDate time.Time  `form:"start_date" json:"start_date"`
 	EndDate   *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// TenantId Tenant to read, another tenant than the caller's needs an active access grant
+	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+
+	// Interval Width of the buckets, a day by default. Minute buckets cover at most 24 hours.
+	Interval *LogStatsInterval `form:"interval,omitempty" json:"interval,omitempty"`
+
+	// GroupBy Field splitting the counts in series, a single total series when left out
+	GroupBy  *LogStatsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+	Action   *Action          `form:"action,omitempty" json:"action,omitempty"`
+	Severity *Severity        `form:"severity,omitempty" json:"severity,omitempty"`
+	Resource *string          `form:"resource,omitempty" json:"resource,omitempty"`
+	UserId   *string          `form:"user_id,omitempty" json:"user_id,omitempty"`
 }
 
 // StreamLogsParams defines parameters for StreamLogs.
@@ -254,6 +1000,59 @@ type StreamLogsParams struct {
 	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
 }
 
+// GetLogParams defines parameters for GetLog.
+type GetLogParams struct {
+	// TenantId Tenant to read, another tenant than the caller's needs an active access grant
+	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+}
+
+// ListRoleBindingsParams defines parameters for ListRoleBindings.
+type ListRoleBindingsParams struct {
+	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+	UserId   *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// ListRolesParams defines parameters for ListRoles.
+type ListRolesParams struct {
+	TenantId *string `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+}
+
+// ListLogSchemasParams defines parameters for ListLogSchemas.
+type ListLogSchemasParams struct {
+	// Resource Filter by resource
+	Resource *string `form:"resource,omitempty" json:"resource,omitempty"`
+
+	// AllVersions Include every version instead of only the latest one
+	AllVersions *bool `form:"all_versions,omitempty" json:"all_versions,omitempty"`
+}
+
+// GetTenantUsageParams defines parameters for GetTenantUsage.
+type GetTenantUsageParams struct {
+	// From First UTC day, as YYYY-MM-DD, defaults to today
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last UTC day included, as YYYY-MM-DD, defaults to today
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetUsageReportParams defines parameters for GetUsageReport.
+type GetUsageReportParams struct {
+	// Month UTC month reported, as YYYY-MM
+	Month string `form:"month" json:"month"`
+}
+
+// CreateAccessGrantJSONRequestBody defines body for CreateAccessGrant for application/json ContentType.
+type CreateAccessGrantJSONRequestBody = CreateAccessGrantRequestBody
+
+// StartReindexJSONRequestBody defines body for StartReindex for application/json ContentType.
+type StartReindexJSONRequestBody = ReindexRequestBody
+
+// IssueApiKeyJSONRequestBody defines body for IssueApiKey for application/json ContentType.
+type IssueApiKeyJSONRequestBody = IssueApiKeyRequestBody
+
+// RevokeSessionsJSONRequestBody defines body for RevokeSessions for application/json ContentType.
+type RevokeSessionsJSONRequestBody = RevokeSessionsRequestBody
+
 // GenerateTokenJSONRequestBody defines body for GenerateToken for application/json ContentType.
 type GenerateTokenJSONRequestBody = GenerateTokenRequestBody
 
@@ -263,5 +1062,29 @@ type CreateLogJSONRequestBody = CreateLogRequestBody
 // CreateBulkLogsJSONRequestBody defines body for CreateBulkLogs for application/json ContentType.
 type CreateBulkLogsJSONRequestBody = CreateBulkLogsJSONBody
 
+// CreateRedactionRuleJSONRequestBody defines body for CreateRedactionRule for application/json ContentType.
+type CreateRedactionRuleJSONRequestBody = CreateRedactionRuleRequestBody
+
+// CreateRoleBindingJSONRequestBody defines body for CreateRoleBinding for application/json ContentType.
+type CreateRoleBindingJSONRequestBody = CreateRoleBindingRequestBody
+
+// CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
+type CreateRoleJSONRequestBody = CreateRoleRequestBody
+
+// UpdateRoleJSONRequestBody defines body for UpdateRole for application/json ContentType.
+type UpdateRoleJSONRequestBody = UpdateRoleRequestBody
+
+// CreateLogSchemaJSONRequestBody defines body for CreateLogSchema for application/json ContentType.
+type CreateLogSchemaJSONRequestBody = CreateLogSchemaRequestBody
+
+// UpdateLogSchemaJSONRequestBody defines body for UpdateLogSchema for application/json ContentType.
+type UpdateLogSchemaJSONRequestBody = UpdateLogSchemaRequestBody
+
 // CreateTenantJSONRequestBody defines body for CreateTenant for application/json ContentType.
 type CreateTenantJSONRequestBody = CreateTenantRequestBody
+
+// UpdateTenantJSONRequestBody defines body for UpdateTenant for application/json ContentType.
+type UpdateTenantJSONRequestBody = UpdateTenantRequestBody
+
+// SetTenantLimitsJSONRequestBody defines body for SetTenantLimits for application/json ContentType.
+type SetTenantLimitsJSONRequestBody = UpdateTenantLimitsRequestBody
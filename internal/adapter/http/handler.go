@@ -15,17 +15,35 @@ const (
 
 type Handler struct {
 	TenantHandler
+	TenantLimitHandler
 	TokenHandler
 	LogHandler
 	LogStreamHandler
+	SchemaHandler
+	RedactionHandler
+	APIKeyHandler
+	SessionHandler
+	RoleHandler
+	AccessGrantHandler
+	TaskHandler
+	OpsHandler
 }
 
 func New(r *registry.Registry) Handler {
 	h := Handler{}
 	h.TenantHandler = newTenantHandler(r)
+	h.TenantLimitHandler = newTenantLimitHandler(r)
 	h.TokenHandler = newTokenHandler(r)
 	h.LogHandler = newLogHandler(r)
 	h.LogStreamHandler = newLogStreamHandler(r)
+	h.SchemaHandler = newSchemaHandler(r)
+	h.RedactionHandler = newRedactionHandler(r)
+	h.APIKeyHandler = newAPIKeyHandler(r)
+	h.SessionHandler = newSessionHandler(r)
+	h.RoleHandler = newRoleHandler(r)
+	h.AccessGrantHandler = newAccessGrantHandler(r)
+	h.TaskHandler = newTaskHandler(r)
+	h.OpsHandler = newOpsHandler(r)
 	return h
 }
 
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,12 +15,20 @@ import (
 
 	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
 	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/audit"
 	"github.com/Haevnen/audit-logging-api/internal/auth"
 	"github.com/Haevnen/audit-logging-api/internal/constant"
 	entity_log "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
 	"github.com/Haevnen/audit-logging-api/internal/registry"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/grant"
 	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/schema"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
 	"github.com/Haevnen/audit-logging-api/pkg/utils"
 )
 
@@ -27,16 +37,30 @@ type LogHandler struct {
 	GetUC       log.GetLogUseCaseInterface
 	DeleteUC    log.DeleteLogUseCaseInterface
 	StatsUC     log.GetStatsUseCaseInterface
+	TopUC       log.GetTopActivityUseCaseInterface
+	AnomaliesUC log.GetAnomaliesUseCaseInterface
 	SearchLogUC log.SearchLogsUseCaseInterface
+	AccessUC    grant.AccessTenantUseCaseInterface
+	TenantUC    tenant.CheckTenantUseCaseInterface
+	QuotaUC     quota.ConsumeQuotaUseCaseInterface
+	MeterUC     quota.RecordActivityUseCaseInterface
+	Visibility  auth.FieldVisibility
 }
 
 func newLogHandler(r *registry.Registry) LogHandler {
 	return LogHandler{
-		CreateUC:    r.CreateLogUseCase(),
+		CreateUC:    r.IngestLogUseCase(),
 		GetUC:       r.GetLogUseCase(),
 		DeleteUC:    r.DeleteLogUseCase(),
 		StatsUC:     r.GetStatsUseCase(),
+		TopUC:       r.GetTopActivityUseCase(),
+		AnomaliesUC: r.GetAnomaliesUseCase(),
 		SearchLogUC: r.SearchLogsUseCase(),
+		AccessUC:    r.AccessTenantUseCase(),
+		TenantUC:    r.CheckTenantUseCase(),
+		QuotaUC:     r.ConsumeQuotaUseCase(),
+		MeterUC:     r.RecordActivityUseCase(),
+		Visibility:  r.FieldVisibility(),
 	}
 }
 
@@ -56,11 +80,30 @@ func (h LogHandler) CreateLog(g *gin.Context) {
 		return
 	}
 
-	logCreated, err := h.CreateUC.Execute(g.Request.Context(), tenantId, userId, e)
+	if title, err := h.checkIngestTenants(g, tenantId, []entity_log.Log{e}); err != nil {
+		SendError(g, title, err)
+		return
+	}
+
+	usages, err := measureIngest(tenantId, []entity_log.Log{e}, requestSize([]api_service.CreateLogRequestBody{body}))
 	if err != nil {
 		SendError(g, err.Error(), apperror.ErrInternalServer)
 		return
 	}
+	if title, err := h.consumeQuota(g, usages); err != nil {
+		SendError(g, title, err)
+		return
+	}
+
+	logCreated, err := h.CreateUC.Execute(g.Request.Context(), tenantId, userId, e)
+	if err != nil {
+		h.refundQuota(g, usages)
+		title, err := createError(err)
+		SendError(g, title, err)
+		return
+	}
+	observeIngestion(usages)
+	h.meterStored(g, tenantId, []entity_log.Log{*logCreated})
 
 	resp := api_service.CreateLogResponse{
 		Id:             logCreated.ID,
@@ -90,11 +133,30 @@ func (h LogHandler) CreateBulkLogs(c *gin.Context) {
 		logs = append(logs, e)
 	}
 
-	logsCreated, err := h.CreateUC.ExecuteBulk(c.Request.Context(), tenantId, userId, logs)
+	if title, err := h.checkIngestTenants(c, tenantId, logs); err != nil {
+		SendError(c, title, err)
+		return
+	}
+
+	usages, err := measureIngest(tenantId, logs, requestSize(body))
 	if err != nil {
 		SendError(c, err.Error(), apperror.ErrInternalServer)
 		return
 	}
+	if title, err := h.consumeQuota(c, usages); err != nil {
+		SendError(c, title, err)
+		return
+	}
+
+	logsCreated, err := h.CreateUC.ExecuteBulk(c.Request.Context(), tenantId, userId, logs)
+	if err != nil {
+		h.refundQuota(c, usages)
+		title, err := createError(err)
+		SendError(c, title, err)
+		return
+	}
+	observeIngestion(usages)
+	h.meterStored(c, tenantId, logsCreated)
 
 	resp := make([]api_service.CreateLogResponse, 0, len(logsCreated))
 	for _, l := range logsCreated {
@@ -106,18 +168,159 @@ func (h LogHandler) CreateBulkLogs(c *gin.Context) {
 	c.JSON(http.StatusCreated, resp)
 }
 
+// checkIngestTenants rejects logs sent by an admin to a suspended or deleted tenant, tenant scoped
+// callers were already checked when authenticated
+func (h LogHandler) checkIngestTenants(c *gin.Context, claimTenant string, logs []entity_log.Log) (string, error) {
+	if len(claimTenant) > 0 {
+		return "", nil
+	}
+
+	checked := map[string]bool{}
+	for _, l := range logs {
+		if checked[l.TenantID] {
+			continue
+		}
+		checked[l.TenantID] = true
+
+		if err := h.TenantUC.Execute(c.Request.Context(), l.TenantID); err != nil {
+			if errors.Is(err, tenant.ErrTenantInactive) {
+				return "tenant " + l.TenantID + " is not active", apperror.ErrTenantInactive
+			}
+			return err.Error(), apperror.ErrInternalServer
+		}
+	}
+	return "", nil
+}
+
+// ingestUsage is what a request ingests for one tenant
+type ingestUsage struct {
+	tenantId      string
+	events, bytes int64
+}
+
+// measureIngest sums the logs and their size per tenant, in the order the tenants first appear
+func measureIngest(claimTenant string, logs []entity_log.Log, size func(i int) (int64, error)) ([]ingestUsage, error) {
+	usages := []ingestUsage{}
+	index := map[string]int{}
+	for i, l := range logs {
+		tenantId := claimTenant
+		if len(tenantId) == 0 {
+			tenantId = l.TenantID
+		}
+		if _, ok := index[tenantId]; !ok {
+			index[tenantId] = len(usages)
+			usages = append(usages, ingestUsage{tenantId: tenantId})
+		}
+
+		n, err := size(i)
+		if err != nil {
+			return nil, err
+		}
+		usages[index[tenantId]].events++
+		usages[index[tenantId]].bytes += n
+	}
+	return usages, nil
+}
+
+// requestSize is the size of the JSON of a log in the request, what the byte quota counts
+func requestSize(bodies []api_service.CreateLogRequestBody) func(i int) (int64, error) {
+	return func(i int) (int64, error) {
+		data, err := json.Marshal(bodies[i])
+		return int64(len(data)), err
+	}
+}
+
+// storedSize is the size of the JSON of a log as stored, once redacted
+func storedSize(logs []entity_log.Log) func(i int) (int64, error) {
+	return func(i int) (int64, error) {
+		resp, err := ToSingleLogResponse(logs[i])
+		if err != nil {
+			return 0, err
+		}
+		data, err := json.Marshal(resp)
+		return int64(len(data)), err
+	}
+}
+
+// meterStored counts the size of the logs written in the usage of their tenant, a failure to count doesn't fail
+// the request
+func (h LogHandler) meterStored(c *gin.Context, claimTenant string, logs []entity_log.Log) {
+	usages, err := measureIngest(claimTenant, logs, storedSize(logs))
+	if err == nil {
+		for _, u := range usages {
+			if err = h.MeterUC.RecordStored(c.Request.Context(), u.tenantId, u.bytes); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).WithField("error", err).Warn("failed to meter the stored bytes")
+	}
+}
+
+// consumeQuota counts the usages against the daily quotas of their tenant. When an admin writes to several
+// tenants and one of them is over quota, nothing is written and the usage already counted for the others is
+// refunded.
+func (h LogHandler) consumeQuota(c *gin.Context, usages []ingestUsage) (string, error) {
+	for i, u := range usages {
+		err := h.QuotaUC.Execute(c.Request.Context(), u.tenantId, u.events, u.bytes)
+		if err != nil {
+			h.refundQuota(c, usages[:i])
+		}
+		var exceeded *quota.QuotaExceededError
+		switch {
+		case errors.As(err, &exceeded):
+			setQuotaHeaders(c, exceeded)
+			return err.Error(), apperror.ErrTooManyRequests
+		case err != nil:
+			return err.Error(), apperror.ErrInternalServer
+		}
+	}
+	return "", nil
+}
+
+// refundQuota takes back the usages consumed for logs that weren't written, a failed refund is only logged
+// since the request already failed
+func (h LogHandler) refundQuota(c *gin.Context, usages []ingestUsage) {
+	for _, u := range usages {
+		if err := h.QuotaUC.Refund(c.Request.Context(), u.tenantId, u.events, u.bytes); err != nil {
+			logger.FromContext(c.Request.Context()).WithField("error", err).WithField("tenantId", u.tenantId).
+				Warn("failed to refund the quota")
+		}
+	}
+}
+
+func observeIngestion(usages []ingestUsage) {
+	for _, u := range usages {
+		metrics.ObserveIngestion(u.tenantId, u.events, u.bytes)
+	}
+}
+
+func setQuotaHeaders(c *gin.Context, e *quota.QuotaExceededError) {
+	retryAfter := int64(math.Ceil(time.Until(e.ResetAt).Seconds()))
+	c.Header("Retry-After", strconv.FormatInt(max(retryAfter, 1), 10))
+	c.Header("X-RateLimit-Limit", strconv.FormatInt(e.Limit, 10))
+	c.Header("X-RateLimit-Remaining", strconv.FormatInt(max(e.Limit-e.Used, 0), 10))
+	c.Header("X-RateLimit-Reset", strconv.FormatInt(e.ResetAt.Unix(), 10))
+}
+
 // GetLog implements (GET /logs/{id})
 // Get a log by its id
 // The response will contain the log in the form of a GetSingleLogResponse.
 // If the log is not found, a ErrRecordNotFound error is returned.
-func (h LogHandler) GetLog(c *gin.Context, id string) {
+func (h LogHandler) GetLog(c *gin.Context, id string, params api_service.GetLogParams) {
 	if len(id) == 0 {
 		SendError(c, "id is required", apperror.ErrInvalidRequestInput)
 		return
 	}
 
-	claimTenantId := getClaimTenant(c)
-	log, err := h.GetUC.Execute(c.Request.Context(), id, claimTenantId)
+	tenantId, title, err := h.readTenant(c, params.TenantId, "get", map[string]string{"log_id": id})
+	if err != nil {
+		SendError(c, title, err)
+		return
+	}
+
+	log, err := h.GetUC.Execute(c.Request.Context(), id, tenantId)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			SendError(c, err.Error(), apperror.ErrRecordNotFound)
@@ -126,10 +329,17 @@ func (h LogHandler) GetLog(c *gin.Context, id string) {
 		SendError(c, err.Error(), apperror.ErrInternalServer)
 		return
 	}
+	if log.System && !isAdmin(c) {
+		// the service's own audit trail is only visible to admins
+		SendError(c, gorm.ErrRecordNotFound.Error(), apperror.ErrRecordNotFound)
+		return
+	}
+	audit.Annotate(c.Request.Context(), audit.KeyResultCount, 1)
 
-	resp, err := ToSingleLogResponse(*log)
+	resp, err := toVisibleLogResponse(c, h.Visibility, *log)
 	if err != nil {
 		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
 	}
 	c.JSON(http.StatusOK, resp)
 }
@@ -155,8 +365,6 @@ func (h LogHandler) CleanupLogs(c *gin.Context, params api_service.CleanupLogsPa
 // - end_date: the end time of the search range (optional, defaults to now)
 // The response will contain statistics about the logs in the form of a LogStatsResponse.
 func (h LogHandler) GetLogsStat(c *gin.Context, params api_service.GetLogsStatParams) {
-	tenantId := getClaimTenant(c)
-
 	endDate := time.Now().UTC()
 	if params.EndDate != nil {
 		endDate = *params.EndDate
@@ -167,13 +375,120 @@ func (h LogHandler) GetLogsStat(c *gin.Context, params api_service.GetLogsStatPa
 		return
 	}
 
-	stats, err := h.StatsUC.Execute(c.Request.Context(), tenantId, params.StartDate, endDate)
+	tenantId, title, err := h.readTenant(c, params.TenantId, "stats", map[string]string{"query": c.Request.URL.RawQuery})
+	if err != nil {
+		SendError(c, title, err)
+		return
+	}
+
+	q := entity_log.StatsQuery{
+		TenantID:  tenantId,
+		StartTime: params.StartDate,
+		EndTime:   endDate,
+		Interval:  entity_log.IntervalDay,
+		Resource:  utils.Deref(params.Resource),
+		UserID:    utils.Deref(params.UserId),
+	}
+	if params.Interval != nil {
+		q.Interval = entity_log.StatsInterval(*params.Interval)
+	}
+	if params.GroupBy != nil {
+		q.GroupBy = entity_log.StatsGroupBy(*params.GroupBy)
+	}
+	if params.Action != nil {
+		q.Action = string(ToEntityAction(*params.Action))
+	}
+	if params.Severity != nil {
+		q.Severity = string(ToEntitySeverity(*params.Severity))
+	}
+
+	stats, err := h.StatsUC.Execute(c.Request.Context(), q)
+	if errors.Is(err, log.ErrInvalidStatsQuery) {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	audit.Annotate(c.Request.Context(), audit.KeyResultCount, len(stats.Series))
+
+	c.JSON(http.StatusOK, ToLogStatsResponse(q, *stats))
+}
+
+// (GET /api/v1/logs/insights/top)
+func (h LogHandler) GetLogsTopInsights(c *gin.Context, params api_service.GetLogsTopInsightsParams) {
+	endDate := time.Now().UTC()
+	if params.EndDate != nil {
+		endDate = *params.EndDate
+	}
+
+	tenantId, title, err := h.readTenant(c, params.TenantId, "insights", map[string]string{"query": c.Request.URL.RawQuery})
+	if err != nil {
+		SendError(c, title, err)
+		return
+	}
+
+	q := entity_log.TopQuery{
+		TenantID:  tenantId,
+		StartTime: params.StartDate,
+		EndTime:   endDate,
+		Dimension: entity_log.InsightDimension(params.Dimension),
+		Limit:     utils.Deref(params.Limit),
+	}
+	if params.Action != nil {
+		q.Action = string(ToEntityAction(*params.Action))
+	}
+
+	entries, err := h.TopUC.Execute(c.Request.Context(), q)
+	if errors.Is(err, log.ErrInvalidInsightQuery) {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	audit.Annotate(c.Request.Context(), audit.KeyResultCount, len(entries))
+
+	c.JSON(http.StatusOK, ToLogTopInsightsResponse(q, entries))
+}
+
+// (GET /api/v1/logs/insights/anomalies)
+func (h LogHandler) GetLogsAnomalies(c *gin.Context, params api_service.GetLogsAnomaliesParams) {
+	endDate := time.Now().UTC()
+	if params.EndDate != nil {
+		endDate = *params.EndDate
+	}
+	startDate := endDate.Add(-24 * time.Hour)
+	if params.StartDate != nil {
+		startDate = *params.StartDate
+	}
+
+	tenantId, title, err := h.readTenant(c, params.TenantId, "insights", map[string]string{"query": c.Request.URL.RawQuery})
+	if err != nil {
+		SendError(c, title, err)
+		return
+	}
+
+	anomalies, err := h.AnomaliesUC.Execute(c.Request.Context(), entity_log.AnomalyQuery{
+		TenantID:     tenantId,
+		StartTime:    startDate,
+		EndTime:      endDate,
+		BaselineDays: utils.Deref(params.BaselineDays),
+		Threshold:    utils.Deref(params.Threshold),
+	})
+	if errors.Is(err, log.ErrInvalidInsightQuery) {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
 	if err != nil {
 		SendError(c, err.Error(), apperror.ErrInternalServer)
 		return
 	}
+	audit.Annotate(c.Request.Context(), audit.KeyResultCount, len(anomalies.Spikes)+len(anomalies.NewIPs))
 
-	c.JSON(http.StatusOK, ToLogStatsResponse(stats))
+	c.JSON(http.StatusOK, ToLogAnomaliesResponse(*anomalies))
 }
 
 // (GET /api/v1/logs/search)
@@ -186,8 +501,11 @@ func (h LogHandler) GetLogsStat(c *gin.Context, params api_service.GetLogsStatPa
 // - start_time: the start time of the search range
 // - end_time: the end time of the search range
 // - q: a query string to search for in the logs
+// - changed_path: a path changed between before and after state, e.g. plan.tier
 // - page_number: the page number of the search results
 // - page_size: the number of search results to return per page
+// - tenant_id: the tenant to read, defaults to the caller's tenant
+// - system: search the service's own audit trail (admin only)
 // The response will contain a list of logs
 func (h LogHandler) SearchLogs(c *gin.Context, params api_service.SearchLogsParams) {
 	pageNumber, pageSize := 1, constant.MaxPageSize
@@ -198,19 +516,31 @@ func (h LogHandler) SearchLogs(c *gin.Context, params api_service.SearchLogsPara
 		pageSize = *params.PageSize
 	}
 
-	tenantId := getClaimTenant(c)
+	system, err := systemStream(c, params.System)
+	if err != nil {
+		SendError(c, "only admins can search the system stream", err)
+		return
+	}
+
+	tenantId, title, err := h.readTenant(c, params.TenantId, "search", map[string]string{"query": c.Request.URL.RawQuery})
+	if err != nil {
+		SendError(c, title, err)
+		return
+	}
 
 	filters := repository.LogSearchFilters{
-		TenantID:  utils.Ptr(tenantId),
-		UserID:    utils.Ptr(c.Query("user_id")),
-		Action:    utils.Ptr(c.Query("action")),
-		Resource:  utils.Ptr(c.Query("resource")),
-		Severity:  utils.Ptr(c.Query("severity")),
-		StartDate: utils.Ptr(c.Query("start_time")),
-		EndDate:   utils.Ptr(c.Query("end_time")),
-		Query:     utils.Ptr(c.Query("q")),
-		Page:      pageNumber,
-		PageSize:  pageSize,
+		TenantID:    utils.Ptr(tenantId),
+		UserID:      utils.Ptr(c.Query("user_id")),
+		Action:      utils.Ptr(c.Query("action")),
+		Resource:    utils.Ptr(c.Query("resource")),
+		Severity:    utils.Ptr(c.Query("severity")),
+		StartDate:   utils.Ptr(c.Query("start_time")),
+		EndDate:     utils.Ptr(c.Query("end_time")),
+		Query:       utils.Ptr(c.Query("q")),
+		ChangedPath: utils.Ptr(c.Query("changed_path")),
+		System:      system,
+		Page:        pageNumber,
+		PageSize:    pageSize,
 	}
 
 	result, err := h.SearchLogUC.Execute(c.Request.Context(), filters)
@@ -219,9 +549,12 @@ func (h LogHandler) SearchLogs(c *gin.Context, params api_service.SearchLogsPara
 		return
 	}
 
+	audit.Annotate(c.Request.Context(), audit.KeyResultCount, len(result.Logs))
+	h.meter(c, tenantId, tenant_usage.ActivitySearch)
+
 	logConverted := make([]api_service.GetSingleLogResponse, 0, len(result.Logs))
 	for _, l := range result.Logs {
-		r, err := ToSingleLogResponse(l)
+		r, err := toVisibleLogResponse(c, h.Visibility, l)
 		if err != nil {
 			SendError(c, err.Error(), apperror.ErrInternalServer)
 			return
@@ -247,20 +580,34 @@ func (h LogHandler) SearchLogs(c *gin.Context, params api_service.SearchLogsPara
 func (h LogHandler) ExportLogs(c *gin.Context, params api_service.ExportLogsParams) {
 	ctx := c.Request.Context()
 
-	tenantId := getClaimTenant(c)
+	system, err := systemStream(c, params.System)
+	if err != nil {
+		SendError(c, "only admins can export the system stream", err)
+		return
+	}
+
+	tenantId, title, err := h.readTenant(c, params.TenantId, "export", map[string]string{"query": c.Request.URL.RawQuery})
+	if err != nil {
+		SendError(c, title, err)
+		return
+	}
 
 	filters := repository.LogSearchFilters{
-		TenantID:  utils.Ptr(tenantId),
-		UserID:    utils.Ptr(c.Query("user_id")),
-		Action:    utils.Ptr(c.Query("action")),
-		Resource:  utils.Ptr(c.Query("resource")),
-		Severity:  utils.Ptr(c.Query("severity")),
-		StartDate: utils.Ptr(c.Query("start_time")),
-		EndDate:   utils.Ptr(c.Query("end_time")),
-		Query:     utils.Ptr(c.Query("q")),
+		TenantID:    utils.Ptr(tenantId),
+		UserID:      utils.Ptr(c.Query("user_id")),
+		Action:      utils.Ptr(c.Query("action")),
+		Resource:    utils.Ptr(c.Query("resource")),
+		Severity:    utils.Ptr(c.Query("severity")),
+		StartDate:   utils.Ptr(c.Query("start_time")),
+		EndDate:     utils.Ptr(c.Query("end_time")),
+		Query:       utils.Ptr(c.Query("q")),
+		ChangedPath: utils.Ptr(c.Query("changed_path")),
+		System:      system,
 	}
 
 	format := params.Format
+	exported := 0
+	defer func() { audit.Annotate(ctx, audit.KeyResultCount, exported) }()
 
 	// prepare HTTP headers
 	filename := fmt.Sprintf("logs.%s", format)
@@ -273,12 +620,17 @@ func (h LogHandler) ExportLogs(c *gin.Context, params api_service.ExportLogsPara
 
 		first := true
 		err := h.SearchLogUC.Stream(ctx, filters, func(l entity_log.Log) error {
-			data, _ := json.Marshal(l)
+			resp, err := toVisibleLogResponse(c, h.Visibility, l)
+			if err != nil {
+				return err
+			}
+			data, _ := json.Marshal(resp)
 			if !first {
 				c.Writer.Write([]byte(","))
 			}
 			c.Writer.Write(data)
 			first = false
+			exported++
 			c.Writer.Flush()
 			return nil
 		})
@@ -288,6 +640,7 @@ func (h LogHandler) ExportLogs(c *gin.Context, params api_service.ExportLogsPara
 		}
 
 		c.Writer.Write([]byte("]")) // close JSON array
+		h.meter(c, tenantId, tenant_usage.ActivityExport)
 
 	case "csv":
 		c.Header("Content-Type", "text/csv")
@@ -297,6 +650,7 @@ func (h LogHandler) ExportLogs(c *gin.Context, params api_service.ExportLogsPara
 		_ = w.Write([]string{"id", "tenant_id", "user_id", "action", "severity", "event_timestamp", "message"})
 
 		err := h.SearchLogUC.Stream(ctx, filters, func(l entity_log.Log) error {
+			exported++
 			return w.Write([]string{
 				l.ID,
 				l.TenantID,
@@ -313,6 +667,7 @@ func (h LogHandler) ExportLogs(c *gin.Context, params api_service.ExportLogsPara
 			SendError(c, err.Error(), apperror.ErrInternalServer)
 			return
 		}
+		h.meter(c, tenantId, tenant_usage.ActivityExport)
 
 	default:
 		SendError(c, "bad request", apperror.ErrInvalidRequestInput)
@@ -374,6 +729,27 @@ func validateAndGenerateLogEntity(g *gin.Context, body api_service.CreateLogRequ
 	}, "", nil
 }
 
+// createError maps a failed write to its response, a log rejected by the schema of its tenant and resource is
+// the caller's error. The schema is checked once the log is redacted, on what gets stored.
+func createError(err error) (string, error) {
+	var violation *schema.ViolationError
+	if errors.As(err, &violation) {
+		return violation.Error(), apperror.ErrSchemaViolation
+	}
+	return err.Error(), apperror.ErrInternalServer
+}
+
+// toVisibleLogResponse converts the log and removes the fields hidden from the caller's role
+func toVisibleLogResponse(g *gin.Context, visibility auth.FieldVisibility, l entity_log.Log) (api_service.GetSingleLogResponse, error) {
+	resp, err := ToSingleLogResponse(l)
+	if err != nil {
+		return api_service.GetSingleLogResponse{}, err
+	}
+
+	ApplyFieldVisibility(&resp, visibility.Hidden(g.MustGet(constant.Role).(auth.Role)))
+	return resp, nil
+}
+
 func getClaimTenant(g *gin.Context) string {
 	claimTenantId := g.GetString(constant.TenantID)
 	role := g.MustGet(constant.Role).(auth.Role)
@@ -384,6 +760,56 @@ func getClaimTenant(g *gin.Context) string {
 	return claimTenantId
 }
 
+// meter counts a search or export in the usage of the tenant read. Reads by admins aren't billed to the
+// tenant, and a failure to count doesn't fail the request.
+func (h LogHandler) meter(c *gin.Context, tenantId string, activity tenant_usage.Activity) {
+	if len(tenantId) == 0 || len(getClaimTenant(c)) == 0 {
+		return
+	}
+	if err := h.MeterUC.Execute(c.Request.Context(), tenantId, activity); err != nil {
+		logger.FromContext(c.Request.Context()).WithField("error", err).WithField("tenant_id", tenantId).Warn("failed to meter " + string(activity))
+	}
+}
+
+// readTenant returns the tenant a read acts on, the caller's own tenant unless another one is requested.
+// Admins read any tenant, everyone else needs an active access grant and the read is recorded in the
+// logs of the tenant being read.
+func (h LogHandler) readTenant(c *gin.Context, requested *string, operation string, details map[string]string) (string, string, error) {
+	claimTenantId := getClaimTenant(c)
+	if requested == nil || len(*requested) == 0 || *requested == claimTenantId {
+		return claimTenantId, "", nil
+	}
+	if len(claimTenantId) == 0 {
+		// admin
+		audit.Annotate(c.Request.Context(), audit.KeyTenantID, *requested)
+		return *requested, "", nil
+	}
+
+	if _, err := h.AccessUC.Execute(c.Request.Context(), claimTenantId, c.GetString(constant.UserID), *requested, operation, details); err != nil {
+		if errors.Is(err, grant.ErrNoGrant) {
+			return "", "no active access grant for tenant " + *requested, apperror.ErrForbidden
+		}
+		return "", err.Error(), apperror.ErrInternalServer
+	}
+	audit.Annotate(c.Request.Context(), audit.KeyTenantID, *requested)
+	return *requested, "", nil
+}
+
+// systemStream tells whether the service's own audit trail is asked for, which only admins may read
+func systemStream(c *gin.Context, requested *bool) (bool, error) {
+	if requested == nil || !*requested {
+		return false, nil
+	}
+	if !isAdmin(c) {
+		return false, apperror.ErrForbidden
+	}
+	return true, nil
+}
+
+func isAdmin(c *gin.Context) bool {
+	return c.MustGet(constant.Role).(auth.Role) == auth.RoleAdmin
+}
+
 func validateMismatchTenant(claimTenantId, bodyTenantId string) error {
 	if len(claimTenantId) == 0 || claimTenantId == bodyTenantId {
 		// admin
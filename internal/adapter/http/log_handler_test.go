@@ -5,14 +5,18 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
 	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
@@ -20,9 +24,21 @@ import (
 	"github.com/Haevnen/audit-logging-api/internal/auth"
 	"github.com/Haevnen/audit-logging-api/internal/constant"
 	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
 
+	"github.com/Haevnen/audit-logging-api/internal/usecase/schema"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/grant"
+	grantMocks "github.com/Haevnen/audit-logging-api/internal/usecase/grant/mocks"
+	logUC "github.com/Haevnen/audit-logging-api/internal/usecase/log"
 	ucMocks "github.com/Haevnen/audit-logging-api/internal/usecase/log/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	quotaMocks "github.com/Haevnen/audit-logging-api/internal/usecase/quota/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+	tenantMocks "github.com/Haevnen/audit-logging-api/internal/usecase/tenant/mocks"
 )
 
 func setupContext(method, path string, body []byte) (*gin.Context, *httptest.ResponseRecorder) {
@@ -43,17 +59,25 @@ func TestLogHandler_CreateLog_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUC := ucMocks.NewMockCreateLogUseCaseInterface(ctrl)
-	handler := h.LogHandler{CreateUC: mockUC}
+	mockQuotaUC := quotaMocks.NewMockConsumeQuotaUseCaseInterface(ctrl)
+	mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{CreateUC: mockUC, QuotaUC: mockQuotaUC, MeterUC: mockMeterUC}
 
 	body := api_service.CreateLogRequestBody{
 		TenantId: "tenant-1", UserId: "user-1", Action: "CREATE", Severity: "INFO", // ✅ fixed
+		Metadata: &map[string]interface{}{"password": "secret"},
 	}
 	data, _ := json.Marshal(body)
 	c, w := setupContext(http.MethodPost, "/logs", data)
 
-	expected := &entitylog.Log{ID: "log-123", EventTimestamp: time.Now().UTC()}
+	// the log as written, its metadata redacted
+	expected := &entitylog.Log{ID: "log-123", TenantID: "tenant-1", EventTimestamp: time.Now().UTC()}
+	resp, _ := h.ToSingleLogResponse(*expected)
+	stored, _ := json.Marshal(resp)
+	mockQuotaUC.EXPECT().Execute(gomock.Any(), "tenant-1", int64(1), int64(len(data))).Return(nil)
 	mockUC.EXPECT().Execute(gomock.Any(), "tenant-1", "user-1", gomock.Any()).
 		Return(expected, nil)
+	mockMeterUC.EXPECT().RecordStored(gomock.Any(), "tenant-1", int64(len(stored))).Return(nil)
 
 	handler.CreateLog(c)
 
@@ -66,7 +90,9 @@ func TestLogHandler_CreateBulkLogs_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUC := ucMocks.NewMockCreateLogUseCaseInterface(ctrl)
-	handler := h.LogHandler{CreateUC: mockUC}
+	mockQuotaUC := quotaMocks.NewMockConsumeQuotaUseCaseInterface(ctrl)
+	mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{CreateUC: mockUC, QuotaUC: mockQuotaUC, MeterUC: mockMeterUC}
 
 	bodies := []api_service.CreateLogRequestBody{{
 		TenantId: "tenant-1", UserId: "user-1", Action: "CREATE", Severity: "INFO", // ✅ fixed
@@ -75,8 +101,10 @@ func TestLogHandler_CreateBulkLogs_Success(t *testing.T) {
 	c, w := setupContext(http.MethodPost, "/logs/bulk", data)
 
 	expected := []entitylog.Log{{ID: "bulk-1", EventTimestamp: time.Now().UTC()}}
+	mockQuotaUC.EXPECT().Execute(gomock.Any(), "tenant-1", int64(1), gomock.Any()).Return(nil)
 	mockUC.EXPECT().ExecuteBulk(gomock.Any(), "tenant-1", "user-1", gomock.Any()).
 		Return(expected, nil)
+	mockMeterUC.EXPECT().RecordStored(gomock.Any(), "tenant-1", gomock.Any()).Return(nil)
 
 	handler.CreateBulkLogs(c)
 
@@ -84,6 +112,145 @@ func TestLogHandler_CreateBulkLogs_Success(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "bulk-1")
 }
 
+func TestLogHandler_CreateLog_SchemaViolation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockQuotaUC := quotaMocks.NewMockConsumeQuotaUseCaseInterface(ctrl)
+	handler := h.LogHandler{CreateUC: mockUC, QuotaUC: mockQuotaUC}
+
+	body := api_service.CreateLogRequestBody{
+		TenantId: "tenant-1", UserId: "user-1", Action: "CREATE", Severity: "INFO",
+	}
+	data, _ := json.Marshal(body)
+	c, w := setupContext(http.MethodPost, "/logs", data)
+
+	mockQuotaUC.EXPECT().Execute(gomock.Any(), "tenant-1", int64(1), int64(len(data))).Return(nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "tenant-1", "user-1", gomock.Any()).
+		Return(nil, &schema.ViolationError{Violations: []string{"metadata: missing properties: 'request_id'"}})
+	// the log isn't written, its quota is given back
+	mockQuotaUC.EXPECT().Refund(gomock.Any(), "tenant-1", int64(1), int64(len(data))).Return(nil)
+
+	handler.CreateLog(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "does not match the registered schema")
+	assert.Contains(t, w.Body.String(), "request_id")
+}
+
+func TestLogHandler_CreateBulkLogs_WriteFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockQuotaUC := quotaMocks.NewMockConsumeQuotaUseCaseInterface(ctrl)
+	mockTenantUC := tenantMocks.NewMockCheckTenantUseCaseInterface(ctrl)
+	handler := h.LogHandler{CreateUC: mockUC, QuotaUC: mockQuotaUC, TenantUC: mockTenantUC}
+
+	bodies := []api_service.CreateLogRequestBody{
+		{TenantId: "tenant-1", UserId: "user-1", Action: "CREATE", Severity: "INFO"},
+		{TenantId: "tenant-2", UserId: "user-1", Action: "CREATE", Severity: "INFO"},
+	}
+	data, _ := json.Marshal(bodies)
+	c, w := setupContext(http.MethodPost, "/logs/bulk", data)
+	c.Set(constant.Role, auth.RoleAdmin)
+
+	mockTenantUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockQuotaUC.EXPECT().Execute(gomock.Any(), "tenant-1", int64(1), gomock.Any()).Return(nil)
+	mockQuotaUC.EXPECT().Execute(gomock.Any(), "tenant-2", int64(1), gomock.Any()).Return(nil)
+	mockUC.EXPECT().ExecuteBulk(gomock.Any(), "", gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+	// nothing was written, both tenants get their quota back
+	mockQuotaUC.EXPECT().Refund(gomock.Any(), "tenant-1", int64(1), gomock.Any()).Return(nil)
+	mockQuotaUC.EXPECT().Refund(gomock.Any(), "tenant-2", int64(1), gomock.Any()).Return(nil)
+
+	handler.CreateBulkLogs(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestLogHandler_CreateBulkLogs_AdminOverQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockQuotaUC := quotaMocks.NewMockConsumeQuotaUseCaseInterface(ctrl)
+	mockTenantUC := tenantMocks.NewMockCheckTenantUseCaseInterface(ctrl)
+	handler := h.LogHandler{CreateUC: mockUC, QuotaUC: mockQuotaUC, TenantUC: mockTenantUC}
+
+	bodies := []api_service.CreateLogRequestBody{
+		{TenantId: "tenant-1", UserId: "user-1", Action: "CREATE", Severity: "INFO"},
+		{TenantId: "tenant-2", UserId: "user-1", Action: "CREATE", Severity: "INFO"},
+	}
+	data, _ := json.Marshal(bodies)
+	c, w := setupContext(http.MethodPost, "/logs/bulk", data)
+	c.Set(constant.Role, auth.RoleAdmin)
+
+	mockTenantUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockQuotaUC.EXPECT().Execute(gomock.Any(), "tenant-1", int64(1), gomock.Any()).Return(nil)
+	mockQuotaUC.EXPECT().Execute(gomock.Any(), "tenant-2", int64(1), gomock.Any()).
+		Return(&quota.QuotaExceededError{Quota: "events", Limit: 100, Used: 100, ResetAt: time.Now().Add(time.Hour)})
+	// the bulk is rejected as a whole, the quota of tenant-1 is given back
+	mockQuotaUC.EXPECT().Refund(gomock.Any(), "tenant-1", int64(1), gomock.Any()).Return(nil)
+
+	handler.CreateBulkLogs(c)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+}
+
+func TestLogHandler_CreateBulkLogs_AdminToInactiveTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockTenantUC := tenantMocks.NewMockCheckTenantUseCaseInterface(ctrl)
+	handler := h.LogHandler{CreateUC: mockUC, TenantUC: mockTenantUC}
+
+	bodies := []api_service.CreateLogRequestBody{
+		{TenantId: "tenant-1", UserId: "user-1", Action: "CREATE", Severity: "INFO"},
+		{TenantId: "tenant-1", UserId: "user-2", Action: "CREATE", Severity: "INFO"},
+		{TenantId: "tenant-2", UserId: "user-1", Action: "CREATE", Severity: "INFO"},
+	}
+	data, _ := json.Marshal(bodies)
+	c, w := setupContext(http.MethodPost, "/logs/bulk", data)
+	c.Set(constant.Role, auth.RoleAdmin)
+
+	mockTenantUC.EXPECT().Execute(gomock.Any(), "tenant-1").Return(nil)
+	mockTenantUC.EXPECT().Execute(gomock.Any(), "tenant-2").Return(tenant.ErrTenantInactive)
+
+	handler.CreateBulkLogs(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "tenant tenant-2 is not active")
+}
+
+func TestLogHandler_CreateLog_QuotaExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockQuotaUC := quotaMocks.NewMockConsumeQuotaUseCaseInterface(ctrl)
+	handler := h.LogHandler{CreateUC: mockUC, QuotaUC: mockQuotaUC}
+
+	body := api_service.CreateLogRequestBody{
+		TenantId: "tenant-1", UserId: "user-1", Action: "CREATE", Severity: "INFO",
+	}
+	data, _ := json.Marshal(body)
+	c, w := setupContext(http.MethodPost, "/logs", data)
+
+	resetAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	mockQuotaUC.EXPECT().Execute(gomock.Any(), "tenant-1", int64(1), gomock.Any()).
+		Return(&quota.QuotaExceededError{Quota: "events", Limit: 100, Used: 100, ResetAt: resetAt})
+
+	handler.CreateLog(c)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "100", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, strconv.FormatInt(resetAt.Unix(), 10), w.Header().Get("X-RateLimit-Reset"))
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+}
+
 func TestLogHandler_GetLog_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -95,7 +262,7 @@ func TestLogHandler_GetLog_Success(t *testing.T) {
 	expected := &entitylog.Log{ID: "id-1"}
 	mockUC.EXPECT().Execute(gomock.Any(), "id-1", "tenant-1").Return(expected, nil)
 
-	handler.GetLog(c, "id-1")
+	handler.GetLog(c, "id-1", api_service.GetLogParams{})
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Contains(t, w.Body.String(), "id-1")
@@ -111,11 +278,165 @@ func TestLogHandler_GetLog_NotFound(t *testing.T) {
 	c, w := setupContext(http.MethodGet, "/logs/id-1", nil)
 	mockUC.EXPECT().Execute(gomock.Any(), "id-1", "tenant-1").Return(nil, gorm.ErrRecordNotFound)
 
-	handler.GetLog(c, "id-1")
+	handler.GetLog(c, "id-1", api_service.GetLogParams{})
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestLogHandler_GetLog_OtherTenantWithGrant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetLogUseCaseInterface(ctrl)
+	mockAccess := grantMocks.NewMockAccessTenantUseCaseInterface(ctrl)
+	handler := h.LogHandler{GetUC: mockUC, AccessUC: mockAccess}
+
+	c, w := setupContext(http.MethodGet, "/logs/id-1?tenant_id=tenant-2", nil)
+	mockAccess.EXPECT().Execute(gomock.Any(), "tenant-1", "user-1", "tenant-2", "get", map[string]string{"log_id": "id-1"}).
+		Return(&access_grant.AccessGrant{ID: "grant-1"}, nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "id-1", "tenant-2").Return(&entitylog.Log{ID: "id-1", TenantID: "tenant-2"}, nil)
+
+	handler.GetLog(c, "id-1", api_service.GetLogParams{TenantId: utils.Ptr("tenant-2")})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLogHandler_GetLog_OtherTenantWithoutGrant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccess := grantMocks.NewMockAccessTenantUseCaseInterface(ctrl)
+	handler := h.LogHandler{AccessUC: mockAccess}
+
+	c, w := setupContext(http.MethodGet, "/logs/id-1?tenant_id=tenant-2", nil)
+	mockAccess.EXPECT().Execute(gomock.Any(), "tenant-1", "user-1", "tenant-2", "get", gomock.Any()).Return(nil, grant.ErrNoGrant)
+
+	handler.GetLog(c, "id-1", api_service.GetLogParams{TenantId: utils.Ptr("tenant-2")})
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestLogHandler_GetLog_OwnTenantSkipsGrant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetLogUseCaseInterface(ctrl)
+	handler := h.LogHandler{GetUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/id-1?tenant_id=tenant-1", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "id-1", "tenant-1").Return(&entitylog.Log{ID: "id-1"}, nil)
+
+	handler.GetLog(c, "id-1", api_service.GetLogParams{TenantId: utils.Ptr("tenant-1")})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLogHandler_GetLogsStat_AdminPicksTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetStatsUseCaseInterface(ctrl)
+	handler := h.LogHandler{StatsUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/stats", nil)
+	c.Set(constant.Role, auth.RoleAdmin)
+	params := api_service.GetLogsStatParams{StartDate: time.Now().Add(-time.Hour), TenantId: utils.Ptr("tenant-2")}
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, q entitylog.StatsQuery) (*entitylog.Stats, error) {
+			assert.Equal(t, "tenant-2", q.TenantID)
+			return &entitylog.Stats{Series: []entitylog.StatSeries{}}, nil
+		})
+
+	handler.GetLogsStat(c, params)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLogHandler_SearchLogs_OtherTenantWithGrant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockSearchLogsUseCaseInterface(ctrl)
+	mockAccess := grantMocks.NewMockAccessTenantUseCaseInterface(ctrl)
+	mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{SearchLogUC: mockUC, AccessUC: mockAccess, MeterUC: mockMeterUC}
+
+	c, w := setupContext(http.MethodGet, "/logs?tenant_id=tenant-2&q=login", nil)
+	// the search is billed to the tenant read
+	mockMeterUC.EXPECT().Execute(gomock.Any(), "tenant-2", tenant_usage.ActivitySearch).Return(nil)
+	mockAccess.EXPECT().Execute(gomock.Any(), "tenant-1", "user-1", "tenant-2", "search", map[string]string{"query": "tenant_id=tenant-2&q=login"}).
+		Return(&access_grant.AccessGrant{ID: "grant-1"}, nil)
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, f repository.LogSearchFilters) (*repository.SearchResult, error) {
+			assert.Equal(t, "tenant-2", *f.TenantID)
+			return &repository.SearchResult{}, nil
+		})
+
+	handler.SearchLogs(c, api_service.SearchLogsParams{TenantId: utils.Ptr("tenant-2")})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLogHandler_ExportLogs_GrantCheckFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccess := grantMocks.NewMockAccessTenantUseCaseInterface(ctrl)
+	handler := h.LogHandler{AccessUC: mockAccess}
+
+	c, w := setupContext(http.MethodGet, "/logs/export?tenant_id=tenant-2&format=json", nil)
+	mockAccess.EXPECT().Execute(gomock.Any(), "tenant-1", "user-1", "tenant-2", "export", gomock.Any()).Return(nil, errors.New("db error"))
+
+	handler.ExportLogs(c, api_service.ExportLogsParams{Format: "json", TenantId: utils.Ptr("tenant-2")})
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestLogHandler_GetLog_SystemEntryHidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetLogUseCaseInterface(ctrl)
+	handler := h.LogHandler{GetUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/id-1", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "id-1", "tenant-1").Return(&entitylog.Log{ID: "id-1", System: true}, nil)
+
+	handler.GetLog(c, "id-1", api_service.GetLogParams{})
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+func TestLogHandler_SearchLogs_SystemStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockSearchLogsUseCaseInterface(ctrl)
+	handler := h.LogHandler{SearchLogUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs?system=true", nil)
+	c.Set(constant.Role, auth.RoleAdmin)
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, f repository.LogSearchFilters) (*repository.SearchResult, error) {
+			assert.True(t, f.System)
+			return &repository.SearchResult{Total: 1, Logs: []entitylog.Log{{ID: "log-1", System: true}}}, nil
+		})
+
+	handler.SearchLogs(c, api_service.SearchLogsParams{System: utils.Ptr(true)})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"system":true`)
+}
+
+func TestLogHandler_SearchLogs_SystemStreamForbidden(t *testing.T) {
+	handler := h.LogHandler{}
+
+	c, w := setupContext(http.MethodGet, "/logs?system=true", nil)
+	handler.SearchLogs(c, api_service.SearchLogsParams{System: utils.Ptr(true)})
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
 func TestLogHandler_CleanupLogs_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -145,14 +466,170 @@ func TestLogHandler_GetLogsStat_Success(t *testing.T) {
 	params := api_service.GetLogsStatParams{StartDate: time.Now().Add(-24 * time.Hour)}
 
 	mockUC.EXPECT().
-		Execute(gomock.Any(), "tenant-1", params.StartDate, gomock.Any()).
-		Return([]entitylog.LogStats{}, nil)
+		Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, q entitylog.StatsQuery) (*entitylog.Stats, error) {
+			assert.Equal(t, "tenant-1", q.TenantID)
+			assert.Equal(t, params.StartDate, q.StartTime)
+			return &entitylog.Stats{Series: []entitylog.StatSeries{}}, nil
+		})
 
 	handler.GetLogsStat(c, params)
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestLogHandler_GetLogsStat_IntervalAndFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetStatsUseCaseInterface(ctrl)
+	handler := h.LogHandler{StatsUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/stats", nil)
+	interval := api_service.IntervalHour
+	groupBy := api_service.GroupByResource
+	severity := api_service.ERROR
+	params := api_service.GetLogsStatParams{
+		StartDate: time.Now().Add(-6 * time.Hour),
+		Interval:  &interval,
+		GroupBy:   &groupBy,
+		Severity:  &severity,
+		UserId:    utils.Ptr("user-9"),
+	}
+
+	mockUC.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, q entitylog.StatsQuery) (*entitylog.Stats, error) {
+			assert.Equal(t, entitylog.IntervalHour, q.Interval)
+			assert.Equal(t, entitylog.GroupByResource, q.GroupBy)
+			assert.Equal(t, string(entitylog.SeverityError), q.Severity)
+			assert.Equal(t, "user-9", q.UserID)
+			assert.Empty(t, q.Action)
+			return &entitylog.Stats{Series: []entitylog.StatSeries{{Key: "invoice", Total: 2}}}, nil
+		})
+
+	handler.GetLogsStat(c, params)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"key":"invoice"`)
+}
+
+func TestLogHandler_GetLogsStat_InvalidQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetStatsUseCaseInterface(ctrl)
+	handler := h.LogHandler{StatsUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/stats", nil)
+	params := api_service.GetLogsStatParams{StartDate: time.Now().Add(-48 * time.Hour)}
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("%w: too long", logUC.ErrInvalidStatsQuery))
+
+	handler.GetLogsStat(c, params)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestLogHandler_GetLogsTopInsights(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetTopActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{TopUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/insights/top", nil)
+	action := api_service.DELETE
+	params := api_service.GetLogsTopInsightsParams{
+		Dimension: api_service.DimensionUserId,
+		StartDate: time.Now().Add(-24 * time.Hour),
+		Action:    &action,
+		Limit:     utils.Ptr(5),
+	}
+
+	mockUC.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, q entitylog.TopQuery) ([]entitylog.TopEntry, error) {
+			assert.Equal(t, "tenant-1", q.TenantID)
+			assert.Equal(t, entitylog.InsightUser, q.Dimension)
+			assert.Equal(t, string(entitylog.ActionDelete), q.Action)
+			assert.Equal(t, 5, q.Limit)
+			return []entitylog.TopEntry{{Key: "user-7", Count: 42}}, nil
+		})
+
+	handler.GetLogsTopInsights(c, params)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `{"count":42,"key":"user-7"}`)
+}
+
+func TestLogHandler_GetLogsTopInsights_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetTopActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{TopUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/insights/top", nil)
+	params := api_service.GetLogsTopInsightsParams{Dimension: "session_id", StartDate: time.Now().Add(-time.Hour)}
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("%w: unknown dimension", logUC.ErrInvalidInsightQuery))
+
+	handler.GetLogsTopInsights(c, params)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestLogHandler_GetLogsAnomalies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetAnomaliesUseCaseInterface(ctrl)
+	handler := h.LogHandler{AnomaliesUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/insights/anomalies", nil)
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+
+	mockUC.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, q entitylog.AnomalyQuery) (*entitylog.Anomalies, error) {
+			assert.Equal(t, "tenant-1", q.TenantID)
+			// the last 24 hours by default
+			assert.Equal(t, 24*time.Hour, q.EndTime.Sub(q.StartTime))
+			assert.Zero(t, q.BaselineDays)
+			q.BaselineDays, q.Threshold = 14, 3
+			return &entitylog.Anomalies{
+				Query:  q,
+				Spikes: []entitylog.ActivitySpike{{UserID: "user-1", Day: day, Count: 80, Mean: 11, StdDev: 1, ZScore: 69}},
+				NewIPs: []entitylog.UserIP{{UserID: "user-1", IPAddress: "203.0.113.7", FirstSeen: day, LastSeen: day, Count: 3}},
+			}, nil
+		})
+
+	handler.GetLogsAnomalies(c, api_service.GetLogsAnomaliesParams{})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp api_service.LogAnomalies
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 14, resp.BaselineDays)
+	assert.Len(t, resp.Spikes, 1)
+	assert.Equal(t, 69.0, resp.Spikes[0].ZScore)
+	assert.Len(t, resp.NewIps, 1)
+	assert.Equal(t, "203.0.113.7", resp.NewIps[0].IpAddress)
+}
+
+func TestLogHandler_GetLogsAnomalies_OtherTenantWithoutGrant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccess := grantMocks.NewMockAccessTenantUseCaseInterface(ctrl)
+	handler := h.LogHandler{AccessUC: mockAccess}
+
+	c, w := setupContext(http.MethodGet, "/logs/insights/anomalies", nil)
+	mockAccess.EXPECT().Execute(gomock.Any(), "tenant-1", "user-1", "tenant-2", "insights", gomock.Any()).Return(nil, grant.ErrNoGrant)
+
+	handler.GetLogsAnomalies(c, api_service.GetLogsAnomaliesParams{TenantId: utils.Ptr("tenant-2")})
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
 func TestLogHandler_GetLogsStat_InvalidRange(t *testing.T) {
 	handler := h.LogHandler{}
 
@@ -171,11 +648,13 @@ func TestLogHandler_SearchLogs_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUC := ucMocks.NewMockSearchLogsUseCaseInterface(ctrl)
-	handler := h.LogHandler{SearchLogUC: mockUC}
+	mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{SearchLogUC: mockUC, MeterUC: mockMeterUC}
 
 	c, w := setupContext(http.MethodGet, "/logs/search", nil)
 	params := api_service.SearchLogsParams{}
 	expected := &repository.SearchResult{Total: 1, Logs: []entitylog.Log{{ID: "log-1"}}}
+	mockMeterUC.EXPECT().Execute(gomock.Any(), "tenant-1", tenant_usage.ActivitySearch).Return(nil)
 
 	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(expected, nil)
 
@@ -185,6 +664,23 @@ func TestLogHandler_SearchLogs_Success(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "log-1")
 }
 
+func TestLogHandler_SearchLogs_MeteringFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockSearchLogsUseCaseInterface(ctrl)
+	mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{SearchLogUC: mockUC, MeterUC: mockMeterUC}
+
+	c, w := setupContext(http.MethodGet, "/logs/search", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&repository.SearchResult{}, nil)
+	mockMeterUC.EXPECT().Execute(gomock.Any(), "tenant-1", tenant_usage.ActivitySearch).Return(errors.New("db error"))
+
+	handler.SearchLogs(c, api_service.SearchLogsParams{})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
 func TestLogHandler_SearchLogs_Fail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -207,10 +703,12 @@ func TestLogHandler_ExportLogs_JSON(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUC := ucMocks.NewMockSearchLogsUseCaseInterface(ctrl)
-	handler := h.LogHandler{SearchLogUC: mockUC}
+	mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{SearchLogUC: mockUC, MeterUC: mockMeterUC}
 
 	c, w := setupContext(http.MethodGet, "/logs/export", nil)
 	params := api_service.ExportLogsParams{Format: "json"}
+	mockMeterUC.EXPECT().Execute(gomock.Any(), "tenant-1", tenant_usage.ActivityExport).Return(nil)
 
 	mockUC.EXPECT().
 		Stream(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -229,10 +727,12 @@ func TestLogHandler_ExportLogs_CSV(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUC := ucMocks.NewMockSearchLogsUseCaseInterface(ctrl)
-	handler := h.LogHandler{SearchLogUC: mockUC}
+	mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+	handler := h.LogHandler{SearchLogUC: mockUC, MeterUC: mockMeterUC}
 
 	c, w := setupContext(http.MethodGet, "/logs/export", nil)
 	params := api_service.ExportLogsParams{Format: "csv"}
+	mockMeterUC.EXPECT().Execute(gomock.Any(), "tenant-1", tenant_usage.ActivityExport).Return(nil)
 
 	mockUC.EXPECT().
 		Stream(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -260,3 +760,115 @@ func TestLogHandler_ExportLogs_BadFormat(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
+
+func visibilityTestLog() entitylog.Log {
+	ip := "10.0.0.1"
+	agent := "curl/8.0"
+	before := datatypes.JSON(`{"status":"draft"}`)
+	after := datatypes.JSON(`{"status":"published"}`)
+	return entitylog.Log{
+		ID: "log-1", TenantID: "tenant-1", UserID: "user-1",
+		Action: entitylog.ActionUpdate, Severity: entitylog.SeverityInfo,
+		EventTimestamp: time.Now().UTC(), Message: "updated",
+		IPAddress: &ip, UserAgent: &agent, BeforeState: &before, AfterState: &after,
+	}
+}
+
+var testVisibility = auth.FieldVisibility{
+	auth.RoleUser: {"ip_address", "user_agent", "before_state"},
+}
+
+func TestLogHandler_GetLog_FieldVisibility(t *testing.T) {
+	tests := []struct {
+		role   auth.Role
+		hidden bool
+	}{
+		{auth.RoleAdmin, false},
+		{auth.RoleAuditor, false},
+		{auth.RoleUser, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := ucMocks.NewMockGetLogUseCaseInterface(ctrl)
+			handler := h.LogHandler{GetUC: mockUC, Visibility: testVisibility}
+
+			c, w := setupContext(http.MethodGet, "/logs/log-1", nil)
+			c.Set(constant.Role, tt.role)
+			l := visibilityTestLog()
+			mockUC.EXPECT().Execute(gomock.Any(), "log-1", gomock.Any()).Return(&l, nil)
+
+			handler.GetLog(c, "log-1", api_service.GetLogParams{})
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var resp api_service.GetSingleLogResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.hidden, resp.IpAddress == nil)
+			assert.Equal(t, tt.hidden, resp.UserAgent == nil)
+			assert.Equal(t, tt.hidden, resp.BeforeState == nil)
+			assert.NotNil(t, resp.AfterState)
+		})
+	}
+}
+
+func TestLogHandler_SearchLogs_FieldVisibility(t *testing.T) {
+	for _, role := range []auth.Role{auth.RoleAdmin, auth.RoleAuditor, auth.RoleUser} {
+		t.Run(string(role), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := ucMocks.NewMockSearchLogsUseCaseInterface(ctrl)
+			mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+			handler := h.LogHandler{SearchLogUC: mockUC, MeterUC: mockMeterUC, Visibility: testVisibility}
+
+			c, w := setupContext(http.MethodGet, "/logs/search", nil)
+			c.Set(constant.Role, role)
+			if role != auth.RoleAdmin {
+				mockMeterUC.EXPECT().Execute(gomock.Any(), "tenant-1", tenant_usage.ActivitySearch).Return(nil)
+			}
+			mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+				Return(&repository.SearchResult{Total: 1, Logs: []entitylog.Log{visibilityTestLog()}}, nil)
+
+			handler.SearchLogs(c, api_service.SearchLogsParams{})
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, role != auth.RoleUser, strings.Contains(w.Body.String(), "10.0.0.1"))
+			assert.Equal(t, role != auth.RoleUser, strings.Contains(w.Body.String(), "draft"))
+			assert.Contains(t, w.Body.String(), "published")
+		})
+	}
+}
+
+func TestLogHandler_ExportLogs_FieldVisibility(t *testing.T) {
+	for _, role := range []auth.Role{auth.RoleAdmin, auth.RoleAuditor, auth.RoleUser} {
+		t.Run(string(role), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := ucMocks.NewMockSearchLogsUseCaseInterface(ctrl)
+			mockMeterUC := quotaMocks.NewMockRecordActivityUseCaseInterface(ctrl)
+			handler := h.LogHandler{SearchLogUC: mockUC, MeterUC: mockMeterUC, Visibility: testVisibility}
+
+			c, w := setupContext(http.MethodGet, "/logs/export", nil)
+			c.Set(constant.Role, role)
+			if role != auth.RoleAdmin {
+				mockMeterUC.EXPECT().Execute(gomock.Any(), "tenant-1", tenant_usage.ActivityExport).Return(nil)
+			}
+			mockUC.EXPECT().
+				Stream(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ repository.LogSearchFilters, fn func(entitylog.Log) error) error {
+					return fn(visibilityTestLog())
+				})
+
+			handler.ExportLogs(c, api_service.ExportLogsParams{Format: "json"})
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, role != auth.RoleUser, strings.Contains(w.Body.String(), "curl/8.0"))
+			assert.Equal(t, role != auth.RoleUser, strings.Contains(w.Body.String(), "draft"))
+			assert.Contains(t, w.Body.String(), "published")
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -8,16 +9,19 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	entity_log "github.com/Haevnen/audit-logging-api/internal/entity/log"
 	"github.com/Haevnen/audit-logging-api/internal/registry"
 	"github.com/Haevnen/audit-logging-api/internal/service"
 )
 
 type LogStreamHandler struct {
-	Pubsub service.PubSub
+	Pubsub     service.PubSub
+	Visibility auth.FieldVisibility
 }
 
 func newLogStreamHandler(r *registry.Registry) LogStreamHandler {
-	return LogStreamHandler{Pubsub: r.PubSub()}
+	return LogStreamHandler{Pubsub: r.PubSub(), Visibility: r.FieldVisibility()}
 }
 
 var upgrader = websocket.Upgrader{
@@ -32,7 +36,7 @@ func (h LogStreamHandler) StreamLogs(c *gin.Context, params api_service.StreamLo
 
 	channel := "logs"
 	if tenantId != "" {
-		channel = "logs:" + tenantId
+		channel = service.TenantChannel(tenantId)
 	}
 
 	// 2. Upgrade to WebSocket and subscribe
@@ -51,10 +55,20 @@ func (h LogStreamHandler) StreamLogs(c *gin.Context, params api_service.StreamLo
 		case <-ctx.Done():
 			return
 		case msg := <-ch:
-			if msg == nil {
+			if msg == nil || msg.Payload == service.TenantChannelClosed {
 				return
 			}
-			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg.Payload)); err != nil {
+
+			// logs are sent in the same shape and with the same field visibility as GET /logs/{id}
+			var l entity_log.Log
+			if err := json.Unmarshal([]byte(msg.Payload), &l); err != nil {
+				continue
+			}
+			resp, err := toVisibleLogResponse(c, h.Visibility, l)
+			if err != nil {
+				continue
+			}
+			if err := conn.WriteJSON(resp); err != nil {
 				return
 			}
 		}
@@ -0,0 +1,104 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+)
+
+func TestLogStreamHandler_StreamLogs_FieldVisibility(t *testing.T) {
+	for _, role := range []auth.Role{auth.RoleAdmin, auth.RoleAuditor, auth.RoleUser} {
+		t.Run(string(role), func(t *testing.T) {
+			mr := miniredis.RunT(t)
+			pubsub := service.NewPubSubImpl(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+			handler := h.LogStreamHandler{Pubsub: pubsub, Visibility: testVisibility}
+
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.GET("/logs/stream", func(c *gin.Context) {
+				c.Set(constant.TenantID, "tenant-1")
+				c.Set(constant.Role, role)
+				handler.StreamLogs(c, api_service.StreamLogsParams{})
+			})
+			srv := httptest.NewServer(r)
+			defer srv.Close()
+
+			conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/logs/stream", nil)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			channel := "logs:tenant-1"
+			if role == auth.RoleAdmin {
+				channel = "logs"
+			}
+			require.Eventually(t, func() bool {
+				return mr.PubSubNumSub(channel)[channel] == 1
+			}, time.Second, 10*time.Millisecond)
+			require.NoError(t, pubsub.BroadcastLog(context.Background(), visibilityTestLog()))
+
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+			_, data, err := conn.ReadMessage()
+			require.NoError(t, err)
+
+			var resp api_service.GetSingleLogResponse
+			require.NoError(t, json.Unmarshal(data, &resp))
+			assert.Equal(t, "log-1", resp.Id)
+			assert.Equal(t, role == auth.RoleUser, resp.IpAddress == nil)
+			assert.Equal(t, role == auth.RoleUser, resp.BeforeState == nil)
+			assert.NotNil(t, resp.AfterState)
+		})
+	}
+}
+
+func TestLogStreamHandler_StreamLogs_ClosedOnTenantDeletion(t *testing.T) {
+	mr := miniredis.RunT(t)
+	pubsub := service.NewPubSubImpl(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	handler := h.LogStreamHandler{Pubsub: pubsub, Visibility: testVisibility}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/logs/stream", func(c *gin.Context) {
+		c.Set(constant.TenantID, "tenant-1")
+		c.Set(constant.Role, auth.RoleUser)
+		handler.StreamLogs(c, api_service.StreamLogsParams{})
+	})
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/logs/stream", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.Eventually(t, func() bool {
+		return mr.PubSubNumSub("logs:tenant-1")["logs:tenant-1"] == 1
+	}, time.Second, 10*time.Millisecond)
+
+	closed, err := pubsub.CloseTenantChannel(context.Background(), "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), closed)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	_, _, err = conn.ReadMessage()
+	assert.Error(t, err)
+	var netErr interface{ Timeout() bool }
+	if errors.As(err, &netErr) {
+		assert.False(t, netErr.Timeout(), "the stream should be closed, not idle")
+	}
+}
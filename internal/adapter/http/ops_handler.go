@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+// defaultOpsWindow is how far back the index lag looks when no start is given
+const defaultOpsWindow = time.Hour
+
+type OpsHandler struct {
+	DashboardUC ops.GetDashboardUseCaseInterface
+	ReindexUC   ops.StartReindexUseCaseInterface
+	RebuildUC   ops.StartIndexRebuildUseCaseInterface
+}
+
+func newOpsHandler(r *registry.Registry) OpsHandler {
+	return OpsHandler{DashboardUC: r.GetDashboardUseCase(), ReindexUC: r.StartReindexUseCase(), RebuildUC: r.StartIndexRebuildUseCase()}
+}
+
+// GetOpsDashboard implements (GET /admin/ops)
+func (h OpsHandler) GetOpsDashboard(c *gin.Context, params api_service.GetOpsDashboardParams) {
+	since := time.Now().UTC().Add(-defaultOpsWindow)
+	if params.Since != nil {
+		since = *params.Since
+	}
+
+	d, err := h.DashboardUC.Execute(c.Request.Context(), since, utils.Deref(params.Limit))
+	if errors.Is(err, ops.ErrInvalidDashboardQuery) {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	c.JSON(http.StatusOK, ToOpsDashboardResponse(*d))
+}
+
+// StartReindex implements (POST /admin/reindex)
+func (h OpsHandler) StartReindex(c *gin.Context) {
+	var body api_service.ReindexRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	task, err := h.ReindexUC.Execute(c.Request.Context(), ops.ReindexRequest{
+		TenantID:  utils.Deref(body.TenantId),
+		StartTime: body.StartTime,
+		EndTime:   body.EndTime,
+		UserID:    c.GetString(constant.UserID),
+	})
+	switch {
+	case errors.Is(err, ops.ErrInvalidReindexRequest):
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		SendError(c, "tenant not found", apperror.ErrRecordNotFound)
+		return
+	case err != nil:
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp, err := ToAsyncTaskResponse(*task)
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// StartIndexRebuild implements (POST /admin/index-rebuild)
+func (h OpsHandler) StartIndexRebuild(c *gin.Context) {
+	task, err := h.RebuildUC.Execute(c.Request.Context(), c.GetString(constant.UserID))
+	switch {
+	case errors.Is(err, service.ErrSearchIndexUpToDate):
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	case err != nil:
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp, err := ToAsyncTaskResponse(*task)
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.JSON(http.StatusAccepted, resp)
+}
@@ -0,0 +1,172 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/ops"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	ucOps "github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+
+	ucMocks "github.com/Haevnen/audit-logging-api/internal/usecase/ops/mocks"
+)
+
+func TestOpsHandler_GetOpsDashboard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetDashboardUseCaseInterface(ctrl)
+	handler := h.OpsHandler{DashboardUC: mockUC}
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	since := now.Add(-time.Hour)
+	oldest := now.Add(-90 * time.Second)
+	mockUC.EXPECT().Execute(gomock.Any(), since, 10).Return(&ops.Dashboard{
+		GeneratedAt: now,
+		Queues:      []ops.QueueStatus{{Name: "index-q", TaskType: async_task.TaskIndex, Messages: 42, InFlight: 5, OldestPendingTask: &oldest}},
+		Tasks:       []async_task.TaskCount{{TaskType: async_task.TaskIndex, Status: async_task.StatusPending, Count: 40, OldestCreatedAt: oldest}},
+		Since:       since,
+		IndexLag:    []ops.TenantIndexLag{{TenantID: "t1", Stored: 100, Indexed: 60}},
+	}, nil)
+
+	c, w := setupContext(http.MethodGet, "/admin/ops", nil)
+	handler.GetOpsDashboard(c, api_service.GetOpsDashboardParams{Since: &since, Limit: utils.Ptr(10)})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp api_service.OpsDashboard
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp.Queues, 1)
+	assert.Equal(t, int64(42), resp.Queues[0].Messages)
+	assert.Equal(t, utils.Ptr(int64(90)), resp.Queues[0].OldestPendingAgeSeconds)
+	assert.Equal(t, api_service.TaskCountStatusPending, resp.Tasks[0].Status)
+	assert.Equal(t, []api_service.TenantIndexLag{{TenantId: "t1", Stored: 100, Indexed: 60, Missing: 40}}, resp.IndexLag.Tenants)
+}
+
+func TestOpsHandler_GetOpsDashboard_DefaultWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetDashboardUseCaseInterface(ctrl)
+	handler := h.OpsHandler{DashboardUC: mockUC}
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any(), 0).DoAndReturn(
+		func(_ interface{}, since time.Time, _ int) (*ops.Dashboard, error) {
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), since, time.Minute)
+			return &ops.Dashboard{}, nil
+		})
+
+	c, w := setupContext(http.MethodGet, "/admin/ops", nil)
+	handler.GetOpsDashboard(c, api_service.GetOpsDashboardParams{})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestOpsHandler_GetOpsDashboard_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetDashboardUseCaseInterface(ctrl)
+	handler := h.OpsHandler{DashboardUC: mockUC}
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any(), 1000).Return(nil, fmt.Errorf("%w: limit", ucOps.ErrInvalidDashboardQuery))
+
+	c, w := setupContext(http.MethodGet, "/admin/ops", nil)
+	handler.GetOpsDashboard(c, api_service.GetOpsDashboardParams{Limit: utils.Ptr(1000)})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestOpsHandler_StartReindex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockStartReindexUseCaseInterface(ctrl)
+	handler := h.OpsHandler{ReindexUC: mockUC}
+
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	mockUC.EXPECT().Execute(gomock.Any(), ucOps.ReindexRequest{TenantID: "t1", StartTime: start, EndTime: end, UserID: "user-1"}).
+		Return(&async_task.AsyncTask{TaskID: "task-1", TaskType: async_task.TaskReindex, Status: async_task.StatusPending, TenantUID: utils.Ptr("t1")}, nil)
+
+	body, _ := json.Marshal(api_service.ReindexRequestBody{TenantId: utils.Ptr("t1"), StartTime: start, EndTime: end})
+	c, w := setupContext(http.MethodPost, "/admin/reindex", body)
+	handler.StartReindex(c)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	var resp api_service.AsyncTask
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "task-1", resp.Id)
+	assert.Equal(t, "reindex", resp.Type)
+}
+
+func TestOpsHandler_StartReindex_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "invalid range", err: fmt.Errorf("%w: range", ucOps.ErrInvalidReindexRequest), code: http.StatusBadRequest},
+		{name: "unknown tenant", err: gorm.ErrRecordNotFound, code: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := ucMocks.NewMockStartReindexUseCaseInterface(ctrl)
+			handler := h.OpsHandler{ReindexUC: mockUC}
+			mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+			body := []byte(`{"start_time":"2026-10-18T00:00:00Z","end_time":"2026-10-19T00:00:00Z"}`)
+			c, w := setupContext(http.MethodPost, "/admin/reindex", body)
+			handler.StartReindex(c)
+
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+}
+
+func TestOpsHandler_StartIndexRebuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockStartIndexRebuildUseCaseInterface(ctrl)
+	handler := h.OpsHandler{RebuildUC: mockUC}
+	mockUC.EXPECT().Execute(gomock.Any(), "user-1").
+		Return(&async_task.AsyncTask{TaskID: "task-1", TaskType: async_task.TaskIndexRebuild, Status: async_task.StatusPending}, nil)
+
+	c, w := setupContext(http.MethodPost, "/admin/index-rebuild", nil)
+	handler.StartIndexRebuild(c)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	var resp api_service.AsyncTask
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "task-1", resp.Id)
+	assert.Equal(t, "index_rebuild", resp.Type)
+}
+
+func TestOpsHandler_StartIndexRebuild_UpToDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockStartIndexRebuildUseCaseInterface(ctrl)
+	handler := h.OpsHandler{RebuildUC: mockUC}
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("%w: version 1", service.ErrSearchIndexUpToDate))
+
+	c, w := setupContext(http.MethodPost, "/admin/index-rebuild", nil)
+	handler.StartIndexRebuild(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
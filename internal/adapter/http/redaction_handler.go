@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/redaction"
+)
+
+type RedactionHandler struct {
+	CreateRuleUC redaction.CreateRedactionRuleUseCaseInterface
+	ListRuleUC   redaction.ListRedactionRulesUseCaseInterface
+	DeleteRuleUC redaction.DeleteRedactionRuleUseCaseInterface
+}
+
+func newRedactionHandler(r *registry.Registry) RedactionHandler {
+	return RedactionHandler{
+		CreateRuleUC: r.CreateRedactionRuleUseCase(),
+		ListRuleUC:   r.ListRedactionRulesUseCase(),
+		DeleteRuleUC: r.DeleteRedactionRuleUseCase(),
+	}
+}
+
+// ListRedactionRules implements (GET /redaction-rules)
+// List the redaction rules of the caller's tenant (every tenant for admin).
+func (h RedactionHandler) ListRedactionRules(c *gin.Context) {
+	rules, err := h.ListRuleUC.Execute(c.Request.Context(), getClaimTenant(c))
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp := make([]api_service.RedactionRule, 0, len(rules))
+	for _, r := range rules {
+		resp = append(resp, ToRedactionRuleResponse(r))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateRedactionRule implements (POST /redaction-rules)
+// Register a rule applied to every new log of the tenant.
+// Regex rules take either a custom pattern or a built-in detector, field rules take a path.
+func (h RedactionHandler) CreateRedactionRule(c *gin.Context) {
+	var body api_service.CreateRedactionRuleRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	if err := validateMismatchTenant(getClaimTenant(c), body.TenantId); err != nil {
+		SendError(c, "tenant id mismatch", err)
+		return
+	}
+
+	if len(body.TenantId) == 0 || len(body.Name) == 0 {
+		SendError(c, "tenant id and name are required", apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	rule := redaction_rule.RedactionRule{
+		TenantID: body.TenantId,
+		Name:     body.Name,
+		Kind:     redaction_rule.Kind(body.Kind),
+		Action:   redaction_rule.Action(body.Action),
+	}
+	if !rule.Kind.IsValid() {
+		SendError(c, "invalid kind", apperror.ErrInvalidRequestInput)
+		return
+	}
+	if !rule.Action.IsValid() {
+		SendError(c, "invalid action", apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	switch rule.Kind {
+	case redaction_rule.KindRegex:
+		rule.Pattern = body.Pattern
+		if (rule.Pattern == nil || len(*rule.Pattern) == 0) && body.Detector != nil {
+			pattern, ok := redaction_rule.Detectors[string(*body.Detector)]
+			if !ok {
+				SendError(c, "invalid detector", apperror.ErrInvalidRequestInput)
+				return
+			}
+			rule.Pattern = &pattern
+		}
+	case redaction_rule.KindField:
+		rule.Path = body.Path
+	}
+
+	created, err := h.CreateRuleUC.Execute(c.Request.Context(), rule)
+	if err != nil {
+		if errors.Is(err, redaction.ErrInvalidRule) {
+			SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+			return
+		}
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.JSON(http.StatusCreated, ToRedactionRuleResponse(*created))
+}
+
+// DeleteRedactionRule implements (DELETE /redaction-rules/{id})
+// Logs stored before the deletion stay redacted.
+func (h RedactionHandler) DeleteRedactionRule(c *gin.Context, id string) {
+	if err := h.DeleteRuleUC.Execute(c.Request.Context(), id, getClaimTenant(c)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			SendError(c, err.Error(), apperror.ErrRecordNotFound)
+			return
+		}
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
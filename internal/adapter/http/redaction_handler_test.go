@@ -0,0 +1,98 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/redaction"
+
+	redactionMocks "github.com/Haevnen/audit-logging-api/internal/usecase/redaction/mocks"
+)
+
+func TestRedactionHandler_CreateRedactionRule_Detector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := redactionMocks.NewMockCreateRedactionRuleUseCaseInterface(ctrl)
+	handler := h.RedactionHandler{CreateRuleUC: mockUC}
+
+	data := []byte(`{"tenant_id":"tenant-1","name":"emails","kind":"regex","detector":"email","action":"mask"}`)
+	c, w := setupContext(http.MethodPost, "/redaction-rules", data)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, r redaction_rule.RedactionRule) (*redaction_rule.RedactionRule, error) {
+			assert.Equal(t, redaction_rule.Detectors["email"], *r.Pattern)
+			r.ID = "rule-1"
+			return &r, nil
+		})
+
+	handler.CreateRedactionRule(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), "rule-1")
+}
+
+func TestRedactionHandler_CreateRedactionRule_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := redactionMocks.NewMockCreateRedactionRuleUseCaseInterface(ctrl)
+	handler := h.RedactionHandler{CreateRuleUC: mockUC}
+
+	data := []byte(`{"tenant_id":"tenant-1","name":"bad","kind":"regex","pattern":"(","action":"mask"}`)
+	c, w := setupContext(http.MethodPost, "/redaction-rules", data)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, redaction.ErrInvalidRule)
+
+	handler.CreateRedactionRule(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRedactionHandler_CreateRedactionRule_TenantMismatch(t *testing.T) {
+	handler := h.RedactionHandler{}
+
+	data := []byte(`{"tenant_id":"tenant-2","name":"emails","kind":"regex","detector":"email","action":"mask"}`)
+	c, w := setupContext(http.MethodPost, "/redaction-rules", data)
+
+	handler.CreateRedactionRule(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestRedactionHandler_ListRedactionRules_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := redactionMocks.NewMockListRedactionRulesUseCaseInterface(ctrl)
+	handler := h.RedactionHandler{ListRuleUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/redaction-rules", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "tenant-1").
+		Return([]redaction_rule.RedactionRule{{ID: "rule-1", Name: "emails", Kind: redaction_rule.KindRegex, Action: redaction_rule.ActionMask}}, nil)
+
+	handler.ListRedactionRules(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "rule-1")
+}
+
+func TestRedactionHandler_DeleteRedactionRule_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := redactionMocks.NewMockDeleteRedactionRuleUseCaseInterface(ctrl)
+	handler := h.RedactionHandler{DeleteRuleUC: mockUC}
+
+	c, _ := setupContext(http.MethodDelete, "/redaction-rules/rule-1", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "rule-1", "tenant-1").Return(nil)
+
+	handler.DeleteRedactionRule(c, "rule-1")
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/rbac"
+)
+
+type RoleHandler struct {
+	CreateRoleUC    rbac.CreateRoleUseCaseInterface
+	UpdateRoleUC    rbac.UpdateRoleUseCaseInterface
+	DeleteRoleUC    rbac.DeleteRoleUseCaseInterface
+	ListRolesUC     rbac.ListRolesUseCaseInterface
+	CreateBindingUC rbac.CreateRoleBindingUseCaseInterface
+	DeleteBindingUC rbac.DeleteRoleBindingUseCaseInterface
+	ListBindingsUC  rbac.ListRoleBindingsUseCaseInterface
+}
+
+func newRoleHandler(r *registry.Registry) RoleHandler {
+	return RoleHandler{
+		CreateRoleUC:    r.CreateRoleUseCase(),
+		UpdateRoleUC:    r.UpdateRoleUseCase(),
+		DeleteRoleUC:    r.DeleteRoleUseCase(),
+		ListRolesUC:     r.ListRolesUseCase(),
+		CreateBindingUC: r.CreateRoleBindingUseCase(),
+		DeleteBindingUC: r.DeleteRoleBindingUseCase(),
+		ListBindingsUC:  r.ListRoleBindingsUseCase(),
+	}
+}
+
+// ListRoles implements (GET /roles)
+// List the global roles, plus the roles of the tenant given in the query (every tenant otherwise).
+func (h RoleHandler) ListRoles(c *gin.Context, params api_service.ListRolesParams) {
+	roles, err := h.ListRolesUC.Execute(c.Request.Context(), optionalString(params.TenantId))
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp := make([]api_service.Role, 0, len(roles))
+	for _, r := range roles {
+		resp = append(resp, ToRoleResponse(r))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateRole implements (POST /roles)
+func (h RoleHandler) CreateRole(c *gin.Context) {
+	var body api_service.CreateRoleRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	r := role.Role{
+		TenantID:    emptyToNil(body.TenantId),
+		Name:        body.Name,
+		Description: body.Description,
+		Permissions: toPermissions(body.Permissions),
+	}
+	created, err := h.CreateRoleUC.Execute(c.Request.Context(), r)
+	if err != nil {
+		sendRoleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ToRoleResponse(*created))
+}
+
+// UpdateRole implements (PUT /roles/{id})
+func (h RoleHandler) UpdateRole(c *gin.Context, id string) {
+	var body api_service.UpdateRoleRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	updated, err := h.UpdateRoleUC.Execute(c.Request.Context(), id, body.Description, toPermissions(body.Permissions))
+	if err != nil {
+		sendRoleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ToRoleResponse(*updated))
+}
+
+// DeleteRole implements (DELETE /roles/{id})
+func (h RoleHandler) DeleteRole(c *gin.Context, id string) {
+	if err := h.DeleteRoleUC.Execute(c.Request.Context(), id); err != nil {
+		sendRoleError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListRoleBindings implements (GET /role-bindings)
+func (h RoleHandler) ListRoleBindings(c *gin.Context, params api_service.ListRoleBindingsParams) {
+	bindings, err := h.ListBindingsUC.Execute(c.Request.Context(), optionalString(params.TenantId), optionalString(params.UserId))
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp := make([]api_service.RoleBinding, 0, len(bindings))
+	for _, b := range bindings {
+		resp = append(resp, ToRoleBindingResponse(b))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateRoleBinding implements (POST /role-bindings)
+// Grant a role to a user, the bound roles replace the permissions of the role in their token.
+func (h RoleHandler) CreateRoleBinding(c *gin.Context) {
+	var body api_service.CreateRoleBindingRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	b := role.Binding{
+		TenantID: emptyToNil(body.TenantId),
+		UserID:   body.UserId,
+		RoleID:   body.RoleId,
+	}
+	created, err := h.CreateBindingUC.Execute(c.Request.Context(), c.GetString(constant.UserID), b)
+	if err != nil {
+		sendRoleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, ToRoleBindingResponse(*created))
+}
+
+// DeleteRoleBinding implements (DELETE /role-bindings/{id})
+func (h RoleHandler) DeleteRoleBinding(c *gin.Context, id string) {
+	if err := h.DeleteBindingUC.Execute(c.Request.Context(), id); err != nil {
+		sendRoleError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func sendRoleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, rbac.ErrInvalidRole), errors.Is(err, rbac.ErrBuiltInRole), errors.Is(err, rbac.ErrInvalidGrant):
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		SendError(c, err.Error(), apperror.ErrRecordNotFound)
+	default:
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+	}
+}
+
+func toPermissions(perms []api_service.Permission) []auth.Permission {
+	out := make([]auth.Permission, 0, len(perms))
+	for _, p := range perms {
+		out = append(out, auth.Permission(p))
+	}
+	return out
+}
+
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func emptyToNil(s *string) *string {
+	if s == nil || len(*s) == 0 {
+		return nil
+	}
+	return s
+}
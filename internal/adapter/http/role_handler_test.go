@@ -0,0 +1,184 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/rbac"
+
+	rbacMocks "github.com/Haevnen/audit-logging-api/internal/usecase/rbac/mocks"
+)
+
+func TestRoleHandler_CreateRole_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := rbacMocks.NewMockCreateRoleUseCaseInterface(ctrl)
+	handler := h.RoleHandler{CreateRoleUC: mockUC}
+
+	data := []byte(`{"tenant_id":"tenant-1","name":"exporter","permissions":["logs:export"]}`)
+	c, w := setupContext(http.MethodPost, "/roles", data)
+	c.Set(constant.Role, auth.RoleAdmin)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, r role.Role) (*role.Role, error) {
+			assert.Equal(t, "tenant-1", *r.TenantID)
+			assert.Equal(t, []auth.Permission{auth.PermissionLogsExport}, []auth.Permission(r.Permissions))
+			r.ID = "role-1"
+			r.CreatedAt = time.Now()
+			r.UpdatedAt = r.CreatedAt
+			return &r, nil
+		})
+
+	handler.CreateRole(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"permissions":["logs:export"]`)
+}
+
+func TestRoleHandler_CreateRole_GlobalWhenTenantEmpty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := rbacMocks.NewMockCreateRoleUseCaseInterface(ctrl)
+	handler := h.RoleHandler{CreateRoleUC: mockUC}
+
+	c, w := setupContext(http.MethodPost, "/roles", []byte(`{"tenant_id":"","name":"read-only-admin","permissions":["logs:read"]}`))
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, r role.Role) (*role.Role, error) {
+			assert.Nil(t, r.TenantID)
+			return &r, nil
+		})
+
+	handler.CreateRole(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestRoleHandler_CreateRole_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := rbacMocks.NewMockCreateRoleUseCaseInterface(ctrl)
+	handler := h.RoleHandler{CreateRoleUC: mockUC}
+
+	c, w := setupContext(http.MethodPost, "/roles", []byte(`not json`))
+	handler.CreateRole(c)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	c, w = setupContext(http.MethodPost, "/roles", []byte(`{"name":"admin","permissions":["logs:read"]}`))
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, rbac.ErrInvalidRole)
+	handler.CreateRole(c)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRoleHandler_UpdateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := rbacMocks.NewMockUpdateRoleUseCaseInterface(ctrl)
+	handler := h.RoleHandler{UpdateRoleUC: mockUC}
+
+	c, w := setupContext(http.MethodPut, "/roles/role-1", []byte(`{"permissions":["logs:read"]}`))
+	mockUC.EXPECT().Execute(gomock.Any(), "role-1", nil, []auth.Permission{auth.PermissionLogsRead}).
+		Return(&role.Role{ID: "role-1", Name: "reader", Permissions: []auth.Permission{auth.PermissionLogsRead}}, nil)
+	handler.UpdateRole(c, "role-1")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	c, w = setupContext(http.MethodPut, "/roles/role-2", []byte(`{"permissions":["logs:read"]}`))
+	mockUC.EXPECT().Execute(gomock.Any(), "role-2", nil, gomock.Any()).Return(nil, rbac.ErrBuiltInRole)
+	handler.UpdateRole(c, "role-2")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRoleHandler_DeleteRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := rbacMocks.NewMockDeleteRoleUseCaseInterface(ctrl)
+	handler := h.RoleHandler{DeleteRoleUC: mockUC}
+
+	c, _ := setupContext(http.MethodDelete, "/roles/role-1", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "role-1").Return(nil)
+	handler.DeleteRole(c, "role-1")
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+
+	c, w := setupContext(http.MethodDelete, "/roles/missing", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "missing").Return(gorm.ErrRecordNotFound)
+	handler.DeleteRole(c, "missing")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestRoleHandler_ListRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := rbacMocks.NewMockListRolesUseCaseInterface(ctrl)
+	handler := h.RoleHandler{ListRolesUC: mockUC}
+
+	tenantId := "tenant-1"
+	c, w := setupContext(http.MethodGet, "/roles", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "tenant-1").
+		Return([]role.Role{{ID: "role-1", Name: "admin", BuiltIn: true}}, nil)
+
+	handler.ListRoles(c, api_service.ListRolesParams{TenantId: &tenantId})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"built_in":true`)
+}
+
+func TestRoleHandler_CreateRoleBinding(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := rbacMocks.NewMockCreateRoleBindingUseCaseInterface(ctrl)
+	handler := h.RoleHandler{CreateBindingUC: mockUC}
+
+	c, w := setupContext(http.MethodPost, "/role-bindings", []byte(`{"tenant_id":"tenant-1","user_id":"u1","role_id":"role-1"}`))
+	mockUC.EXPECT().Execute(gomock.Any(), "user-1", gomock.Any()).
+		DoAndReturn(func(_ interface{}, createdBy string, b role.Binding) (*role.Binding, error) {
+			assert.Equal(t, "tenant-1", *b.TenantID)
+			b.ID = "binding-1"
+			b.CreatedBy = createdBy
+			return &b, nil
+		})
+	handler.CreateRoleBinding(c)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"id":"binding-1"`)
+
+	c, w = setupContext(http.MethodPost, "/role-bindings", []byte(`{"user_id":"u1","role_id":"role-2"}`))
+	mockUC.EXPECT().Execute(gomock.Any(), "user-1", gomock.Any()).Return(nil, rbac.ErrInvalidGrant)
+	handler.CreateRoleBinding(c)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRoleHandler_ListAndDeleteRoleBindings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockList := rbacMocks.NewMockListRoleBindingsUseCaseInterface(ctrl)
+	mockDelete := rbacMocks.NewMockDeleteRoleBindingUseCaseInterface(ctrl)
+	handler := h.RoleHandler{ListBindingsUC: mockList, DeleteBindingUC: mockDelete}
+
+	userId := "u1"
+	c, w := setupContext(http.MethodGet, "/role-bindings", nil)
+	mockList.EXPECT().Execute(gomock.Any(), "", "u1").Return([]role.Binding{{ID: "binding-1", UserID: "u1", RoleID: "role-1"}}, nil)
+	handler.ListRoleBindings(c, api_service.ListRoleBindingsParams{UserId: &userId})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "binding-1")
+
+	c, _ = setupContext(http.MethodDelete, "/role-bindings/binding-1", nil)
+	mockDelete.EXPECT().Execute(gomock.Any(), "binding-1").Return(nil)
+	handler.DeleteRoleBinding(c, "binding-1")
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/schema"
+)
+
+type SchemaHandler struct {
+	CreateSchemaUC schema.CreateLogSchemaUseCaseInterface
+	UpdateSchemaUC schema.UpdateLogSchemaUseCaseInterface
+	GetSchemaUC    schema.GetLogSchemaUseCaseInterface
+	ListSchemaUC   schema.ListLogSchemasUseCaseInterface
+	DeleteSchemaUC schema.DeleteLogSchemaUseCaseInterface
+}
+
+func newSchemaHandler(r *registry.Registry) SchemaHandler {
+	return SchemaHandler{
+		CreateSchemaUC: r.CreateLogSchemaUseCase(),
+		UpdateSchemaUC: r.UpdateLogSchemaUseCase(),
+		GetSchemaUC:    r.GetLogSchemaUseCase(),
+		ListSchemaUC:   r.ListLogSchemasUseCase(),
+		DeleteSchemaUC: r.DeleteLogSchemaUseCase(),
+	}
+}
+
+// ListLogSchemas implements (GET /schemas)
+// List the active schema versions of the caller's tenant (every tenant for admin).
+// Set all_versions to include the deactivated versions that older logs refer to.
+func (h SchemaHandler) ListLogSchemas(c *gin.Context, params api_service.ListLogSchemasParams) {
+	tenantId := getClaimTenant(c)
+
+	allVersions := params.AllVersions != nil && *params.AllVersions
+	schemas, err := h.ListSchemaUC.Execute(c.Request.Context(), tenantId, params.Resource, allVersions)
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp := make([]api_service.LogSchema, 0, len(schemas))
+	for _, s := range schemas {
+		r, err := ToLogSchemaResponse(s)
+		if err != nil {
+			SendError(c, err.Error(), apperror.ErrInternalServer)
+			return
+		}
+		resp = append(resp, r)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateLogSchema implements (POST /schemas)
+// Register the schemas as the next version for the tenant and resource.
+func (h SchemaHandler) CreateLogSchema(c *gin.Context) {
+	var body api_service.CreateLogSchemaRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	if err := validateMismatchTenant(getClaimTenant(c), body.TenantId); err != nil {
+		SendError(c, "tenant id mismatch", err)
+		return
+	}
+
+	if len(body.TenantId) == 0 {
+		SendError(c, "tenant id is required", apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	s, title, err := generateLogSchemaEntity(body.Enforcement, body.MetadataSchema, body.BeforeStateSchema, body.AfterStateSchema)
+	if err != nil {
+		SendError(c, title, err)
+		return
+	}
+	s.TenantID = body.TenantId
+	if body.Resource != nil && len(*body.Resource) > 0 {
+		s.Resource = body.Resource
+	}
+
+	created, err := h.CreateSchemaUC.Execute(c.Request.Context(), s)
+	if err != nil {
+		sendSchemaError(c, err)
+		return
+	}
+
+	resp, err := ToLogSchemaResponse(*created)
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetLogSchema implements (GET /schemas/{id})
+func (h SchemaHandler) GetLogSchema(c *gin.Context, id string) {
+	s, err := h.GetSchemaUC.Execute(c.Request.Context(), id, getClaimTenant(c))
+	if err != nil {
+		sendSchemaError(c, err)
+		return
+	}
+
+	resp, err := ToLogSchemaResponse(*s)
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateLogSchema implements (PUT /schemas/{id})
+// Publish a new version for the tenant and resource of the schema.
+func (h SchemaHandler) UpdateLogSchema(c *gin.Context, id string) {
+	var body api_service.UpdateLogSchemaRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	s, title, err := generateLogSchemaEntity(body.Enforcement, body.MetadataSchema, body.BeforeStateSchema, body.AfterStateSchema)
+	if err != nil {
+		SendError(c, title, err)
+		return
+	}
+
+	updated, err := h.UpdateSchemaUC.Execute(c.Request.Context(), id, getClaimTenant(c), s)
+	if err != nil {
+		sendSchemaError(c, err)
+		return
+	}
+
+	resp, err := ToLogSchemaResponse(*updated)
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteLogSchema implements (DELETE /schemas/{id})
+// Stop enforcing the schema, its versions are kept for the logs referencing them.
+func (h SchemaHandler) DeleteLogSchema(c *gin.Context, id string) {
+	if err := h.DeleteSchemaUC.Execute(c.Request.Context(), id, getClaimTenant(c)); err != nil {
+		sendSchemaError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func generateLogSchemaEntity(enforcement api_service.SchemaEnforcement, metadata, before, after *map[string]interface{}) (log_schema.LogSchema, string, error) {
+	e := log_schema.Enforcement(enforcement)
+	if !e.IsValid() {
+		return log_schema.LogSchema{}, "invalid enforcement", apperror.ErrInvalidRequestInput
+	}
+
+	if metadata == nil && before == nil && after == nil {
+		return log_schema.LogSchema{}, "at least one schema is required", apperror.ErrInvalidRequestInput
+	}
+
+	metadataJSON, err := MarshallData(metadata)
+	if err != nil {
+		return log_schema.LogSchema{}, err.Error(), apperror.ErrInvalidRequestInput
+	}
+
+	beforeJSON, err := MarshallData(before)
+	if err != nil {
+		return log_schema.LogSchema{}, err.Error(), apperror.ErrInvalidRequestInput
+	}
+
+	afterJSON, err := MarshallData(after)
+	if err != nil {
+		return log_schema.LogSchema{}, err.Error(), apperror.ErrInvalidRequestInput
+	}
+
+	return log_schema.LogSchema{
+		Enforcement:       e,
+		MetadataSchema:    metadataJSON,
+		BeforeStateSchema: beforeJSON,
+		AfterStateSchema:  afterJSON,
+	}, "", nil
+}
+
+func sendSchemaError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		SendError(c, err.Error(), apperror.ErrRecordNotFound)
+	case errors.Is(err, schema.ErrInvalidSchema):
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+	default:
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+	}
+}
@@ -0,0 +1,180 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/schema"
+
+	schemaMocks "github.com/Haevnen/audit-logging-api/internal/usecase/schema/mocks"
+)
+
+func TestSchemaHandler_CreateLogSchema_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := schemaMocks.NewMockCreateLogSchemaUseCaseInterface(ctrl)
+	handler := h.SchemaHandler{CreateSchemaUC: mockUC}
+
+	metadata := map[string]interface{}{"type": "object", "required": []string{"request_id"}}
+	body := api_service.CreateLogSchemaRequestBody{
+		TenantId:       "tenant-1",
+		Enforcement:    api_service.Reject,
+		MetadataSchema: &metadata,
+	}
+	data, _ := json.Marshal(body)
+	c, w := setupContext(http.MethodPost, "/schemas", data)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, s log_schema.LogSchema) (*log_schema.LogSchema, error) {
+			assert.Equal(t, "tenant-1", s.TenantID)
+			assert.Equal(t, log_schema.EnforcementReject, s.Enforcement)
+			assert.NotNil(t, s.MetadataSchema)
+			s.ID = "schema-1"
+			s.Version = 1
+			s.IsActive = true
+			s.CreatedAt = time.Now().UTC()
+			return &s, nil
+		})
+
+	handler.CreateLogSchema(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), "schema-1")
+	assert.Contains(t, w.Body.String(), "request_id")
+}
+
+func TestSchemaHandler_CreateLogSchema_TenantMismatch(t *testing.T) {
+	handler := h.SchemaHandler{}
+
+	metadata := map[string]interface{}{"type": "object"}
+	body := api_service.CreateLogSchemaRequestBody{
+		TenantId:       "tenant-2",
+		Enforcement:    api_service.Flag,
+		MetadataSchema: &metadata,
+	}
+	data, _ := json.Marshal(body)
+	c, w := setupContext(http.MethodPost, "/schemas", data)
+
+	handler.CreateLogSchema(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestSchemaHandler_CreateLogSchema_NoSchema(t *testing.T) {
+	handler := h.SchemaHandler{}
+
+	body := api_service.CreateLogSchemaRequestBody{
+		TenantId:    "tenant-1",
+		Enforcement: api_service.Flag,
+	}
+	data, _ := json.Marshal(body)
+	c, w := setupContext(http.MethodPost, "/schemas", data)
+
+	handler.CreateLogSchema(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSchemaHandler_CreateLogSchema_InvalidEnforcement(t *testing.T) {
+	handler := h.SchemaHandler{}
+
+	data := []byte(`{"tenant_id":"tenant-1","enforcement":"warn","metadata_schema":{"type":"object"}}`)
+	c, w := setupContext(http.MethodPost, "/schemas", data)
+
+	handler.CreateLogSchema(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSchemaHandler_CreateLogSchema_InvalidSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := schemaMocks.NewMockCreateLogSchemaUseCaseInterface(ctrl)
+	handler := h.SchemaHandler{CreateSchemaUC: mockUC}
+
+	data := []byte(`{"tenant_id":"tenant-1","enforcement":"flag","metadata_schema":{"type":"unknown"}}`)
+	c, w := setupContext(http.MethodPost, "/schemas", data)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, schema.ErrInvalidSchema)
+
+	handler.CreateLogSchema(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSchemaHandler_ListLogSchemas_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := schemaMocks.NewMockListLogSchemasUseCaseInterface(ctrl)
+	handler := h.SchemaHandler{ListSchemaUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/schemas", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "tenant-1", nil, false).
+		Return([]log_schema.LogSchema{{ID: "schema-1", TenantID: "tenant-1", Version: 2, Enforcement: log_schema.EnforcementFlag}}, nil)
+
+	handler.ListLogSchemas(c, api_service.ListLogSchemasParams{})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "schema-1")
+}
+
+func TestSchemaHandler_GetLogSchema_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := schemaMocks.NewMockGetLogSchemaUseCaseInterface(ctrl)
+	handler := h.SchemaHandler{GetSchemaUC: mockUC}
+
+	c, w := setupContext(http.MethodGet, "/schemas/schema-1", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "schema-1", "tenant-1").Return(nil, gorm.ErrRecordNotFound)
+
+	handler.GetLogSchema(c, "schema-1")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSchemaHandler_UpdateLogSchema_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := schemaMocks.NewMockUpdateLogSchemaUseCaseInterface(ctrl)
+	handler := h.SchemaHandler{UpdateSchemaUC: mockUC}
+
+	data := []byte(`{"enforcement":"flag","after_state_schema":{"type":"object"}}`)
+	c, w := setupContext(http.MethodPut, "/schemas/schema-1", data)
+
+	mockUC.EXPECT().Execute(gomock.Any(), "schema-1", "tenant-1", gomock.Any()).
+		Return(&log_schema.LogSchema{ID: "schema-2", TenantID: "tenant-1", Version: 2, Enforcement: log_schema.EnforcementFlag, IsActive: true}, nil)
+
+	handler.UpdateLogSchema(c, "schema-1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "schema-2")
+}
+
+func TestSchemaHandler_DeleteLogSchema_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := schemaMocks.NewMockDeleteLogSchemaUseCaseInterface(ctrl)
+	handler := h.SchemaHandler{DeleteSchemaUC: mockUC}
+
+	c, _ := setupContext(http.MethodDelete, "/schemas/schema-1", nil)
+	mockUC.EXPECT().Execute(gomock.Any(), "schema-1", "tenant-1").Return(nil)
+
+	handler.DeleteLogSchema(c, "schema-1")
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/session"
+)
+
+type SessionHandler struct {
+	RevokeTokenUC    session.RevokeTokenUseCaseInterface
+	RevokeSessionsUC session.RevokeSessionsUseCaseInterface
+}
+
+func newSessionHandler(r *registry.Registry) SessionHandler {
+	return SessionHandler{
+		RevokeTokenUC:    r.RevokeTokenUseCase(),
+		RevokeSessionsUC: r.RevokeSessionsUseCase(),
+	}
+}
+
+// RevokeToken implements (POST /auth/revoke)
+// Revoke the token the request was made with, e.g. on logout or when it leaked.
+func (h SessionHandler) RevokeToken(c *gin.Context) {
+	jti := c.GetString(constant.TokenID)
+	expiresAt := c.GetTime(constant.TokenExpiresAt)
+	if len(jti) == 0 || expiresAt.IsZero() {
+		SendError(c, "token has no jti, it can't be revoked on its own", apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	token := token_revocation.RevokedToken{
+		JTI:       jti,
+		UserID:    c.GetString(constant.UserID),
+		ExpiresAt: expiresAt.UTC(),
+		RevokedBy: c.GetString(constant.UserID),
+	}
+	if tenantId := c.GetString(constant.TenantID); len(tenantId) > 0 {
+		token.TenantID = &tenantId
+	}
+
+	if err := h.RevokeTokenUC.Execute(c.Request.Context(), token); err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeSessions implements (POST /auth/revoke-all)
+// Revoke every token issued so far to a user of a tenant, or to a tenant. API keys are revoked separately.
+func (h SessionHandler) RevokeSessions(c *gin.Context) {
+	var body api_service.RevokeSessionsRequestBody
+	if err := BindRequestBody(c, &body); err != nil {
+		SendError(c, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	userId, tenantId := "", ""
+	if body.UserId != nil {
+		userId = *body.UserId
+	}
+	if body.TenantId != nil {
+		tenantId = *body.TenantId
+	}
+	if len(userId) == 0 && len(tenantId) == 0 {
+		SendError(c, "user_id or tenant_id is required", apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	// a user is revoked in its tenant only, without tenant_id its tokens without a tenant are
+	subjectType, subjectId := token_revocation.SubjectUser, userId
+	if len(userId) == 0 {
+		subjectType, subjectId, tenantId = token_revocation.SubjectTenant, tenantId, ""
+	}
+
+	if err := h.RevokeSessionsUC.Execute(c.Request.Context(), c.GetString(constant.UserID), subjectType, tenantId, subjectId); err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
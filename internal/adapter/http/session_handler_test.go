@@ -0,0 +1,134 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+
+	sessionMocks "github.com/Haevnen/audit-logging-api/internal/usecase/session/mocks"
+)
+
+func TestSessionHandler_RevokeToken_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := sessionMocks.NewMockRevokeTokenUseCaseInterface(ctrl)
+	handler := h.SessionHandler{RevokeTokenUC: mockUC}
+
+	expiresAt := time.Now().Add(time.Hour)
+	c, _ := setupContext(http.MethodPost, "/auth/revoke", nil)
+	c.Set(constant.TokenID, "jti-1")
+	c.Set(constant.TokenExpiresAt, expiresAt)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, tok token_revocation.RevokedToken) error {
+			assert.Equal(t, "jti-1", tok.JTI)
+			assert.Equal(t, "user-1", tok.UserID)
+			assert.Equal(t, "user-1", tok.RevokedBy)
+			assert.Equal(t, "tenant-1", *tok.TenantID)
+			assert.True(t, expiresAt.Equal(tok.ExpiresAt))
+			return nil
+		})
+
+	handler.RevokeToken(c)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+}
+
+func TestSessionHandler_RevokeToken_NoJTI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := h.SessionHandler{RevokeTokenUC: sessionMocks.NewMockRevokeTokenUseCaseInterface(ctrl)}
+	c, w := setupContext(http.MethodPost, "/auth/revoke", nil)
+
+	handler.RevokeToken(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSessionHandler_RevokeToken_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := sessionMocks.NewMockRevokeTokenUseCaseInterface(ctrl)
+	handler := h.SessionHandler{RevokeTokenUC: mockUC}
+
+	c, w := setupContext(http.MethodPost, "/auth/revoke", nil)
+	c.Set(constant.TokenID, "jti-1")
+	c.Set(constant.TokenExpiresAt, time.Now().Add(time.Hour))
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+	handler.RevokeToken(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestSessionHandler_RevokeSessions(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		subjectType token_revocation.SubjectType
+		tenantId    string
+		subjectId   string
+	}{
+		{"User", `{"user_id":"u1","tenant_id":"tenant-9"}`, token_revocation.SubjectUser, "tenant-9", "u1"},
+		{"User Without Tenant", `{"user_id":"u1"}`, token_revocation.SubjectUser, "", "u1"},
+		{"Tenant", `{"tenant_id":"tenant-9"}`, token_revocation.SubjectTenant, "", "tenant-9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := sessionMocks.NewMockRevokeSessionsUseCaseInterface(ctrl)
+			handler := h.SessionHandler{RevokeSessionsUC: mockUC}
+
+			c, _ := setupContext(http.MethodPost, "/auth/revoke-all", []byte(tt.body))
+			c.Set(constant.Role, auth.RoleAdmin)
+			mockUC.EXPECT().Execute(gomock.Any(), "user-1", tt.subjectType, tt.tenantId, tt.subjectId).Return(nil)
+
+			handler.RevokeSessions(c)
+
+			assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+		})
+	}
+}
+
+func TestSessionHandler_RevokeSessions_Invalid(t *testing.T) {
+	for _, body := range []string{`{}`, `not json`} {
+		ctrl := gomock.NewController(t)
+		handler := h.SessionHandler{RevokeSessionsUC: sessionMocks.NewMockRevokeSessionsUseCaseInterface(ctrl)}
+
+		c, w := setupContext(http.MethodPost, "/auth/revoke-all", []byte(body))
+		handler.RevokeSessions(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		ctrl.Finish()
+	}
+}
+
+func TestSessionHandler_RevokeSessions_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := sessionMocks.NewMockRevokeSessionsUseCaseInterface(ctrl)
+	handler := h.SessionHandler{RevokeSessionsUC: mockUC}
+
+	c, w := setupContext(http.MethodPost, "/auth/revoke-all", []byte(`{"user_id":"u1"}`))
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+	handler.RevokeSessions(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
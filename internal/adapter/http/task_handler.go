@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/task"
+)
+
+type TaskHandler struct {
+	GetTaskUC task.GetTaskUseCaseInterface
+}
+
+func newTaskHandler(r *registry.Registry) TaskHandler {
+	return TaskHandler{GetTaskUC: r.GetTaskUseCase()}
+}
+
+// GetTask implements (GET /tasks/{id})
+func (h TaskHandler) GetTask(c *gin.Context, id string) {
+	t, err := h.GetTaskUC.Execute(c.Request.Context(), id, getClaimTenant(c))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			SendError(c, "task not found", apperror.ErrRecordNotFound)
+			return
+		}
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	resp, err := ToAsyncTaskResponse(*t)
+	if err != nil {
+		SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
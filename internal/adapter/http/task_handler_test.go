@@ -0,0 +1,51 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+
+	ucMocks "github.com/Haevnen/audit-logging-api/internal/usecase/task/mocks"
+)
+
+func TestTaskHandler_GetTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetTaskUseCaseInterface(ctrl)
+	handler := h.TaskHandler{GetTaskUC: mockUC}
+
+	progress := datatypes.JSON(`{"completed_steps":["archive_logs"],"archived_logs":12}`)
+	mockUC.EXPECT().Execute(gomock.Any(), "task-1", "tenant-1").Return(&async_task.AsyncTask{
+		TaskID: "task-1", TaskType: async_task.TaskTenantDeletion, Status: async_task.StatusRunning, Progress: &progress,
+	}, nil)
+
+	c, w := setupContext(http.MethodGet, "/tasks/task-1", nil)
+	handler.GetTask(c, "task-1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"running"`)
+	assert.Contains(t, w.Body.String(), `"progress":{"archived_logs":12,"completed_steps":["archive_logs"]}`)
+}
+
+func TestTaskHandler_GetTask_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetTaskUseCaseInterface(ctrl)
+	handler := h.TaskHandler{GetTaskUC: mockUC}
+
+	mockUC.EXPECT().Execute(gomock.Any(), "task-2", "tenant-1").Return(nil, gorm.ErrRecordNotFound)
+
+	c, w := setupContext(http.MethodGet, "/tasks/task-2", nil)
+	handler.GetTask(c, "task-2")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
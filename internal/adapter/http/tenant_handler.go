@@ -1,12 +1,16 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 
 	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
 	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
 	"github.com/Haevnen/audit-logging-api/internal/registry"
 	"github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
 )
@@ -14,12 +18,18 @@ import (
 type TenantHandler struct {
 	CreateUC tenant.CreateTenantUseCaseInterface
 	ListUC   tenant.ListTenantsUseCaseInterface
+	GetUC    tenant.GetTenantUseCaseInterface
+	UpdateUC tenant.UpdateTenantUseCaseInterface
+	DeleteUC tenant.DeleteTenantUseCaseInterface
 }
 
 func newTenantHandler(r *registry.Registry) TenantHandler {
 	return TenantHandler{
 		CreateUC: r.CreateTenantUseCase(),
 		ListUC:   r.ListTenantsUseCase(),
+		GetUC:    r.GetTenantUseCase(),
+		UpdateUC: r.UpdateTenantUseCase(),
+		DeleteUC: r.DeleteTenantUseCase(),
 	}
 }
 
@@ -33,12 +43,7 @@ func (h TenantHandler) ListTenants(g *gin.Context) {
 
 	resp := make([]api_service.Tenant, 0, len(tenants))
 	for _, t := range tenants {
-		resp = append(resp, api_service.Tenant{
-			Id:        t.ID,
-			Name:      t.Name,
-			CreatedAt: t.CreatedAt.Format(DateTimeFormat),
-			UpdatedAt: t.UpdatedAt.Format(DateTimeFormat),
-		})
+		resp = append(resp, ToTenantResponse(t))
 	}
 	g.JSON(http.StatusOK, resp)
 }
@@ -62,11 +67,71 @@ func (h TenantHandler) CreateTenant(g *gin.Context) {
 		return
 	}
 
-	resp := api_service.Tenant{
-		Id:        t.ID,
-		Name:      t.Name,
-		CreatedAt: t.CreatedAt.Format(DateTimeFormat),
-		UpdatedAt: t.UpdatedAt.Format(DateTimeFormat),
+	g.JSON(http.StatusCreated, ToTenantResponse(*t))
+}
+
+// (GET /tenants/{id})
+func (h TenantHandler) GetTenant(g *gin.Context, id string) {
+	t, err := h.GetUC.Execute(g.Request.Context(), id)
+	if err != nil {
+		sendTenantError(g, err)
+		return
+	}
+	g.JSON(http.StatusOK, ToTenantResponse(*t))
+}
+
+// (PATCH /tenants/{id})
+func (h TenantHandler) UpdateTenant(g *gin.Context, id string) {
+	var body api_service.UpdateTenantRequestBody
+	if err := BindRequestBody(g, &body); err != nil {
+		SendError(g, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+	if body.Name != nil && len(*body.Name) == 0 {
+		SendError(g, "name can't be empty", apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	input := tenant.UpdateTenantInput{Name: body.Name}
+	if body.Status != nil {
+		status := entitytenant.Status(*body.Status)
+		input.Status = &status
+	}
+	if body.Settings != nil {
+		input.Settings = *body.Settings
+	}
+
+	t, err := h.UpdateUC.Execute(g.Request.Context(), id, input)
+	if err != nil {
+		sendTenantError(g, err)
+		return
+	}
+	g.JSON(http.StatusOK, ToTenantResponse(*t))
+}
+
+// (DELETE /tenants/{id})
+func (h TenantHandler) DeleteTenant(g *gin.Context, id string) {
+	task, err := h.DeleteUC.Execute(g.Request.Context(), id, g.GetString(constant.UserID))
+	if err != nil {
+		sendTenantError(g, err)
+		return
+	}
+
+	resp, err := ToAsyncTaskResponse(*task)
+	if err != nil {
+		SendError(g, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	g.JSON(http.StatusAccepted, resp)
+}
+
+func sendTenantError(g *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		SendError(g, "tenant not found", apperror.ErrRecordNotFound)
+	case errors.Is(err, tenant.ErrInvalidStatus), errors.Is(err, tenant.ErrTenantDeleting):
+		SendError(g, err.Error(), apperror.ErrInvalidRequestInput)
+	default:
+		SendError(g, err.Error(), apperror.ErrInternalServer)
 	}
-	g.JSON(http.StatusCreated, resp)
 }
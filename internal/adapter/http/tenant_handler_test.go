@@ -12,10 +12,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
 	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
 
 	ucMocks "github.com/Haevnen/audit-logging-api/internal/usecase/tenant/mocks"
 )
@@ -160,3 +163,86 @@ func TestTenantHandler_CreateTenant_FailUC(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
+
+func TestTenantHandler_GetTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockGetTenantUseCaseInterface(ctrl)
+	handler := h.TenantHandler{GetUC: mockUC}
+
+	mockUC.EXPECT().Execute(gomock.Any(), "t1").Return(&entitytenant.Tenant{
+		ID: "t1", Name: "Tenant1", Status: entitytenant.StatusSuspended, Settings: map[string]interface{}{"retention_days": 30},
+	}, nil)
+	c, w := setupContext(http.MethodGet, "/tenants/t1", nil)
+	handler.GetTenant(c, "t1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"suspended"`)
+	assert.Contains(t, w.Body.String(), `"settings":{"retention_days":30}`)
+
+	mockUC.EXPECT().Execute(gomock.Any(), "missing").Return(nil, gorm.ErrRecordNotFound)
+	c, w = setupContext(http.MethodGet, "/tenants/missing", nil)
+	handler.GetTenant(c, "missing")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestTenantHandler_UpdateTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockUpdateTenantUseCaseInterface(ctrl)
+	handler := h.TenantHandler{UpdateUC: mockUC}
+
+	suspended := entitytenant.StatusSuspended
+	mockUC.EXPECT().Execute(gomock.Any(), "t1", uc.UpdateTenantInput{
+		Status:   &suspended,
+		Settings: map[string]interface{}{"retention_days": float64(30), "legacy": nil},
+	}).Return(&entitytenant.Tenant{ID: "t1", Name: "Tenant1", Status: suspended}, nil)
+
+	c, w := setupContext(http.MethodPatch, "/tenants/t1", []byte(`{"status":"suspended","settings":{"retention_days":30,"legacy":null}}`))
+	handler.UpdateTenant(c, "t1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"suspended"`)
+
+	c, w = setupContext(http.MethodPatch, "/tenants/t1", []byte(`{"name":""}`))
+	handler.UpdateTenant(c, "t1")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	mockUC.EXPECT().Execute(gomock.Any(), "t2", gomock.Any()).Return(nil, uc.ErrTenantDeleting)
+	c, w = setupContext(http.MethodPatch, "/tenants/t2", []byte(`{"name":"renamed"}`))
+	handler.UpdateTenant(c, "t2")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "tenant is being deleted")
+}
+
+func TestTenantHandler_DeleteTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockDeleteTenantUseCaseInterface(ctrl)
+	handler := h.TenantHandler{DeleteUC: mockUC}
+
+	tenantId := "t1"
+	mockUC.EXPECT().Execute(gomock.Any(), "t1", "user-1").Return(&async_task.AsyncTask{
+		TaskID: "task-1", TaskType: async_task.TaskTenantDeletion, Status: async_task.StatusPending, TenantUID: &tenantId,
+	}, nil)
+
+	c, w := setupContext(http.MethodDelete, "/tenants/t1", nil)
+	handler.DeleteTenant(c, "t1")
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Contains(t, w.Body.String(), `"id":"task-1"`)
+	assert.Contains(t, w.Body.String(), `"type":"tenant_deletion"`)
+	assert.Contains(t, w.Body.String(), `"status":"pending"`)
+
+	mockUC.EXPECT().Execute(gomock.Any(), "missing", "user-1").Return(nil, gorm.ErrRecordNotFound)
+	c, w = setupContext(http.MethodDelete, "/tenants/missing", nil)
+	handler.DeleteTenant(c, "missing")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
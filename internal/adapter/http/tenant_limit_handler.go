@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/apperror"
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/registry"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+)
+
+type TenantLimitHandler struct {
+	GetLimitsUC quota.GetLimitsUseCaseInterface
+	SetLimitsUC quota.SetLimitsUseCaseInterface
+	GetUsageUC  quota.GetUsageUseCaseInterface
+	ReportUC    quota.GetUsageReportUseCaseInterface
+}
+
+func newTenantLimitHandler(r *registry.Registry) TenantLimitHandler {
+	return TenantLimitHandler{
+		GetLimitsUC: r.GetLimitsUseCase(),
+		SetLimitsUC: r.SetLimitsUseCase(),
+		GetUsageUC:  r.GetUsageUseCase(),
+		ReportUC:    r.GetUsageReportUseCase(),
+	}
+}
+
+// (GET /tenants/{id}/limits)
+func (h TenantLimitHandler) GetTenantLimits(g *gin.Context, id string) {
+	limits, err := h.GetLimitsUC.Execute(g.Request.Context(), id)
+	if err != nil {
+		sendTenantError(g, err)
+		return
+	}
+	g.JSON(http.StatusOK, ToTenantLimitsResponse(limits))
+}
+
+// (PUT /tenants/{id}/limits)
+func (h TenantLimitHandler) SetTenantLimits(g *gin.Context, id string) {
+	var body api_service.UpdateTenantLimitsRequestBody
+	if err := BindRequestBody(g, &body); err != nil {
+		SendError(g, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	limits, err := h.SetLimitsUC.Execute(g.Request.Context(), tenant_limit.TenantLimit{
+		TenantID:        id,
+		ReadRPS:         body.ReadRps,
+		ReadBurst:       body.ReadBurst,
+		WriteRPS:        body.WriteRps,
+		WriteBurst:      body.WriteBurst,
+		ExportRPS:       body.ExportRps,
+		ExportBurst:     body.ExportBurst,
+		DailyEventQuota: body.DailyEventQuota,
+		DailyByteQuota:  body.DailyByteQuota,
+		UpdatedBy:       g.GetString(constant.UserID),
+	})
+	if errors.Is(err, quota.ErrInvalidLimits) {
+		SendError(g, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+	if err != nil {
+		sendTenantError(g, err)
+		return
+	}
+	g.JSON(http.StatusOK, ToTenantLimitsResponse(limits))
+}
+
+// (GET /tenants/{id}/usage)
+func (h TenantLimitHandler) GetTenantUsage(g *gin.Context, id string, params api_service.GetTenantUsageParams) {
+	var from, to time.Time
+	for _, d := range []struct {
+		value *string
+		day   *time.Time
+	}{{params.From, &from}, {params.To, &to}} {
+		if d.value == nil || len(*d.value) == 0 {
+			continue
+		}
+		day, err := time.Parse("2006-01-02", *d.value)
+		if err != nil {
+			SendError(g, "from and to must be formatted as YYYY-MM-DD", apperror.ErrInvalidRequestInput)
+			return
+		}
+		*d.day = day
+	}
+
+	usage, err := h.GetUsageUC.Execute(g.Request.Context(), id, from, to)
+	if errors.Is(err, quota.ErrInvalidUsageRange) {
+		SendError(g, err.Error(), apperror.ErrInvalidRequestInput)
+		return
+	}
+	if err != nil {
+		sendTenantError(g, err)
+		return
+	}
+	g.JSON(http.StatusOK, ToTenantUsageResponse(*usage))
+}
+
+// (GET /usage/report)
+func (h TenantLimitHandler) GetUsageReport(g *gin.Context, params api_service.GetUsageReportParams) {
+	month, err := time.Parse("2006-01", params.Month)
+	if err != nil {
+		SendError(g, "month must be formatted as YYYY-MM", apperror.ErrInvalidRequestInput)
+		return
+	}
+
+	usages, err := h.ReportUC.Execute(g.Request.Context(), month)
+	if err != nil {
+		SendError(g, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+	audit.Annotate(g.Request.Context(), audit.KeyResultCount, len(usages))
+
+	g.Header("Content-Type", "text/csv")
+	g.Header("Content-Disposition", fmt.Sprintf("attachment; filename=usage-%s.csv", month.Format("2006-01")))
+	w := csv.NewWriter(g.Writer)
+	_ = w.Write([]string{"month", "tenant_id", "tenant_name", "events", "bytes", "stored_bytes", "searches", "exports"})
+	for _, u := range usages {
+		_ = w.Write([]string{
+			u.Month.Format("2006-01"),
+			u.TenantID,
+			u.TenantName,
+			strconv.FormatInt(u.Events, 10),
+			strconv.FormatInt(u.Bytes, 10),
+			strconv.FormatInt(u.StoredBytes, 10),
+			strconv.FormatInt(u.Searches, 10),
+			strconv.FormatInt(u.Exports, 10),
+		})
+	}
+	w.Flush()
+}
@@ -0,0 +1,198 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	h "github.com/Haevnen/audit-logging-api/internal/adapter/http"
+	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	quotaMocks "github.com/Haevnen/audit-logging-api/internal/usecase/quota/mocks"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+var testLimits = tenant_limit.Limits{
+	Read:        tenant_limit.Rate{RPS: 10, Burst: 20},
+	Write:       tenant_limit.Rate{RPS: 5, Burst: 10},
+	Export:      tenant_limit.Rate{RPS: 1, Burst: 5},
+	DailyEvents: 1000,
+}
+
+func TestTenantLimitHandler_GetTenantLimits_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := quotaMocks.NewMockGetLimitsUseCaseInterface(ctrl)
+	handler := h.TenantLimitHandler{GetLimitsUC: mockUC}
+	c, w := setupContext(http.MethodGet, "/tenants/t1/limits", nil)
+
+	mockUC.EXPECT().Execute(gomock.Any(), "t1").Return(testLimits, nil)
+
+	handler.GetTenantLimits(c, "t1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp api_service.TenantLimits
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, api_service.RateLimit{Rps: 5, Burst: 10}, resp.Write)
+	assert.Equal(t, int64(1000), resp.DailyEventQuota)
+}
+
+func TestTenantLimitHandler_GetTenantLimits_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := quotaMocks.NewMockGetLimitsUseCaseInterface(ctrl)
+	handler := h.TenantLimitHandler{GetLimitsUC: mockUC}
+	c, w := setupContext(http.MethodGet, "/tenants/t1/limits", nil)
+
+	mockUC.EXPECT().Execute(gomock.Any(), "t1").Return(tenant_limit.Limits{}, gorm.ErrRecordNotFound)
+
+	handler.GetTenantLimits(c, "t1")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestTenantLimitHandler_SetTenantLimits_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := quotaMocks.NewMockSetLimitsUseCaseInterface(ctrl)
+	handler := h.TenantLimitHandler{SetLimitsUC: mockUC}
+	data, _ := json.Marshal(api_service.UpdateTenantLimitsRequestBody{WriteRps: utils.Ptr(5), DailyEventQuota: utils.Ptr(int64(1000))})
+	c, w := setupContext(http.MethodPut, "/tenants/t1/limits", data)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, l tenant_limit.TenantLimit) (tenant_limit.Limits, error) {
+			assert.Equal(t, "t1", l.TenantID)
+			assert.Equal(t, "user-1", l.UpdatedBy)
+			assert.Equal(t, 5, *l.WriteRPS)
+			assert.Equal(t, int64(1000), *l.DailyEventQuota)
+			assert.Nil(t, l.ReadRPS)
+			return testLimits, nil
+		})
+
+	handler.SetTenantLimits(c, "t1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestTenantLimitHandler_SetTenantLimits_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := quotaMocks.NewMockSetLimitsUseCaseInterface(ctrl)
+	handler := h.TenantLimitHandler{SetLimitsUC: mockUC}
+	data, _ := json.Marshal(api_service.UpdateTenantLimitsRequestBody{ReadBurst: utils.Ptr(0)})
+	c, w := setupContext(http.MethodPut, "/tenants/t1/limits", data)
+
+	mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(tenant_limit.Limits{}, quota.ErrInvalidLimits)
+
+	handler.SetTenantLimits(c, "t1")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTenantLimitHandler_GetTenantUsage_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := quotaMocks.NewMockGetUsageUseCaseInterface(ctrl)
+	handler := h.TenantLimitHandler{GetUsageUC: mockUC}
+	c, w := setupContext(http.MethodGet, "/tenants/t1/usage?from=2026-10-01&to=2026-10-02", nil)
+
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	mockUC.EXPECT().Execute(gomock.Any(), "t1", from, to).Return(&quota.Usage{
+		TenantID: "t1",
+		From:     from,
+		To:       to,
+		Days: []tenant_usage.DailyUsage{
+			{TenantID: "t1", Day: from, Events: 42, Bytes: 4096, StoredBytes: 4000, Searches: 3},
+			{TenantID: "t1", Day: to},
+		},
+		Limits:  testLimits,
+		ResetAt: tenant_usage.Day(time.Now()).Add(24 * time.Hour),
+	}, nil)
+
+	fromParam, toParam := "2026-10-01", "2026-10-02"
+	handler.GetTenantUsage(c, "t1", api_service.GetTenantUsageParams{From: &fromParam, To: &toParam})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp api_service.TenantUsage
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Days, 2)
+	assert.Equal(t, "2026-10-01", resp.Days[0].Day.String())
+	assert.Equal(t, int64(42), resp.Days[0].Events)
+	assert.Equal(t, int64(4096), resp.Days[0].Bytes)
+	assert.Equal(t, int64(4000), resp.Days[0].StoredBytes)
+	assert.Equal(t, int64(3), resp.Days[0].Searches)
+	assert.Equal(t, int64(0), resp.Days[1].Events)
+	assert.Equal(t, int64(1000), resp.DailyEventQuota)
+	assert.Equal(t, "2026-10-02", resp.To.String())
+}
+
+func TestTenantLimitHandler_GetTenantUsage_InvalidRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		err      error
+	}{
+		{"Bad Format", "01/10/2026", "", nil},
+		{"Rejected Range", "2026-10-02", "2026-10-01", quota.ErrInvalidUsageRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := quotaMocks.NewMockGetUsageUseCaseInterface(ctrl)
+			handler := h.TenantLimitHandler{GetUsageUC: mockUC}
+			c, w := setupContext(http.MethodGet, "/tenants/t1/usage", nil)
+			if tt.err != nil {
+				mockUC.EXPECT().Execute(gomock.Any(), "t1", gomock.Any(), gomock.Any()).Return(nil, tt.err)
+			}
+
+			handler.GetTenantUsage(c, "t1", api_service.GetTenantUsageParams{From: &tt.from, To: &tt.to})
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestTenantLimitHandler_GetUsageReport_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := quotaMocks.NewMockGetUsageReportUseCaseInterface(ctrl)
+	handler := h.TenantLimitHandler{ReportUC: mockUC}
+	c, w := setupContext(http.MethodGet, "/usage/report?month=2026-10", nil)
+
+	month := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	mockUC.EXPECT().Execute(gomock.Any(), month).Return([]tenant_usage.MonthlyUsage{
+		{TenantID: "t1", TenantName: "Tenant 1", Month: month, Events: 120, Bytes: 4096, StoredBytes: 4000, Searches: 7, Exports: 2},
+	}, nil)
+
+	handler.GetUsageReport(c, api_service.GetUsageReportParams{Month: "2026-10"})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	assert.Equal(t, "month,tenant_id,tenant_name,events,bytes,stored_bytes,searches,exports\n2026-10,t1,Tenant 1,120,4096,4000,7,2\n", w.Body.String())
+}
+
+func TestTenantLimitHandler_GetUsageReport_InvalidMonth(t *testing.T) {
+	handler := h.TenantLimitHandler{}
+	c, w := setupContext(http.MethodGet, "/usage/report?month=october", nil)
+
+	handler.GetUsageReport(c, api_service.GetUsageReportParams{Month: "october"})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -14,16 +14,23 @@ import (
 
 type TokenHandler struct {
 	JwtManager auth.ManagerInterface
+	Enabled    bool
 }
 
 func newTokenHandler(registry *registry.Registry) TokenHandler {
 	return TokenHandler{
 		JwtManager: registry.Manager(),
+		Enabled:    registry.TokenIssuingEnabled(),
 	}
 }
 
-// GenerateToken (POST /auth/token)
+// GenerateToken (POST /auth/token), only served in dev mode with locally signed tokens
 func (t TokenHandler) GenerateToken(c *gin.Context) {
+	if !t.Enabled {
+		SendError(c, "token endpoint is disabled", apperror.ErrTokenIssuingDisabled)
+		return
+	}
+
 	var genTokenReqBody api_service.GenerateTokenRequestBody
 	if BindRequestBody(c, &genTokenReqBody) != nil {
 		SendError(c, "invalid request body", apperror.ErrInvalidRequestInput)
@@ -23,7 +23,7 @@ func TestTokenHandler_GenerateToken_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockManager := authMocks.NewMockManagerInterface(ctrl)
-	handler := h.TokenHandler{JwtManager: mockManager}
+	handler := h.TokenHandler{JwtManager: mockManager, Enabled: true}
 
 	gin.SetMode(gin.TestMode)
 	w := httptest.NewRecorder()
@@ -45,7 +45,7 @@ func TestTokenHandler_GenerateToken_Success(t *testing.T) {
 }
 
 func TestTokenHandler_GenerateToken_InvalidBody(t *testing.T) {
-	handler := h.TokenHandler{}
+	handler := h.TokenHandler{Enabled: true}
 
 	gin.SetMode(gin.TestMode)
 	w := httptest.NewRecorder()
@@ -63,7 +63,7 @@ func TestTokenHandler_GenerateToken_InvalidBody(t *testing.T) {
 }
 
 func TestTokenHandler_GenerateToken_InvalidRole(t *testing.T) {
-	handler := h.TokenHandler{}
+	handler := h.TokenHandler{Enabled: true}
 
 	gin.SetMode(gin.TestMode)
 	w := httptest.NewRecorder()
@@ -85,7 +85,7 @@ func TestTokenHandler_GenerateToken_JWTError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockManager := authMocks.NewMockManagerInterface(ctrl)
-	handler := h.TokenHandler{JwtManager: mockManager}
+	handler := h.TokenHandler{JwtManager: mockManager, Enabled: true}
 
 	gin.SetMode(gin.TestMode)
 	w := httptest.NewRecorder()
@@ -105,3 +105,24 @@ func TestTokenHandler_GenerateToken_JWTError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 	assert.Contains(t, w.Body.String(), "failed to generate token")
 }
+
+func TestTokenHandler_GenerateToken_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := authMocks.NewMockManagerInterface(ctrl)
+	handler := h.TokenHandler{JwtManager: mockManager, Enabled: false}
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	body := []byte(`{"user_id":"u1","tenant_id":"t1","role":"admin"}`)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/auth/token", bytes.NewBuffer(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	handler.GenerateToken(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "token endpoint is disabled")
+}
@@ -30,6 +30,11 @@ var (
 	ErrInvalidRequestInput              = errors.New("ERR_INVALID_REQUEST_INPUT")
 	ErrRecordNotFound                   = errors.New("ERR_RECORD_NOT_FOUND")
 	ErrTooManyRequests                  = errors.New("ERR_TOO_MANY_REQUESTS")
+	ErrSchemaViolation                  = errors.New("ERR_SCHEMA_VIOLATION")
+	ErrTokenIssuingDisabled             = errors.New("ERR_TOKEN_ISSUING_DISABLED")
+	ErrInvalidAPIKey                    = errors.New("ERR_INVALID_API_KEY")
+	ErrTokenRevoked                     = errors.New("ERR_TOKEN_REVOKED")
+	ErrTenantInactive                   = errors.New("ERR_TENANT_INACTIVE")
 )
 
 func New(_ context.Context, err error, params ...any) *Error {
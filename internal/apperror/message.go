@@ -27,6 +27,11 @@ var (
 		ErrInvalidRequestInput:             {httpStatus: http.StatusBadRequest, resType: string(api.ValidationFailed), errCode: errCodeInvalidRequest, msg: "The input is invalid."},
 		ErrRecordNotFound:                  {httpStatus: http.StatusNotFound, resType: string(api.RequestNotFound), errCode: errCodeNotFound, msg: "The record is not found."},
 		ErrTooManyRequests:                 {httpStatus: http.StatusTooManyRequests, resType: string(api.ValidationFailed), errCode: errCodeInvalidRequest, msg: "Too many requests."},
+		ErrSchemaViolation:                 {httpStatus: http.StatusBadRequest, resType: string(api.ValidationFailed), errCode: errCodeInvalidRequest, msg: "The log does not match the registered schema."},
+		ErrInvalidAPIKey:                   {httpStatus: http.StatusUnauthorized, resType: string(api.ValidationFailed), errCode: errCodeUnauthorized, msg: "The API key is invalid, expired or revoked."},
+		ErrTokenRevoked:                    {httpStatus: http.StatusUnauthorized, resType: string(api.ValidationFailed), errCode: errCodeUnauthorized, msg: "The token has been revoked."},
+		ErrTenantInactive:                  {httpStatus: http.StatusForbidden, resType: string(api.PermissionDenied), errCode: errCodeForbidden, msg: "The tenant is suspended or being deleted."},
+		ErrTokenIssuingDisabled:            {httpStatus: http.StatusNotFound, resType: string(api.RequestNotFound), errCode: errCodeNotFound, msg: "Tokens are issued by the identity provider."},
 	}
 )
 
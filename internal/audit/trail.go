@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+	"maps"
+	"sync"
+)
+
+const (
+	KeyTenantID    = "tenant_id"
+	KeyResultCount = "result_count"
+	KeyTaskID      = "task_id"
+)
+
+type trailKey struct{}
+
+// Trail collects what handlers and use cases learn while serving a request (result count,
+// task id, ...) so that it ends up in the request's audit entry
+type Trail struct {
+	mu     sync.Mutex
+	values map[string]interface{}
+}
+
+// NewTrail returns a context carrying a new, empty trail
+func NewTrail(ctx context.Context) (context.Context, *Trail) {
+	t := &Trail{values: map[string]interface{}{}}
+	return context.WithValue(ctx, trailKey{}, t), t
+}
+
+// Annotate adds a value to the trail of the request, it does nothing outside an audited request
+func Annotate(ctx context.Context, key string, value interface{}) {
+	t, ok := ctx.Value(trailKey{}).(*Trail)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.values[key] = value
+}
+
+// Values returns a copy of the collected values
+func (t *Trail) Values() map[string]interface{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.values)
+}
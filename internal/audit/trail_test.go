@@ -0,0 +1,30 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+)
+
+func TestAnnotate(t *testing.T) {
+	ctx, trail := audit.NewTrail(context.Background())
+
+	audit.Annotate(ctx, audit.KeyResultCount, 3)
+	audit.Annotate(ctx, audit.KeyTaskID, "task-1")
+
+	values := trail.Values()
+	assert.Equal(t, map[string]interface{}{audit.KeyResultCount: 3, audit.KeyTaskID: "task-1"}, values)
+
+	// values is a copy
+	values["other"] = true
+	assert.NotContains(t, trail.Values(), "other")
+}
+
+func TestAnnotate_WithoutTrail(t *testing.T) {
+	assert.NotPanics(t, func() {
+		audit.Annotate(context.Background(), audit.KeyResultCount, 1)
+	})
+}
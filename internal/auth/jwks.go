@@ -0,0 +1,236 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+var ErrUnknownSigningKey = errors.New("unknown signing key")
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwksCache keeps the identity provider signing keys in memory. Keys are refetched once the
+// cache is older than ttl, or earlier when a token references a key id we have not seen yet
+// (the provider rotated its keys), at most once per minRefresh. The requests waiting on a refetch share
+// it and the lock is never held across it, a slow provider only holds up the tokens it has to answer.
+type jwksCache struct {
+	issuer     string
+	ttl        time.Duration
+	minRefresh time.Duration
+	client     *http.Client
+	fetches    singleflight.Group
+
+	mu        sync.Mutex
+	url       string
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func newJWKSCache(issuer, url string, ttl, minRefresh time.Duration) *jwksCache {
+	return &jwksCache{
+		issuer:     issuer,
+		url:        url,
+		ttl:        ttl,
+		minRefresh: minRefresh,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// keyFunc resolves the verification key of a token from its kid header, waiting on a refetch until ctx is done
+func (c *jwksCache) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return c.key(ctx, kid)
+	}
+}
+
+func (c *jwksCache) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	fetchedAt, fetched := c.fetchedAt, c.keys != nil
+	key, found := c.lookup(kid)
+	c.mu.Unlock()
+
+	age := time.Since(fetchedAt)
+	if found && age < c.ttl {
+		return key, nil
+	}
+	if !found && fetched && age < c.minRefresh {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSigningKey, kid)
+	}
+
+	// the fetch is shared, a caller giving up doesn't cancel it for the others, the client timeout bounds it
+	fetch := c.fetches.DoChan("", func() (interface{}, error) {
+		return nil, c.refresh(context.WithoutCancel(ctx), fetchedAt)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-fetch:
+		if res.Err != nil {
+			// keep serving the keys we already have while the provider is unreachable
+			if found {
+				return key, nil
+			}
+			return nil, res.Err
+		}
+	}
+
+	c.mu.Lock()
+	key, found = c.lookup(kid)
+	c.mu.Unlock()
+	if !found {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSigningKey, kid)
+	}
+	return key, nil
+}
+
+// lookup finds the key by id, tokens without kid are accepted only when the set has a single key.
+// c.mu must be held.
+func (c *jwksCache) lookup(kid string) (crypto.PublicKey, bool) {
+	if len(kid) == 0 && len(c.keys) == 1 {
+		for _, k := range c.keys {
+			return k, true
+		}
+	}
+	k, ok := c.keys[kid]
+	return k, ok
+}
+
+// refresh fetches the key set and swaps it in, unless it was fetched again since the caller looked at it
+func (c *jwksCache) refresh(ctx context.Context, since time.Time) error {
+	c.mu.Lock()
+	url, done := c.url, c.fetchedAt.After(since)
+	c.mu.Unlock()
+	if done {
+		return nil
+	}
+
+	if len(url) == 0 {
+		discovered, err := c.discover(ctx)
+		if err != nil {
+			return err
+		}
+		url = discovered
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.getJSON(ctx, url, &set); err != nil {
+		return fmt.Errorf("fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if len(k.Use) > 0 && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			// one malformed or unsupported key must not invalidate the whole set
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.url = url
+	c.keys = keys
+	c.fetchedAt = time.Now()
+	return nil
+}
+
+// discover reads the jwks_uri from the issuer's OpenID configuration
+func (c *jwksCache) discover(ctx context.Context) (string, error) {
+	var doc struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := c.getJSON(ctx, strings.TrimSuffix(c.issuer, "/")+"/.well-known/openid-configuration", &doc); err != nil {
+		return "", fmt.Errorf("discover jwks uri: %w", err)
+	}
+	if len(doc.JWKSURI) == 0 {
+		return "", errors.New("discover jwks uri: jwks_uri missing from openid configuration")
+	}
+	return doc.JWKSURI, nil
+}
+
+func (c *jwksCache) getJSON(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -3,14 +3,16 @@ package auth
 //go:generate mockgen -source=jwt.go -destination=mocks/jwt.go
 
 import (
+	"context"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type ManagerInterface interface {
 	GenerateToken(userID, tenantID string, role Role, ttl time.Duration) (string, error)
-	ParseToken(tokenStr string) (*Claims, error)
+	ParseToken(ctx context.Context, tokenStr string) (*Claims, error)
 }
 
 type Manager struct {
@@ -21,6 +23,8 @@ type Claims struct {
 	UserID   string
 	TenantID string
 	Role     Role
+	// Permissions replace the ones of the role and its bindings when present
+	Permissions []Permission `json:",omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -39,6 +43,8 @@ func (m *Manager) GenerateToken(userID, tenantID string, role Role, ttl time.Dur
 		TenantID: tenantID,
 		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			// jti, the key of the revocation list
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
@@ -47,7 +53,7 @@ func (m *Manager) GenerateToken(userID, tenantID string, role Role, ttl time.Dur
 	return token.SignedString(m.secretKey)
 }
 
-func (m *Manager) ParseToken(tokenStr string) (*Claims, error) {
+func (m *Manager) ParseToken(_ context.Context, tokenStr string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		return m.secretKey, nil
 	})
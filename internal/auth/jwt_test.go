@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -20,12 +21,26 @@ func TestGenerateAndParseToken_Success(t *testing.T) {
 	assert.NotEmpty(t, tokenStr)
 
 	// parse token
-	claims, err := manager.ParseToken(tokenStr)
+	claims, err := manager.ParseToken(context.Background(), tokenStr)
 	assert.NoError(t, err)
 	assert.NotNil(t, claims)
 	assert.Equal(t, "u1", claims.UserID)
 	assert.Equal(t, "t1", claims.TenantID)
 	assert.Equal(t, auth.RoleUser, claims.Role)
+	assert.NotEmpty(t, claims.ID)
+}
+
+func TestGenerateToken_UniqueID(t *testing.T) {
+	manager := auth.NewManager("secret-key")
+
+	first, err := manager.GenerateToken("u1", "t1", auth.RoleUser, time.Minute)
+	assert.NoError(t, err)
+	second, err := manager.GenerateToken("u1", "t1", auth.RoleUser, time.Minute)
+	assert.NoError(t, err)
+
+	firstClaims, _ := manager.ParseToken(context.Background(), first)
+	secondClaims, _ := manager.ParseToken(context.Background(), second)
+	assert.NotEqual(t, firstClaims.ID, secondClaims.ID)
 }
 
 func TestGenerateToken_InvalidRole(t *testing.T) {
@@ -46,7 +61,7 @@ func TestParseToken_Expired(t *testing.T) {
 	assert.NotEmpty(t, tokenStr)
 
 	// parse should fail
-	claims, err := manager.ParseToken(tokenStr)
+	claims, err := manager.ParseToken(context.Background(), tokenStr)
 	assert.Error(t, err)
 	assert.Nil(t, claims)
 
@@ -63,7 +78,7 @@ func TestParseToken_Tampered(t *testing.T) {
 	assert.NoError(t, err)
 
 	// parse with manager2 (wrong secret) → should fail
-	claims, err := manager2.ParseToken(tokenStr)
+	claims, err := manager2.ParseToken(context.Background(), tokenStr)
 	assert.Error(t, err)
 	assert.Nil(t, claims)
 }
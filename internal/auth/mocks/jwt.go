@@ -10,6 +10,7 @@
 package mock_auth
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
@@ -57,16 +58,16 @@ func (mr *MockManagerInterfaceMockRecorder) GenerateToken(userID, tenantID, role
 }
 
 // ParseToken mocks base method.
-func (m *MockManagerInterface) ParseToken(tokenStr string) (*auth.Claims, error) {
+func (m *MockManagerInterface) ParseToken(ctx context.Context, tokenStr string) (*auth.Claims, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ParseToken", tokenStr)
+	ret := m.ctrl.Call(m, "ParseToken", ctx, tokenStr)
 	ret0, _ := ret[0].(*auth.Claims)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ParseToken indicates an expected call of ParseToken.
-func (mr *MockManagerInterfaceMockRecorder) ParseToken(tokenStr any) *gomock.Call {
+func (mr *MockManagerInterfaceMockRecorder) ParseToken(ctx, tokenStr any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockManagerInterface)(nil).ParseToken), tokenStr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockManagerInterface)(nil).ParseToken), ctx, tokenStr)
 }
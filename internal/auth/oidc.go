@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultJWKSCacheTTL   = 15 * time.Minute
+	defaultJWKSMinRefresh = 10 * time.Second
+	defaultUserIDClaim    = "sub"
+	defaultTenantIDClaim  = "tenant_id"
+	defaultRoleClaim      = "role"
+	defaultPermsClaim     = "permissions"
+	allowedClockSkew      = 30 * time.Second
+)
+
+var (
+	ErrTokenIssuingDisabled = errors.New("tokens are issued by the identity provider")
+	ErrMissingClaim         = errors.New("missing claim")
+)
+
+// ClaimMapping names the token claims holding the user, tenant and role.
+// A name is first looked up as is (e.g. "https://example.com/tenant"), then as a dotted path
+// into nested objects (e.g. "app_metadata.tenant_id").
+type ClaimMapping struct {
+	UserID   string
+	TenantID string
+	Role     string
+	// Permissions may be a list or a space separated string like the OAuth scope claim
+	Permissions string
+}
+
+type OIDCConfig struct {
+	Issuer   string
+	Audience string
+	// JWKSURL is discovered from the issuer's OpenID configuration when empty
+	JWKSURL        string
+	JWKSCacheTTL   time.Duration
+	JWKSMinRefresh time.Duration
+	Claims         ClaimMapping
+}
+
+// OIDCManager validates RS256/ES256 tokens issued by an external identity provider
+type OIDCManager struct {
+	claims ClaimMapping
+	keys   *jwksCache
+	parser *jwt.Parser
+}
+
+func NewOIDCManager(cfg OIDCConfig) *OIDCManager {
+	if cfg.JWKSCacheTTL <= 0 {
+		cfg.JWKSCacheTTL = defaultJWKSCacheTTL
+	}
+	if cfg.JWKSMinRefresh <= 0 {
+		cfg.JWKSMinRefresh = defaultJWKSMinRefresh
+	}
+	if len(cfg.Claims.UserID) == 0 {
+		cfg.Claims.UserID = defaultUserIDClaim
+	}
+	if len(cfg.Claims.TenantID) == 0 {
+		cfg.Claims.TenantID = defaultTenantIDClaim
+	}
+	if len(cfg.Claims.Role) == 0 {
+		cfg.Claims.Role = defaultRoleClaim
+	}
+	if len(cfg.Claims.Permissions) == 0 {
+		cfg.Claims.Permissions = defaultPermsClaim
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+		jwt.WithIssuer(cfg.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(allowedClockSkew),
+	}
+	if len(cfg.Audience) > 0 {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &OIDCManager{
+		claims: cfg.Claims,
+		keys:   newJWKSCache(cfg.Issuer, cfg.JWKSURL, cfg.JWKSCacheTTL, cfg.JWKSMinRefresh),
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+// GenerateToken is not supported, users sign in with the identity provider
+func (m *OIDCManager) GenerateToken(userID, tenantID string, role Role, ttl time.Duration) (string, error) {
+	return "", ErrTokenIssuingDisabled
+}
+
+// ParseToken verifies the token with the keys of the identity provider, fetching them at most until ctx is done
+func (m *OIDCManager) ParseToken(ctx context.Context, tokenStr string) (*Claims, error) {
+	mapClaims := jwt.MapClaims{}
+	token, err := m.parser.ParseWithClaims(tokenStr, mapClaims, m.keys.keyFunc(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	userID, _ := lookupClaim(mapClaims, m.claims.UserID).(string)
+	if len(userID) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingClaim, m.claims.UserID)
+	}
+	role, ok := mapRole(lookupClaim(mapClaims, m.claims.Role))
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingClaim, m.claims.Role)
+	}
+	tenantID, _ := lookupClaim(mapClaims, m.claims.TenantID).(string)
+	if len(tenantID) == 0 && role != RoleAdmin {
+		// only admins work across tenants
+		return nil, fmt.Errorf("%w: %s", ErrMissingClaim, m.claims.TenantID)
+	}
+
+	claims := &Claims{UserID: userID, TenantID: tenantID, Role: role}
+	claims.Permissions = mapPermissions(lookupClaim(mapClaims, m.claims.Permissions))
+	claims.Issuer, _ = mapClaims.GetIssuer()
+	claims.Subject, _ = mapClaims.GetSubject()
+	claims.Audience, _ = mapClaims.GetAudience()
+	claims.ExpiresAt, _ = mapClaims.GetExpirationTime()
+	claims.IssuedAt, _ = mapClaims.GetIssuedAt()
+	claims.ID, _ = mapClaims["jti"].(string)
+	return claims, nil
+}
+
+func lookupClaim(claims jwt.MapClaims, name string) interface{} {
+	if v, ok := claims[name]; ok {
+		return v
+	}
+
+	var node interface{} = map[string]interface{}(claims)
+	for _, key := range strings.Split(name, ".") {
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		node = obj[key]
+	}
+	return node
+}
+
+// mapRole accepts a single role or a list of roles, in which case the first known one is used
+func mapRole(v interface{}) (Role, bool) {
+	switch r := v.(type) {
+	case string:
+		role := Role(r)
+		return role, role.IsValid()
+	case []interface{}:
+		for _, item := range r {
+			if s, ok := item.(string); ok && Role(s).IsValid() {
+				return Role(s), true
+			}
+		}
+	}
+	return "", false
+}
+
+// mapPermissions returns nil when the claim is missing, so that stored role bindings apply.
+// Unknown entries are ignored, e.g. other scopes granted by the identity provider.
+func mapPermissions(v interface{}) []Permission {
+	var items []string
+	switch p := v.(type) {
+	case string:
+		items = strings.Fields(p)
+	case []interface{}:
+		for _, item := range p {
+			if s, ok := item.(string); ok {
+				items = append(items, s)
+			}
+		}
+	default:
+		return nil
+	}
+
+	perms := []Permission{}
+	for _, item := range items {
+		if Permission(item).IsValid() {
+			perms = append(perms, Permission(item))
+		}
+	}
+	return perms
+}
@@ -0,0 +1,382 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "audit-logging-api"
+)
+
+type signingKey struct {
+	kid    string
+	method jwt.SigningMethod
+	key    crypto.Signer
+}
+
+// jwksServer serves the public part of the current keys, the set can be swapped to simulate rotation
+type jwksServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	keys     []signingKey
+	requests atomic.Int32
+}
+
+func newJWKSServer(t *testing.T, keys ...signingKey) *jwksServer {
+	s := &jwksServer{keys: keys}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		set := []map[string]string{}
+		for _, k := range s.keys {
+			set = append(set, publicJWK(k))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": set})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) setKeys(keys ...signingKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func publicJWK(k signingKey) map[string]string {
+	enc := func(b *big.Int) string { return base64.RawURLEncoding.EncodeToString(b.Bytes()) }
+	switch pub := k.key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{"kty": "RSA", "kid": k.kid, "use": "sig", "n": enc(pub.N), "e": enc(big.NewInt(int64(pub.E)))}
+	case *ecdsa.PublicKey:
+		return map[string]string{"kty": "EC", "kid": k.kid, "use": "sig", "crv": "P-256", "x": enc(pub.X), "y": enc(pub.Y)}
+	}
+	return nil
+}
+
+func newRSAKey(t *testing.T, kid string) signingKey {
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return signingKey{kid: kid, method: jwt.SigningMethodRS256, key: k}
+}
+
+func newECKey(t *testing.T, kid string) signingKey {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return signingKey{kid: kid, method: jwt.SigningMethodES256, key: k}
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":       testIssuer,
+		"aud":       testAudience,
+		"sub":       "u1",
+		"tenant_id": "t1",
+		"role":      "auditor",
+		"jti":       "token-1",
+		"iat":       time.Now().Unix(),
+		"exp":       time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func sign(t *testing.T, k signingKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(k.method, claims)
+	token.Header["kid"] = k.kid
+	s, err := token.SignedString(k.key)
+	require.NoError(t, err)
+	return s
+}
+
+func newOIDCManager(srv *jwksServer) *auth.OIDCManager {
+	return auth.NewOIDCManager(auth.OIDCConfig{
+		Issuer:   testIssuer,
+		Audience: testAudience,
+		JWKSURL:  srv.URL,
+	})
+}
+
+func TestOIDCManager_ParseToken_RS256(t *testing.T) {
+	key := newRSAKey(t, "rsa-1")
+	manager := newOIDCManager(newJWKSServer(t, key))
+
+	claims, err := manager.ParseToken(context.Background(), sign(t, key, validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "u1", claims.UserID)
+	assert.Equal(t, "t1", claims.TenantID)
+	assert.Equal(t, auth.RoleAuditor, claims.Role)
+	assert.Equal(t, "token-1", claims.ID)
+	assert.Equal(t, testIssuer, claims.Issuer)
+}
+
+func TestOIDCManager_ParseToken_ES256(t *testing.T) {
+	key := newECKey(t, "ec-1")
+	manager := newOIDCManager(newJWKSServer(t, newRSAKey(t, "rsa-1"), key))
+
+	claims, err := manager.ParseToken(context.Background(), sign(t, key, validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "u1", claims.UserID)
+}
+
+func TestOIDCManager_ParseToken_CachesKeys(t *testing.T) {
+	key := newRSAKey(t, "rsa-1")
+	srv := newJWKSServer(t, key)
+	manager := newOIDCManager(srv)
+
+	for i := 0; i < 3; i++ {
+		_, err := manager.ParseToken(context.Background(), sign(t, key, validClaims()))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), srv.requests.Load())
+}
+
+func TestOIDCManager_ParseToken_KeyRotation(t *testing.T) {
+	oldKey, newKey := newRSAKey(t, "rsa-1"), newRSAKey(t, "rsa-2")
+	srv := newJWKSServer(t, oldKey)
+	manager := auth.NewOIDCManager(auth.OIDCConfig{
+		Issuer:         testIssuer,
+		Audience:       testAudience,
+		JWKSURL:        srv.URL,
+		JWKSMinRefresh: time.Nanosecond,
+	})
+
+	_, err := manager.ParseToken(context.Background(), sign(t, oldKey, validClaims()))
+	require.NoError(t, err)
+
+	// the provider rotates, tokens signed with the new kid trigger a refetch
+	srv.setKeys(newKey)
+	_, err = manager.ParseToken(context.Background(), sign(t, newKey, validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), srv.requests.Load())
+
+	_, err = manager.ParseToken(context.Background(), sign(t, oldKey, validClaims()))
+	assert.ErrorIs(t, err, auth.ErrUnknownSigningKey)
+}
+
+func TestOIDCManager_ParseToken_UnknownKidIsThrottled(t *testing.T) {
+	key := newRSAKey(t, "rsa-1")
+	srv := newJWKSServer(t, key)
+	manager := newOIDCManager(srv)
+
+	_, err := manager.ParseToken(context.Background(), sign(t, key, validClaims()))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = manager.ParseToken(context.Background(), sign(t, newRSAKey(t, "unknown"), validClaims()))
+		assert.ErrorIs(t, err, auth.ErrUnknownSigningKey)
+	}
+	assert.Equal(t, int32(1), srv.requests.Load())
+}
+
+func TestOIDCManager_ParseToken_SlowProvider(t *testing.T) {
+	oldKey, newKey := newRSAKey(t, "rsa-1"), newRSAKey(t, "rsa-2")
+	srv := newJWKSServer(t, oldKey)
+	manager := auth.NewOIDCManager(auth.OIDCConfig{
+		Issuer:         testIssuer,
+		Audience:       testAudience,
+		JWKSURL:        srv.URL,
+		JWKSMinRefresh: time.Nanosecond,
+	})
+	_, err := manager.ParseToken(context.Background(), sign(t, oldKey, validClaims()))
+	require.NoError(t, err)
+
+	// the provider hangs on the refetch triggered by the new kid
+	srv.setKeys(oldKey, newKey)
+	srv.mu.Lock()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := manager.ParseToken(context.Background(), sign(t, newKey, validClaims()))
+			assert.NoError(t, err)
+		}()
+	}
+	require.Eventually(t, func() bool { return srv.requests.Load() == 2 }, time.Second, time.Millisecond)
+
+	// tokens of a known key don't wait for it
+	parsed := make(chan error, 1)
+	go func() {
+		_, err := manager.ParseToken(context.Background(), sign(t, oldKey, validClaims()))
+		parsed <- err
+	}()
+	select {
+	case err := <-parsed:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Error("token of a known key waited for the key set")
+	}
+
+	// a request gone stops waiting, without cancelling the fetch the others wait on
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = manager.ParseToken(ctx, sign(t, newKey, validClaims()))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	srv.mu.Unlock()
+	wg.Wait()
+	// the waiting tokens shared a single fetch
+	assert.Equal(t, int32(2), srv.requests.Load())
+}
+
+func TestOIDCManager_ParseToken_Invalid(t *testing.T) {
+	key := newRSAKey(t, "rsa-1")
+	manager := newOIDCManager(newJWKSServer(t, key))
+
+	tests := []struct {
+		name   string
+		mutate func(jwt.MapClaims)
+		err    error
+	}{
+		{"Wrong Issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }, jwt.ErrTokenInvalidIssuer},
+		{"Wrong Audience", func(c jwt.MapClaims) { c["aud"] = "other-api" }, jwt.ErrTokenInvalidAudience},
+		{"Expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }, jwt.ErrTokenExpired},
+		{"No Expiry", func(c jwt.MapClaims) { delete(c, "exp") }, jwt.ErrTokenRequiredClaimMissing},
+		{"Unknown Role", func(c jwt.MapClaims) { c["role"] = "superman" }, auth.ErrMissingClaim},
+		{"No Tenant", func(c jwt.MapClaims) { delete(c, "tenant_id") }, auth.ErrMissingClaim},
+		{"No Subject", func(c jwt.MapClaims) { delete(c, "sub") }, auth.ErrMissingClaim},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims()
+			tt.mutate(claims)
+
+			_, err := manager.ParseToken(context.Background(), sign(t, key, claims))
+			assert.True(t, errors.Is(err, tt.err), "expected %v, got %v", tt.err, err)
+		})
+	}
+}
+
+func TestOIDCManager_ParseToken_RejectsHS256(t *testing.T) {
+	manager := newOIDCManager(newJWKSServer(t, newRSAKey(t, "rsa-1")))
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims())
+	token.Header["kid"] = "rsa-1"
+	s, err := token.SignedString([]byte("shared-secret"))
+	require.NoError(t, err)
+
+	_, err = manager.ParseToken(context.Background(), s)
+	assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+}
+
+func TestOIDCManager_ParseToken_ClaimMapping(t *testing.T) {
+	key := newRSAKey(t, "rsa-1")
+	srv := newJWKSServer(t, key)
+	manager := auth.NewOIDCManager(auth.OIDCConfig{
+		Issuer:  testIssuer,
+		JWKSURL: srv.URL,
+		Claims: auth.ClaimMapping{
+			UserID:   "email",
+			TenantID: "https://audit.example.com/tenant",
+			Role:     "realm_access.roles",
+		},
+	})
+
+	claims := validClaims()
+	claims["email"] = "jane@example.com"
+	claims["https://audit.example.com/tenant"] = "t9"
+	claims["realm_access"] = map[string]interface{}{"roles": []string{"offline_access", "user"}}
+
+	parsed, err := manager.ParseToken(context.Background(), sign(t, key, claims))
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", parsed.UserID)
+	assert.Equal(t, "t9", parsed.TenantID)
+	assert.Equal(t, auth.RoleUser, parsed.Role)
+}
+
+func TestOIDCManager_ParseToken_Permissions(t *testing.T) {
+	key := newRSAKey(t, "rsa-1")
+	srv := newJWKSServer(t, key)
+
+	manager := newOIDCManager(srv)
+	parsed, err := manager.ParseToken(context.Background(), sign(t, key, validClaims()))
+	require.NoError(t, err)
+	assert.Nil(t, parsed.Permissions, "no claim, stored bindings apply")
+
+	claims := validClaims()
+	claims["permissions"] = []string{"logs:export", "billing:read"}
+	parsed, err = manager.ParseToken(context.Background(), sign(t, key, claims))
+	require.NoError(t, err)
+	assert.Equal(t, []auth.Permission{auth.PermissionLogsExport}, parsed.Permissions)
+
+	scoped := auth.NewOIDCManager(auth.OIDCConfig{
+		Issuer:  testIssuer,
+		JWKSURL: srv.URL,
+		Claims:  auth.ClaimMapping{Permissions: "scope"},
+	})
+	claims = validClaims()
+	claims["scope"] = "openid logs:read logs:write"
+	parsed, err = scoped.ParseToken(context.Background(), sign(t, key, claims))
+	require.NoError(t, err)
+	assert.Equal(t, []auth.Permission{auth.PermissionLogsRead, auth.PermissionLogsWrite}, parsed.Permissions)
+}
+
+func TestOIDCManager_ParseToken_AdminWithoutTenant(t *testing.T) {
+	key := newECKey(t, "ec-1")
+	manager := newOIDCManager(newJWKSServer(t, key))
+
+	claims := validClaims()
+	claims["role"] = "admin"
+	delete(claims, "tenant_id")
+
+	parsed, err := manager.ParseToken(context.Background(), sign(t, key, claims))
+	require.NoError(t, err)
+	assert.Equal(t, auth.RoleAdmin, parsed.Role)
+	assert.Empty(t, parsed.TenantID)
+}
+
+func TestOIDCManager_ParseToken_Discovery(t *testing.T) {
+	key := newRSAKey(t, "rsa-1")
+	jwks := newJWKSServer(t, key)
+
+	var issuer string
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer, "jwks_uri": jwks.URL})
+	}))
+	defer idp.Close()
+	issuer = idp.URL
+
+	manager := auth.NewOIDCManager(auth.OIDCConfig{Issuer: issuer})
+	claims := validClaims()
+	claims["iss"] = issuer
+
+	parsed, err := manager.ParseToken(context.Background(), sign(t, key, claims))
+	require.NoError(t, err)
+	assert.Equal(t, "u1", parsed.UserID)
+}
+
+func TestOIDCManager_GenerateToken_Disabled(t *testing.T) {
+	manager := auth.NewOIDCManager(auth.OIDCConfig{Issuer: testIssuer})
+
+	token, err := manager.GenerateToken("u1", "t1", auth.RoleAdmin, time.Minute)
+	assert.ErrorIs(t, err, auth.ErrTokenIssuingDisabled)
+	assert.Empty(t, token)
+}
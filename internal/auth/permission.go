@@ -0,0 +1,94 @@
+package auth
+
+import "slices"
+
+type Permission string
+
+const (
+	PermissionLogsRead       Permission = "logs:read"
+	PermissionLogsWrite      Permission = "logs:write"
+	PermissionLogsExport     Permission = "logs:export"
+	PermissionLogsCleanup    Permission = "logs:cleanup"
+	PermissionSchemasRead    Permission = "schemas:read"
+	PermissionSchemasWrite   Permission = "schemas:write"
+	PermissionRedactionRead  Permission = "redaction:read"
+	PermissionRedactionWrite Permission = "redaction:write"
+
+	// platform permissions, only held by admins since they reach across tenants
+	PermissionTenantsManage      Permission = "tenants:manage"
+	PermissionAPIKeysManage      Permission = "api_keys:manage"
+	PermissionRolesManage        Permission = "roles:manage"
+	PermissionSessionsRevoke     Permission = "sessions:revoke"
+	PermissionAccessGrantsManage Permission = "access_grants:manage"
+	PermissionOpsRead            Permission = "ops:read"
+	PermissionOpsManage          Permission = "ops:manage"
+)
+
+var tenantPermissions = []Permission{
+	PermissionLogsRead,
+	PermissionLogsWrite,
+	PermissionLogsExport,
+	PermissionLogsCleanup,
+	PermissionSchemasRead,
+	PermissionSchemasWrite,
+	PermissionRedactionRead,
+	PermissionRedactionWrite,
+}
+
+var platformPermissions = []Permission{
+	PermissionTenantsManage,
+	PermissionAPIKeysManage,
+	PermissionRolesManage,
+	PermissionSessionsRevoke,
+	PermissionAccessGrantsManage,
+	PermissionOpsRead,
+	PermissionOpsManage,
+}
+
+// defaultPermissions are the permissions of the built-in roles, seeded as non editable roles. Users don't
+// manage schemas and redaction rules by default, a redaction rule changes what every writer of the tenant
+// keeps; the seeded data_steward role or a custom one grants it.
+var defaultPermissions = map[Role][]Permission{
+	RoleAdmin: slices.Concat(tenantPermissions, platformPermissions),
+	RoleAuditor: {
+		PermissionLogsRead,
+		PermissionLogsExport,
+		PermissionSchemasRead,
+		PermissionRedactionRead,
+	},
+	RoleUser: {
+		PermissionLogsRead,
+		PermissionLogsWrite,
+		PermissionSchemasRead,
+		PermissionRedactionRead,
+	},
+}
+
+func (p Permission) IsValid() bool {
+	return slices.Contains(tenantPermissions, p) || slices.Contains(platformPermissions, p)
+}
+
+// IsTenantScoped tells whether the permission only acts within the caller's tenant
+func (p Permission) IsTenantScoped() bool {
+	return slices.Contains(tenantPermissions, p)
+}
+
+// DefaultPermissions returns the permissions of a built-in role
+func DefaultPermissions(role Role) []Permission {
+	return slices.Clone(defaultPermissions[role])
+}
+
+// ScopePermissions drops what the role can't hold whatever was granted, only admins keep
+// platform permissions because everyone else is confined to their tenant
+func ScopePermissions(role Role, perms []Permission) []Permission {
+	scoped := make([]Permission, 0, len(perms))
+	for _, p := range perms {
+		if !p.IsValid() || slices.Contains(scoped, p) {
+			continue
+		}
+		if role == RoleAdmin || p.IsTenantScoped() {
+			scoped = append(scoped, p)
+		}
+	}
+	return scoped
+}
@@ -0,0 +1,45 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+)
+
+func TestDefaultPermissions(t *testing.T) {
+	admin := auth.DefaultPermissions(auth.RoleAdmin)
+	assert.Contains(t, admin, auth.PermissionTenantsManage)
+	assert.Contains(t, admin, auth.PermissionLogsCleanup)
+
+	auditor := auth.DefaultPermissions(auth.RoleAuditor)
+	assert.Contains(t, auditor, auth.PermissionLogsExport)
+	assert.NotContains(t, auditor, auth.PermissionLogsWrite)
+
+	user := auth.DefaultPermissions(auth.RoleUser)
+	assert.Contains(t, user, auth.PermissionLogsWrite)
+	assert.NotContains(t, user, auth.PermissionLogsExport)
+	assert.NotContains(t, user, auth.PermissionLogsCleanup)
+	assert.NotContains(t, user, auth.PermissionSchemasWrite)
+	assert.NotContains(t, user, auth.PermissionRedactionWrite)
+
+	assert.Empty(t, auth.DefaultPermissions(auth.Role("unknown")))
+
+	// callers get a copy, the defaults can't be changed through it
+	user[0] = auth.PermissionTenantsManage
+	assert.NotContains(t, auth.DefaultPermissions(auth.RoleUser), auth.PermissionTenantsManage)
+}
+
+func TestPermission_IsTenantScoped(t *testing.T) {
+	assert.True(t, auth.PermissionLogsRead.IsTenantScoped())
+	assert.False(t, auth.PermissionRolesManage.IsTenantScoped())
+	assert.False(t, auth.Permission("logs:delete").IsValid())
+}
+
+func TestScopePermissions(t *testing.T) {
+	perms := []auth.Permission{auth.PermissionLogsRead, auth.PermissionTenantsManage, auth.PermissionLogsRead, "unknown"}
+
+	assert.Equal(t, []auth.Permission{auth.PermissionLogsRead, auth.PermissionTenantsManage}, auth.ScopePermissions(auth.RoleAdmin, perms))
+	assert.Equal(t, []auth.Permission{auth.PermissionLogsRead}, auth.ScopePermissions(auth.RoleUser, perms))
+}
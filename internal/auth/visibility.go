@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"fmt"
+)
+
+// HideableLogFields are the optional log response fields a role projection can remove.
+// Required fields (id, tenant_id, user_id, action, severity, event_timestamp, message) are always returned.
+var HideableLogFields = []string{
+	"session_id",
+	"resource",
+	"resource_id",
+	"ip_address",
+	"user_agent",
+	"before_state",
+	"after_state",
+	"metadata",
+	"schema_id",
+	"schema_violations",
+	"diff",
+	"redactions",
+}
+
+// FieldVisibility lists, per role, the log fields removed from read responses
+// (search, get, export and stream).
+type FieldVisibility map[Role][]string
+
+func (v FieldVisibility) Validate() error {
+	for role, fields := range v {
+		if !role.IsValid() {
+			return fmt.Errorf("field visibility: unknown role %q", role)
+		}
+		for _, f := range fields {
+			if !isHideable(f) {
+				return fmt.Errorf("field visibility: field %q cannot be hidden from %s", f, role)
+			}
+		}
+	}
+	return nil
+}
+
+// Hidden returns the set of fields hidden from the role
+func (v FieldVisibility) Hidden(role Role) map[string]bool {
+	hidden := make(map[string]bool, len(v[role]))
+	for _, f := range v[role] {
+		hidden[f] = true
+	}
+	return hidden
+}
+
+func isHideable(field string) bool {
+	for _, f := range HideableLogFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+)
+
+func TestFieldVisibility_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility auth.FieldVisibility
+		wantErr    bool
+	}{
+		{"Valid", auth.FieldVisibility{auth.RoleUser: {"ip_address", "before_state"}}, false},
+		{"Empty", auth.FieldVisibility{}, false},
+		{"Unknown Role", auth.FieldVisibility{auth.Role("guest"): {"ip_address"}}, true},
+		{"Required Field", auth.FieldVisibility{auth.RoleUser: {"tenant_id"}}, true},
+		{"Unknown Field", auth.FieldVisibility{auth.RoleAuditor: {"password"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.visibility.Validate()
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestFieldVisibility_Hidden(t *testing.T) {
+	v := auth.FieldVisibility{auth.RoleUser: {"ip_address", "user_agent"}}
+
+	assert.Equal(t, map[string]bool{"ip_address": true, "user_agent": true}, v.Hidden(auth.RoleUser))
+	assert.Empty(t, v.Hidden(auth.RoleAuditor))
+	assert.Empty(t, v.Hidden(auth.RoleAdmin))
+}
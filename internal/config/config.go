@@ -2,11 +2,17 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v6"
 	"github.com/joho/godotenv"
 
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/service"
 	"github.com/Haevnen/audit-logging-api/pkg/gormdb"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
 type Config struct {
@@ -22,20 +28,44 @@ type Config struct {
 	ProjectName string `env:"PROJECT_NAME"`
 	SpecDir     string `env:"SPEC_DIR"`
 	LogFile     string `env:"LOG_FILE"`
+	// the log file is rotated past LOG_MAX_SIZE_MB, rotated files are kept LOG_MAX_AGE_DAYS
+	LogMaxSizeMB  int `env:"LOG_MAX_SIZE_MB" envDefault:"100"`
+	LogMaxAgeDays int `env:"LOG_MAX_AGE_DAYS" envDefault:"7"`
+	LogMaxBackups int `env:"LOG_MAX_BACKUPS" envDefault:"5"`
 
 	APIPort           int    `env:"API_PORT"`
 	APIHost           string `env:"API_HOST"`
 	Mode              string `env:"RUN_MODE"`
 	TokenSymmetricKey string `env:"TOKEN_SYMMETRIC_KEY"`
 
-	RateLimitBurst int `env:"RATE_LIMIT_BURST"`
-	RateLimitRPS   int `env:"RATE_LIMIT_RPS"`
+	// identity provider, tokens are signed with TokenSymmetricKey when no issuer is set
+	OIDCIssuer           string        `env:"OIDC_ISSUER"`
+	OIDCAudience         string        `env:"OIDC_AUDIENCE"`
+	OIDCJWKSURL          string        `env:"OIDC_JWKS_URL"`
+	OIDCJWKSCacheTTL     time.Duration `env:"OIDC_JWKS_CACHE_TTL" envDefault:"15m"`
+	OIDCUserIDClaim      string        `env:"OIDC_USER_ID_CLAIM" envDefault:"sub"`
+	OIDCTenantIDClaim    string        `env:"OIDC_TENANT_ID_CLAIM" envDefault:"tenant_id"`
+	OIDCRoleClaim        string        `env:"OIDC_ROLE_CLAIM" envDefault:"role"`
+	OIDCPermissionsClaim string        `env:"OIDC_PERMISSIONS_CLAIM" envDefault:"permissions"`
 
-	SqsLogCleanupQueueURL  string `env:"SQS_LOG_CLEANUP_QUEUE_URL"`
-	SqsLogArchivalQueueURL string `env:"SQS_LOG_ARCHIVAL_QUEUE_URL"`
-	SqsIndexQueueURL       string `env:"SQS_INDEX_QUEUE_URL"`
-	S3ArchiveLogURL        string `env:"S3_ARCHIVE_LOG_URL"`
-	S3ArchiveLogBucketName string `env:"S3_ARCHIVE_LOG_BUCKET_NAME"`
+	// default limits of every tenant, overridden per tenant over the API
+	RateLimitBurst       int   `env:"RATE_LIMIT_BURST"`
+	RateLimitRPS         int   `env:"RATE_LIMIT_RPS"`
+	RateLimitWriteBurst  int   `env:"RATE_LIMIT_WRITE_BURST"`
+	RateLimitWriteRPS    int   `env:"RATE_LIMIT_WRITE_RPS"`
+	RateLimitExportBurst int   `env:"RATE_LIMIT_EXPORT_BURST" envDefault:"5"`
+	RateLimitExportRPS   int   `env:"RATE_LIMIT_EXPORT_RPS" envDefault:"1"`
+	DailyEventQuota      int64 `env:"DAILY_EVENT_QUOTA" envDefault:"0"`
+	DailyByteQuota       int64 `env:"DAILY_BYTE_QUOTA" envDefault:"0"`
+
+	SqsLogCleanupQueueURL     string `env:"SQS_LOG_CLEANUP_QUEUE_URL"`
+	SqsLogArchivalQueueURL    string `env:"SQS_LOG_ARCHIVAL_QUEUE_URL"`
+	SqsIndexQueueURL          string `env:"SQS_INDEX_QUEUE_URL"`
+	SqsTenantDeletionQueueURL string `env:"SQS_TENANT_DELETION_QUEUE_URL"`
+	SqsReindexQueueURL        string `env:"SQS_REINDEX_QUEUE_URL"`
+	SqsIndexRebuildQueueURL   string `env:"SQS_INDEX_REBUILD_QUEUE_URL"`
+	S3ArchiveLogURL           string `env:"S3_ARCHIVE_LOG_URL"`
+	S3ArchiveLogBucketName    string `env:"S3_ARCHIVE_LOG_BUCKET_NAME"`
 
 	AwsRegion         string `env:"AWS_REGION"`
 	AwsKey            string `env:"AWS_ACCESS_KEY_ID"`
@@ -44,6 +74,46 @@ type Config struct {
 
 	OpenSearchURL string `env:"OPENSEARCH_URL"`
 	RedisAddr     string `env:"REDIS_ADDR"`
+
+	// indices of the logs, rolled over every SEARCH_INDEX_ROLLOVER_AGE and dropped once their newest log is
+	// older than SEARCH_INDEX_RETENTION, kept forever when zero
+	SearchIndexShards              int           `env:"SEARCH_INDEX_SHARDS" envDefault:"1"`
+	SearchIndexReplicas            int           `env:"SEARCH_INDEX_REPLICAS" envDefault:"1"`
+	SearchIndexRolloverAge         time.Duration `env:"SEARCH_INDEX_ROLLOVER_AGE" envDefault:"24h"`
+	SearchIndexRetention           time.Duration `env:"SEARCH_INDEX_RETENTION" envDefault:"0"`
+	SearchIndexMaintenanceInterval time.Duration `env:"SEARCH_INDEX_MAINTENANCE_INTERVAL" envDefault:"1h"`
+	// partitions of an index rebuild backfilled at once
+	SearchIndexRebuildConcurrency int `env:"SEARCH_INDEX_REBUILD_CONCURRENCY" envDefault:"4"`
+
+	RedactionHashKey string `env:"REDACTION_HASH_KEY"`
+
+	// metrics of the async-task process
+	MetricsAddr string `env:"METRICS_ADDR" envDefault:":9091"`
+	// metrics of the API, on an internal port apart from the public one
+	APIMetricsAddr string `env:"API_METRICS_ADDR" envDefault:":9093"`
+	// tenants past this many share the "other" label of the per-tenant metrics
+	MetricsMaxTenants int `env:"METRICS_MAX_TENANTS" envDefault:"100"`
+
+	// each dependency probe of /readyz fails past this
+	HealthCheckTimeout time.Duration `env:"HEALTH_CHECK_TIMEOUT" envDefault:"2s"`
+	// /livez and /readyz of the async-task process
+	HealthAddr string `env:"HEALTH_ADDR" envDefault:":9092"`
+	// a worker loop without a successful poll for this long is reported stuck
+	WorkerStaleAfter time.Duration `env:"WORKER_STALE_AFTER" envDefault:"5m"`
+
+	// hour (UTC) of the nightly reindex of every tenant over the last REINDEX_SCHEDULE_WINDOW, off when negative
+	ReindexScheduleHour   int           `env:"REINDEX_SCHEDULE_HOUR" envDefault:"-1"`
+	ReindexScheduleWindow time.Duration `env:"REINDEX_SCHEDULE_WINDOW" envDefault:"48h"`
+
+	// none, stdout or otlp
+	TracingExporter string `env:"TRACING_EXPORTER" envDefault:"none"`
+	// OTLP/HTTP collector, e.g. http://localhost:4318
+	TracingOTLPEndpoint string  `env:"TRACING_OTLP_ENDPOINT"`
+	TracingSampleRatio  float64 `env:"TRACING_SAMPLE_RATIO" envDefault:"1"`
+
+	LogFieldsHiddenFromAdmin   []string `env:"LOG_FIELDS_HIDDEN_FROM_ADMIN" envSeparator:","`
+	LogFieldsHiddenFromAuditor []string `env:"LOG_FIELDS_HIDDEN_FROM_AUDITOR" envSeparator:","`
+	LogFieldsHiddenFromUser    []string `env:"LOG_FIELDS_HIDDEN_FROM_USER" envSeparator:","`
 }
 
 func LoadConfig() (config Config, err error) {
@@ -58,6 +128,7 @@ func LoadConfig() (config Config, err error) {
 		panic(err)
 	}
 
+	err = config.GetFieldVisibility().Validate()
 	return
 }
 
@@ -76,6 +147,85 @@ func (e *Config) GetGORMConfig() *gormdb.Config {
 	}
 }
 
+// GetLoggerConfig returns where the log lines go and when the log file is rotated
+func (e *Config) GetLoggerConfig() logger.Config {
+	return logger.Config{
+		Level:      e.Mode,
+		File:       e.LogFile,
+		MaxSizeMB:  e.LogMaxSizeMB,
+		MaxAgeDays: e.LogMaxAgeDays,
+		MaxBackups: e.LogMaxBackups,
+	}
+}
+
+// GetTracingConfig returns where the spans of the service go
+func (e *Config) GetTracingConfig(serviceName string) tracing.Config {
+	return tracing.Config{
+		ServiceName:  serviceName,
+		Exporter:     e.TracingExporter,
+		OTLPEndpoint: e.TracingOTLPEndpoint,
+		SampleRatio:  e.TracingSampleRatio,
+	}
+}
+
+// IsDevMode reports whether the server runs in gin debug mode, the test token endpoint is only served then
+func (e *Config) IsDevMode() bool {
+	return e.Mode == "debug"
+}
+
+// GetOIDCConfig returns the identity provider settings, nil when tokens are signed locally
+func (e *Config) GetOIDCConfig() *auth.OIDCConfig {
+	if len(e.OIDCIssuer) == 0 {
+		return nil
+	}
+
+	return &auth.OIDCConfig{
+		Issuer:       e.OIDCIssuer,
+		Audience:     e.OIDCAudience,
+		JWKSURL:      e.OIDCJWKSURL,
+		JWKSCacheTTL: e.OIDCJWKSCacheTTL,
+		Claims: auth.ClaimMapping{
+			UserID:      e.OIDCUserIDClaim,
+			TenantID:    e.OIDCTenantIDClaim,
+			Role:        e.OIDCRoleClaim,
+			Permissions: e.OIDCPermissionsClaim,
+		},
+	}
+}
+
+// GetFieldVisibility builds the per-role projection applied to log read responses
+func (e *Config) GetFieldVisibility() auth.FieldVisibility {
+	return auth.FieldVisibility{
+		auth.RoleAdmin:   e.LogFieldsHiddenFromAdmin,
+		auth.RoleAuditor: e.LogFieldsHiddenFromAuditor,
+		auth.RoleUser:    e.LogFieldsHiddenFromUser,
+	}
+}
+
+// GetDefaultLimits returns the limits of the tenants without overrides, writes fall back to the
+// read limits when not set and a zero quota is unlimited
+func (e *Config) GetDefaultLimits() tenant_limit.Limits {
+	write := tenant_limit.Rate{RPS: e.RateLimitWriteRPS, Burst: e.RateLimitWriteBurst}
+	if write.RPS == 0 {
+		write.RPS = e.RateLimitRPS
+	}
+	if write.Burst == 0 {
+		write.Burst = e.RateLimitBurst
+	}
+
+	return tenant_limit.Limits{
+		Read:        tenant_limit.Rate{RPS: e.RateLimitRPS, Burst: e.RateLimitBurst},
+		Write:       write,
+		Export:      tenant_limit.Rate{RPS: e.RateLimitExportRPS, Burst: e.RateLimitExportBurst},
+		DailyEvents: e.DailyEventQuota,
+		DailyBytes:  e.DailyByteQuota,
+	}
+}
+
+func (e *Config) GetIndexSettings() service.IndexSettings {
+	return service.IndexSettings{Shards: e.SearchIndexShards, Replicas: e.SearchIndexReplicas}
+}
+
 // GetURLBase build server config from env
 func (e *Config) GetURLBase() string {
 	return fmt.Sprintf("%s:%d", e.APIHost, e.APIPort)
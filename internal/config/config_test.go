@@ -6,7 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/Haevnen/audit-logging-api/internal/auth"
 	"github.com/Haevnen/audit-logging-api/internal/config"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
 )
 
 func TestGetGORMConfig(t *testing.T) {
@@ -41,6 +43,37 @@ func TestGetURLBase(t *testing.T) {
 	assert.Equal(t, "127.0.0.1:8080", url)
 }
 
+func TestGetFieldVisibility(t *testing.T) {
+	cfg := config.Config{
+		LogFieldsHiddenFromUser: []string{"ip_address", "before_state"},
+	}
+
+	v := cfg.GetFieldVisibility()
+	assert.NoError(t, v.Validate())
+	assert.Equal(t, map[string]bool{"ip_address": true, "before_state": true}, v.Hidden(auth.RoleUser))
+	assert.Empty(t, v.Hidden(auth.RoleAuditor))
+}
+
+func TestGetOIDCConfig(t *testing.T) {
+	cfg := config.Config{TokenSymmetricKey: "secret"}
+	assert.Nil(t, cfg.GetOIDCConfig())
+
+	cfg.OIDCIssuer = "https://idp.example.com"
+	cfg.OIDCAudience = "audit-logging-api"
+	cfg.OIDCTenantIDClaim = "org_id"
+
+	oidc := cfg.GetOIDCConfig()
+	assert.NotNil(t, oidc)
+	assert.Equal(t, "https://idp.example.com", oidc.Issuer)
+	assert.Equal(t, "audit-logging-api", oidc.Audience)
+	assert.Equal(t, "org_id", oidc.Claims.TenantID)
+}
+
+func TestIsDevMode(t *testing.T) {
+	assert.True(t, (&config.Config{Mode: "debug"}).IsDevMode())
+	assert.False(t, (&config.Config{Mode: "release"}).IsDevMode())
+}
+
 func TestLoadConfig_Success(t *testing.T) {
 	// prepare env variables
 	os.Setenv("POSTGRES_HOST", "localhost")
@@ -71,3 +104,22 @@ func TestLoadConfig_ParseEnv(t *testing.T) {
 
 	assert.Equal(t, "myhost:9090", cfg.GetURLBase())
 }
+
+func TestGetDefaultLimits(t *testing.T) {
+	cfg := config.Config{
+		RateLimitRPS:         100,
+		RateLimitBurst:       300,
+		RateLimitWriteBurst:  50,
+		RateLimitExportRPS:   1,
+		RateLimitExportBurst: 5,
+		DailyEventQuota:      1000,
+	}
+
+	l := cfg.GetDefaultLimits()
+	assert.Equal(t, tenant_limit.Rate{RPS: 100, Burst: 300}, l.Read)
+	// unset write limits fall back to the read limits
+	assert.Equal(t, tenant_limit.Rate{RPS: 100, Burst: 50}, l.Write)
+	assert.Equal(t, tenant_limit.Rate{RPS: 1, Burst: 5}, l.Export)
+	assert.Equal(t, int64(1000), l.DailyEvents)
+	assert.Zero(t, l.DailyBytes)
+}
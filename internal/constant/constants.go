@@ -3,9 +3,15 @@ package constant
 const (
 	AuthorizationHeaderKey  = "authorization"
 	AuthorizationTypeBearer = "Bearer "
+	APIKeyHeaderKey         = "X-API-Key"
+	RequestIDHeaderKey      = "X-Request-ID"
+	RequestID               = "request_id"
+	Permissions             = "permissions"
 	TenantID                = "tenant_id"
 	UserID                  = "user_id"
 	Role                    = "role"
+	TokenID                 = "token_id"
+	TokenExpiresAt          = "token_expires_at"
 	BaseURL                 = "/api/v1"
 	MaxPageSize             = 100
 )
@@ -0,0 +1,33 @@
+package access_grant
+
+import (
+	"slices"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// AccessGrant lets a principal (e.g. an external auditor) read the logs of tenants other than their own
+// between StartsAt and ExpiresAt. The grantee is the user GranteeID of the tenant GranteeTenantID, user ids are
+// only unique within a tenant.
+type AccessGrant struct {
+	ID              string // UUID
+	GranteeTenantID string
+	GranteeID       string
+	TenantIDs       datatypes.JSONSlice[string]
+	Reason          string
+	StartsAt        time.Time
+	ExpiresAt       time.Time
+	RevokedAt       *time.Time
+	CreatedBy       string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (g AccessGrant) IsActive(now time.Time) bool {
+	return g.RevokedAt == nil && !now.Before(g.StartsAt) && now.Before(g.ExpiresAt)
+}
+
+func (g AccessGrant) Covers(tenantId string) bool {
+	return slices.Contains(g.TenantIDs, tenantId)
+}
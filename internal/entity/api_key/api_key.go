@@ -0,0 +1,56 @@
+package api_key
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type Scope string
+
+const (
+	ScopeLogsRead   Scope = "logs:read"
+	ScopeLogsWrite  Scope = "logs:write"
+	ScopeLogsExport Scope = "logs:export"
+)
+
+func (s Scope) IsValid() bool {
+	switch s {
+	case ScopeLogsRead, ScopeLogsWrite, ScopeLogsExport:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey authenticates a machine producer of a single tenant. Only the SHA-256 of the key is
+// stored, Prefix is the public part used to find the record.
+type APIKey struct {
+	ID         string // UUID
+	TenantID   string
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Role       string
+	Scopes     datatypes.JSONSlice[Scope]
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (k APIKey) HasScope(scope Scope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive tells whether the key can still authenticate
+func (k APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
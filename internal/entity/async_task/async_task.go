@@ -15,10 +15,13 @@ const (
 	StatusSucceeded AsyncTaskStatus = "succeeded"
 	StatusFailed    AsyncTaskStatus = "failed"
 
-	TaskLogCleanup AsyncTaskType = "log_cleanup"
-	TaskArchive    AsyncTaskType = "archive"
-	TaskExport     AsyncTaskType = "export"
-	TaskReindex    AsyncTaskType = "reindex"
+	TaskLogCleanup     AsyncTaskType = "log_cleanup"
+	TaskArchive        AsyncTaskType = "archive"
+	TaskExport         AsyncTaskType = "export"
+	TaskIndex          AsyncTaskType = "index"
+	TaskReindex        AsyncTaskType = "reindex"
+	TaskIndexRebuild   AsyncTaskType = "index_rebuild"
+	TaskTenantDeletion AsyncTaskType = "tenant_deletion"
 )
 
 type AsyncTask struct {
@@ -26,9 +29,72 @@ type AsyncTask struct {
 	Status    AsyncTaskStatus
 	TaskType  AsyncTaskType
 	Payload   *datatypes.JSON
+	Progress  *datatypes.JSON
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	TenantUID *string
 	UserID    string
 	ErrorMsg  *string
 }
+
+// TaskCount is the number of tasks of a type in a status, and when the oldest of them was created
+type TaskCount struct {
+	TaskType        AsyncTaskType
+	Status          AsyncTaskStatus
+	Count           int64
+	OldestCreatedAt time.Time
+}
+
+// TenantDeletionProgress is stored on the tenant deletion task after every completed step
+type TenantDeletionProgress struct {
+	CompletedSteps    []string `json:"completed_steps"`
+	ArchivedLogs      int      `json:"archived_logs"`
+	DeletedDocuments  int      `json:"deleted_documents"`
+	ClosedSubscribers int64    `json:"closed_subscribers"`
+	DeletedLogs       int64    `json:"deleted_logs"`
+}
+
+const (
+	StepArchiveLogs     = "archive_logs"
+	StepDeleteDocuments = "delete_documents"
+	StepPurgeChannels   = "purge_channels"
+	StepDeleteRows      = "delete_rows"
+)
+
+// ReindexPayload is the range a reindex task reconciles, over every tenant when the task has none
+type ReindexPayload struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// ReindexProgress is stored on the reindex task after every reconciled chunk
+type ReindexProgress struct {
+	// start of the next chunk, the chunks before it are reconciled
+	ReconciledUntil time.Time `json:"reconciled_until"`
+	Chunks          int       `json:"chunks"`
+	// logs in Postgres and documents in OpenSearch compared so far
+	StoredLogs  int `json:"stored_logs"`
+	IndexedDocs int `json:"indexed_documents"`
+	// logs missing from OpenSearch, now indexed, and documents without a log, now deleted
+	Missing int `json:"missing"`
+	Orphans int `json:"orphans"`
+}
+
+// IndexRebuildPayload is the version of the mapping an index rebuild task builds, see service.LogsIndexVersion
+type IndexRebuildPayload struct {
+	Version int `json:"version"`
+}
+
+// IndexRebuildProgress is stored on the index rebuild task after every backfilled partition
+type IndexRebuildProgress struct {
+	// index built, it takes the aliases once backfilled
+	Index string `json:"index"`
+	// a partition is the logs of a tenant over a day
+	Partitions          int   `json:"partitions"`
+	CompletedPartitions int   `json:"completed_partitions"`
+	TotalLogs           int64 `json:"total_logs"`
+	IndexedLogs         int64 `json:"indexed_logs"`
+	// set once the aliases moved to the new index, with the indices of the previous version then dropped
+	Switched       bool     `json:"switched"`
+	DroppedIndices []string `json:"dropped_indices,omitempty"`
+}
@@ -45,17 +45,167 @@ type Log struct {
 	BeforeState *datatypes.JSON
 	AfterState  *datatypes.JSON
 	Metadata    *datatypes.JSON
+
+	// schema validation
+	SchemaID         *string
+	SchemaViolations *datatypes.JSON
+
+	// field-level diff between BeforeState and AfterState, a list of DiffEntry
+	Diff *datatypes.JSON
+
+	// what the tenant's redaction rules removed from the log, a list of redaction_rule.Summary
+	Redactions *datatypes.JSON
+
+	// entry of the service's own audit trail (reads, exports, admin actions), only visible to admins
+	// and never removed by the cleanup
+	System bool
+}
+
+type DiffOp string
+
+const (
+	DiffAdded   DiffOp = "added"
+	DiffRemoved DiffOp = "removed"
+	DiffChanged DiffOp = "changed"
+)
+
+// DiffEntry describes a change of a single path, e.g. "plan.tier" or "tags[0]".
+// Values are kept JSON encoded so that the search index gets a stable type for them.
+type DiffEntry struct {
+	Path     string  `json:"path"`
+	Op       DiffOp  `json:"op"`
+	OldValue *string `json:"old_value,omitempty"`
+	NewValue *string `json:"new_value,omitempty"`
+}
+
+// StatsInterval is the width of the buckets of a stats series
+type StatsInterval string
+
+const (
+	IntervalMinute StatsInterval = "minute"
+	IntervalHour   StatsInterval = "hour"
+	IntervalDay    StatsInterval = "day"
+	IntervalWeek   StatsInterval = "week"
+)
+
+// StatsGroupBy is the field splitting the stats in series, empty for a single series of every log
+type StatsGroupBy string
+
+const (
+	GroupByNone     StatsGroupBy = ""
+	GroupByAction   StatsGroupBy = "action"
+	GroupBySeverity StatsGroupBy = "severity"
+	GroupByResource StatsGroupBy = "resource"
+	GroupByUserID   StatsGroupBy = "user_id"
+)
+
+// StatsQuery selects the logs counted, an empty filter matches every log
+type StatsQuery struct {
+	// empty for every tenant
+	TenantID  string
+	StartTime time.Time
+	EndTime   time.Time
+	Interval  StatsInterval
+	GroupBy   StatsGroupBy
+
+	Action   string
+	Severity string
+	Resource string
+	UserID   string
+}
+
+// StatPoint counts the logs of a group in the bucket starting at Bucket
+type StatPoint struct {
+	Bucket time.Time
+	Group  string
+	Count  int64
+}
+
+// StatSeries holds the buckets of one group, buckets without logs are left out
+type StatSeries struct {
+	Key    string
+	Total  int64
+	Points []StatPoint
+}
+
+// Stats are the series of a StatsQuery
+type Stats struct {
+	Series []StatSeries
+	// time up to which the counts were materialized by the aggregates, later buckets are counted live from
+	// the logs. Nil when every bucket is counted from the logs.
+	Freshness *time.Time
+}
+
+// InsightDimension is the field the top activity is ranked by
+type InsightDimension string
+
+const (
+	InsightUser      InsightDimension = "user_id"
+	InsightResource  InsightDimension = "resource"
+	InsightIPAddress InsightDimension = "ip_address"
+)
+
+// TopQuery ranks the values of a dimension by their number of logs, an empty action counts every action
+type TopQuery struct {
+	// empty for every tenant
+	TenantID  string
+	StartTime time.Time
+	EndTime   time.Time
+	Dimension InsightDimension
+	Action    string
+	Limit     int
+}
+
+// TopEntry counts the logs of one value of the dimension
+type TopEntry struct {
+	Key   string
+	Count int64
+}
+
+// TenantSpan is the number and the range of event timestamps of the logs of a tenant
+type TenantSpan struct {
+	TenantID string
+	Count    int64
+	Oldest   time.Time
+	Newest   time.Time
+}
+
+// AnomalyQuery looks for anomalies between StartTime and EndTime, compared to the BaselineDays days before
+type AnomalyQuery struct {
+	// empty for every tenant
+	TenantID     string
+	StartTime    time.Time
+	EndTime      time.Time
+	BaselineDays int
+	// z-score from which a day of activity is a spike
+	Threshold float64
+}
+
+// UserIP is an IP address a user sent logs from
+type UserIP struct {
+	UserID    string
+	IPAddress string
+	FirstSeen time.Time
+	LastSeen  time.Time
+	Count     int64
+}
+
+// ActivitySpike is a day a user sent far more logs than usual
+type ActivitySpike struct {
+	UserID string
+	Day    time.Time
+	Count  int64
+	// daily mean and standard deviation of the baseline
+	Mean   float64
+	StdDev float64
+	ZScore float64
 }
 
-type LogStats struct {
-	Day              time.Time
-	Total            int64
-	ActionCreate     int64
-	ActionUpdate     int64
-	ActionDelete     int64
-	ActionView       int64
-	SeverityInfo     int64
-	SeverityError    int64
-	SeverityWarning  int64
-	SeverityCritical int64
+// Anomalies found over the period of an AnomalyQuery
+type Anomalies struct {
+	// the query with its defaults applied
+	Query  AnomalyQuery
+	Spikes []ActivitySpike
+	// addresses a known user was not seen with during the baseline
+	NewIPs []UserIP
 }
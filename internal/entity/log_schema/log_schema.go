@@ -0,0 +1,41 @@
+package log_schema
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type Enforcement string
+
+const (
+	// EnforcementReject rejects non-conforming logs at ingestion
+	EnforcementReject Enforcement = "reject"
+	// EnforcementFlag stores non-conforming logs with their violations
+	EnforcementFlag Enforcement = "flag"
+)
+
+func (e Enforcement) IsValid() bool {
+	switch e {
+	case EnforcementReject, EnforcementFlag:
+		return true
+	default:
+		return false
+	}
+}
+
+// LogSchema is one immutable version of a tenant's JSON schemas.
+// Updating a schema creates a new version so older logs keep
+// referencing the version they were written with.
+type LogSchema struct {
+	ID                string // UUID
+	TenantID          string
+	Resource          *string // nil applies to every resource of the tenant
+	Version           int
+	Enforcement       Enforcement
+	MetadataSchema    *datatypes.JSON
+	BeforeStateSchema *datatypes.JSON
+	AfterStateSchema  *datatypes.JSON
+	IsActive          bool
+	CreatedAt         time.Time
+}
@@ -0,0 +1,44 @@
+package ops
+
+import (
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+)
+
+// QueueStatus is the approximate backlog of one SQS queue
+type QueueStatus struct {
+	Name     string
+	TaskType async_task.AsyncTaskType
+	// messages waiting to be received
+	Messages int64
+	// messages received by a worker and not deleted yet
+	InFlight int64
+	Delayed  int64
+	// creation time of the oldest task still pending for the queue. SQS doesn't report the age of its
+	// messages, every message carries a task and the task stays pending until a worker picks it up.
+	OldestPendingTask *time.Time
+}
+
+// TenantIndexLag compares the logs of a tenant stored in Postgres with the documents indexed in OpenSearch
+type TenantIndexLag struct {
+	TenantID string
+	Stored   int64
+	Indexed  int64
+}
+
+// Missing is the number of stored logs not indexed yet, negative when documents outlive their rows
+func (l TenantIndexLag) Missing() int64 {
+	return l.Stored - l.Indexed
+}
+
+// Dashboard is the state of the queues, the tasks and the indexing
+type Dashboard struct {
+	GeneratedAt time.Time
+	Queues      []QueueStatus
+	// pending and running tasks, and the tasks that failed since Since
+	Tasks []async_task.TaskCount
+	// logs with an event timestamp from Since, tenants with the most missing documents first
+	Since    time.Time
+	IndexLag []TenantIndexLag
+}
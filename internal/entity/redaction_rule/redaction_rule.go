@@ -0,0 +1,68 @@
+package redaction_rule
+
+import (
+	"time"
+)
+
+type Kind string
+
+const (
+	// KindRegex redacts every match of Pattern in the message and in string values of metadata and states
+	KindRegex Kind = "regex"
+	// KindField redacts the value found at Path, e.g. "metadata.user.email" or "after_state.cards.*.number"
+	KindField Kind = "field"
+)
+
+func (k Kind) IsValid() bool {
+	switch k {
+	case KindRegex, KindField:
+		return true
+	default:
+		return false
+	}
+}
+
+type Action string
+
+const (
+	ActionMask Action = "mask"
+	ActionHash Action = "hash"
+	ActionDrop Action = "drop"
+)
+
+func (a Action) IsValid() bool {
+	switch a {
+	case ActionMask, ActionHash, ActionDrop:
+		return true
+	default:
+		return false
+	}
+}
+
+// Detectors are the built-in patterns a regex rule can refer to instead of a custom pattern.
+var Detectors = map[string]string{
+	"email":        `[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`,
+	"credit_card":  `\b(?:\d[ \-]?){12,18}\d\b`,
+	"bearer_token": `(?i)bearer\s+[A-Za-z0-9\-._~+/]+=*`,
+	"jwt":          `eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`,
+}
+
+type RedactionRule struct {
+	ID        string // UUID
+	TenantID  string
+	Name      string
+	Kind      Kind
+	Pattern   *string // regex rules
+	Path      *string // field rules
+	Action    Action
+	CreatedAt time.Time
+}
+
+// Summary records that a rule redacted a field of a log, never the redacted value itself.
+type Summary struct {
+	RuleID string `json:"rule_id"`
+	Rule   string `json:"rule"`
+	Field  string `json:"field"`
+	Action Action `json:"action"`
+	Count  int    `json:"count"`
+}
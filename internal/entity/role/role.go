@@ -0,0 +1,47 @@
+package role
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+)
+
+// Role is a named set of permissions. Built-in roles (admin, auditor, user) are global and can't be changed,
+// custom roles are global when TenantID is nil, otherwise they only exist in that tenant.
+type Role struct {
+	ID          string // UUID
+	TenantID    *string
+	Name        string
+	Description *string
+	Permissions datatypes.JSONSlice[auth.Permission]
+	BuiltIn     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Binding grants a role to a user of a tenant, or to a platform user when TenantID is nil.
+// The roles bound to a user replace the permissions of the built-in role in their token.
+type Binding struct {
+	ID        string // UUID
+	TenantID  *string
+	UserID    string
+	RoleID    string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+func (Binding) TableName() string {
+	return "role_bindings"
+}
+
+// IsTenantScoped tells whether every permission of the role stays within a tenant
+func (r Role) IsTenantScoped() bool {
+	for _, p := range r.Permissions {
+		if !p.IsTenantScoped() {
+			return false
+		}
+	}
+	return true
+}
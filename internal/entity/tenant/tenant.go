@@ -2,11 +2,32 @@ package tenant
 
 import (
 	"time"
+
+	"gorm.io/datatypes"
+)
+
+// SystemTenantID is the reserved tenant holding the audit entries of platform actions
+// that don't belong to any tenant, e.g. listing tenants or managing global roles
+const SystemTenantID = "00000000-0000-0000-0000-000000000000"
+
+type Status string
+
+const (
+	StatusActive    Status = "active"
+	StatusSuspended Status = "suspended"
+	// StatusDeleting is set when the deletion task is queued, the tenant row goes away once it completes
+	StatusDeleting Status = "deleting"
 )
 
 type Tenant struct {
 	ID        string
 	Name      string
+	Status    Status
+	Settings  datatypes.JSONMap
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+func (t Tenant) IsActive() bool {
+	return t.Status == StatusActive
+}
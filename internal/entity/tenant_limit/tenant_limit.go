@@ -0,0 +1,79 @@
+package tenant_limit
+
+import (
+	"time"
+)
+
+// RouteClass groups the routes sharing a rate limit
+type RouteClass string
+
+const (
+	ClassRead   RouteClass = "read"
+	ClassWrite  RouteClass = "write"
+	ClassExport RouteClass = "export"
+)
+
+type Rate struct {
+	RPS   int
+	Burst int
+}
+
+// Limits are the limits in effect for a tenant, a zero quota means unlimited
+type Limits struct {
+	Read        Rate
+	Write       Rate
+	Export      Rate
+	DailyEvents int64
+	DailyBytes  int64
+}
+
+func (l Limits) Rate(class RouteClass) Rate {
+	switch class {
+	case ClassWrite:
+		return l.Write
+	case ClassExport:
+		return l.Export
+	default:
+		return l.Read
+	}
+}
+
+// TenantLimit overrides the configured defaults for one tenant, nil fields keep the default
+type TenantLimit struct {
+	TenantID        string
+	ReadRPS         *int
+	ReadBurst       *int
+	WriteRPS        *int
+	WriteBurst      *int
+	ExportRPS       *int
+	ExportBurst     *int
+	DailyEventQuota *int64
+	DailyByteQuota  *int64
+	UpdatedBy       string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Apply returns the defaults with the tenant's overrides applied
+func (t TenantLimit) Apply(defaults Limits) Limits {
+	l := defaults
+	setInt(&l.Read.RPS, t.ReadRPS)
+	setInt(&l.Read.Burst, t.ReadBurst)
+	setInt(&l.Write.RPS, t.WriteRPS)
+	setInt(&l.Write.Burst, t.WriteBurst)
+	setInt(&l.Export.RPS, t.ExportRPS)
+	setInt(&l.Export.Burst, t.ExportBurst)
+	if t.DailyEventQuota != nil {
+		l.DailyEvents = *t.DailyEventQuota
+	}
+	if t.DailyByteQuota != nil {
+		l.DailyBytes = *t.DailyByteQuota
+	}
+	return l
+}
+
+func setInt(dst *int, v *int) {
+	if v != nil {
+		*dst = *v
+	}
+}
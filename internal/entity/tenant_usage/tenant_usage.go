@@ -0,0 +1,53 @@
+package tenant_usage
+
+import (
+	"time"
+)
+
+// Activity is a metered operation other than ingestion, its value is the column counting it
+type Activity string
+
+const (
+	ActivitySearch Activity = "searches"
+	ActivityExport Activity = "exports"
+)
+
+// DailyUsage meters what a tenant ingested and read during one UTC day. Bytes is the size of the logs
+// received, what the byte quota counts, StoredBytes their size once redacted.
+type DailyUsage struct {
+	TenantID    string
+	Day         time.Time
+	Events      int64
+	Bytes       int64
+	StoredBytes int64
+	Searches    int64
+	Exports     int64
+	UpdatedAt   time.Time
+}
+
+func (DailyUsage) TableName() string {
+	return "tenant_usage_daily"
+}
+
+// MonthlyUsage sums the daily usage of a tenant over a calendar month
+type MonthlyUsage struct {
+	TenantID    string
+	TenantName  string
+	Month       time.Time
+	Events      int64
+	Bytes       int64
+	StoredBytes int64
+	Searches    int64
+	Exports     int64
+}
+
+// Day truncates t to the UTC day its usage is counted in
+func Day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// Month truncates t to the first day of its UTC month
+func Month(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,43 @@
+package token_revocation
+
+import (
+	"time"
+)
+
+type SubjectType string
+
+const (
+	SubjectUser   SubjectType = "user"
+	SubjectTenant SubjectType = "tenant"
+)
+
+func (s SubjectType) IsValid() bool {
+	switch s {
+	case SubjectUser, SubjectTenant:
+		return true
+	default:
+		return false
+	}
+}
+
+// RevokedToken is a single token revoked by its jti, kept until the token would have expired anyway
+type RevokedToken struct {
+	JTI       string `gorm:"column:jti;primaryKey"`
+	UserID    string
+	TenantID  *string
+	ExpiresAt time.Time
+	RevokedBy string
+	RevokedAt time.Time
+}
+
+// SessionRevocation revokes every token of a user or a tenant issued before RevokedBefore
+type SessionRevocation struct {
+	SubjectType SubjectType `gorm:"primaryKey"`
+	// tenant of a user, user ids are only unique within a tenant. Empty for a tenant and for the tokens of a
+	// user without a tenant (admins)
+	TenantID      string `gorm:"primaryKey"`
+	SubjectID     string `gorm:"primaryKey"`
+	RevokedBefore time.Time
+	RevokedBy     string
+	UpdatedAt     time.Time
+}
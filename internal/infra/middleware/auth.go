@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"errors"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -10,26 +12,76 @@ import (
 	"github.com/Haevnen/audit-logging-api/internal/apperror"
 	"github.com/Haevnen/audit-logging-api/internal/auth"
 	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/rbac"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/session"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
 )
 
 const (
 	exceptionAPI = "POST:/auth/token"
 )
 
-var roleMap = map[string][]auth.Role{
-	"GET:/logs":            {auth.RoleAdmin, auth.RoleAuditor, auth.RoleUser},
-	"POST:/logs":           {auth.RoleAdmin, auth.RoleUser},
-	"GET:/logs/:id":        {auth.RoleAdmin, auth.RoleAuditor, auth.RoleUser},
-	"GET:/logs/export":     {auth.RoleAdmin, auth.RoleAuditor},
-	"GET:/logs/stats":      {auth.RoleAdmin, auth.RoleAuditor, auth.RoleUser},
-	"POST:/logs/bulk":      {auth.RoleAdmin, auth.RoleUser},
-	"DELETE:/logs/cleanup": {auth.RoleAdmin},
-	"GET:/logs/stream":     {auth.RoleAdmin, auth.RoleAuditor, auth.RoleUser},
-	"GET:/tenants":         {auth.RoleAdmin},
-	"POST:/tenants":        {auth.RoleAdmin},
+// permissionMap lists the permission each route needs. Routes mapped to no permission are open
+// to any authenticated caller, routes missing from the map are forbidden.
+var permissionMap = map[string]auth.Permission{
+	"GET:/logs":                    auth.PermissionLogsRead,
+	"POST:/logs":                   auth.PermissionLogsWrite,
+	"GET:/logs/:id":                auth.PermissionLogsRead,
+	"GET:/logs/export":             auth.PermissionLogsExport,
+	"GET:/logs/stats":              auth.PermissionLogsRead,
+	"GET:/logs/insights/top":       auth.PermissionLogsRead,
+	"GET:/logs/insights/anomalies": auth.PermissionLogsRead,
+	"POST:/logs/bulk":              auth.PermissionLogsWrite,
+	"DELETE:/logs/cleanup":         auth.PermissionLogsCleanup,
+	"GET:/logs/stream":             auth.PermissionLogsRead,
+	"GET:/tenants":                 auth.PermissionTenantsManage,
+	"POST:/tenants":                auth.PermissionTenantsManage,
+	"GET:/tenants/:id":             auth.PermissionTenantsManage,
+	"PATCH:/tenants/:id":           auth.PermissionTenantsManage,
+	"DELETE:/tenants/:id":          auth.PermissionTenantsManage,
+	"GET:/tenants/:id/limits":      auth.PermissionTenantsManage,
+	"PUT:/tenants/:id/limits":      auth.PermissionTenantsManage,
+	"GET:/tenants/:id/usage":       auth.PermissionTenantsManage,
+	"GET:/usage/report":            auth.PermissionTenantsManage,
+	"GET:/tasks/:id":               "",
+	"GET:/schemas":                 auth.PermissionSchemasRead,
+	"POST:/schemas":                auth.PermissionSchemasWrite,
+	"GET:/schemas/:id":             auth.PermissionSchemasRead,
+	"PUT:/schemas/:id":             auth.PermissionSchemasWrite,
+	"DELETE:/schemas/:id":          auth.PermissionSchemasWrite,
+
+	"GET:/redaction-rules":        auth.PermissionRedactionRead,
+	"POST:/redaction-rules":       auth.PermissionRedactionWrite,
+	"DELETE:/redaction-rules/:id": auth.PermissionRedactionWrite,
+
+	"GET:/api-keys":             auth.PermissionAPIKeysManage,
+	"POST:/api-keys":            auth.PermissionAPIKeysManage,
+	"POST:/api-keys/:id/rotate": auth.PermissionAPIKeysManage,
+	"DELETE:/api-keys/:id":      auth.PermissionAPIKeysManage,
+
+	"POST:/auth/revoke":     "",
+	"POST:/auth/revoke-all": auth.PermissionSessionsRevoke,
+
+	"GET:/roles":                auth.PermissionRolesManage,
+	"POST:/roles":               auth.PermissionRolesManage,
+	"PUT:/roles/:id":            auth.PermissionRolesManage,
+	"DELETE:/roles/:id":         auth.PermissionRolesManage,
+	"GET:/role-bindings":        auth.PermissionRolesManage,
+	"POST:/role-bindings":       auth.PermissionRolesManage,
+	"DELETE:/role-bindings/:id": auth.PermissionRolesManage,
+	"GET:/access-grants":        auth.PermissionAccessGrantsManage,
+	"POST:/access-grants":       auth.PermissionAccessGrantsManage,
+	"DELETE:/access-grants/:id": auth.PermissionAccessGrantsManage,
+
+	"GET:/admin/ops":            auth.PermissionOpsRead,
+	"POST:/admin/reindex":       auth.PermissionOpsManage,
+	"POST:/admin/index-rebuild": auth.PermissionOpsManage,
 }
 
-func RequireAuth(jwtManager auth.ManagerInterface) api_service.MiddlewareFunc {
+func RequireAuth(jwtManager auth.ManagerInterface, apiKeys apikey.AuthenticateAPIKeyUseCaseInterface, revocations session.CheckRevocationUseCaseInterface) api_service.MiddlewareFunc {
 	return func(c *gin.Context) {
 		key := c.Request.Method + ":" + strings.TrimPrefix(c.FullPath(), constant.BaseURL)
 		if key == exceptionAPI {
@@ -37,6 +89,11 @@ func RequireAuth(jwtManager auth.ManagerInterface) api_service.MiddlewareFunc {
 			return
 		}
 
+		if rawKey := c.GetHeader(constant.APIKeyHeaderKey); len(rawKey) > 0 {
+			requireAPIKey(c, apiKeys, rawKey)
+			return
+		}
+
 		authHeader := c.GetHeader(constant.AuthorizationHeaderKey)
 		if len(authHeader) == 0 {
 			c.Abort()
@@ -50,22 +107,72 @@ func RequireAuth(jwtManager auth.ManagerInterface) api_service.MiddlewareFunc {
 		}
 
 		tokenStr := strings.TrimPrefix(authHeader, constant.AuthorizationTypeBearer)
-		claims, err := jwtManager.ParseToken(tokenStr)
+		claims, err := jwtManager.ParseToken(c.Request.Context(), tokenStr)
 		if err != nil {
 			c.Abort()
 			handler.SendError(c, "invalid token", apperror.ErrInvalidToken)
 			return
 		}
 
-		c.Set(constant.UserID, claims.UserID)
-		c.Set(constant.TenantID, claims.TenantID)
+		revoked, err := revocations.Execute(c.Request.Context(), claims)
+		if err != nil {
+			c.Abort()
+			handler.SendError(c, err.Error(), apperror.ErrInternalServer)
+			return
+		}
+		if revoked {
+			c.Abort()
+			handler.SendError(c, "token revoked", apperror.ErrTokenRevoked)
+			return
+		}
+
+		setCaller(c, claims.UserID, claims.TenantID)
 		c.Set(constant.Role, claims.Role)
+		if claims.Permissions != nil {
+			c.Set(constant.Permissions, auth.ScopePermissions(claims.Role, claims.Permissions))
+		}
+		c.Set(constant.TokenID, claims.ID)
+		if claims.ExpiresAt != nil {
+			c.Set(constant.TokenExpiresAt, claims.ExpiresAt.Time)
+		}
 
 		c.Next()
 	}
 }
 
-func RequireRole() api_service.MiddlewareFunc {
+// requireAPIKey authenticates a machine producer, the key is bound to one tenant and gets the
+// permissions of its role that its scopes allow
+func requireAPIKey(c *gin.Context, apiKeys apikey.AuthenticateAPIKeyUseCaseInterface, rawKey string) {
+	apiKey, err := apiKeys.Execute(c.Request.Context(), rawKey)
+	if err != nil {
+		c.Abort()
+		if errors.Is(err, apikey.ErrUnauthorizedKey) {
+			handler.SendError(c, "invalid api key", apperror.ErrInvalidAPIKey)
+			return
+		}
+		handler.SendError(c, err.Error(), apperror.ErrInternalServer)
+		return
+	}
+
+	setCaller(c, "api-key:"+apiKey.ID, apiKey.TenantID)
+	c.Set(constant.Role, auth.Role(apiKey.Role))
+	c.Set(constant.Permissions, apiKeyPermissions(*apiKey))
+
+	c.Next()
+}
+
+// setCaller records who is calling, on the gin context for the handlers and on the request context
+// for the log lines of the request
+func setCaller(c *gin.Context, userId, tenantId string) {
+	c.Set(constant.UserID, userId)
+	c.Set(constant.TenantID, tenantId)
+
+	ctx := logger.With(c.Request.Context(), logger.KeyUserID, userId)
+	c.Request = c.Request.WithContext(logger.With(ctx, logger.KeyTenantID, tenantId))
+}
+
+// RequireActiveTenant locks suspended and deleted tenants out, admins aren't bound to a tenant
+func RequireActiveTenant(tenants tenant.CheckTenantUseCaseInterface) api_service.MiddlewareFunc {
 	return func(c *gin.Context) {
 		key := c.Request.Method + ":" + strings.TrimPrefix(c.FullPath(), constant.BaseURL)
 		if key == exceptionAPI {
@@ -73,22 +180,76 @@ func RequireRole() api_service.MiddlewareFunc {
 			return
 		}
 
-		allowedRoles, ok := roleMap[key]
+		tenantID := c.GetString(constant.TenantID)
+		if c.MustGet(constant.Role).(auth.Role) == auth.RoleAdmin || tenantID == "" {
+			c.Next()
+			return
+		}
+
+		if err := tenants.Execute(c.Request.Context(), tenantID); err != nil {
+			c.Abort()
+			if errors.Is(err, tenant.ErrTenantInactive) {
+				handler.SendError(c, "tenant is not active", apperror.ErrTenantInactive)
+				return
+			}
+			handler.SendError(c, err.Error(), apperror.ErrInternalServer)
+			return
+		}
+		c.Next()
+	}
+}
+
+func RequireRole(resolver rbac.ResolvePermissionsUseCaseInterface) api_service.MiddlewareFunc {
+	return func(c *gin.Context) {
+		key := c.Request.Method + ":" + strings.TrimPrefix(c.FullPath(), constant.BaseURL)
+		if key == exceptionAPI {
+			c.Next()
+			return
+		}
+
+		required, ok := permissionMap[key]
 		if !ok {
 			// no rule defined -> forbid
 			c.Abort()
 			handler.SendError(c, "forbidden", apperror.ErrForbidden)
 			return
 		}
+		if len(required) == 0 {
+			c.Next()
+			return
+		}
 
-		role := c.MustGet(constant.Role).(auth.Role)
-		for _, r := range allowedRoles {
-			if role == r {
-				c.Next()
-				return
-			}
+		perms, err := callerPermissions(c, resolver)
+		if err != nil {
+			c.Abort()
+			handler.SendError(c, err.Error(), apperror.ErrInternalServer)
+			return
+		}
+		if !slices.Contains(perms, required) {
+			c.Abort()
+			handler.SendError(c, "forbidden", apperror.ErrForbidden)
+			return
+		}
+		c.Next()
+	}
+}
+
+// callerPermissions uses the permissions carried by the token or API key, else the stored role bindings
+func callerPermissions(c *gin.Context, resolver rbac.ResolvePermissionsUseCaseInterface) ([]auth.Permission, error) {
+	if v, ok := c.Get(constant.Permissions); ok {
+		return v.([]auth.Permission), nil
+	}
+
+	role := c.MustGet(constant.Role).(auth.Role)
+	return resolver.Execute(c.Request.Context(), c.GetString(constant.TenantID), c.GetString(constant.UserID), role)
+}
+
+func apiKeyPermissions(k api_key.APIKey) []auth.Permission {
+	perms := []auth.Permission{}
+	for _, p := range auth.DefaultPermissions(auth.Role(k.Role)) {
+		if slices.Contains(k.Scopes, api_key.Scope(p)) {
+			perms = append(perms, p)
 		}
-		c.Abort()
-		handler.SendError(c, "forbidden", apperror.ErrForbidden)
 	}
+	return perms
 }
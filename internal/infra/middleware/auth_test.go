@@ -1,9 +1,11 @@
 package middleware_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -13,7 +15,15 @@ import (
 	"github.com/Haevnen/audit-logging-api/internal/auth"
 	authMocks "github.com/Haevnen/audit-logging-api/internal/auth/mocks"
 	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
 	m "github.com/Haevnen/audit-logging-api/internal/infra/middleware"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+
+	apiKeyMocks "github.com/Haevnen/audit-logging-api/internal/usecase/apikey/mocks"
+	rbacMocks "github.com/Haevnen/audit-logging-api/internal/usecase/rbac/mocks"
+	sessionMocks "github.com/Haevnen/audit-logging-api/internal/usecase/session/mocks"
+	tenantMocks "github.com/Haevnen/audit-logging-api/internal/usecase/tenant/mocks"
 )
 
 func runRequest(r *gin.Engine, method, path string, headers map[string]string) *httptest.ResponseRecorder {
@@ -35,7 +45,7 @@ func TestRequireAuth_NoHeader(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	r.GET("/api/v1/logs",
-		gin.HandlerFunc(m.RequireAuth(jwtMock)), // ✅ cast
+		gin.HandlerFunc(m.RequireAuth(jwtMock, nil, nil)), // ✅ cast
 		func(c *gin.Context) { c.String(200, "ok") })
 
 	w := runRequest(r, "GET", "/api/v1/logs", nil)
@@ -52,7 +62,7 @@ func TestRequireAuth_BadAuthType(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	r.GET("/api/v1/logs",
-		gin.HandlerFunc(m.RequireAuth(jwtMock)), // ✅ cast
+		gin.HandlerFunc(m.RequireAuth(jwtMock, nil, nil)), // ✅ cast
 		func(c *gin.Context) { c.String(200, "ok") })
 
 	headers := map[string]string{constant.AuthorizationHeaderKey: "Basic sometoken"}
@@ -66,12 +76,12 @@ func TestRequireAuth_InvalidToken(t *testing.T) {
 	defer ctrl.Finish()
 
 	jwtMock := authMocks.NewMockManagerInterface(ctrl)
-	jwtMock.EXPECT().ParseToken("badtoken").Return(nil, errors.New("invalid"))
+	jwtMock.EXPECT().ParseToken(gomock.Any(), "badtoken").Return(nil, errors.New("invalid"))
 
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	r.GET("/api/v1/logs",
-		gin.HandlerFunc(m.RequireAuth(jwtMock)), // ✅ cast
+		gin.HandlerFunc(m.RequireAuth(jwtMock, nil, nil)), // ✅ cast
 		func(c *gin.Context) { c.String(200, "ok") })
 
 	headers := map[string]string{constant.AuthorizationHeaderKey: "Bearer badtoken"}
@@ -86,12 +96,14 @@ func TestRequireAuth_Success(t *testing.T) {
 
 	claims := &auth.Claims{UserID: "u1", TenantID: "t1", Role: auth.RoleUser}
 	jwtMock := authMocks.NewMockManagerInterface(ctrl)
-	jwtMock.EXPECT().ParseToken("goodtoken").Return(claims, nil)
+	jwtMock.EXPECT().ParseToken(gomock.Any(), "goodtoken").Return(claims, nil)
+	revocationMock := sessionMocks.NewMockCheckRevocationUseCaseInterface(ctrl)
+	revocationMock.EXPECT().Execute(gomock.Any(), claims).Return(false, nil)
 
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	r.GET("/api/v1/logs",
-		gin.HandlerFunc(m.RequireAuth(jwtMock)), // ✅ cast
+		gin.HandlerFunc(m.RequireAuth(jwtMock, nil, revocationMock)), // ✅ cast
 		func(c *gin.Context) { c.String(200, "ok") })
 
 	headers := map[string]string{constant.AuthorizationHeaderKey: "Bearer goodtoken"}
@@ -100,9 +112,64 @@ func TestRequireAuth_Success(t *testing.T) {
 	assert.Equal(t, "ok", w.Body.String())
 }
 
-func makeRoleRouter(method, path string, role auth.Role) *gin.Engine {
+func TestRequireAuth_RevokedToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	claims := &auth.Claims{UserID: "u1", TenantID: "t1", Role: auth.RoleAdmin}
+	jwtMock := authMocks.NewMockManagerInterface(ctrl)
+	jwtMock.EXPECT().ParseToken(gomock.Any(), "leakedtoken").Return(claims, nil)
+	revocationMock := sessionMocks.NewMockCheckRevocationUseCaseInterface(ctrl)
+	revocationMock.EXPECT().Execute(gomock.Any(), claims).Return(true, nil)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/v1/logs",
+		gin.HandlerFunc(m.RequireAuth(jwtMock, nil, revocationMock)),
+		func(c *gin.Context) { c.String(200, "ok") })
+
+	headers := map[string]string{constant.AuthorizationHeaderKey: "Bearer leakedtoken"}
+	w := runRequest(r, "GET", "/api/v1/logs", headers)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "token revoked")
+	assert.Contains(t, w.Body.String(), "The token has been revoked.")
+}
+
+func TestRequireAuth_RevocationCheckFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	claims := &auth.Claims{UserID: "u1", TenantID: "t1", Role: auth.RoleUser}
+	jwtMock := authMocks.NewMockManagerInterface(ctrl)
+	jwtMock.EXPECT().ParseToken(gomock.Any(), "goodtoken").Return(claims, nil)
+	revocationMock := sessionMocks.NewMockCheckRevocationUseCaseInterface(ctrl)
+	revocationMock.EXPECT().Execute(gomock.Any(), claims).Return(false, errors.New("db down"))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/v1/logs",
+		gin.HandlerFunc(m.RequireAuth(jwtMock, nil, revocationMock)),
+		func(c *gin.Context) { c.String(200, "ok") })
+
+	headers := map[string]string{constant.AuthorizationHeaderKey: "Bearer goodtoken"}
+	w := runRequest(r, "GET", "/api/v1/logs", headers)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+// defaultsResolver resolves callers without bindings, to the permissions of their built-in role
+func defaultsResolver(ctrl *gomock.Controller) *rbacMocks.MockResolvePermissionsUseCaseInterface {
+	resolver := rbacMocks.NewMockResolvePermissionsUseCaseInterface(ctrl)
+	resolver.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, role auth.Role) ([]auth.Permission, error) {
+			return auth.DefaultPermissions(role), nil
+		}).AnyTimes()
+	return resolver
+}
+
+func makeRoleRouter(t *testing.T, method, path string, role auth.Role) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	resolver := defaultsResolver(gomock.NewController(t))
 
 	// inject role
 	r.Use(func(c *gin.Context) {
@@ -111,7 +178,7 @@ func makeRoleRouter(method, path string, role auth.Role) *gin.Engine {
 	})
 
 	r.Handle(method, path,
-		gin.HandlerFunc(m.RequireRole()),
+		gin.HandlerFunc(m.RequireRole(resolver)),
 		func(c *gin.Context) {
 			c.String(http.StatusOK, "ok")
 		},
@@ -131,7 +198,7 @@ func TestRequireRole_ExceptionAPI(t *testing.T) {
 
 	// /auth/token → exceptionAPI
 	r.POST("/api/v1/auth/token",
-		gin.HandlerFunc(m.RequireRole()),
+		gin.HandlerFunc(m.RequireRole(nil)),
 		func(c *gin.Context) { c.String(http.StatusOK, "ok") })
 
 	w := httptest.NewRecorder()
@@ -143,7 +210,7 @@ func TestRequireRole_ExceptionAPI(t *testing.T) {
 }
 
 func TestRequireRole_NoRuleDefined(t *testing.T) {
-	r := makeRoleRouter("GET", "/api/v1/unknown", auth.RoleUser)
+	r := makeRoleRouter(t, "GET", "/api/v1/unknown", auth.RoleUser)
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/v1/unknown", nil)
@@ -154,7 +221,7 @@ func TestRequireRole_NoRuleDefined(t *testing.T) {
 }
 
 func TestRequireRole_AllowedRole(t *testing.T) {
-	r := makeRoleRouter("GET", "/api/v1/logs", auth.RoleUser) // RoleUser is allowed
+	r := makeRoleRouter(t, "GET", "/api/v1/logs", auth.RoleUser) // RoleUser is allowed
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/v1/logs", nil)
@@ -165,7 +232,7 @@ func TestRequireRole_AllowedRole(t *testing.T) {
 }
 
 func TestRequireRole_NotAllowedRole(t *testing.T) {
-	r := makeRoleRouter("GET", "/api/v1/tenants", auth.RoleUser) // Only Admin allowed
+	r := makeRoleRouter(t, "GET", "/api/v1/tenants", auth.RoleUser) // Only Admin allowed
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/v1/tenants", nil)
@@ -174,3 +241,219 @@ func TestRequireRole_NotAllowedRole(t *testing.T) {
 	assert.Equal(t, http.StatusForbidden, w.Code)
 	assert.Contains(t, w.Body.String(), "forbidden")
 }
+
+func TestRequireAuth_APIKey_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyMock := apiKeyMocks.NewMockAuthenticateAPIKeyUseCaseInterface(ctrl)
+	keyMock.EXPECT().Execute(gomock.Any(), "alk_goodkey").Return(&api_key.APIKey{
+		ID: "key-1", TenantID: "t1", Role: "user", Scopes: []api_key.Scope{api_key.ScopeLogsWrite},
+	}, nil)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/v1/logs",
+		gin.HandlerFunc(m.RequireAuth(nil, keyMock, nil)),
+		func(c *gin.Context) {
+			assert.Equal(t, "api-key:key-1", c.GetString(constant.UserID))
+			assert.Equal(t, "t1", c.GetString(constant.TenantID))
+			assert.Equal(t, auth.RoleUser, c.MustGet(constant.Role))
+			c.String(200, "ok")
+		})
+
+	w := runRequest(r, "POST", "/api/v1/logs", map[string]string{constant.APIKeyHeaderKey: "alk_goodkey"})
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRequireAuth_APIKey_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyMock := apiKeyMocks.NewMockAuthenticateAPIKeyUseCaseInterface(ctrl)
+	keyMock.EXPECT().Execute(gomock.Any(), "alk_badkey").Return(nil, apikey.ErrUnauthorizedKey)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/v1/logs",
+		gin.HandlerFunc(m.RequireAuth(nil, keyMock, nil)),
+		func(c *gin.Context) { c.String(200, "ok") })
+
+	w := runRequest(r, "POST", "/api/v1/logs", map[string]string{constant.APIKeyHeaderKey: "alk_badkey"})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid api key")
+}
+
+func makeAPIKeyRouter(t *testing.T, method, path string, key *api_key.APIKey) *gin.Engine {
+	ctrl := gomock.NewController(t)
+	keyMock := apiKeyMocks.NewMockAuthenticateAPIKeyUseCaseInterface(ctrl)
+	keyMock.EXPECT().Execute(gomock.Any(), "alk_key").Return(key, nil)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Handle(method, path,
+		gin.HandlerFunc(m.RequireAuth(nil, keyMock, nil)),
+		// keys carry their permissions, bindings are never looked up
+		gin.HandlerFunc(m.RequireRole(nil)),
+		func(c *gin.Context) { c.String(http.StatusOK, "ok") },
+	)
+	return r
+}
+
+func TestRequireRole_APIKeyScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		role   auth.Role
+		scopes []api_key.Scope
+		status int
+	}{
+		{"Write Scope Creates Logs", "POST", "/api/v1/logs", auth.RoleUser, []api_key.Scope{api_key.ScopeLogsWrite}, http.StatusOK},
+		{"Write Scope Cannot Read", "GET", "/api/v1/logs", auth.RoleUser, []api_key.Scope{api_key.ScopeLogsWrite}, http.StatusForbidden},
+		{"Read Scope Reads", "GET", "/api/v1/logs/:id", auth.RoleUser, []api_key.Scope{api_key.ScopeLogsRead}, http.StatusOK},
+		{"Route Not Open To Keys", "GET", "/api/v1/schemas", auth.RoleUser, []api_key.Scope{api_key.ScopeLogsRead, api_key.ScopeLogsWrite}, http.StatusForbidden},
+		{"Scope Beyond Role", "GET", "/api/v1/logs/export", auth.RoleUser, []api_key.Scope{api_key.ScopeLogsExport}, http.StatusForbidden},
+		{"Auditor Exports", "GET", "/api/v1/logs/export", auth.RoleAuditor, []api_key.Scope{api_key.ScopeLogsExport}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := makeAPIKeyRouter(t, tt.method, tt.path, &api_key.APIKey{ID: "key-1", TenantID: "t1", Role: string(tt.role), Scopes: tt.scopes})
+
+			w := runRequest(r, tt.method, strings.Replace(tt.path, ":id", "log-1", 1), map[string]string{constant.APIKeyHeaderKey: "alk_key"})
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestRequireRole_TokenPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// a read-only admin: the permissions claim replaces those of the admin role
+	claims := &auth.Claims{UserID: "u1", Role: auth.RoleAdmin, Permissions: []auth.Permission{auth.PermissionLogsRead}}
+	jwtMock := authMocks.NewMockManagerInterface(ctrl)
+	jwtMock.EXPECT().ParseToken(gomock.Any(), "token").Return(claims, nil).Times(2)
+	revocationMock := sessionMocks.NewMockCheckRevocationUseCaseInterface(ctrl)
+	revocationMock.EXPECT().Execute(gomock.Any(), claims).Return(false, nil).Times(2)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	mw := []gin.HandlerFunc{gin.HandlerFunc(m.RequireAuth(jwtMock, nil, revocationMock)), gin.HandlerFunc(m.RequireRole(nil))}
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "ok") }
+	r.GET("/api/v1/logs", append(mw, ok)...)
+	r.DELETE("/api/v1/logs/cleanup", append(mw, ok)...)
+
+	headers := map[string]string{constant.AuthorizationHeaderKey: "Bearer token"}
+	assert.Equal(t, http.StatusOK, runRequest(r, "GET", "/api/v1/logs", headers).Code)
+	assert.Equal(t, http.StatusForbidden, runRequest(r, "DELETE", "/api/v1/logs/cleanup", headers).Code)
+}
+
+func TestRequireRole_TokenPermissionsConfinedToTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	claims := &auth.Claims{UserID: "u1", TenantID: "t1", Role: auth.RoleUser, Permissions: []auth.Permission{auth.PermissionTenantsManage}}
+	jwtMock := authMocks.NewMockManagerInterface(ctrl)
+	jwtMock.EXPECT().ParseToken(gomock.Any(), "token").Return(claims, nil)
+	revocationMock := sessionMocks.NewMockCheckRevocationUseCaseInterface(ctrl)
+	revocationMock.EXPECT().Execute(gomock.Any(), claims).Return(false, nil)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/v1/tenants",
+		gin.HandlerFunc(m.RequireAuth(jwtMock, nil, revocationMock)),
+		gin.HandlerFunc(m.RequireRole(nil)),
+		func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+
+	w := runRequest(r, "GET", "/api/v1/tenants", map[string]string{constant.AuthorizationHeaderKey: "Bearer token"})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestRequireRole_RoleBindings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the user is bound to an export-only role in tenant t1
+	resolver := rbacMocks.NewMockResolvePermissionsUseCaseInterface(ctrl)
+	resolver.EXPECT().Execute(gomock.Any(), "t1", "u1", auth.RoleUser).
+		Return([]auth.Permission{auth.PermissionLogsExport}, nil).Times(2)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(constant.UserID, "u1")
+		c.Set(constant.TenantID, "t1")
+		c.Set(constant.Role, auth.RoleUser)
+		c.Next()
+	})
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "ok") }
+	r.GET("/api/v1/logs/export", gin.HandlerFunc(m.RequireRole(resolver)), ok)
+	r.POST("/api/v1/logs", gin.HandlerFunc(m.RequireRole(resolver)), ok)
+
+	assert.Equal(t, http.StatusOK, runRequest(r, "GET", "/api/v1/logs/export", nil).Code)
+	assert.Equal(t, http.StatusForbidden, runRequest(r, "POST", "/api/v1/logs", nil).Code)
+}
+
+func TestRequireRole_ResolveError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resolver := rbacMocks.NewMockResolvePermissionsUseCaseInterface(ctrl)
+	resolver.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(constant.Role, auth.RoleUser)
+		c.Next()
+	})
+	r.GET("/api/v1/logs", gin.HandlerFunc(m.RequireRole(resolver)), func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+
+	assert.Equal(t, http.StatusInternalServerError, runRequest(r, "GET", "/api/v1/logs", nil).Code)
+}
+
+func TestRequireRole_AuthenticatedOnlyRoute(t *testing.T) {
+	r := makeRoleRouter(t, "POST", "/api/v1/auth/revoke", auth.RoleAuditor)
+
+	assert.Equal(t, http.StatusOK, runRequest(r, "POST", "/api/v1/auth/revoke", nil).Code)
+}
+
+func makeActiveTenantRouter(checker *tenantMocks.MockCheckTenantUseCaseInterface, tenantId string, role auth.Role) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(constant.TenantID, tenantId)
+		c.Set(constant.Role, role)
+		c.Next()
+	})
+	r.POST("/api/v1/logs",
+		gin.HandlerFunc(m.RequireActiveTenant(checker)),
+		func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+	return r
+}
+
+func TestRequireActiveTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checker := tenantMocks.NewMockCheckTenantUseCaseInterface(ctrl)
+	checker.EXPECT().Execute(gomock.Any(), "t1").Return(nil)
+	checker.EXPECT().Execute(gomock.Any(), "t2").Return(tenant.ErrTenantInactive)
+	checker.EXPECT().Execute(gomock.Any(), "t3").Return(errors.New("db down"))
+
+	w := runRequest(makeActiveTenantRouter(checker, "t1", auth.RoleUser), "POST", "/api/v1/logs", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = runRequest(makeActiveTenantRouter(checker, "t2", auth.RoleUser), "POST", "/api/v1/logs", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "ERR_403")
+
+	w = runRequest(makeActiveTenantRouter(checker, "t3", auth.RoleAuditor), "POST", "/api/v1/logs", nil)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	// admins aren't bound to a tenant
+	w = runRequest(makeActiveTenantRouter(checker, "", auth.RoleAdmin), "POST", "/api/v1/logs", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
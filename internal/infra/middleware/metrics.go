@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
+)
+
+// unmatchedRoute labels the requests no route matched, their raw paths would make a series each
+const unmatchedRoute = "unmatched"
+
+// Metrics records the latency and status of every request by route template, e.g. /api/v1/logs/:id
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if len(route) == 0 {
+			route = unmatchedRoute
+		}
+		metrics.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,34 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Haevnen/audit-logging-api/internal/infra/middleware"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
+)
+
+func TestMetrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.Metrics())
+	r.GET("/api/v1/logs/:id", func(c *gin.Context) { c.Status(http.StatusNotFound) })
+	r.GET("/metrics", gin.WrapH(metrics.Handler()))
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/logs/abc", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nowhere/abc", nil))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, `audit_http_requests_total{method="GET",route="/api/v1/logs/:id",status="404"} 1`)
+	assert.Contains(t, body, `audit_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, body, `audit_http_request_duration_seconds_count{method="GET",route="/api/v1/logs/:id",status="404"} 1`)
+	assert.NotContains(t, body, "/api/v1/logs/abc")
+}
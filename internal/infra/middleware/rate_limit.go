@@ -1,34 +1,40 @@
 package middleware
 
 import (
+	"math"
+	"strconv"
 	"strings"
-	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"golang.org/x/time/rate"
 
 	handler "github.com/Haevnen/audit-logging-api/internal/adapter/http"
 	api_service "github.com/Haevnen/audit-logging-api/internal/adapter/http/gen/api"
 	"github.com/Haevnen/audit-logging-api/internal/apperror"
 	"github.com/Haevnen/audit-logging-api/internal/auth"
 	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/quota"
 )
 
-var tenantLimiter sync.Map
+// routeClasses lists the routes not limited as reads
+var routeClasses = map[string]tenant_limit.RouteClass{
+	"POST:/logs":       tenant_limit.ClassWrite,
+	"POST:/logs/bulk":  tenant_limit.ClassWrite,
+	"GET:/logs/export": tenant_limit.ClassExport,
+}
 
-// Use token bucket algorithm
-func getLimiter(tenantID string, reqPerSec, burst int) *rate.Limiter {
-	val, ok := tenantLimiter.Load(tenantID)
-	if ok {
-		return val.(*rate.Limiter)
+func routeClass(key string) tenant_limit.RouteClass {
+	if class, ok := routeClasses[key]; ok {
+		return class
 	}
-
-	limiter := rate.NewLimiter(rate.Limit(reqPerSec), burst)
-	tenantLimiter.Store(tenantID, limiter)
-	return limiter
+	return tenant_limit.ClassRead
 }
 
-func RequireRateLimit(reqPerSec, burst int) api_service.MiddlewareFunc {
+// RequireRateLimit takes a token from the bucket of the tenant for the class of the route, reads, writes and
+// exports are limited separately with the limits resolved for the tenant
+func RequireRateLimit(limits quota.ResolveLimitsUseCaseInterface, limiter service.RateLimiter) api_service.MiddlewareFunc {
 	return func(c *gin.Context) {
 		key := c.Request.Method + ":" + strings.TrimPrefix(c.FullPath(), constant.BaseURL)
 		if key == exceptionAPI {
@@ -49,8 +55,24 @@ func RequireRateLimit(reqPerSec, burst int) api_service.MiddlewareFunc {
 			return
 		}
 
-		limiter := getLimiter(tenantID, reqPerSec, burst)
-		if !limiter.Allow() {
+		resolved, err := limits.Execute(c.Request.Context(), tenantID)
+		if err != nil {
+			c.Abort()
+			handler.SendError(c, err.Error(), apperror.ErrInternalServer)
+			return
+		}
+
+		class := routeClass(key)
+		result, err := limiter.Allow(c.Request.Context(), tenantID+":"+string(class), resolved.Rate(class))
+		if err != nil {
+			c.Abort()
+			handler.SendError(c, err.Error(), apperror.ErrInternalServer)
+			return
+		}
+
+		setRateLimitHeaders(c, result)
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
 			c.Abort()
 			handler.SendError(c, "rate limit exceed", apperror.ErrTooManyRequests)
 			return
@@ -58,3 +80,11 @@ func RequireRateLimit(reqPerSec, burst int) api_service.MiddlewareFunc {
 		c.Next()
 	}
 }
+
+// setRateLimitHeaders describes the bucket: its size, the requests left and when it is full again
+func setRateLimitHeaders(c *gin.Context, result service.RateLimitResult) {
+	reset := time.Now().Add(result.ResetAfter)
+	c.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+	c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	c.Header("X-RateLimit-Reset", strconv.FormatInt(int64(math.Ceil(float64(reset.UnixNano())/float64(time.Second))), 10))
+}
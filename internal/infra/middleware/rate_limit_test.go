@@ -1,19 +1,30 @@
 package middleware_test
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
 	"github.com/Haevnen/audit-logging-api/internal/auth"
 	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
 	m "github.com/Haevnen/audit-logging-api/internal/infra/middleware"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+	quotaMocks "github.com/Haevnen/audit-logging-api/internal/usecase/quota/mocks"
 )
 
-func makeRouter(role auth.Role, tenantID string, reqPerSec, burst int) *gin.Engine {
+func makeRouter(role auth.Role, tenantID string, limits *quotaMocks.MockResolveLimitsUseCaseInterface) *gin.Engine {
+	return makeRouterWithLimiter(role, tenantID, limits, service.NewLocalRateLimiter())
+}
+
+func makeRouterWithLimiter(role auth.Role, tenantID string, limits *quotaMocks.MockResolveLimitsUseCaseInterface, limiter service.RateLimiter) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 
@@ -24,58 +35,144 @@ func makeRouter(role auth.Role, tenantID string, reqPerSec, burst int) *gin.Engi
 		c.Next()
 	})
 
-	r.GET("/api/v1/logs",
-		gin.HandlerFunc(m.RequireRateLimit(reqPerSec, burst)),
-		func(c *gin.Context) {
-			c.String(http.StatusOK, "ok")
-		},
-	)
+	ok := func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	}
+	mw := gin.HandlerFunc(m.RequireRateLimit(limits, limiter))
+	r.GET("/api/v1/logs", mw, ok)
+	r.POST("/api/v1/logs", mw, ok)
+	r.GET("/api/v1/logs/export", mw, ok)
 	return r
 }
 
-func TestRequireRateLimit_AdminBypass(t *testing.T) {
-	r := makeRouter(auth.RoleAdmin, "tenant-1", 1, 1)
+func serve(r *gin.Engine, method, path string) *httptest.ResponseRecorder {
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/v1/logs", nil)
-
+	req, _ := http.NewRequest(method, path, nil)
 	r.ServeHTTP(w, req)
+	return w
+}
+
+func limitsOf(rps, burst int) tenant_limit.Limits {
+	r := tenant_limit.Rate{RPS: rps, Burst: burst}
+	return tenant_limit.Limits{Read: r, Write: r, Export: r}
+}
+
+func TestRequireRateLimit_AdminBypass(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	r := makeRouter(auth.RoleAdmin, "tenant-1", quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl))
+
+	w := serve(r, http.MethodGet, "/api/v1/logs")
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Equal(t, "ok", w.Body.String())
 }
 
 func TestRequireRateLimit_NoTenantID(t *testing.T) {
-	r := makeRouter(auth.RoleUser, "", 1, 1)
-	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/v1/logs", nil)
+	ctrl := gomock.NewController(t)
+	r := makeRouter(auth.RoleUser, "", quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl))
 
-	r.ServeHTTP(w, req)
+	w := serve(r, http.MethodGet, "/api/v1/logs")
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 	assert.Contains(t, w.Body.String(), "tenant id not provided")
 }
 
 func TestRequireRateLimit_Allowed(t *testing.T) {
-	r := makeRouter(auth.RoleUser, "tenant-1", 1, 1)
-	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/v1/logs", nil)
+	ctrl := gomock.NewController(t)
+	limits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+	limits.EXPECT().Execute(gomock.Any(), "tenant-1").Return(limitsOf(1, 3), nil)
+	r := makeRouter(auth.RoleUser, "tenant-1", limits)
 
-	r.ServeHTTP(w, req)
+	w := serve(r, http.MethodGet, "/api/v1/logs")
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Equal(t, "ok", w.Body.String())
+	assert.Equal(t, "3", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "2", w.Header().Get("X-RateLimit-Remaining"))
+	assert.NotEmpty(t, w.Header().Get("X-RateLimit-Reset"))
 }
 
 func TestRequireRateLimit_Exceed(t *testing.T) {
-	r := makeRouter(auth.RoleUser, "tenant-2", 1, 1)
+	ctrl := gomock.NewController(t)
+	limits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+	limits.EXPECT().Execute(gomock.Any(), "tenant-2").Return(limitsOf(1, 1), nil).Times(2)
+	r := makeRouter(auth.RoleUser, "tenant-2", limits)
 
 	// first request passes
-	w1 := httptest.NewRecorder()
-	req1, _ := http.NewRequest("GET", "/api/v1/logs", nil)
-	r.ServeHTTP(w1, req1)
+	w1 := serve(r, http.MethodGet, "/api/v1/logs")
 	assert.Equal(t, http.StatusOK, w1.Code)
 
 	// second request should hit limiter
-	w2 := httptest.NewRecorder()
-	req2, _ := http.NewRequest("GET", "/api/v1/logs", nil)
-	r.ServeHTTP(w2, req2)
+	w2 := serve(r, http.MethodGet, "/api/v1/logs")
 	assert.Equal(t, http.StatusTooManyRequests, w2.Code)
 	assert.Contains(t, w2.Body.String(), "rate limit exceed")
+	assert.Equal(t, "1", w2.Header().Get("Retry-After"))
+	assert.Equal(t, "1", w2.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "0", w2.Header().Get("X-RateLimit-Remaining"))
+}
+
+func TestRequireRateLimit_SeparateClasses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	limits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+	limits.EXPECT().Execute(gomock.Any(), "tenant-3").Return(tenant_limit.Limits{
+		Read:   tenant_limit.Rate{RPS: 1, Burst: 1},
+		Write:  tenant_limit.Rate{RPS: 1, Burst: 2},
+		Export: tenant_limit.Rate{RPS: 1, Burst: 1},
+	}, nil).AnyTimes()
+	r := makeRouter(auth.RoleUser, "tenant-3", limits)
+
+	assert.Equal(t, http.StatusOK, serve(r, http.MethodGet, "/api/v1/logs").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(r, http.MethodGet, "/api/v1/logs").Code)
+
+	// writes and exports have their own buckets
+	assert.Equal(t, http.StatusOK, serve(r, http.MethodPost, "/api/v1/logs").Code)
+	assert.Equal(t, http.StatusOK, serve(r, http.MethodPost, "/api/v1/logs").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(r, http.MethodPost, "/api/v1/logs").Code)
+	assert.Equal(t, http.StatusOK, serve(r, http.MethodGet, "/api/v1/logs/export").Code)
+}
+
+func TestRequireRateLimit_HotReload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	limits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+	gomock.InOrder(
+		limits.EXPECT().Execute(gomock.Any(), "tenant-4").Return(limitsOf(1, 1), nil).Times(2),
+		limits.EXPECT().Execute(gomock.Any(), "tenant-4").Return(limitsOf(1, 5), nil),
+	)
+	r := makeRouter(auth.RoleUser, "tenant-4", limits)
+
+	assert.Equal(t, http.StatusOK, serve(r, http.MethodGet, "/api/v1/logs").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(r, http.MethodGet, "/api/v1/logs").Code)
+
+	// a raised burst is applied to the existing bucket, the tokens already used stay used
+	w := serve(r, http.MethodGet, "/api/v1/logs")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "5", w.Header().Get("X-RateLimit-Limit"))
+}
+
+func TestRequireRateLimit_ResolveFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	limits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+	limits.EXPECT().Execute(gomock.Any(), "tenant-5").Return(tenant_limit.Limits{}, errors.New("db down"))
+	r := makeRouter(auth.RoleUser, "tenant-5", limits)
+
+	w := serve(r, http.MethodGet, "/api/v1/logs")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestRequireRateLimit_SharedLimiter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	limits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+	limiter := serviceMocks.NewMockRateLimiter(ctrl)
+	limits.EXPECT().Execute(gomock.Any(), "tenant-6").Return(limitsOf(2, 4), nil).Times(2)
+	r := makeRouterWithLimiter(auth.RoleUser, "tenant-6", limits, limiter)
+
+	limiter.EXPECT().Allow(gomock.Any(), "tenant-6:write", tenant_limit.Rate{RPS: 2, Burst: 4}).
+		Return(service.RateLimitResult{Allowed: true, Limit: 4, Remaining: 3, ResetAfter: time.Second}, nil)
+	w := serve(r, http.MethodPost, "/api/v1/logs")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "3", w.Header().Get("X-RateLimit-Remaining"))
+
+	limiter.EXPECT().Allow(gomock.Any(), "tenant-6:read", tenant_limit.Rate{RPS: 2, Burst: 4}).
+		Return(service.RateLimitResult{Limit: 4, RetryAfter: 1500 * time.Millisecond, ResetAfter: 2 * time.Second}, nil)
+	w = serve(r, http.MethodGet, "/api/v1/logs")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
 }
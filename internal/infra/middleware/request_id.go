@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+// ids sent by callers are kept when they are safe to write in logs and headers
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID gives every request an id, the caller's X-Request-ID or a new one. The id is sent back
+// in the response and carried by the request context, so use cases, queued tasks and logs share it.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(constant.RequestIDHeaderKey)
+		if !validRequestID.MatchString(id) {
+			id = logger.NewRequestID()
+		}
+
+		c.Set(constant.RequestID, id)
+		c.Header(constant.RequestIDHeaderKey, id)
+		trace.SpanFromContext(c.Request.Context()).SetAttributes(attribute.String("http.request.id", id))
+		c.Request = c.Request.WithContext(logger.With(c.Request.Context(), logger.KeyRequestID, id))
+		c.Next()
+	}
+}
+
+// AccessLog writes a line per request once it is served, with the fields of the request context
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		entry := logger.FromContext(c.Request.Context()).WithFields(map[string]interface{}{
+			"method":     c.Request.Method,
+			"path":       c.Request.URL.Path,
+			"route":      c.FullPath(),
+			"status":     c.Writer.Status(),
+			"latency_ms": time.Since(start).Milliseconds(),
+			"client_ip":  c.ClientIP(),
+		})
+		if len(c.Errors) > 0 {
+			entry = entry.WithField("error", c.Errors.String())
+		}
+
+		switch status := c.Writer.Status(); {
+		case status >= 500:
+			entry.Error("request served")
+		case status >= 400:
+			entry.Warn("request served")
+		default:
+			entry.Info("request served")
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Haevnen/audit-logging-api/internal/constant"
+	"github.com/Haevnen/audit-logging-api/internal/infra/middleware"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+func serveRequestID(t *testing.T, header string) (*httptest.ResponseRecorder, string) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.RequestID())
+
+	var seen string
+	r.GET("/logs", func(c *gin.Context) {
+		seen = logger.RequestID(c.Request.Context())
+		assert.Equal(t, seen, c.GetString(constant.RequestID))
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/logs", nil)
+	if len(header) > 0 {
+		req.Header.Set(constant.RequestIDHeaderKey, header)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w, seen
+}
+
+func TestRequestID_KeepsCallerID(t *testing.T) {
+	w, seen := serveRequestID(t, "req-42")
+
+	assert.Equal(t, "req-42", seen)
+	assert.Equal(t, "req-42", w.Header().Get(constant.RequestIDHeaderKey))
+}
+
+func TestRequestID_GeneratesID(t *testing.T) {
+	for _, header := range []string{"", "bad id\nwith newline"} {
+		w, seen := serveRequestID(t, header)
+
+		assert.NotEmpty(t, seen)
+		assert.NotEqual(t, header, seen)
+		assert.Equal(t, seen, w.Header().Get(constant.RequestIDHeaderKey))
+	}
+}
@@ -28,7 +28,11 @@ func NewTxManager(db *gorm.DB) *txManager {
 
 // TransactionExec executes fn inside a transaction.
 // If fn returns an error → rollback, else commit.
+// Inside a transaction already, fn runs in it: its writes commit or roll back with the outer ones.
 func (t *txManager) TransactionExec(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey).(*gorm.DB); ok {
+		return fn(ctx)
+	}
 	return t.db.Transaction(func(tx *gorm.DB) error {
 		txCtx := context.WithValue(ctx, txKey, tx)
 		return fn(txCtx) // rollback/commit managed by GORM
@@ -0,0 +1,82 @@
+package interactor_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+)
+
+// countingConnector hands out connections which count the transactions begun and ended on them
+type countingConnector struct {
+	begun, committed, rolledBack atomic.Int32
+}
+
+func (c *countingConnector) Connect(context.Context) (driver.Conn, error) {
+	return countingConn{c}, nil
+}
+func (c *countingConnector) Driver() driver.Driver { return nil }
+
+type countingConn struct{ c *countingConnector }
+
+func (conn countingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("no statements")
+}
+func (conn countingConn) Close() error { return nil }
+func (conn countingConn) Begin() (driver.Tx, error) {
+	conn.c.begun.Add(1)
+	return countingTx(conn), nil
+}
+
+type countingTx countingConn
+
+func (tx countingTx) Commit() error   { tx.c.committed.Add(1); return nil }
+func (tx countingTx) Rollback() error { tx.c.rolledBack.Add(1); return nil }
+
+func newTxManager(t *testing.T) (interactor.TxManager, *countingConnector) {
+	connector := &countingConnector{}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(connector)}), &gorm.Config{})
+	require.NoError(t, err)
+	return interactor.NewTxManager(db), connector
+}
+
+func TestTxManager_TransactionExec_Nested(t *testing.T) {
+	txManager, connector := newTxManager(t)
+
+	err := txManager.TransactionExec(context.Background(), func(ctx context.Context) error {
+		outer := txManager.GetTx(ctx)
+		return txManager.TransactionExec(ctx, func(ctx context.Context) error {
+			assert.Same(t, outer, txManager.GetTx(ctx))
+			return nil
+		})
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), connector.begun.Load())
+	assert.Equal(t, int32(1), connector.committed.Load())
+}
+
+func TestTxManager_TransactionExec_NestedFails(t *testing.T) {
+	txManager, connector := newTxManager(t)
+
+	err := txManager.TransactionExec(context.Background(), func(ctx context.Context) error {
+		return txManager.TransactionExec(ctx, func(ctx context.Context) error {
+			return assert.AnError
+		})
+	})
+
+	// the outer writes roll back with the inner ones
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, int32(1), connector.begun.Load())
+	assert.Equal(t, int32(0), connector.committed.Load())
+	assert.Equal(t, int32(1), connector.rolledBack.Load())
+}
@@ -3,42 +3,57 @@ package registry
 import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
 	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/config"
 	"github.com/Haevnen/audit-logging-api/internal/interactor"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
 	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/grant"
 	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/rbac"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/redaction"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/schema"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/selfaudit"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/session"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/task"
 	"github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+	"github.com/Haevnen/audit-logging-api/pkg/health"
 )
 
 type Registry struct {
-	db              *gorm.DB
-	sqsClient       *sqs.Client
-	s3Client        *s3.Client
-	key             string
-	archiveQueueURL string
-	cleanUpQueueURL string
-	indexQueueURL   string
-	s3BucketName    string
-	openSearchURL   string
-	redisAddr       string
-}
-
-func NewRegistry(db *gorm.DB, key string, sqsClient *sqs.Client, s3Client *s3.Client, archiveQueueURL, cleanUpQueueURL, indexQueueURL, s3BucketName, openSearchURL, redisAddr string) *Registry {
-	return &Registry{
-		db:              db,
-		key:             key,
-		sqsClient:       sqsClient,
-		archiveQueueURL: archiveQueueURL,
-		cleanUpQueueURL: cleanUpQueueURL,
-		indexQueueURL:   indexQueueURL,
-		s3Client:        s3Client,
-		s3BucketName:    s3BucketName,
-		openSearchURL:   openSearchURL,
-		redisAddr:       redisAddr,
+	db        *gorm.DB
+	sqsClient *sqs.Client
+	s3Client  *s3.Client
+	cfg       config.Config
+	// one connection pool for the pub/sub, the rate limiter and the revocation cache
+	redis *redis.Client
+	// shared so that the signing keys of the identity provider are cached once per process
+	manager auth.ManagerInterface
+	// shared so that every read is counted in the batch written by RecordActivityUseCase.Run
+	meter *quota.RecordActivityUseCase
+}
+
+func NewRegistry(db *gorm.DB, cfg config.Config, sqsClient *sqs.Client, s3Client *s3.Client) *Registry {
+	var manager auth.ManagerInterface = auth.NewManager(cfg.TokenSymmetricKey)
+	if oidc := cfg.GetOIDCConfig(); oidc != nil {
+		manager = auth.NewOIDCManager(*oidc)
+	}
+	r := &Registry{
+		db:        db,
+		sqsClient: sqsClient,
+		s3Client:  s3Client,
+		cfg:       cfg,
+		redis:     redis.NewClient(&redis.Options{Addr: cfg.RedisAddr}),
+		manager:   manager,
 	}
+	r.meter = quota.NewRecordActivityUseCase(r.TenantLimitRepository())
+	return r
 }
 
 func (r *Registry) TenantRepository() repository.TenantRepository {
@@ -54,7 +69,35 @@ func (r *Registry) LogRepository() repository.LogRepository {
 }
 
 func (r *Registry) LogSearchRepository() repository.LogSearchRepository {
-	return repository.NewLogSearchRepository(r.openSearchURL, "logs")
+	return repository.NewLogSearchRepository(r.cfg.OpenSearchURL, service.LogsReadAlias)
+}
+
+func (r *Registry) LogSchemaRepository() repository.LogSchemaRepository {
+	return repository.NewLogSchemaRepository(r.db)
+}
+
+func (r *Registry) RedactionRuleRepository() repository.RedactionRuleRepository {
+	return repository.NewRedactionRuleRepository(r.db)
+}
+
+func (r *Registry) APIKeyRepository() repository.APIKeyRepository {
+	return repository.NewAPIKeyRepository(r.db)
+}
+
+func (r *Registry) TokenRevocationRepository() repository.TokenRevocationRepository {
+	return repository.NewTokenRevocationRepository(r.db)
+}
+
+func (r *Registry) RoleRepository() repository.RoleRepository {
+	return repository.NewRoleRepository(r.db)
+}
+
+func (r *Registry) AccessGrantRepository() repository.AccessGrantRepository {
+	return repository.NewAccessGrantRepository(r.db)
+}
+
+func (r *Registry) TenantLimitRepository() repository.TenantLimitRepository {
+	return repository.NewTenantLimitRepository(r.db)
 }
 
 func (r *Registry) CreateTenantUseCase() *tenant.CreateTenantUseCase {
@@ -66,8 +109,74 @@ func (r *Registry) ListTenantsUseCase() *tenant.ListTenantsUseCase {
 	return tenant.NewListTenantsUseCase(r.TenantRepository())
 }
 
+func (r *Registry) GetTenantUseCase() *tenant.GetTenantUseCase {
+	return tenant.NewGetTenantUseCase(r.TenantRepository())
+}
+
+func (r *Registry) UpdateTenantUseCase() *tenant.UpdateTenantUseCase {
+	return tenant.NewUpdateTenantUseCase(r.TenantRepository())
+}
+
+func (r *Registry) DeleteTenantUseCase() *tenant.DeleteTenantUseCase {
+	return tenant.NewDeleteTenantUseCase(r.TenantRepository(), r.AsyncTaskRepository(), r.QueuePublisher(), r.TxManager())
+}
+
+func (r *Registry) CheckTenantUseCase() *tenant.CheckTenantUseCase {
+	return tenant.NewCheckTenantUseCase(r.TenantRepository())
+}
+
+func (r *Registry) GetTaskUseCase() *task.GetTaskUseCase {
+	return task.NewGetTaskUseCase(r.AsyncTaskRepository())
+}
+
+func (r *Registry) GetDashboardUseCase() *ops.GetDashboardUseCase {
+	return ops.NewGetDashboardUseCase(r.QueuePublisher(), r.AsyncTaskRepository(), r.LogRepository(), r.LogSearchRepository())
+}
+
+func (r *Registry) StartIndexRebuildUseCase() *ops.StartIndexRebuildUseCase {
+	return ops.NewStartIndexRebuildUseCase(r.AsyncTaskRepository(), r.QueuePublisher(), r.SearchIndexManager(), r.TxManager())
+}
+
+func (r *Registry) StartReindexUseCase() *ops.StartReindexUseCase {
+	return ops.NewStartReindexUseCase(r.TenantRepository(), r.AsyncTaskRepository(), r.QueuePublisher(), r.TxManager())
+}
+
+func (r *Registry) ResolveLimitsUseCase() *quota.ResolveLimitsUseCase {
+	return quota.NewResolveLimitsUseCase(r.TenantLimitRepository(), r.cfg.GetDefaultLimits())
+}
+
+func (r *Registry) GetLimitsUseCase() *quota.GetLimitsUseCase {
+	return quota.NewGetLimitsUseCase(r.TenantLimitRepository(), r.TenantRepository(), r.cfg.GetDefaultLimits())
+}
+
+func (r *Registry) SetLimitsUseCase() *quota.SetLimitsUseCase {
+	return quota.NewSetLimitsUseCase(r.TenantLimitRepository(), r.TenantRepository(), r.cfg.GetDefaultLimits())
+}
+
+func (r *Registry) ConsumeQuotaUseCase() *quota.ConsumeQuotaUseCase {
+	return quota.NewConsumeQuotaUseCase(r.TenantLimitRepository(), r.ResolveLimitsUseCase())
+}
+
+func (r *Registry) RecordActivityUseCase() *quota.RecordActivityUseCase {
+	return r.meter
+}
+
+func (r *Registry) GetUsageReportUseCase() *quota.GetUsageReportUseCase {
+	return quota.NewGetUsageReportUseCase(r.TenantLimitRepository())
+}
+
+func (r *Registry) GetUsageUseCase() *quota.GetUsageUseCase {
+	return quota.NewGetUsageUseCase(r.TenantLimitRepository(), r.TenantRepository(), r.cfg.GetDefaultLimits())
+}
+
+// CreateLogUseCase writes the logs of the service itself, e.g. reads under an access grant
 func (r *Registry) CreateLogUseCase() *log.CreateLogUseCase {
-	return log.NewCreateLogUseCase(r.LogRepository(), r.TxManager(), r.QueuePublisher(), r.PubSub(), r.AsyncTaskRepository())
+	return log.NewCreateLogUseCase(r.LogRepository(), r.TxManager(), r.QueuePublisher(), r.PubSub(), r.AsyncTaskRepository(), r.RedactLogUseCase(), nil)
+}
+
+// IngestLogUseCase writes the logs sent by clients, they are checked against the schemas of their tenant
+func (r *Registry) IngestLogUseCase() *log.CreateLogUseCase {
+	return log.NewCreateLogUseCase(r.LogRepository(), r.TxManager(), r.QueuePublisher(), r.PubSub(), r.AsyncTaskRepository(), r.RedactLogUseCase(), r.ValidateLogUseCase())
 }
 
 func (r *Registry) GetLogUseCase() *log.GetLogUseCase {
@@ -82,28 +191,202 @@ func (r *Registry) GetStatsUseCase() *log.GetStatsUseCase {
 	return log.NewGetStatsUseCase(r.LogRepository())
 }
 
+func (r *Registry) GetTopActivityUseCase() *log.GetTopActivityUseCase {
+	return log.NewGetTopActivityUseCase(r.LogRepository())
+}
+
+func (r *Registry) GetAnomaliesUseCase() *log.GetAnomaliesUseCase {
+	return log.NewGetAnomaliesUseCase(r.LogRepository())
+}
+
 func (r *Registry) SearchLogsUseCase() *log.SearchLogsUseCase {
 	return log.NewSearchLogsUseCase(r.LogSearchRepository())
 }
 
+func (r *Registry) CreateLogSchemaUseCase() *schema.CreateLogSchemaUseCase {
+	return schema.NewCreateLogSchemaUseCase(r.LogSchemaRepository(), r.TxManager())
+}
+
+func (r *Registry) UpdateLogSchemaUseCase() *schema.UpdateLogSchemaUseCase {
+	return schema.NewUpdateLogSchemaUseCase(r.LogSchemaRepository(), r.TxManager())
+}
+
+func (r *Registry) GetLogSchemaUseCase() *schema.GetLogSchemaUseCase {
+	return schema.NewGetLogSchemaUseCase(r.LogSchemaRepository())
+}
+
+func (r *Registry) ListLogSchemasUseCase() *schema.ListLogSchemasUseCase {
+	return schema.NewListLogSchemasUseCase(r.LogSchemaRepository())
+}
+
+func (r *Registry) DeleteLogSchemaUseCase() *schema.DeleteLogSchemaUseCase {
+	return schema.NewDeleteLogSchemaUseCase(r.LogSchemaRepository())
+}
+
+func (r *Registry) ValidateLogUseCase() *schema.ValidateLogUseCase {
+	return schema.NewValidateLogUseCase(r.LogSchemaRepository())
+}
+
+func (r *Registry) CreateRedactionRuleUseCase() *redaction.CreateRedactionRuleUseCase {
+	return redaction.NewCreateRedactionRuleUseCase(r.RedactionRuleRepository())
+}
+
+func (r *Registry) ListRedactionRulesUseCase() *redaction.ListRedactionRulesUseCase {
+	return redaction.NewListRedactionRulesUseCase(r.RedactionRuleRepository())
+}
+
+func (r *Registry) DeleteRedactionRuleUseCase() *redaction.DeleteRedactionRuleUseCase {
+	return redaction.NewDeleteRedactionRuleUseCase(r.RedactionRuleRepository())
+}
+
+func (r *Registry) RedactLogUseCase() *redaction.RedactLogUseCase {
+	return redaction.NewRedactLogUseCase(r.RedactionRuleRepository(), r.cfg.RedactionHashKey)
+}
+
+func (r *Registry) IssueAPIKeyUseCase() *apikey.IssueAPIKeyUseCase {
+	return apikey.NewIssueAPIKeyUseCase(r.APIKeyRepository(), r.TxManager(), r.CreateLogUseCase())
+}
+
+func (r *Registry) ListAPIKeysUseCase() *apikey.ListAPIKeysUseCase {
+	return apikey.NewListAPIKeysUseCase(r.APIKeyRepository())
+}
+
+func (r *Registry) RotateAPIKeyUseCase() *apikey.RotateAPIKeyUseCase {
+	return apikey.NewRotateAPIKeyUseCase(r.APIKeyRepository(), r.TxManager(), r.CreateLogUseCase())
+}
+
+func (r *Registry) RevokeAPIKeyUseCase() *apikey.RevokeAPIKeyUseCase {
+	return apikey.NewRevokeAPIKeyUseCase(r.APIKeyRepository(), r.TxManager(), r.CreateLogUseCase())
+}
+
+func (r *Registry) AuthenticateAPIKeyUseCase() *apikey.AuthenticateAPIKeyUseCase {
+	return apikey.NewAuthenticateAPIKeyUseCase(r.APIKeyRepository())
+}
+
+func (r *Registry) RevokeTokenUseCase() *session.RevokeTokenUseCase {
+	return session.NewRevokeTokenUseCase(r.TokenRevocationRepository(), r.RevocationCache())
+}
+
+func (r *Registry) RevokeSessionsUseCase() *session.RevokeSessionsUseCase {
+	return session.NewRevokeSessionsUseCase(r.TokenRevocationRepository(), r.RevocationCache())
+}
+
+func (r *Registry) CheckRevocationUseCase() *session.CheckRevocationUseCase {
+	return session.NewCheckRevocationUseCase(r.TokenRevocationRepository(), r.RevocationCache())
+}
+
+func (r *Registry) CreateRoleUseCase() *rbac.CreateRoleUseCase {
+	return rbac.NewCreateRoleUseCase(r.RoleRepository())
+}
+
+func (r *Registry) UpdateRoleUseCase() *rbac.UpdateRoleUseCase {
+	return rbac.NewUpdateRoleUseCase(r.RoleRepository())
+}
+
+func (r *Registry) DeleteRoleUseCase() *rbac.DeleteRoleUseCase {
+	return rbac.NewDeleteRoleUseCase(r.RoleRepository())
+}
+
+func (r *Registry) ListRolesUseCase() *rbac.ListRolesUseCase {
+	return rbac.NewListRolesUseCase(r.RoleRepository())
+}
+
+func (r *Registry) CreateRoleBindingUseCase() *rbac.CreateRoleBindingUseCase {
+	return rbac.NewCreateRoleBindingUseCase(r.RoleRepository())
+}
+
+func (r *Registry) DeleteRoleBindingUseCase() *rbac.DeleteRoleBindingUseCase {
+	return rbac.NewDeleteRoleBindingUseCase(r.RoleRepository())
+}
+
+func (r *Registry) ListRoleBindingsUseCase() *rbac.ListRoleBindingsUseCase {
+	return rbac.NewListRoleBindingsUseCase(r.RoleRepository())
+}
+
+func (r *Registry) ResolvePermissionsUseCase() *rbac.ResolvePermissionsUseCase {
+	return rbac.NewResolvePermissionsUseCase(r.RoleRepository())
+}
+
+func (r *Registry) CreateGrantUseCase() *grant.CreateGrantUseCase {
+	return grant.NewCreateGrantUseCase(r.AccessGrantRepository())
+}
+
+func (r *Registry) ListGrantsUseCase() *grant.ListGrantsUseCase {
+	return grant.NewListGrantsUseCase(r.AccessGrantRepository())
+}
+
+func (r *Registry) RevokeGrantUseCase() *grant.RevokeGrantUseCase {
+	return grant.NewRevokeGrantUseCase(r.AccessGrantRepository())
+}
+
+func (r *Registry) AccessTenantUseCase() *grant.AccessTenantUseCase {
+	return grant.NewAccessTenantUseCase(r.AccessGrantRepository(), r.CreateLogUseCase())
+}
+
+func (r *Registry) RecordAuditEntryUseCase() *selfaudit.RecordUseCase {
+	return selfaudit.NewRecordUseCase(r.CreateLogUseCase())
+}
+
 func (r *Registry) QueuePublisher() service.SQSPublisher {
-	return service.NewSQSPublisherImpl(r.sqsClient, r.archiveQueueURL, r.cleanUpQueueURL, r.indexQueueURL)
+	return service.NewSQSPublisherImpl(r.sqsClient, r.cfg.SqsLogArchivalQueueURL, r.cfg.SqsLogCleanupQueueURL, r.cfg.SqsIndexQueueURL, r.cfg.SqsTenantDeletionQueueURL, r.cfg.SqsReindexQueueURL, r.cfg.SqsIndexRebuildQueueURL)
 }
 
 func (r *Registry) S3Publisher() service.S3Publisher {
-	return service.NewS3PublisherImpl(r.s3Client, r.s3BucketName)
+	return service.NewS3PublisherImpl(r.s3Client, r.cfg.S3ArchiveLogBucketName)
 }
 
 func (r *Registry) OpenSearchPublisher() service.OpenSearchPublisher {
-	return service.NewOpenSearchPublisher(r.openSearchURL, "logs")
+	return service.NewOpenSearchPublisher(r.cfg.OpenSearchURL, service.LogsWriteAlias, service.LogsReadAlias, service.LogsRebuildAlias)
+}
+
+// RebuildPublisher writes the logs to the index of a rebuild in progress
+func (r *Registry) RebuildPublisher() service.OpenSearchPublisher {
+	return service.NewOpenSearchPublisher(r.cfg.OpenSearchURL, service.LogsRebuildAlias, service.LogsReadAlias, "")
+}
+
+func (r *Registry) SearchIndexManager() service.SearchIndexManager {
+	return service.NewSearchIndexManager(r.cfg.OpenSearchURL, r.cfg.GetIndexSettings())
 }
 
 func (r *Registry) PubSub() service.PubSub {
-	return service.NewPubSubImpl(r.redisAddr)
+	return service.NewPubSubImpl(r.redis)
+}
+
+// HealthChecks probes every dependency of the API and the workers
+func (r *Registry) HealthChecks() ([]health.Check, error) {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	return []health.Check{
+		health.Postgres(sqlDB),
+		health.Redis(r.redis),
+		health.OpenSearch(r.cfg.OpenSearchURL),
+		health.SQS(r.sqsClient, r.cfg.SqsLogArchivalQueueURL, r.cfg.SqsLogCleanupQueueURL, r.cfg.SqsIndexQueueURL, r.cfg.SqsTenantDeletionQueueURL, r.cfg.SqsReindexQueueURL, r.cfg.SqsIndexRebuildQueueURL),
+	}, nil
+}
+
+func (r *Registry) RevocationCache() service.RevocationCache {
+	return service.NewRevocationCacheImpl(r.redis)
+}
+
+// RateLimiter shares the rate limits of the tenants between the API instances through Redis, each instance
+// limits on its own while Redis is unreachable
+func (r *Registry) RateLimiter() service.RateLimiter {
+	return service.NewFallbackRateLimiter(service.NewRedisRateLimiter(r.redis), service.NewLocalRateLimiter())
+}
+
+func (r *Registry) Manager() auth.ManagerInterface {
+	return r.manager
+}
+
+// TokenIssuingEnabled tells whether POST /auth/token may mint tokens, only locally signed ones in dev mode
+func (r *Registry) TokenIssuingEnabled() bool {
+	return r.cfg.IsDevMode() && r.cfg.GetOIDCConfig() == nil
 }
 
-func (r *Registry) Manager() *auth.Manager {
-	return auth.NewManager(r.key)
+func (r *Registry) FieldVisibility() auth.FieldVisibility {
+	return r.cfg.GetFieldVisibility()
 }
 
 func (r *Registry) TxManager() interactor.TxManager {
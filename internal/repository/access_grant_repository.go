@@ -0,0 +1,78 @@
+package repository
+
+//go:generate mockgen -source=access_grant_repository.go -destination=./mocks/mock_access_grant_repository.go -package=mocks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+)
+
+type AccessGrantRepository interface {
+	Create(ctx context.Context, g *access_grant.AccessGrant) (*access_grant.AccessGrant, error)
+	Update(ctx context.Context, g *access_grant.AccessGrant) error
+	GetByID(ctx context.Context, id string) (*access_grant.AccessGrant, error)
+	List(ctx context.Context, granteeId, tenantId string) ([]access_grant.AccessGrant, error)
+	// FindActive returns the active grant of the grantee of the home tenant covering the tenant,
+	// gorm.ErrRecordNotFound if none
+	FindActive(ctx context.Context, granteeTenantId, granteeId, tenantId string, now time.Time) (*access_grant.AccessGrant, error)
+}
+
+type accessGrantRepository struct {
+	db *gorm.DB
+}
+
+func NewAccessGrantRepository(db *gorm.DB) *accessGrantRepository {
+	return &accessGrantRepository{db: db}
+}
+
+func (r *accessGrantRepository) Create(ctx context.Context, g *access_grant.AccessGrant) (*access_grant.AccessGrant, error) {
+	if err := r.db.WithContext(ctx).Create(g).Error; err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (r *accessGrantRepository) Update(ctx context.Context, g *access_grant.AccessGrant) error {
+	return r.db.WithContext(ctx).Save(g).Error
+}
+
+func (r *accessGrantRepository) GetByID(ctx context.Context, id string) (*access_grant.AccessGrant, error) {
+	var g access_grant.AccessGrant
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&g).Error
+	return &g, err
+}
+
+func (r *accessGrantRepository) List(ctx context.Context, granteeId, tenantId string) ([]access_grant.AccessGrant, error) {
+	var grants []access_grant.AccessGrant
+	q := r.db.WithContext(ctx)
+
+	if len(granteeId) > 0 {
+		q = q.Where("grantee_id = ?", granteeId)
+	}
+	if len(tenantId) > 0 {
+		q = q.Where("tenant_ids @> ?", tenantIdsJSON(tenantId))
+	}
+	err := q.Order("created_at DESC").Find(&grants).Error
+	return grants, err
+}
+
+func (r *accessGrantRepository) FindActive(ctx context.Context, granteeTenantId, granteeId, tenantId string, now time.Time) (*access_grant.AccessGrant, error) {
+	var g access_grant.AccessGrant
+	err := r.db.WithContext(ctx).
+		Where("grantee_tenant_id = ? AND grantee_id = ?", granteeTenantId, granteeId).
+		Where("tenant_ids @> ?", tenantIdsJSON(tenantId)).
+		Where("revoked_at IS NULL AND starts_at <= ? AND expires_at > ?", now, now).
+		Order("expires_at DESC").
+		First(&g).Error
+	return &g, err
+}
+
+func tenantIdsJSON(tenantId string) string {
+	b, _ := json.Marshal([]string{tenantId})
+	return string(b)
+}
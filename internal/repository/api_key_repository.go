@@ -0,0 +1,78 @@
+package repository
+
+//go:generate mockgen -source=api_key_repository.go -destination=./mocks/mock_api_key_repository.go -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, db *gorm.DB, key *api_key.APIKey) (*api_key.APIKey, error)
+	Update(ctx context.Context, db *gorm.DB, key *api_key.APIKey) error
+	GetByID(ctx context.Context, id string, tenantId string) (*api_key.APIKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (*api_key.APIKey, error)
+	List(ctx context.Context, tenantId string) ([]api_key.APIKey, error)
+	TouchLastUsed(ctx context.Context, id string, at time.Time) error
+}
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) *apiKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, db *gorm.DB, key *api_key.APIKey) (*api_key.APIKey, error) {
+	if db == nil {
+		db = r.db
+	}
+	if err := db.WithContext(ctx).Create(key).Error; err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (r *apiKeyRepository) Update(ctx context.Context, db *gorm.DB, key *api_key.APIKey) error {
+	if db == nil {
+		db = r.db
+	}
+	return db.WithContext(ctx).Save(key).Error
+}
+
+func (r *apiKeyRepository) GetByID(ctx context.Context, id string, tenantId string) (*api_key.APIKey, error) {
+	var key api_key.APIKey
+	q := r.db.WithContext(ctx).Where("id = ?", id)
+
+	if len(tenantId) > 0 {
+		q = q.Where("tenant_id = ?", tenantId)
+	}
+	err := q.First(&key).Error
+	return &key, err
+}
+
+func (r *apiKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*api_key.APIKey, error) {
+	var key api_key.APIKey
+	err := r.db.WithContext(ctx).Where("prefix = ?", prefix).First(&key).Error
+	return &key, err
+}
+
+func (r *apiKeyRepository) List(ctx context.Context, tenantId string) ([]api_key.APIKey, error) {
+	var keys []api_key.APIKey
+	q := r.db.WithContext(ctx)
+
+	if len(tenantId) > 0 {
+		q = q.Where("tenant_id = ?", tenantId)
+	}
+	err := q.Order("tenant_id, created_at").Find(&keys).Error
+	return keys, err
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&api_key.APIKey{}).Where("id = ?", id).UpdateColumn("last_used_at", at).Error
+}
@@ -4,16 +4,29 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 )
 
 type AsyncTaskRepository interface {
 	Create(ctx context.Context, db *gorm.DB, task *async_task.AsyncTask) (*async_task.AsyncTask, error)
+	// CreateIfAbsent creates the task unless one with the same id exists, it tells whether the task was created
+	CreateIfAbsent(ctx context.Context, db *gorm.DB, task *async_task.AsyncTask) (bool, error)
 	UpdateStatus(ctx context.Context, db *gorm.DB, taskID string, status async_task.AsyncTaskStatus, errorMsg *string) error
+	UpdateProgress(ctx context.Context, taskID string, progress datatypes.JSON) error
+	// Claim marks the task running for the caller when it is pending, or running but untouched since staleBefore
+	// because its worker is gone. It tells whether the task was claimed.
+	Claim(ctx context.Context, taskID string, staleBefore time.Time) (bool, error)
+	// Touch records that the worker of the running task is still on it
+	Touch(ctx context.Context, taskID string) error
 	GetByID(ctx context.Context, taskID string) (*async_task.AsyncTask, error)
+	// CountOpen counts the pending and running tasks, and the tasks that failed since failedSince, by type and status
+	CountOpen(ctx context.Context, failedSince time.Time) ([]async_task.TaskCount, error)
 }
 
 type asyncTaskRepository struct {
@@ -31,6 +44,14 @@ func (r *asyncTaskRepository) Create(ctx context.Context, db *gorm.DB, task *asy
 	return task, db.WithContext(ctx).Create(task).Error
 }
 
+func (r *asyncTaskRepository) CreateIfAbsent(ctx context.Context, db *gorm.DB, task *async_task.AsyncTask) (bool, error) {
+	if db == nil {
+		db = r.db
+	}
+	res := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(task)
+	return res.RowsAffected > 0, res.Error
+}
+
 func (r *asyncTaskRepository) UpdateStatus(ctx context.Context, db *gorm.DB, taskID string, status async_task.AsyncTaskStatus, errorMsg *string) error {
 	if db == nil {
 		db = r.db
@@ -38,7 +59,38 @@ func (r *asyncTaskRepository) UpdateStatus(ctx context.Context, db *gorm.DB, tas
 	return db.WithContext(ctx).Where("task_id = ?", taskID).Updates(async_task.AsyncTask{Status: status, ErrorMsg: errorMsg}).Error
 }
 
+func (r *asyncTaskRepository) UpdateProgress(ctx context.Context, taskID string, progress datatypes.JSON) error {
+	return r.db.WithContext(ctx).Model(&async_task.AsyncTask{}).Where("task_id = ?", taskID).Update("progress", progress).Error
+}
+
+func (r *asyncTaskRepository) Claim(ctx context.Context, taskID string, staleBefore time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&async_task.AsyncTask{}).
+		Where("task_id = ?", taskID).
+		Where("status = ? OR (status = ? AND updated_at < ?)", async_task.StatusPending, async_task.StatusRunning, staleBefore).
+		Updates(map[string]interface{}{"status": async_task.StatusRunning, "error_msg": nil})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *asyncTaskRepository) Touch(ctx context.Context, taskID string) error {
+	return r.db.WithContext(ctx).Model(&async_task.AsyncTask{}).
+		Where("task_id = ? AND status = ?", taskID, async_task.StatusRunning).
+		Update("updated_at", time.Now().UTC()).Error
+}
+
 func (r *asyncTaskRepository) GetByID(ctx context.Context, taskID string) (*async_task.AsyncTask, error) {
 	var task async_task.AsyncTask
 	return &task, r.db.WithContext(ctx).Where("task_id = ?", taskID).First(&task).Error
 }
+
+func (r *asyncTaskRepository) CountOpen(ctx context.Context, failedSince time.Time) ([]async_task.TaskCount, error) {
+	var counts []async_task.TaskCount
+	err := r.db.WithContext(ctx).
+		Model(&async_task.AsyncTask{}).
+		Select("task_type, status, COUNT(*) AS count, MIN(created_at) AS oldest_created_at").
+		Where("status IN ? OR (status = ? AND updated_at >= ?)",
+			[]async_task.AsyncTaskStatus{async_task.StatusPending, async_task.StatusRunning}, async_task.StatusFailed, failedSince).
+		Group("task_type, status").
+		Order("task_type, status").
+		Scan(&counts).Error
+	return counts, err
+}
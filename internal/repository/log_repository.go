@@ -4,6 +4,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -21,7 +22,28 @@ type LogRepository interface {
 	GetByID(ctx context.Context, id string, tenantId string) (*log.Log, error)
 	FindLogsForArchival(ctx context.Context, tenantId *string, beforeDate time.Time) ([]log.Log, error)
 	CleanupLogsBefore(ctx context.Context, db *gorm.DB, tenantId *string, beforeDate time.Time) ([]string, error)
-	GetStats(ctx context.Context, tenantId string, startTime, endTime time.Time) ([]log.LogStats, error)
+	// GetStats counts the logs per bucket and group, ordered by group then bucket
+	GetStats(ctx context.Context, q log.StatsQuery) ([]log.StatPoint, error)
+	// GetStatsFreshness returns the time up to which the aggregate GetStats reads for the query is materialized,
+	// nil when the stats are counted from the logs or nothing was materialized yet
+	GetStatsFreshness(ctx context.Context, q log.StatsQuery) (*time.Time, error)
+	// GetTopActivity returns the values of the dimension with the most logs, most logs first
+	GetTopActivity(ctx context.Context, q log.TopQuery) ([]log.TopEntry, error)
+	// ListUserIPs returns the IP addresses each user sent logs from over the range, ordered by user then first seen
+	ListUserIPs(ctx context.Context, tenantId string, startTime, endTime time.Time) ([]log.UserIP, error)
+	// CountByTenant counts the logs of each tenant with an event timestamp in [startTime, endTime)
+	CountByTenant(ctx context.Context, startTime, endTime time.Time) (map[string]int64, error)
+	// FindTenantLogsAfter returns a page of the logs of the tenant in (event timestamp, id) order, the first one
+	// when after is nil and else the one following the log after
+	FindTenantLogsAfter(ctx context.Context, tenantId string, after *log.Log, limit int) ([]log.Log, error)
+	// ListIDs returns the ids of the logs with an event timestamp in [startTime, endTime), of every tenant
+	// when tenantId is nil
+	ListIDs(ctx context.Context, tenantId *string, startTime, endTime time.Time) ([]string, error)
+	FindByIDs(ctx context.Context, ids []string) ([]log.Log, error)
+	// ListTenantSpans returns the number and the range of event timestamps of the logs of every tenant, over
+	// the logs with an event timestamp from startTime
+	ListTenantSpans(ctx context.Context, startTime time.Time) ([]log.TenantSpan, error)
+	DeleteTenantLogs(ctx context.Context, db *gorm.DB, tenantId string) (int64, error)
 }
 
 type logRepository struct {
@@ -59,7 +81,8 @@ func (r *logRepository) FindLogsForArchival(ctx context.Context, tenantID *strin
 	allLogs := make([]log.Log, 0)
 	batchSize := 1000 // tune based on your workload
 
-	q := r.db.WithContext(ctx).Where("event_timestamp < ?", beforeDate)
+	// the service's own audit trail is never archived nor cleaned up
+	q := r.db.WithContext(ctx).Where("event_timestamp < ? AND NOT system", beforeDate)
 	if tenantID != nil && len(*tenantID) > 0 {
 		q = q.Where("tenant_id = ?", *tenantID)
 	}
@@ -85,7 +108,7 @@ func (r *logRepository) CleanupLogsBefore(ctx context.Context, db *gorm.DB, tena
 	}
 
 	var ids []string
-	q := db.WithContext(ctx).Model(&log.Log{}).Where("event_timestamp < ?", beforeDate)
+	q := db.WithContext(ctx).Model(&log.Log{}).Where("event_timestamp < ? AND NOT system", beforeDate)
 	if tenantId != nil && len(*tenantId) > 0 {
 		q = q.Where("tenant_id = ?", *tenantId)
 	}
@@ -96,54 +119,249 @@ func (r *logRepository) CleanupLogsBefore(ctx context.Context, db *gorm.DB, tena
 	}
 
 	if len(ids) > 0 {
-		if err := db.WithContext(ctx).Where("id IN ?", ids).Delete(&log.Log{}).Error; err != nil {
+		if err := db.WithContext(ctx).Where("id IN ? AND NOT system", ids).Delete(&log.Log{}).Error; err != nil {
 			return nil, err
 		}
 	}
 	return ids, nil
 }
 
-func (r *logRepository) GetStats(ctx context.Context, tenantId string, startTime, endTime time.Time) ([]log.LogStats, error) {
-	var stats []log.LogStats
+// statsSource is a table the stats can be counted from
+type statsSource struct {
+	table      string
+	timeColumn string
+	count      string
+	where      string
+}
+
+var (
+	// the raw logs serve what no aggregate holds: minute buckets and users along with resources
+	statsFromLogs          = statsSource{table: "logs", timeColumn: "event_timestamp", count: "COUNT(*)", where: "NOT system"}
+	statsFromHourly        = statsSource{table: "log_stats_hourly", timeColumn: "bucket", count: "SUM(log_count)"}
+	statsFromUserHourly    = statsSource{table: "log_user_activity_hourly", timeColumn: "bucket", count: "SUM(log_count)"}
+	statsFromDaily         = statsSource{table: "log_stats_daily", timeColumn: "day", count: "SUM(log_count)"}
+	statsFromResourceDaily = statsSource{table: "log_stats_resource_daily", timeColumn: "day", count: "SUM(log_count)"}
+)
+
+var statsBuckets = map[log.StatsInterval]string{
+	log.IntervalMinute: "1 minute",
+	log.IntervalHour:   "1 hour",
+	log.IntervalDay:    "1 day",
+	log.IntervalWeek:   "1 week",
+}
+
+var statsGroupColumns = map[log.StatsGroupBy]string{
+	log.GroupByNone:     "''",
+	log.GroupByAction:   "action",
+	log.GroupBySeverity: "severity",
+	log.GroupByResource: "COALESCE(resource, '')",
+	log.GroupByUserID:   "user_id",
+}
+
+// pickStatsSource returns the smallest table holding the buckets, groups and filters of the query
+func pickStatsSource(q log.StatsQuery) statsSource {
+	byUser := q.GroupBy == log.GroupByUserID || len(q.UserID) > 0
+	byResource := q.GroupBy == log.GroupByResource || len(q.Resource) > 0
+
+	switch {
+	case q.Interval == log.IntervalMinute, byUser && byResource:
+		return statsFromLogs
+	case byUser:
+		return statsFromUserHourly
+	case q.Interval == log.IntervalHour:
+		return statsFromHourly
+	case byResource:
+		return statsFromResourceDaily
+	default:
+		return statsFromDaily
+	}
+}
+
+func (r *logRepository) GetStats(ctx context.Context, q log.StatsQuery) ([]log.StatPoint, error) {
+	bucket, ok := statsBuckets[q.Interval]
+	if !ok {
+		return nil, fmt.Errorf("unknown stats interval %q", q.Interval)
+	}
+	group, ok := statsGroupColumns[q.GroupBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown stats grouping %q", q.GroupBy)
+	}
+	src := pickStatsSource(q)
+
+	// the first bucket is counted whole, like the rows of the aggregates
+	tx := r.db.WithContext(ctx).
+		Table(src.table).
+		Select(fmt.Sprintf(`time_bucket(CAST(? AS INTERVAL), %[1]s) AS bucket, %[2]s AS "group", %[3]s AS count`, src.timeColumn, group, src.count), bucket).
+		Where(fmt.Sprintf("%[1]s >= time_bucket(CAST(? AS INTERVAL), CAST(? AS TIMESTAMPTZ)) AND %[1]s <= ?", src.timeColumn), bucket, q.StartTime, q.EndTime)
+	if len(src.where) > 0 {
+		tx = tx.Where(src.where)
+	}
+	filters := []struct{ column, value string }{
+		{"tenant_id", q.TenantID}, {"action", q.Action}, {"severity", q.Severity}, {"resource", q.Resource}, {"user_id", q.UserID},
+	}
+	for _, f := range filters {
+		if len(f.value) > 0 {
+			tx = tx.Where(f.column+" = ?", f.value)
+		}
+	}
+
+	points := []log.StatPoint{}
+	err := tx.Group(`1, 2`).Order(`2, 1`).Scan(&points).Error
+	return points, err
+}
+
+func (r *logRepository) GetStatsFreshness(ctx context.Context, q log.StatsQuery) (*time.Time, error) {
+	src := pickStatsSource(q)
+	if src == statsFromLogs {
+		return nil, nil
+	}
+
+	// the watermark is -infinity until the first refresh
+	var watermarks []struct{ Watermark time.Time }
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT watermark FROM (
+			SELECT _timescaledb_functions.to_timestamp(_timescaledb_functions.cagg_watermark(mat_hypertable_id)) AS watermark
+			FROM _timescaledb_catalog.continuous_agg
+			WHERE user_view_name = ?
+		) w
+		WHERE isfinite(watermark)`, src.table).
+		Scan(&watermarks).Error
+	if err != nil || len(watermarks) == 0 {
+		return nil, err
+	}
+	return &watermarks[0].Watermark, nil
+}
+
+// topSource is the aggregate a dimension is ranked from
+type topSource struct {
+	statsSource
+	key string
+	// width of the buckets of the aggregate
+	bucket string
+}
+
+var topSources = map[log.InsightDimension]topSource{
+	log.InsightUser: {statsSource: statsFromUserHourly, key: "user_id", bucket: "1 hour"},
+	log.InsightResource: {
+		statsSource: statsSource{table: "log_stats_hourly", timeColumn: "bucket", count: "SUM(log_count)", where: "resource IS NOT NULL"},
+		key:         "resource",
+		bucket:      "1 hour",
+	},
+	log.InsightIPAddress: {
+		statsSource: statsSource{table: "log_user_ips_daily", timeColumn: "day", count: "SUM(log_count)"},
+		key:         "HOST(ip_address)",
+		bucket:      "1 day",
+	},
+}
+
+func (r *logRepository) GetTopActivity(ctx context.Context, q log.TopQuery) ([]log.TopEntry, error) {
+	src, ok := topSources[q.Dimension]
+	if !ok {
+		return nil, fmt.Errorf("unknown insight dimension %q", q.Dimension)
+	}
+
+	tx := r.db.WithContext(ctx).
+		Table(src.table).
+		Select(fmt.Sprintf(`%s AS "key", %s AS count`, src.key, src.count)).
+		Where(fmt.Sprintf("%[1]s >= time_bucket(CAST(? AS INTERVAL), CAST(? AS TIMESTAMPTZ)) AND %[1]s <= ?", src.timeColumn), src.bucket, q.StartTime, q.EndTime)
+	if len(src.where) > 0 {
+		tx = tx.Where(src.where)
+	}
+	if len(q.TenantID) > 0 {
+		tx = tx.Where("tenant_id = ?", q.TenantID)
+	}
+	if len(q.Action) > 0 {
+		tx = tx.Where("action = ?", q.Action)
+	}
+
+	entries := []log.TopEntry{}
+	err := tx.Group("1").Order("2 DESC, 1").Limit(q.Limit).Scan(&entries).Error
+	return entries, err
+}
 
+func (r *logRepository) ListUserIPs(ctx context.Context, tenantId string, startTime, endTime time.Time) ([]log.UserIP, error) {
+	tx := r.db.WithContext(ctx).
+		Table("log_user_ips_daily").
+		Select(`user_id, HOST(ip_address) AS ip_address, MIN(first_seen) AS first_seen, MAX(last_seen) AS last_seen, SUM(log_count) AS count`).
+		Where("day >= time_bucket('1 day', CAST(? AS TIMESTAMPTZ)) AND day <= ?", startTime, endTime)
 	if len(tenantId) > 0 {
-		query := `
-        SELECT
-			day,
-			SUM(log_count) AS total_count,
-			SUM(CASE WHEN action = 'CREATE' THEN log_count ELSE 0 END) AS create_count,
-			SUM(CASE WHEN action = 'UPDATE' THEN log_count ELSE 0 END) AS update_count,
-			SUM(CASE WHEN action = 'DELETE' THEN log_count ELSE 0 END) AS delete_count,
-			SUM(CASE WHEN action = 'VIEW'   THEN log_count ELSE 0 END) AS view_count,
-			SUM(CASE WHEN severity = 'INFO'     THEN log_count ELSE 0 END) AS info_count,
-			SUM(CASE WHEN severity = 'ERROR'    THEN log_count ELSE 0 END) AS error_count,
-			SUM(CASE WHEN severity = 'WARNING'  THEN log_count ELSE 0 END) AS warning_count,
-			SUM(CASE WHEN severity = 'CRITICAL' THEN log_count ELSE 0 END) AS critical_count
-		FROM log_stats_daily
-		WHERE tenant_id = ? AND day BETWEEN ? AND ?
-		GROUP BY day
-		ORDER BY day DESC;`
-		err := r.db.WithContext(ctx).Raw(query, tenantId, startTime, endTime).Scan(&stats).Error
-		return stats, err
-	}
-
-	query := `
-        SELECT
-			day,
-			SUM(log_count) AS total,
-			SUM(CASE WHEN action = 'CREATE' THEN log_count ELSE 0 END) AS action_create,
-			SUM(CASE WHEN action = 'UPDATE' THEN log_count ELSE 0 END) AS action_update,
-			SUM(CASE WHEN action = 'DELETE' THEN log_count ELSE 0 END) AS action_delete,
-			SUM(CASE WHEN action = 'VIEW'   THEN log_count ELSE 0 END) AS action_view,
-			SUM(CASE WHEN severity = 'INFO'     THEN log_count ELSE 0 END) AS severity_info,
-			SUM(CASE WHEN severity = 'ERROR'    THEN log_count ELSE 0 END) AS severity_error,
-			SUM(CASE WHEN severity = 'WARNING'  THEN log_count ELSE 0 END) AS severity_warning,
-			SUM(CASE WHEN severity = 'CRITICAL' THEN log_count ELSE 0 END) AS severity_critical
-		FROM log_stats_daily
-		WHERE day BETWEEN ? AND ?
-		GROUP BY day
-		ORDER BY day DESC;`
-	err := r.db.WithContext(ctx).Raw(query, startTime, endTime).Scan(&stats).Error
-	return stats, err
+		tx = tx.Where("tenant_id = ?", tenantId)
+	}
+
+	ips := []log.UserIP{}
+	err := tx.Group("user_id, ip_address").Order("user_id, first_seen").Scan(&ips).Error
+	return ips, err
+}
+
+func (r *logRepository) CountByTenant(ctx context.Context, startTime, endTime time.Time) (map[string]int64, error) {
+	var rows []struct {
+		TenantID string
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&log.Log{}).
+		Select("tenant_id, COUNT(*) AS count").
+		Where("event_timestamp >= ? AND event_timestamp < ?", startTime, endTime).
+		Group("tenant_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.TenantID] = row.Count
+	}
+	return counts, nil
+}
+
+// FindTenantLogsAfter pages through the logs of the tenant, its system stream included, along the primary key
+// (tenant_id, event_timestamp, id) so that every page is an index range scan however deep
+func (r *logRepository) FindTenantLogsAfter(ctx context.Context, tenantId string, after *log.Log, limit int) ([]log.Log, error) {
+	logs := make([]log.Log, 0, limit)
+	q := r.db.WithContext(ctx).Where("tenant_id = ?", tenantId)
+	if after != nil {
+		q = q.Where("(event_timestamp, id) > (?, ?)", after.EventTimestamp, after.ID)
+	}
+	err := q.Order("event_timestamp ASC, id ASC").Limit(limit).Find(&logs).Error
+	return logs, err
+}
+
+func (r *logRepository) ListIDs(ctx context.Context, tenantId *string, startTime, endTime time.Time) ([]string, error) {
+	var ids []string
+	q := r.db.WithContext(ctx).Model(&log.Log{}).Where("event_timestamp >= ? AND event_timestamp < ?", startTime, endTime)
+	if tenantId != nil && len(*tenantId) > 0 {
+		q = q.Where("tenant_id = ?", *tenantId)
+	}
+	err := q.Pluck("id", &ids).Error
+	return ids, err
+}
 
+func (r *logRepository) FindByIDs(ctx context.Context, ids []string) ([]log.Log, error) {
+	logs := []log.Log{}
+	if len(ids) == 0 {
+		return logs, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&logs).Error
+	return logs, err
+}
+
+func (r *logRepository) ListTenantSpans(ctx context.Context, startTime time.Time) ([]log.TenantSpan, error) {
+	spans := []log.TenantSpan{}
+	err := r.db.WithContext(ctx).
+		Model(&log.Log{}).
+		Select("tenant_id, COUNT(*) AS count, MIN(event_timestamp) AS oldest, MAX(event_timestamp) AS newest").
+		Where("event_timestamp >= ?", startTime).
+		Group("tenant_id").
+		Order("tenant_id").
+		Scan(&spans).Error
+	return spans, err
+}
+
+func (r *logRepository) DeleteTenantLogs(ctx context.Context, db *gorm.DB, tenantId string) (int64, error) {
+	if db == nil {
+		db = r.db
+	}
+	res := db.WithContext(ctx).Where("tenant_id = ?", tenantId).Delete(&log.Log{})
+	return res.RowsAffected, res.Error
 }
@@ -0,0 +1,134 @@
+package repository
+
+//go:generate mockgen -source=log_schema_repository.go -destination=./mocks/mock_log_schema_repository.go -package=mocks
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+)
+
+type LogSchemaRepository interface {
+	Create(ctx context.Context, db *gorm.DB, s *log_schema.LogSchema) (*log_schema.LogSchema, error)
+	GetByID(ctx context.Context, id string, tenantId string) (*log_schema.LogSchema, error)
+	List(ctx context.Context, tenantId string, resource *string, allVersions bool) ([]log_schema.LogSchema, error)
+	// LockVersions makes the publishers of a tenant and resource wait for each other until the end of the
+	// transaction of db, so they don't pick the same next version
+	LockVersions(ctx context.Context, db *gorm.DB, tenantId string, resource *string) error
+	GetLatestVersion(ctx context.Context, db *gorm.DB, tenantId string, resource *string) (int, error)
+	FindActive(ctx context.Context, tenantId string, resource *string) (*log_schema.LogSchema, error)
+	Deactivate(ctx context.Context, db *gorm.DB, tenantId string, resource *string) error
+}
+
+type logSchemaRepository struct {
+	db *gorm.DB
+}
+
+func NewLogSchemaRepository(db *gorm.DB) *logSchemaRepository {
+	return &logSchemaRepository{db: db}
+}
+
+func (r *logSchemaRepository) Create(ctx context.Context, db *gorm.DB, s *log_schema.LogSchema) (*log_schema.LogSchema, error) {
+	if db == nil {
+		db = r.db
+	}
+	if err := db.WithContext(ctx).Create(s).Error; err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *logSchemaRepository) GetByID(ctx context.Context, id string, tenantId string) (*log_schema.LogSchema, error) {
+	var s log_schema.LogSchema
+	q := r.db.WithContext(ctx).Where("id = ?", id)
+
+	if len(tenantId) > 0 {
+		// user or auditor
+		q = q.Where("tenant_id = ?", tenantId)
+	}
+	err := q.First(&s).Error
+	return &s, err
+}
+
+func (r *logSchemaRepository) List(ctx context.Context, tenantId string, resource *string, allVersions bool) ([]log_schema.LogSchema, error) {
+	var schemas []log_schema.LogSchema
+	q := r.db.WithContext(ctx)
+
+	if len(tenantId) > 0 {
+		q = q.Where("tenant_id = ?", tenantId)
+	}
+	if resource != nil && len(*resource) > 0 {
+		q = q.Where("resource = ?", *resource)
+	}
+	if !allVersions {
+		q = q.Where("is_active = ?", true)
+	}
+
+	err := q.Order("tenant_id, resource NULLS FIRST, version DESC").Find(&schemas).Error
+	return schemas, err
+}
+
+// LockVersions takes a transaction advisory lock rather than locking the latest row, the first version of a
+// resource has no row to lock
+func (r *logSchemaRepository) LockVersions(ctx context.Context, db *gorm.DB, tenantId string, resource *string) error {
+	key := "log_schemas/" + tenantId + "/"
+	if resource != nil {
+		key += *resource
+	}
+	return db.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(hashtext(?))", key).Error
+}
+
+// GetLatestVersion returns the highest version registered for the tenant and resource, 0 if none
+func (r *logSchemaRepository) GetLatestVersion(ctx context.Context, db *gorm.DB, tenantId string, resource *string) (int, error) {
+	if db == nil {
+		db = r.db
+	}
+
+	var version int
+	err := whereResource(db.WithContext(ctx).Model(&log_schema.LogSchema{}).Where("tenant_id = ?", tenantId), resource).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).Error
+	return version, err
+}
+
+// FindActive returns the active schema for the resource, falling back to the
+// tenant-wide schema. It returns nil when the tenant has no applicable schema.
+func (r *logSchemaRepository) FindActive(ctx context.Context, tenantId string, resource *string) (*log_schema.LogSchema, error) {
+	candidates := []*string{nil}
+	if resource != nil && len(*resource) > 0 {
+		candidates = []*string{resource, nil}
+	}
+
+	for _, res := range candidates {
+		var s log_schema.LogSchema
+		err := whereResource(r.db.WithContext(ctx).Where("tenant_id = ? AND is_active = ?", tenantId, true), res).
+			Order("version DESC").
+			First(&s).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &s, nil
+	}
+	return nil, nil
+}
+
+func (r *logSchemaRepository) Deactivate(ctx context.Context, db *gorm.DB, tenantId string, resource *string) error {
+	if db == nil {
+		db = r.db
+	}
+	return whereResource(db.WithContext(ctx).Model(&log_schema.LogSchema{}).Where("tenant_id = ?", tenantId), resource).
+		Update("is_active", false).Error
+}
+
+func whereResource(q *gorm.DB, resource *string) *gorm.DB {
+	if resource == nil || len(*resource) == 0 {
+		return q.Where("resource IS NULL")
+	}
+	return q.Where("resource = ?", *resource)
+}
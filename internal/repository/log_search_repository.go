@@ -8,22 +8,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
 	"github.com/Haevnen/audit-logging-api/pkg/logger"
 )
 
 type LogSearchFilters struct {
-	TenantID  *string
-	UserID    *string
-	Action    *string
-	Resource  *string
-	Severity  *string
-	StartDate *string
-	EndDate   *string
-	Query     *string
-	Page      int
-	PageSize  int
+	TenantID    *string
+	UserID      *string
+	Action      *string
+	Resource    *string
+	Severity    *string
+	StartDate   *string
+	EndDate     *string
+	Query       *string
+	ChangedPath *string
+	System      bool // search the service's own audit trail instead of the tenant's logs
+	Page        int
+	PageSize    int
 }
 
 type SearchResult struct {
@@ -34,8 +39,20 @@ type SearchResult struct {
 type LogSearchRepository interface {
 	Search(ctx context.Context, filters LogSearchFilters) (*SearchResult, error)
 	Stream(ctx context.Context, filters LogSearchFilters, fn func(log.Log) error) error
+	// CountByTenant counts the documents of each tenant with an event timestamp in [startTime, endTime)
+	CountByTenant(ctx context.Context, startTime, endTime time.Time) (map[string]int64, error)
+	// ListIDs returns the ids of the documents with an event timestamp in [startTime, endTime), of every tenant
+	// when tenantId is nil
+	ListIDs(ctx context.Context, tenantId *string, startTime, endTime time.Time) ([]string, error)
 }
 
+const (
+	// maxCountedTenants bounds the tenants CountByTenant returns
+	maxCountedTenants = 10000
+	// idPageSize is the number of ids ListIDs reads per request
+	idPageSize = 5000
+)
+
 type openSearchRepo struct {
 	baseURL   string
 	indexName string
@@ -46,7 +63,7 @@ func NewLogSearchRepository(baseURL, indexName string) LogSearchRepository {
 	return &openSearchRepo{
 		baseURL:   baseURL,
 		indexName: indexName,
-		client:    &http.Client{},
+		client:    &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)},
 	}
 }
 
@@ -61,7 +78,7 @@ func (r *openSearchRepo) Search(ctx context.Context, filters LogSearchFilters) (
 	// Build ES query
 	query := buildQuery(filters, &from)
 	payload, _ := json.Marshal(query)
-	logger.GetLogger().Info(string(payload))
+	logger.FromContext(ctx).Info(string(payload))
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payload))
 	if err != nil {
@@ -110,7 +127,7 @@ func (r *openSearchRepo) Search(ctx context.Context, filters LogSearchFilters) (
 func (r *openSearchRepo) Stream(ctx context.Context, filters LogSearchFilters, fn func(log.Log) error) error {
 	size := 1000
 	searchAfter := []interface{}{}
-	logger := logger.GetLogger()
+	logger := logger.FromContext(ctx)
 
 	for {
 		query := buildQuery(filters, nil) // reuse your search filters
@@ -174,29 +191,43 @@ func buildQuery(filters LogSearchFilters, from *int) map[string]interface{} {
 
 	query["sort"] = []map[string]interface{}{
 		{"EventTimestamp": map[string]string{"order": "asc"}},
-		{"ID.keyword": map[string]string{"order": "asc"}}, // tie-breaker
+		{"ID": map[string]string{"order": "asc"}}, // tie-breaker
 	}
 
 	boolQuery := query["query"].(map[string]interface{})["bool"].(map[string]interface{})
 
+	// documents indexed before the system stream existed have no System field, so the tenant's
+	// logs are matched by excluding system entries rather than by System=false
+	systemTerm := map[string]interface{}{"term": map[string]interface{}{"System": true}}
+	if filters.System {
+		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]interface{}), systemTerm)
+	} else {
+		boolQuery["must_not"] = []map[string]interface{}{systemTerm}
+	}
+
 	if filters.TenantID != nil && *filters.TenantID != "" {
 		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]interface{}), map[string]interface{}{
-			"term": map[string]interface{}{"TenantID.keyword": *filters.TenantID},
+			"term": map[string]interface{}{"TenantID": *filters.TenantID},
 		})
 	}
 	if filters.UserID != nil && *filters.UserID != "" {
 		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]interface{}), map[string]interface{}{
-			"term": map[string]interface{}{"UserID.keyword": *filters.UserID},
+			"term": map[string]interface{}{"UserID": *filters.UserID},
 		})
 	}
 	if filters.Action != nil && *filters.Action != "" {
 		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]interface{}), map[string]interface{}{
-			"term": map[string]interface{}{"Action.keyword": *filters.Action},
+			"term": map[string]interface{}{"Action": *filters.Action},
 		})
 	}
 	if filters.Severity != nil && *filters.Severity != "" {
 		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]interface{}), map[string]interface{}{
-			"term": map[string]interface{}{"Severity.keyword": *filters.Severity},
+			"term": map[string]interface{}{"Severity": *filters.Severity},
+		})
+	}
+	if filters.ChangedPath != nil && *filters.ChangedPath != "" {
+		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]interface{}), map[string]interface{}{
+			"term": map[string]interface{}{"Diff.path": *filters.ChangedPath},
 		})
 	}
 	if filters.StartDate != nil && filters.EndDate != nil {
@@ -240,3 +271,133 @@ func buildQuery(filters LogSearchFilters, from *int) map[string]interface{} {
 
 	return query
 }
+
+func (r *openSearchRepo) CountByTenant(ctx context.Context, startTime, endTime time.Time) (map[string]int64, error) {
+	url := fmt.Sprintf("%s/%s/_search", r.baseURL, r.indexName)
+	query := map[string]interface{}{
+		"size": 0,
+		"query": map[string]interface{}{
+			"range": map[string]interface{}{
+				"EventTimestamp": map[string]interface{}{
+					"gte": startTime.UTC().Format(time.RFC3339Nano),
+					"lt":  endTime.UTC().Format(time.RFC3339Nano),
+				},
+			},
+		},
+		"aggs": map[string]interface{}{
+			"tenants": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "TenantID", "size": maxCountedTenants},
+			},
+		},
+	}
+	payload, _ := json.Marshal(query)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var errBody bytes.Buffer
+		_, _ = errBody.ReadFrom(resp.Body)
+		return nil, fmt.Errorf("opensearch error: %s - %s", resp.Status, errBody.String())
+	}
+
+	var res struct {
+		Aggregations struct {
+			Tenants struct {
+				Buckets []struct {
+					Key      string `json:"key"`
+					DocCount int64  `json:"doc_count"`
+				} `json:"buckets"`
+			} `json:"tenants"`
+		} `json:"aggregations"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(res.Aggregations.Tenants.Buckets))
+	for _, b := range res.Aggregations.Tenants.Buckets {
+		counts[b.Key] = b.DocCount
+	}
+	return counts, nil
+}
+
+func (r *openSearchRepo) ListIDs(ctx context.Context, tenantId *string, startTime, endTime time.Time) ([]string, error) {
+	url := fmt.Sprintf("%s/%s/_search", r.baseURL, r.indexName)
+	filters := []map[string]interface{}{{
+		"range": map[string]interface{}{
+			"EventTimestamp": map[string]interface{}{
+				"gte": startTime.UTC().Format(time.RFC3339Nano),
+				"lt":  endTime.UTC().Format(time.RFC3339Nano),
+			},
+		},
+	}}
+	if tenantId != nil && len(*tenantId) > 0 {
+		filters = append(filters, map[string]interface{}{
+			"term": map[string]interface{}{"TenantID": *tenantId},
+		})
+	}
+
+	ids := []string{}
+	var searchAfter []interface{}
+	for {
+		query := map[string]interface{}{
+			"size":    idPageSize,
+			"_source": false,
+			"query":   map[string]interface{}{"bool": map[string]interface{}{"filter": filters}},
+			"sort":    []map[string]interface{}{{"ID": map[string]string{"order": "asc"}}},
+		}
+		if len(searchAfter) > 0 {
+			query["search_after"] = searchAfter
+		}
+		payload, _ := json.Marshal(query)
+
+		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := r.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var res struct {
+			Hits struct {
+				Hits []struct {
+					ID   string        `json:"_id"`
+					Sort []interface{} `json:"sort"`
+				} `json:"hits"`
+			} `json:"hits"`
+		}
+		if resp.StatusCode >= 300 {
+			var errBody bytes.Buffer
+			_, _ = errBody.ReadFrom(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("opensearch error: %s - %s", resp.Status, errBody.String())
+		}
+		err = json.NewDecoder(resp.Body).Decode(&res)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range res.Hits.Hits {
+			ids = append(ids, h.ID)
+			searchAfter = h.Sort
+		}
+		if len(res.Hits.Hits) < idPageSize {
+			return ids, nil
+		}
+	}
+}
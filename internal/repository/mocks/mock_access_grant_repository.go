@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: access_grant_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=access_grant_repository.go -destination=./mocks/mock_access_grant_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	access_grant "github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccessGrantRepository is a mock of AccessGrantRepository interface.
+type MockAccessGrantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessGrantRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessGrantRepositoryMockRecorder is the mock recorder for MockAccessGrantRepository.
+type MockAccessGrantRepositoryMockRecorder struct {
+	mock *MockAccessGrantRepository
+}
+
+// NewMockAccessGrantRepository creates a new mock instance.
+func NewMockAccessGrantRepository(ctrl *gomock.Controller) *MockAccessGrantRepository {
+	mock := &MockAccessGrantRepository{ctrl: ctrl}
+	mock.recorder = &MockAccessGrantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessGrantRepository) EXPECT() *MockAccessGrantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAccessGrantRepository) Create(ctx context.Context, g *access_grant.AccessGrant) (*access_grant.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, g)
+	ret0, _ := ret[0].(*access_grant.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccessGrantRepositoryMockRecorder) Create(ctx, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccessGrantRepository)(nil).Create), ctx, g)
+}
+
+// FindActive mocks base method.
+func (m *MockAccessGrantRepository) FindActive(ctx context.Context, granteeTenantId, granteeId, tenantId string, now time.Time) (*access_grant.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, granteeTenantId, granteeId, tenantId, now)
+	ret0, _ := ret[0].(*access_grant.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockAccessGrantRepositoryMockRecorder) FindActive(ctx, granteeTenantId, granteeId, tenantId, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockAccessGrantRepository)(nil).FindActive), ctx, granteeTenantId, granteeId, tenantId, now)
+}
+
+// GetByID mocks base method.
+func (m *MockAccessGrantRepository) GetByID(ctx context.Context, id string) (*access_grant.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*access_grant.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAccessGrantRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAccessGrantRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockAccessGrantRepository) List(ctx context.Context, granteeId, tenantId string) ([]access_grant.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, granteeId, tenantId)
+	ret0, _ := ret[0].([]access_grant.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAccessGrantRepositoryMockRecorder) List(ctx, granteeId, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAccessGrantRepository)(nil).List), ctx, granteeId, tenantId)
+}
+
+// Update mocks base method.
+func (m *MockAccessGrantRepository) Update(ctx context.Context, g *access_grant.AccessGrant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, g)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAccessGrantRepositoryMockRecorder) Update(ctx, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccessGrantRepository)(nil).Update), ctx, g)
+}
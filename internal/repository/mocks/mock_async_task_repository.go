@@ -12,9 +12,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	async_task "github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	gomock "go.uber.org/mock/gomock"
+	datatypes "gorm.io/datatypes"
 	gorm "gorm.io/gorm"
 )
 
@@ -42,6 +44,36 @@ func (m *MockAsyncTaskRepository) EXPECT() *MockAsyncTaskRepositoryMockRecorder
 	return m.recorder
 }
 
+// Claim mocks base method.
+func (m *MockAsyncTaskRepository) Claim(ctx context.Context, taskID string, staleBefore time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, taskID, staleBefore)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockAsyncTaskRepositoryMockRecorder) Claim(ctx, taskID, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockAsyncTaskRepository)(nil).Claim), ctx, taskID, staleBefore)
+}
+
+// CountOpen mocks base method.
+func (m *MockAsyncTaskRepository) CountOpen(ctx context.Context, failedSince time.Time) ([]async_task.TaskCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpen", ctx, failedSince)
+	ret0, _ := ret[0].([]async_task.TaskCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpen indicates an expected call of CountOpen.
+func (mr *MockAsyncTaskRepositoryMockRecorder) CountOpen(ctx, failedSince any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpen", reflect.TypeOf((*MockAsyncTaskRepository)(nil).CountOpen), ctx, failedSince)
+}
+
 // Create mocks base method.
 func (m *MockAsyncTaskRepository) Create(ctx context.Context, db *gorm.DB, task *async_task.AsyncTask) (*async_task.AsyncTask, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +89,21 @@ func (mr *MockAsyncTaskRepositoryMockRecorder) Create(ctx, db, task any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAsyncTaskRepository)(nil).Create), ctx, db, task)
 }
 
+// CreateIfAbsent mocks base method.
+func (m *MockAsyncTaskRepository) CreateIfAbsent(ctx context.Context, db *gorm.DB, task *async_task.AsyncTask) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfAbsent", ctx, db, task)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIfAbsent indicates an expected call of CreateIfAbsent.
+func (mr *MockAsyncTaskRepositoryMockRecorder) CreateIfAbsent(ctx, db, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfAbsent", reflect.TypeOf((*MockAsyncTaskRepository)(nil).CreateIfAbsent), ctx, db, task)
+}
+
 // GetByID mocks base method.
 func (m *MockAsyncTaskRepository) GetByID(ctx context.Context, taskID string) (*async_task.AsyncTask, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +119,34 @@ func (mr *MockAsyncTaskRepositoryMockRecorder) GetByID(ctx, taskID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAsyncTaskRepository)(nil).GetByID), ctx, taskID)
 }
 
+// Touch mocks base method.
+func (m *MockAsyncTaskRepository) Touch(ctx context.Context, taskID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockAsyncTaskRepositoryMockRecorder) Touch(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockAsyncTaskRepository)(nil).Touch), ctx, taskID)
+}
+
+// UpdateProgress mocks base method.
+func (m *MockAsyncTaskRepository) UpdateProgress(ctx context.Context, taskID string, progress datatypes.JSON) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", ctx, taskID, progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockAsyncTaskRepositoryMockRecorder) UpdateProgress(ctx, taskID, progress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockAsyncTaskRepository)(nil).UpdateProgress), ctx, taskID, progress)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockAsyncTaskRepository) UpdateStatus(ctx context.Context, db *gorm.DB, taskID string, status async_task.AsyncTaskStatus, errorMsg *string) error {
 	m.ctrl.T.Helper()
@@ -58,6 +58,21 @@ func (mr *MockLogRepositoryMockRecorder) CleanupLogsBefore(ctx, db, tenantId, be
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupLogsBefore", reflect.TypeOf((*MockLogRepository)(nil).CleanupLogsBefore), ctx, db, tenantId, beforeDate)
 }
 
+// CountByTenant mocks base method.
+func (m *MockLogRepository) CountByTenant(ctx context.Context, startTime, endTime time.Time) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTenant", ctx, startTime, endTime)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTenant indicates an expected call of CountByTenant.
+func (mr *MockLogRepositoryMockRecorder) CountByTenant(ctx, startTime, endTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTenant", reflect.TypeOf((*MockLogRepository)(nil).CountByTenant), ctx, startTime, endTime)
+}
+
 // Create mocks base method.
 func (m *MockLogRepository) Create(ctx context.Context, arg1 *log.Log) error {
 	m.ctrl.T.Helper()
@@ -86,6 +101,36 @@ func (mr *MockLogRepositoryMockRecorder) CreateBulk(ctx, db, logs any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBulk", reflect.TypeOf((*MockLogRepository)(nil).CreateBulk), ctx, db, logs)
 }
 
+// DeleteTenantLogs mocks base method.
+func (m *MockLogRepository) DeleteTenantLogs(ctx context.Context, db *gorm.DB, tenantId string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantLogs", ctx, db, tenantId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantLogs indicates an expected call of DeleteTenantLogs.
+func (mr *MockLogRepositoryMockRecorder) DeleteTenantLogs(ctx, db, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantLogs", reflect.TypeOf((*MockLogRepository)(nil).DeleteTenantLogs), ctx, db, tenantId)
+}
+
+// FindByIDs mocks base method.
+func (m *MockLogRepository) FindByIDs(ctx context.Context, ids []string) ([]log.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]log.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockLogRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockLogRepository)(nil).FindByIDs), ctx, ids)
+}
+
 // FindLogsForArchival mocks base method.
 func (m *MockLogRepository) FindLogsForArchival(ctx context.Context, tenantId *string, beforeDate time.Time) ([]log.Log, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +146,21 @@ func (mr *MockLogRepositoryMockRecorder) FindLogsForArchival(ctx, tenantId, befo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLogsForArchival", reflect.TypeOf((*MockLogRepository)(nil).FindLogsForArchival), ctx, tenantId, beforeDate)
 }
 
+// FindTenantLogsAfter mocks base method.
+func (m *MockLogRepository) FindTenantLogsAfter(ctx context.Context, tenantId string, after *log.Log, limit int) ([]log.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTenantLogsAfter", ctx, tenantId, after, limit)
+	ret0, _ := ret[0].([]log.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTenantLogsAfter indicates an expected call of FindTenantLogsAfter.
+func (mr *MockLogRepositoryMockRecorder) FindTenantLogsAfter(ctx, tenantId, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTenantLogsAfter", reflect.TypeOf((*MockLogRepository)(nil).FindTenantLogsAfter), ctx, tenantId, after, limit)
+}
+
 // GetByID mocks base method.
 func (m *MockLogRepository) GetByID(ctx context.Context, id, tenantId string) (*log.Log, error) {
 	m.ctrl.T.Helper()
@@ -117,16 +177,91 @@ func (mr *MockLogRepositoryMockRecorder) GetByID(ctx, id, tenantId any) *gomock.
 }
 
 // GetStats mocks base method.
-func (m *MockLogRepository) GetStats(ctx context.Context, tenantId string, startTime, endTime time.Time) ([]log.LogStats, error) {
+func (m *MockLogRepository) GetStats(ctx context.Context, q log.StatsQuery) ([]log.StatPoint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStats", ctx, tenantId, startTime, endTime)
-	ret0, _ := ret[0].([]log.LogStats)
+	ret := m.ctrl.Call(m, "GetStats", ctx, q)
+	ret0, _ := ret[0].([]log.StatPoint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStats indicates an expected call of GetStats.
-func (mr *MockLogRepositoryMockRecorder) GetStats(ctx, tenantId, startTime, endTime any) *gomock.Call {
+func (mr *MockLogRepositoryMockRecorder) GetStats(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockLogRepository)(nil).GetStats), ctx, q)
+}
+
+// GetStatsFreshness mocks base method.
+func (m *MockLogRepository) GetStatsFreshness(ctx context.Context, q log.StatsQuery) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsFreshness", ctx, q)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsFreshness indicates an expected call of GetStatsFreshness.
+func (mr *MockLogRepositoryMockRecorder) GetStatsFreshness(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsFreshness", reflect.TypeOf((*MockLogRepository)(nil).GetStatsFreshness), ctx, q)
+}
+
+// GetTopActivity mocks base method.
+func (m *MockLogRepository) GetTopActivity(ctx context.Context, q log.TopQuery) ([]log.TopEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopActivity", ctx, q)
+	ret0, _ := ret[0].([]log.TopEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopActivity indicates an expected call of GetTopActivity.
+func (mr *MockLogRepositoryMockRecorder) GetTopActivity(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopActivity", reflect.TypeOf((*MockLogRepository)(nil).GetTopActivity), ctx, q)
+}
+
+// ListIDs mocks base method.
+func (m *MockLogRepository) ListIDs(ctx context.Context, tenantId *string, startTime, endTime time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIDs", ctx, tenantId, startTime, endTime)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIDs indicates an expected call of ListIDs.
+func (mr *MockLogRepositoryMockRecorder) ListIDs(ctx, tenantId, startTime, endTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIDs", reflect.TypeOf((*MockLogRepository)(nil).ListIDs), ctx, tenantId, startTime, endTime)
+}
+
+// ListTenantSpans mocks base method.
+func (m *MockLogRepository) ListTenantSpans(ctx context.Context, startTime time.Time) ([]log.TenantSpan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantSpans", ctx, startTime)
+	ret0, _ := ret[0].([]log.TenantSpan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantSpans indicates an expected call of ListTenantSpans.
+func (mr *MockLogRepositoryMockRecorder) ListTenantSpans(ctx, startTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantSpans", reflect.TypeOf((*MockLogRepository)(nil).ListTenantSpans), ctx, startTime)
+}
+
+// ListUserIPs mocks base method.
+func (m *MockLogRepository) ListUserIPs(ctx context.Context, tenantId string, startTime, endTime time.Time) ([]log.UserIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserIPs", ctx, tenantId, startTime, endTime)
+	ret0, _ := ret[0].([]log.UserIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserIPs indicates an expected call of ListUserIPs.
+func (mr *MockLogRepositoryMockRecorder) ListUserIPs(ctx, tenantId, startTime, endTime any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockLogRepository)(nil).GetStats), ctx, tenantId, startTime, endTime)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserIPs", reflect.TypeOf((*MockLogRepository)(nil).ListUserIPs), ctx, tenantId, startTime, endTime)
 }
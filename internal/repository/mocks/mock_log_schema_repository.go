@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: log_schema_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=log_schema_repository.go -destination=./mocks/mock_log_schema_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	log_schema "github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockLogSchemaRepository is a mock of LogSchemaRepository interface.
+type MockLogSchemaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogSchemaRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLogSchemaRepositoryMockRecorder is the mock recorder for MockLogSchemaRepository.
+type MockLogSchemaRepositoryMockRecorder struct {
+	mock *MockLogSchemaRepository
+}
+
+// NewMockLogSchemaRepository creates a new mock instance.
+func NewMockLogSchemaRepository(ctrl *gomock.Controller) *MockLogSchemaRepository {
+	mock := &MockLogSchemaRepository{ctrl: ctrl}
+	mock.recorder = &MockLogSchemaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogSchemaRepository) EXPECT() *MockLogSchemaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLogSchemaRepository) Create(ctx context.Context, db *gorm.DB, s *log_schema.LogSchema) (*log_schema.LogSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, db, s)
+	ret0, _ := ret[0].(*log_schema.LogSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLogSchemaRepositoryMockRecorder) Create(ctx, db, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLogSchemaRepository)(nil).Create), ctx, db, s)
+}
+
+// Deactivate mocks base method.
+func (m *MockLogSchemaRepository) Deactivate(ctx context.Context, db *gorm.DB, tenantId string, resource *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, db, tenantId, resource)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockLogSchemaRepositoryMockRecorder) Deactivate(ctx, db, tenantId, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockLogSchemaRepository)(nil).Deactivate), ctx, db, tenantId, resource)
+}
+
+// FindActive mocks base method.
+func (m *MockLogSchemaRepository) FindActive(ctx context.Context, tenantId string, resource *string) (*log_schema.LogSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, tenantId, resource)
+	ret0, _ := ret[0].(*log_schema.LogSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockLogSchemaRepositoryMockRecorder) FindActive(ctx, tenantId, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockLogSchemaRepository)(nil).FindActive), ctx, tenantId, resource)
+}
+
+// GetByID mocks base method.
+func (m *MockLogSchemaRepository) GetByID(ctx context.Context, id, tenantId string) (*log_schema.LogSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, tenantId)
+	ret0, _ := ret[0].(*log_schema.LogSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLogSchemaRepositoryMockRecorder) GetByID(ctx, id, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLogSchemaRepository)(nil).GetByID), ctx, id, tenantId)
+}
+
+// GetLatestVersion mocks base method.
+func (m *MockLogSchemaRepository) GetLatestVersion(ctx context.Context, db *gorm.DB, tenantId string, resource *string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestVersion", ctx, db, tenantId, resource)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestVersion indicates an expected call of GetLatestVersion.
+func (mr *MockLogSchemaRepositoryMockRecorder) GetLatestVersion(ctx, db, tenantId, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestVersion", reflect.TypeOf((*MockLogSchemaRepository)(nil).GetLatestVersion), ctx, db, tenantId, resource)
+}
+
+// List mocks base method.
+func (m *MockLogSchemaRepository) List(ctx context.Context, tenantId string, resource *string, allVersions bool) ([]log_schema.LogSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tenantId, resource, allVersions)
+	ret0, _ := ret[0].([]log_schema.LogSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLogSchemaRepositoryMockRecorder) List(ctx, tenantId, resource, allVersions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLogSchemaRepository)(nil).List), ctx, tenantId, resource, allVersions)
+}
+
+// LockVersions mocks base method.
+func (m *MockLogSchemaRepository) LockVersions(ctx context.Context, db *gorm.DB, tenantId string, resource *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockVersions", ctx, db, tenantId, resource)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockVersions indicates an expected call of LockVersions.
+func (mr *MockLogSchemaRepositoryMockRecorder) LockVersions(ctx, db, tenantId, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockVersions", reflect.TypeOf((*MockLogSchemaRepository)(nil).LockVersions), ctx, db, tenantId, resource)
+}
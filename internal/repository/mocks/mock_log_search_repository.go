@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	log "github.com/Haevnen/audit-logging-api/internal/entity/log"
 	repository "github.com/Haevnen/audit-logging-api/internal/repository"
@@ -42,6 +43,36 @@ func (m *MockLogSearchRepository) EXPECT() *MockLogSearchRepositoryMockRecorder
 	return m.recorder
 }
 
+// CountByTenant mocks base method.
+func (m *MockLogSearchRepository) CountByTenant(ctx context.Context, startTime, endTime time.Time) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTenant", ctx, startTime, endTime)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTenant indicates an expected call of CountByTenant.
+func (mr *MockLogSearchRepositoryMockRecorder) CountByTenant(ctx, startTime, endTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTenant", reflect.TypeOf((*MockLogSearchRepository)(nil).CountByTenant), ctx, startTime, endTime)
+}
+
+// ListIDs mocks base method.
+func (m *MockLogSearchRepository) ListIDs(ctx context.Context, tenantId *string, startTime, endTime time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIDs", ctx, tenantId, startTime, endTime)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIDs indicates an expected call of ListIDs.
+func (mr *MockLogSearchRepositoryMockRecorder) ListIDs(ctx, tenantId, startTime, endTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIDs", reflect.TypeOf((*MockLogSearchRepository)(nil).ListIDs), ctx, tenantId, startTime, endTime)
+}
+
 // Search mocks base method.
 func (m *MockLogSearchRepository) Search(ctx context.Context, filters repository.LogSearchFilters) (*repository.SearchResult, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: redaction_rule_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=redaction_rule_repository.go -destination=./mocks/mock_redaction_rule_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	redaction_rule "github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRedactionRuleRepository is a mock of RedactionRuleRepository interface.
+type MockRedactionRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedactionRuleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRedactionRuleRepositoryMockRecorder is the mock recorder for MockRedactionRuleRepository.
+type MockRedactionRuleRepositoryMockRecorder struct {
+	mock *MockRedactionRuleRepository
+}
+
+// NewMockRedactionRuleRepository creates a new mock instance.
+func NewMockRedactionRuleRepository(ctrl *gomock.Controller) *MockRedactionRuleRepository {
+	mock := &MockRedactionRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockRedactionRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedactionRuleRepository) EXPECT() *MockRedactionRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRedactionRuleRepository) Create(ctx context.Context, rule *redaction_rule.RedactionRule) (*redaction_rule.RedactionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rule)
+	ret0, _ := ret[0].(*redaction_rule.RedactionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRedactionRuleRepositoryMockRecorder) Create(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRedactionRuleRepository)(nil).Create), ctx, rule)
+}
+
+// Delete mocks base method.
+func (m *MockRedactionRuleRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRedactionRuleRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRedactionRuleRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockRedactionRuleRepository) GetByID(ctx context.Context, id, tenantId string) (*redaction_rule.RedactionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, tenantId)
+	ret0, _ := ret[0].(*redaction_rule.RedactionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRedactionRuleRepositoryMockRecorder) GetByID(ctx, id, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRedactionRuleRepository)(nil).GetByID), ctx, id, tenantId)
+}
+
+// List mocks base method.
+func (m *MockRedactionRuleRepository) List(ctx context.Context, tenantId string) ([]redaction_rule.RedactionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tenantId)
+	ret0, _ := ret[0].([]redaction_rule.RedactionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRedactionRuleRepositoryMockRecorder) List(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRedactionRuleRepository)(nil).List), ctx, tenantId)
+}
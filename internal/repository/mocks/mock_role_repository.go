@@ -0,0 +1,189 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: role_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=role_repository.go -destination=./mocks/mock_role_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	role "github.com/Haevnen/audit-logging-api/internal/entity/role"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleRepository is a mock of RoleRepository interface.
+type MockRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleRepositoryMockRecorder is the mock recorder for MockRoleRepository.
+type MockRoleRepositoryMockRecorder struct {
+	mock *MockRoleRepository
+}
+
+// NewMockRoleRepository creates a new mock instance.
+func NewMockRoleRepository(ctrl *gomock.Controller) *MockRoleRepository {
+	mock := &MockRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleRepository) EXPECT() *MockRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRoleRepository) Create(ctx context.Context, r *role.Role) (*role.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(*role.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleRepositoryMockRecorder) Create(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleRepository)(nil).Create), ctx, r)
+}
+
+// CreateBinding mocks base method.
+func (m *MockRoleRepository) CreateBinding(ctx context.Context, b *role.Binding) (*role.Binding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBinding", ctx, b)
+	ret0, _ := ret[0].(*role.Binding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBinding indicates an expected call of CreateBinding.
+func (mr *MockRoleRepositoryMockRecorder) CreateBinding(ctx, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBinding", reflect.TypeOf((*MockRoleRepository)(nil).CreateBinding), ctx, b)
+}
+
+// Delete mocks base method.
+func (m *MockRoleRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteBinding mocks base method.
+func (m *MockRoleRepository) DeleteBinding(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBinding", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBinding indicates an expected call of DeleteBinding.
+func (mr *MockRoleRepositoryMockRecorder) DeleteBinding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBinding", reflect.TypeOf((*MockRoleRepository)(nil).DeleteBinding), ctx, id)
+}
+
+// GetBindingByID mocks base method.
+func (m *MockRoleRepository) GetBindingByID(ctx context.Context, id string) (*role.Binding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBindingByID", ctx, id)
+	ret0, _ := ret[0].(*role.Binding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBindingByID indicates an expected call of GetBindingByID.
+func (mr *MockRoleRepositoryMockRecorder) GetBindingByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBindingByID", reflect.TypeOf((*MockRoleRepository)(nil).GetBindingByID), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockRoleRepository) GetByID(ctx context.Context, id string) (*role.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*role.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRoleRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRoleRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRoleRepository) List(ctx context.Context, tenantId string) ([]role.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tenantId)
+	ret0, _ := ret[0].([]role.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRoleRepositoryMockRecorder) List(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleRepository)(nil).List), ctx, tenantId)
+}
+
+// ListBindings mocks base method.
+func (m *MockRoleRepository) ListBindings(ctx context.Context, tenantId, userId string) ([]role.Binding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBindings", ctx, tenantId, userId)
+	ret0, _ := ret[0].([]role.Binding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBindings indicates an expected call of ListBindings.
+func (mr *MockRoleRepositoryMockRecorder) ListBindings(ctx, tenantId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBindings", reflect.TypeOf((*MockRoleRepository)(nil).ListBindings), ctx, tenantId, userId)
+}
+
+// ListRolesOfUser mocks base method.
+func (m *MockRoleRepository) ListRolesOfUser(ctx context.Context, tenantId, userId string) ([]role.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolesOfUser", ctx, tenantId, userId)
+	ret0, _ := ret[0].([]role.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolesOfUser indicates an expected call of ListRolesOfUser.
+func (mr *MockRoleRepositoryMockRecorder) ListRolesOfUser(ctx, tenantId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolesOfUser", reflect.TypeOf((*MockRoleRepository)(nil).ListRolesOfUser), ctx, tenantId, userId)
+}
+
+// Update mocks base method.
+func (m *MockRoleRepository) Update(ctx context.Context, r *role.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRoleRepositoryMockRecorder) Update(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoleRepository)(nil).Update), ctx, r)
+}
@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant_limit_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tenant_limit_repository.go -destination=./mocks/mock_tenant_limit_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	tenant_limit "github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	tenant_usage "github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantLimitRepository is a mock of TenantLimitRepository interface.
+type MockTenantLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantLimitRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantLimitRepositoryMockRecorder is the mock recorder for MockTenantLimitRepository.
+type MockTenantLimitRepositoryMockRecorder struct {
+	mock *MockTenantLimitRepository
+}
+
+// NewMockTenantLimitRepository creates a new mock instance.
+func NewMockTenantLimitRepository(ctrl *gomock.Controller) *MockTenantLimitRepository {
+	mock := &MockTenantLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockTenantLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantLimitRepository) EXPECT() *MockTenantLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeUsage mocks base method.
+func (m *MockTenantLimitRepository) ConsumeUsage(ctx context.Context, tenantId string, day time.Time, events, bytes, eventQuota, byteQuota int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeUsage", ctx, tenantId, day, events, bytes, eventQuota, byteQuota)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeUsage indicates an expected call of ConsumeUsage.
+func (mr *MockTenantLimitRepositoryMockRecorder) ConsumeUsage(ctx, tenantId, day, events, bytes, eventQuota, byteQuota any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeUsage", reflect.TypeOf((*MockTenantLimitRepository)(nil).ConsumeUsage), ctx, tenantId, day, events, bytes, eventQuota, byteQuota)
+}
+
+// Get mocks base method.
+func (m *MockTenantLimitRepository) Get(ctx context.Context, tenantId string) (*tenant_limit.TenantLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tenantId)
+	ret0, _ := ret[0].(*tenant_limit.TenantLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTenantLimitRepositoryMockRecorder) Get(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTenantLimitRepository)(nil).Get), ctx, tenantId)
+}
+
+// GetUsage mocks base method.
+func (m *MockTenantLimitRepository) GetUsage(ctx context.Context, tenantId string, day time.Time) (*tenant_usage.DailyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, tenantId, day)
+	ret0, _ := ret[0].(*tenant_usage.DailyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockTenantLimitRepositoryMockRecorder) GetUsage(ctx, tenantId, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockTenantLimitRepository)(nil).GetUsage), ctx, tenantId, day)
+}
+
+// ListMonthlyUsage mocks base method.
+func (m *MockTenantLimitRepository) ListMonthlyUsage(ctx context.Context, month time.Time) ([]tenant_usage.MonthlyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMonthlyUsage", ctx, month)
+	ret0, _ := ret[0].([]tenant_usage.MonthlyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMonthlyUsage indicates an expected call of ListMonthlyUsage.
+func (mr *MockTenantLimitRepositoryMockRecorder) ListMonthlyUsage(ctx, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonthlyUsage", reflect.TypeOf((*MockTenantLimitRepository)(nil).ListMonthlyUsage), ctx, month)
+}
+
+// ListUsage mocks base method.
+func (m *MockTenantLimitRepository) ListUsage(ctx context.Context, tenantId string, from, to time.Time) ([]tenant_usage.DailyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsage", ctx, tenantId, from, to)
+	ret0, _ := ret[0].([]tenant_usage.DailyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsage indicates an expected call of ListUsage.
+func (mr *MockTenantLimitRepositoryMockRecorder) ListUsage(ctx, tenantId, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsage", reflect.TypeOf((*MockTenantLimitRepository)(nil).ListUsage), ctx, tenantId, from, to)
+}
+
+// RecordActivity mocks base method.
+func (m *MockTenantLimitRepository) RecordActivity(ctx context.Context, usages []tenant_usage.DailyUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordActivity", ctx, usages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordActivity indicates an expected call of RecordActivity.
+func (mr *MockTenantLimitRepositoryMockRecorder) RecordActivity(ctx, usages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivity", reflect.TypeOf((*MockTenantLimitRepository)(nil).RecordActivity), ctx, usages)
+}
+
+// RefundUsage mocks base method.
+func (m *MockTenantLimitRepository) RefundUsage(ctx context.Context, tenantId string, day time.Time, events, bytes int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundUsage", ctx, tenantId, day, events, bytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundUsage indicates an expected call of RefundUsage.
+func (mr *MockTenantLimitRepositoryMockRecorder) RefundUsage(ctx, tenantId, day, events, bytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundUsage", reflect.TypeOf((*MockTenantLimitRepository)(nil).RefundUsage), ctx, tenantId, day, events, bytes)
+}
+
+// Upsert mocks base method.
+func (m *MockTenantLimitRepository) Upsert(ctx context.Context, l *tenant_limit.TenantLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, l)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockTenantLimitRepositoryMockRecorder) Upsert(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockTenantLimitRepository)(nil).Upsert), ctx, l)
+}
@@ -15,6 +15,7 @@ import (
 
 	tenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
 	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
 )
 
 // MockTenantRepository is a mock of TenantRepository interface.
@@ -56,6 +57,35 @@ func (mr *MockTenantRepositoryMockRecorder) Create(ctx, t any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTenantRepository)(nil).Create), ctx, t)
 }
 
+// Delete mocks base method.
+func (m *MockTenantRepository) Delete(ctx context.Context, db *gorm.DB, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, db, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTenantRepositoryMockRecorder) Delete(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTenantRepository)(nil).Delete), ctx, db, id)
+}
+
+// GetByID mocks base method.
+func (m *MockTenantRepository) GetByID(ctx context.Context, id string) (*tenant.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*tenant.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTenantRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTenantRepository)(nil).GetByID), ctx, id)
+}
+
 // List mocks base method.
 func (m *MockTenantRepository) List(ctx context.Context) ([]tenant.Tenant, error) {
 	m.ctrl.T.Helper()
@@ -70,3 +100,17 @@ func (mr *MockTenantRepositoryMockRecorder) List(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTenantRepository)(nil).List), ctx)
 }
+
+// Update mocks base method.
+func (m *MockTenantRepository) Update(ctx context.Context, db *gorm.DB, t *tenant.Tenant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, db, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTenantRepositoryMockRecorder) Update(ctx, db, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTenantRepository)(nil).Update), ctx, db, t)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: token_revocation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=token_revocation_repository.go -destination=./mocks/mock_token_revocation_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	token_revocation "github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTokenRevocationRepository is a mock of TokenRevocationRepository interface.
+type MockTokenRevocationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenRevocationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenRevocationRepositoryMockRecorder is the mock recorder for MockTokenRevocationRepository.
+type MockTokenRevocationRepositoryMockRecorder struct {
+	mock *MockTokenRevocationRepository
+}
+
+// NewMockTokenRevocationRepository creates a new mock instance.
+func NewMockTokenRevocationRepository(ctrl *gomock.Controller) *MockTokenRevocationRepository {
+	mock := &MockTokenRevocationRepository{ctrl: ctrl}
+	mock.recorder = &MockTokenRevocationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenRevocationRepository) EXPECT() *MockTokenRevocationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRevokedBefore mocks base method.
+func (m *MockTokenRevocationRepository) GetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId, subjectId string) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevokedBefore", ctx, subjectType, tenantId, subjectId)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevokedBefore indicates an expected call of GetRevokedBefore.
+func (mr *MockTokenRevocationRepositoryMockRecorder) GetRevokedBefore(ctx, subjectType, tenantId, subjectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevokedBefore", reflect.TypeOf((*MockTokenRevocationRepository)(nil).GetRevokedBefore), ctx, subjectType, tenantId, subjectId)
+}
+
+// IsTokenRevoked mocks base method.
+func (m *MockTokenRevocationRepository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", ctx, jti)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockTokenRevocationRepositoryMockRecorder) IsTokenRevoked(ctx, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockTokenRevocationRepository)(nil).IsTokenRevoked), ctx, jti)
+}
+
+// ListActiveTokens mocks base method.
+func (m *MockTokenRevocationRepository) ListActiveTokens(ctx context.Context, now time.Time) ([]token_revocation.RevokedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveTokens", ctx, now)
+	ret0, _ := ret[0].([]token_revocation.RevokedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveTokens indicates an expected call of ListActiveTokens.
+func (mr *MockTokenRevocationRepositoryMockRecorder) ListActiveTokens(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveTokens", reflect.TypeOf((*MockTokenRevocationRepository)(nil).ListActiveTokens), ctx, now)
+}
+
+// ListSessions mocks base method.
+func (m *MockTokenRevocationRepository) ListSessions(ctx context.Context) ([]token_revocation.SessionRevocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx)
+	ret0, _ := ret[0].([]token_revocation.SessionRevocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockTokenRevocationRepositoryMockRecorder) ListSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockTokenRevocationRepository)(nil).ListSessions), ctx)
+}
+
+// RevokeSessions mocks base method.
+func (m *MockTokenRevocationRepository) RevokeSessions(ctx context.Context, s *token_revocation.SessionRevocation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessions", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockTokenRevocationRepositoryMockRecorder) RevokeSessions(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockTokenRevocationRepository)(nil).RevokeSessions), ctx, s)
+}
+
+// RevokeToken mocks base method.
+func (m *MockTokenRevocationRepository) RevokeToken(ctx context.Context, t *token_revocation.RevokedToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockTokenRevocationRepositoryMockRecorder) RevokeToken(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockTokenRevocationRepository)(nil).RevokeToken), ctx, t)
+}
@@ -0,0 +1,60 @@
+package repository
+
+//go:generate mockgen -source=redaction_rule_repository.go -destination=./mocks/mock_redaction_rule_repository.go -package=mocks
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+)
+
+type RedactionRuleRepository interface {
+	Create(ctx context.Context, rule *redaction_rule.RedactionRule) (*redaction_rule.RedactionRule, error)
+	GetByID(ctx context.Context, id string, tenantId string) (*redaction_rule.RedactionRule, error)
+	List(ctx context.Context, tenantId string) ([]redaction_rule.RedactionRule, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type redactionRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewRedactionRuleRepository(db *gorm.DB) *redactionRuleRepository {
+	return &redactionRuleRepository{db: db}
+}
+
+func (r *redactionRuleRepository) Create(ctx context.Context, rule *redaction_rule.RedactionRule) (*redaction_rule.RedactionRule, error) {
+	if err := r.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *redactionRuleRepository) GetByID(ctx context.Context, id string, tenantId string) (*redaction_rule.RedactionRule, error) {
+	var rule redaction_rule.RedactionRule
+	q := r.db.WithContext(ctx).Where("id = ?", id)
+
+	if len(tenantId) > 0 {
+		// user or auditor
+		q = q.Where("tenant_id = ?", tenantId)
+	}
+	err := q.First(&rule).Error
+	return &rule, err
+}
+
+func (r *redactionRuleRepository) List(ctx context.Context, tenantId string) ([]redaction_rule.RedactionRule, error) {
+	var rules []redaction_rule.RedactionRule
+	q := r.db.WithContext(ctx)
+
+	if len(tenantId) > 0 {
+		q = q.Where("tenant_id = ?", tenantId)
+	}
+	err := q.Order("tenant_id, created_at").Find(&rules).Error
+	return rules, err
+}
+
+func (r *redactionRuleRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&redaction_rule.RedactionRule{}).Error
+}
@@ -0,0 +1,113 @@
+package repository
+
+//go:generate mockgen -source=role_repository.go -destination=./mocks/mock_role_repository.go -package=mocks
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+)
+
+type RoleRepository interface {
+	Create(ctx context.Context, r *role.Role) (*role.Role, error)
+	Update(ctx context.Context, r *role.Role) error
+	GetByID(ctx context.Context, id string) (*role.Role, error)
+	List(ctx context.Context, tenantId string) ([]role.Role, error)
+	Delete(ctx context.Context, id string) error
+
+	CreateBinding(ctx context.Context, b *role.Binding) (*role.Binding, error)
+	GetBindingByID(ctx context.Context, id string) (*role.Binding, error)
+	ListBindings(ctx context.Context, tenantId, userId string) ([]role.Binding, error)
+	DeleteBinding(ctx context.Context, id string) error
+	// ListRolesOfUser returns the roles bound to the user, tenantId is empty for platform users
+	ListRolesOfUser(ctx context.Context, tenantId, userId string) ([]role.Role, error)
+}
+
+type roleRepository struct {
+	db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) *roleRepository {
+	return &roleRepository{db: db}
+}
+
+func (r *roleRepository) Create(ctx context.Context, ro *role.Role) (*role.Role, error) {
+	if err := r.db.WithContext(ctx).Create(ro).Error; err != nil {
+		return nil, err
+	}
+	return ro, nil
+}
+
+func (r *roleRepository) Update(ctx context.Context, ro *role.Role) error {
+	return r.db.WithContext(ctx).Save(ro).Error
+}
+
+func (r *roleRepository) GetByID(ctx context.Context, id string) (*role.Role, error) {
+	var ro role.Role
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&ro).Error
+	return &ro, err
+}
+
+// List returns the global roles and those of the tenant, or every role when tenantId is empty
+func (r *roleRepository) List(ctx context.Context, tenantId string) ([]role.Role, error) {
+	var roles []role.Role
+	q := r.db.WithContext(ctx)
+
+	if len(tenantId) > 0 {
+		q = q.Where("tenant_id IS NULL OR tenant_id = ?", tenantId)
+	}
+	err := q.Order("tenant_id NULLS FIRST, name").Find(&roles).Error
+	return roles, err
+}
+
+func (r *roleRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&role.Role{}).Error
+}
+
+func (r *roleRepository) CreateBinding(ctx context.Context, b *role.Binding) (*role.Binding, error) {
+	if err := r.db.WithContext(ctx).Create(b).Error; err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (r *roleRepository) GetBindingByID(ctx context.Context, id string) (*role.Binding, error) {
+	var b role.Binding
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&b).Error
+	return &b, err
+}
+
+func (r *roleRepository) ListBindings(ctx context.Context, tenantId, userId string) ([]role.Binding, error) {
+	var bindings []role.Binding
+	q := r.db.WithContext(ctx)
+
+	if len(tenantId) > 0 {
+		q = q.Where("tenant_id = ?", tenantId)
+	}
+	if len(userId) > 0 {
+		q = q.Where("user_id = ?", userId)
+	}
+	err := q.Order("tenant_id NULLS FIRST, user_id, created_at").Find(&bindings).Error
+	return bindings, err
+}
+
+func (r *roleRepository) DeleteBinding(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&role.Binding{}).Error
+}
+
+func (r *roleRepository) ListRolesOfUser(ctx context.Context, tenantId, userId string) ([]role.Role, error) {
+	var roles []role.Role
+	q := r.db.WithContext(ctx).
+		Joins("JOIN role_bindings ON role_bindings.role_id = roles.id").
+		Where("role_bindings.user_id = ?", userId)
+
+	if len(tenantId) > 0 {
+		q = q.Where("role_bindings.tenant_id = ?", tenantId)
+	} else {
+		q = q.Where("role_bindings.tenant_id IS NULL")
+	}
+	err := q.Find(&roles).Error
+	return roles, err
+}
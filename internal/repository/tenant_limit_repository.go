@@ -0,0 +1,153 @@
+package repository
+
+//go:generate mockgen -source=tenant_limit_repository.go -destination=./mocks/mock_tenant_limit_repository.go -package=mocks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+)
+
+type TenantLimitRepository interface {
+	Get(ctx context.Context, tenantId string) (*tenant_limit.TenantLimit, error)
+	Upsert(ctx context.Context, l *tenant_limit.TenantLimit) error
+	// ConsumeUsage adds to the usage of the day unless it would exceed a quota, a zero quota is unlimited.
+	// It reports whether the usage was added.
+	ConsumeUsage(ctx context.Context, tenantId string, day time.Time, events, bytes, eventQuota, byteQuota int64) (bool, error)
+	// RefundUsage takes back events and bytes consumed that day, the usage never goes below zero
+	RefundUsage(ctx context.Context, tenantId string, day time.Time, events, bytes int64) error
+	GetUsage(ctx context.Context, tenantId string, day time.Time) (*tenant_usage.DailyUsage, error)
+	// ListUsage returns the usage of the tenant on the days from from to to included, the days without usage
+	// have no row
+	ListUsage(ctx context.Context, tenantId string, from, to time.Time) ([]tenant_usage.DailyUsage, error)
+	// RecordActivity adds the stored bytes, searches and exports of each usage to the day of its tenant in one
+	// statement, the usages of a tenant deleted since are dropped
+	RecordActivity(ctx context.Context, usages []tenant_usage.DailyUsage) error
+	// ListMonthlyUsage sums the usage of each tenant over the month starting at month
+	ListMonthlyUsage(ctx context.Context, month time.Time) ([]tenant_usage.MonthlyUsage, error)
+}
+
+type tenantLimitRepository struct {
+	db *gorm.DB
+}
+
+func NewTenantLimitRepository(db *gorm.DB) *tenantLimitRepository {
+	return &tenantLimitRepository{db: db}
+}
+
+func (r *tenantLimitRepository) Get(ctx context.Context, tenantId string) (*tenant_limit.TenantLimit, error) {
+	var l tenant_limit.TenantLimit
+	return &l, r.db.WithContext(ctx).Where("tenant_id = ?", tenantId).First(&l).Error
+}
+
+// Upsert replaces every override of the tenant
+func (r *tenantLimitRepository) Upsert(ctx context.Context, l *tenant_limit.TenantLimit) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"read_rps", "read_burst", "write_rps", "write_burst", "export_rps", "export_burst",
+			"daily_event_quota", "daily_byte_quota", "updated_by", "updated_at",
+		}),
+	}).Create(l).Error
+}
+
+func (r *tenantLimitRepository) ConsumeUsage(ctx context.Context, tenantId string, day time.Time, events, bytes, eventQuota, byteQuota int64) (bool, error) {
+	// the first statement of the day is checked by the SELECT, the following ones by the conflict update
+	query := `
+		INSERT INTO tenant_usage_daily (tenant_id, day, events, bytes, updated_at)
+		SELECT CAST(@tenant AS UUID), CAST(@day AS DATE), CAST(@events AS BIGINT), CAST(@bytes AS BIGINT), NOW()
+		WHERE (@event_quota = 0 OR @events <= @event_quota) AND (@byte_quota = 0 OR @bytes <= @byte_quota)
+		ON CONFLICT (tenant_id, day) DO UPDATE SET
+			events = tenant_usage_daily.events + EXCLUDED.events,
+			bytes = tenant_usage_daily.bytes + EXCLUDED.bytes,
+			updated_at = EXCLUDED.updated_at
+		WHERE (@event_quota = 0 OR tenant_usage_daily.events + EXCLUDED.events <= @event_quota)
+			AND (@byte_quota = 0 OR tenant_usage_daily.bytes + EXCLUDED.bytes <= @byte_quota)`
+
+	res := r.db.WithContext(ctx).Exec(query, map[string]interface{}{
+		"tenant":      tenantId,
+		"day":         day.Format("2006-01-02"),
+		"events":      events,
+		"bytes":       bytes,
+		"event_quota": eventQuota,
+		"byte_quota":  byteQuota,
+	})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *tenantLimitRepository) RefundUsage(ctx context.Context, tenantId string, day time.Time, events, bytes int64) error {
+	return r.db.WithContext(ctx).Model(&tenant_usage.DailyUsage{}).
+		Where("tenant_id = ? AND day = ?", tenantId, day.Format("2006-01-02")).
+		Updates(map[string]interface{}{
+			"events":     gorm.Expr("GREATEST(events - ?, 0)", events),
+			"bytes":      gorm.Expr("GREATEST(bytes - ?, 0)", bytes),
+			"updated_at": time.Now().UTC(),
+		}).Error
+}
+
+// GetUsage returns a zero usage when nothing was ingested that day
+func (r *tenantLimitRepository) GetUsage(ctx context.Context, tenantId string, day time.Time) (*tenant_usage.DailyUsage, error) {
+	usages := []tenant_usage.DailyUsage{}
+	err := r.db.WithContext(ctx).Where("tenant_id = ? AND day = ?", tenantId, day.Format("2006-01-02")).Limit(1).Find(&usages).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(usages) == 0 {
+		return &tenant_usage.DailyUsage{TenantID: tenantId, Day: day}, nil
+	}
+	return &usages[0], nil
+}
+
+func (r *tenantLimitRepository) ListUsage(ctx context.Context, tenantId string, from, to time.Time) ([]tenant_usage.DailyUsage, error) {
+	usages := []tenant_usage.DailyUsage{}
+	err := r.db.WithContext(ctx).
+		Where("tenant_id = ? AND day >= ? AND day <= ?", tenantId, from.Format("2006-01-02"), to.Format("2006-01-02")).
+		Order("day").
+		Find(&usages).Error
+	return usages, err
+}
+
+func (r *tenantLimitRepository) RecordActivity(ctx context.Context, usages []tenant_usage.DailyUsage) error {
+	if len(usages) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(usages))
+	args := make([]interface{}, 0, 5*len(usages))
+	for _, u := range usages {
+		values = append(values, "(CAST(? AS UUID), CAST(? AS DATE), CAST(? AS BIGINT), CAST(? AS BIGINT), CAST(? AS BIGINT))")
+		args = append(args, u.TenantID, u.Day.Format("2006-01-02"), u.StoredBytes, u.Searches, u.Exports)
+	}
+	query := `
+		INSERT INTO tenant_usage_daily (tenant_id, day, stored_bytes, searches, exports, updated_at)
+		SELECT v.tenant_id, v.day, v.stored_bytes, v.searches, v.exports, NOW()
+		FROM (VALUES ` + strings.Join(values, ", ") + `) AS v (tenant_id, day, stored_bytes, searches, exports)
+		JOIN tenants t ON t.id = v.tenant_id
+		ON CONFLICT (tenant_id, day) DO UPDATE SET
+			stored_bytes = tenant_usage_daily.stored_bytes + EXCLUDED.stored_bytes,
+			searches = tenant_usage_daily.searches + EXCLUDED.searches,
+			exports = tenant_usage_daily.exports + EXCLUDED.exports,
+			updated_at = EXCLUDED.updated_at`
+	return r.db.WithContext(ctx).Exec(query, args...).Error
+}
+
+func (r *tenantLimitRepository) ListMonthlyUsage(ctx context.Context, month time.Time) ([]tenant_usage.MonthlyUsage, error) {
+	usages := []tenant_usage.MonthlyUsage{}
+	err := r.db.WithContext(ctx).
+		Table("tenant_usage_daily u").
+		Select(`u.tenant_id, t.name AS tenant_name, CAST(? AS DATE) AS month,
+			SUM(u.events) AS events, SUM(u.bytes) AS bytes, SUM(u.stored_bytes) AS stored_bytes, SUM(u.searches) AS searches, SUM(u.exports) AS exports`,
+			month.Format("2006-01-02")).
+		Joins("JOIN tenants t ON t.id = u.tenant_id").
+		Where("u.day >= ? AND u.day < ?", month.Format("2006-01-02"), month.AddDate(0, 1, 0).Format("2006-01-02")).
+		Group("u.tenant_id, t.name").
+		Order("t.name, u.tenant_id").
+		Scan(&usages).Error
+	return usages, err
+}
@@ -13,6 +13,9 @@ import (
 type TenantRepository interface {
 	Create(ctx context.Context, t *entity.Tenant) (*entity.Tenant, error)
 	List(ctx context.Context) ([]entity.Tenant, error)
+	GetByID(ctx context.Context, id string) (*entity.Tenant, error)
+	Update(ctx context.Context, db *gorm.DB, t *entity.Tenant) error
+	Delete(ctx context.Context, db *gorm.DB, id string) error
 }
 
 type tenantRepository struct {
@@ -32,6 +35,27 @@ func (r *tenantRepository) Create(ctx context.Context, tenant *entity.Tenant) (*
 
 func (r *tenantRepository) List(ctx context.Context) ([]entity.Tenant, error) {
 	var tenants []entity.Tenant
-	err := r.db.WithContext(ctx).Order("created_at asc").Find(&tenants).Error
+	// the reserved system tenant only holds the service's own audit trail
+	err := r.db.WithContext(ctx).Where("id <> ?", entity.SystemTenantID).Order("created_at asc").Find(&tenants).Error
 	return tenants, err
 }
+
+func (r *tenantRepository) GetByID(ctx context.Context, id string) (*entity.Tenant, error) {
+	var t entity.Tenant
+	return &t, r.db.WithContext(ctx).Where("id = ?", id).First(&t).Error
+}
+
+func (r *tenantRepository) Update(ctx context.Context, db *gorm.DB, t *entity.Tenant) error {
+	if db == nil {
+		db = r.db
+	}
+	return db.WithContext(ctx).Model(t).Select("name", "status", "settings", "updated_at").Updates(t).Error
+}
+
+// Delete removes the tenant row, its keys, roles, bindings, rules, schemas and logs go with it
+func (r *tenantRepository) Delete(ctx context.Context, db *gorm.DB, id string) error {
+	if db == nil {
+		db = r.db
+	}
+	return db.WithContext(ctx).Where("id = ?", id).Delete(&entity.Tenant{}).Error
+}
@@ -0,0 +1,77 @@
+package repository
+
+//go:generate mockgen -source=token_revocation_repository.go -destination=./mocks/mock_token_revocation_repository.go -package=mocks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+)
+
+type TokenRevocationRepository interface {
+	RevokeToken(ctx context.Context, t *token_revocation.RevokedToken) error
+	RevokeSessions(ctx context.Context, s *token_revocation.SessionRevocation) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	GetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId string, subjectId string) (*time.Time, error)
+	ListActiveTokens(ctx context.Context, now time.Time) ([]token_revocation.RevokedToken, error)
+	ListSessions(ctx context.Context) ([]token_revocation.SessionRevocation, error)
+}
+
+type tokenRevocationRepository struct {
+	db *gorm.DB
+}
+
+func NewTokenRevocationRepository(db *gorm.DB) *tokenRevocationRepository {
+	return &tokenRevocationRepository{db: db}
+}
+
+func (r *tokenRevocationRepository) RevokeToken(ctx context.Context, t *token_revocation.RevokedToken) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(t).Error
+}
+
+// RevokeSessions moves the cutoff of the subject forward, it never moves back
+func (r *tokenRevocationRepository) RevokeSessions(ctx context.Context, s *token_revocation.SessionRevocation) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "subject_type"}, {Name: "tenant_id"}, {Name: "subject_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"revoked_before": gorm.Expr("GREATEST(session_revocations.revoked_before, EXCLUDED.revoked_before)"),
+			"revoked_by":     gorm.Expr("EXCLUDED.revoked_by"),
+			"updated_at":     gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}).Create(s).Error
+}
+
+func (r *tokenRevocationRepository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&token_revocation.RevokedToken{}).Where("jti = ?", jti).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *tokenRevocationRepository) GetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId string, subjectId string) (*time.Time, error) {
+	var s token_revocation.SessionRevocation
+	err := r.db.WithContext(ctx).Where("subject_type = ? AND tenant_id = ? AND subject_id = ?", subjectType, tenantId, subjectId).First(&s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s.RevokedBefore, nil
+}
+
+func (r *tokenRevocationRepository) ListActiveTokens(ctx context.Context, now time.Time) ([]token_revocation.RevokedToken, error) {
+	var tokens []token_revocation.RevokedToken
+	err := r.db.WithContext(ctx).Where("expires_at > ?", now).Find(&tokens).Error
+	return tokens, err
+}
+
+func (r *tokenRevocationRepository) ListSessions(ctx context.Context) ([]token_revocation.SessionRevocation, error) {
+	var sessions []token_revocation.SessionRevocation
+	err := r.db.WithContext(ctx).Find(&sessions).Error
+	return sessions, err
+}
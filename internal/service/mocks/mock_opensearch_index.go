@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: opensearch_index.go
+//
+// Generated by this command:
+//
+//	mockgen -source=opensearch_index.go -destination=./mocks/mock_opensearch_index.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSearchIndexManager is a mock of SearchIndexManager interface.
+type MockSearchIndexManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchIndexManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchIndexManagerMockRecorder is the mock recorder for MockSearchIndexManager.
+type MockSearchIndexManagerMockRecorder struct {
+	mock *MockSearchIndexManager
+}
+
+// NewMockSearchIndexManager creates a new mock instance.
+func NewMockSearchIndexManager(ctrl *gomock.Controller) *MockSearchIndexManager {
+	mock := &MockSearchIndexManager{ctrl: ctrl}
+	mock.recorder = &MockSearchIndexManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchIndexManager) EXPECT() *MockSearchIndexManagerMockRecorder {
+	return m.recorder
+}
+
+// AbortRebuild mocks base method.
+func (m *MockSearchIndexManager) AbortRebuild(ctx context.Context, index string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortRebuild", ctx, index)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortRebuild indicates an expected call of AbortRebuild.
+func (mr *MockSearchIndexManagerMockRecorder) AbortRebuild(ctx, index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortRebuild", reflect.TypeOf((*MockSearchIndexManager)(nil).AbortRebuild), ctx, index)
+}
+
+// Bootstrap mocks base method.
+func (m *MockSearchIndexManager) Bootstrap(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bootstrap", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Bootstrap indicates an expected call of Bootstrap.
+func (mr *MockSearchIndexManagerMockRecorder) Bootstrap(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bootstrap", reflect.TypeOf((*MockSearchIndexManager)(nil).Bootstrap), ctx)
+}
+
+// DropExpired mocks base method.
+func (m *MockSearchIndexManager) DropExpired(ctx context.Context, retention time.Duration) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropExpired", ctx, retention)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DropExpired indicates an expected call of DropExpired.
+func (mr *MockSearchIndexManagerMockRecorder) DropExpired(ctx, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropExpired", reflect.TypeOf((*MockSearchIndexManager)(nil).DropExpired), ctx, retention)
+}
+
+// FinishRebuild mocks base method.
+func (m *MockSearchIndexManager) FinishRebuild(ctx context.Context, index string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRebuild", ctx, index)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishRebuild indicates an expected call of FinishRebuild.
+func (mr *MockSearchIndexManagerMockRecorder) FinishRebuild(ctx, index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRebuild", reflect.TypeOf((*MockSearchIndexManager)(nil).FinishRebuild), ctx, index)
+}
+
+// LiveVersion mocks base method.
+func (m *MockSearchIndexManager) LiveVersion(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LiveVersion", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LiveVersion indicates an expected call of LiveVersion.
+func (mr *MockSearchIndexManagerMockRecorder) LiveVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiveVersion", reflect.TypeOf((*MockSearchIndexManager)(nil).LiveVersion), ctx)
+}
+
+// Rollover mocks base method.
+func (m *MockSearchIndexManager) Rollover(ctx context.Context, maxAge time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollover", ctx, maxAge)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollover indicates an expected call of Rollover.
+func (mr *MockSearchIndexManagerMockRecorder) Rollover(ctx, maxAge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollover", reflect.TypeOf((*MockSearchIndexManager)(nil).Rollover), ctx, maxAge)
+}
+
+// StartRebuild mocks base method.
+func (m *MockSearchIndexManager) StartRebuild(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRebuild", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRebuild indicates an expected call of StartRebuild.
+func (mr *MockSearchIndexManagerMockRecorder) StartRebuild(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRebuild", reflect.TypeOf((*MockSearchIndexManager)(nil).StartRebuild), ctx)
+}
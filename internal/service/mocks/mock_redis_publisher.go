@@ -70,6 +70,21 @@ func (mr *MockPubSubMockRecorder) BroadcastLogs(ctx, logs any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastLogs", reflect.TypeOf((*MockPubSub)(nil).BroadcastLogs), ctx, logs)
 }
 
+// CloseTenantChannel mocks base method.
+func (m *MockPubSub) CloseTenantChannel(ctx context.Context, tenantId string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseTenantChannel", ctx, tenantId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseTenantChannel indicates an expected call of CloseTenantChannel.
+func (mr *MockPubSubMockRecorder) CloseTenantChannel(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseTenantChannel", reflect.TypeOf((*MockPubSub)(nil).CloseTenantChannel), ctx, tenantId)
+}
+
 // Publish mocks base method.
 func (m *MockPubSub) Publish(ctx context.Context, channel, message string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: redis_revocation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=redis_revocation.go -destination=./mocks/mock_redis_revocation.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	token_revocation "github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRevocationCache is a mock of RevocationCache interface.
+type MockRevocationCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockRevocationCacheMockRecorder is the mock recorder for MockRevocationCache.
+type MockRevocationCacheMockRecorder struct {
+	mock *MockRevocationCache
+}
+
+// NewMockRevocationCache creates a new mock instance.
+func NewMockRevocationCache(ctrl *gomock.Controller) *MockRevocationCache {
+	mock := &MockRevocationCache{ctrl: ctrl}
+	mock.recorder = &MockRevocationCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationCache) EXPECT() *MockRevocationCacheMockRecorder {
+	return m.recorder
+}
+
+// GetRevokedBefore mocks base method.
+func (m *MockRevocationCache) GetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId, subjectId string) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevokedBefore", ctx, subjectType, tenantId, subjectId)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevokedBefore indicates an expected call of GetRevokedBefore.
+func (mr *MockRevocationCacheMockRecorder) GetRevokedBefore(ctx, subjectType, tenantId, subjectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevokedBefore", reflect.TypeOf((*MockRevocationCache)(nil).GetRevokedBefore), ctx, subjectType, tenantId, subjectId)
+}
+
+// IsLoaded mocks base method.
+func (m *MockRevocationCache) IsLoaded(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLoaded", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsLoaded indicates an expected call of IsLoaded.
+func (mr *MockRevocationCacheMockRecorder) IsLoaded(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLoaded", reflect.TypeOf((*MockRevocationCache)(nil).IsLoaded), ctx)
+}
+
+// IsTokenRevoked mocks base method.
+func (m *MockRevocationCache) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", ctx, jti)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockRevocationCacheMockRecorder) IsTokenRevoked(ctx, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockRevocationCache)(nil).IsTokenRevoked), ctx, jti)
+}
+
+// MarkLoaded mocks base method.
+func (m *MockRevocationCache) MarkLoaded(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLoaded", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkLoaded indicates an expected call of MarkLoaded.
+func (mr *MockRevocationCacheMockRecorder) MarkLoaded(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLoaded", reflect.TypeOf((*MockRevocationCache)(nil).MarkLoaded), ctx)
+}
+
+// ResetLoaded mocks base method.
+func (m *MockRevocationCache) ResetLoaded(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLoaded", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetLoaded indicates an expected call of ResetLoaded.
+func (mr *MockRevocationCacheMockRecorder) ResetLoaded(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoaded", reflect.TypeOf((*MockRevocationCache)(nil).ResetLoaded), ctx)
+}
+
+// RevokeToken mocks base method.
+func (m *MockRevocationCache) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, jti, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockRevocationCacheMockRecorder) RevokeToken(ctx, jti, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockRevocationCache)(nil).RevokeToken), ctx, jti, expiresAt)
+}
+
+// SetRevokedBefore mocks base method.
+func (m *MockRevocationCache) SetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId, subjectId string, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRevokedBefore", ctx, subjectType, tenantId, subjectId, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRevokedBefore indicates an expected call of SetRevokedBefore.
+func (mr *MockRevocationCacheMockRecorder) SetRevokedBefore(ctx, subjectType, tenantId, subjectId, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRevokedBefore", reflect.TypeOf((*MockRevocationCache)(nil).SetRevokedBefore), ctx, subjectType, tenantId, subjectId, before)
+}
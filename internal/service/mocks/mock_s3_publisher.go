@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	log "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	service "github.com/Haevnen/audit-logging-api/internal/service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +42,21 @@ func (m *MockS3Publisher) EXPECT() *MockS3PublisherMockRecorder {
 	return m.recorder
 }
 
+// StartArchive mocks base method.
+func (m *MockS3Publisher) StartArchive(ctx context.Context, taskId string) (service.LogArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartArchive", ctx, taskId)
+	ret0, _ := ret[0].(service.LogArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartArchive indicates an expected call of StartArchive.
+func (mr *MockS3PublisherMockRecorder) StartArchive(ctx, taskId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartArchive", reflect.TypeOf((*MockS3Publisher)(nil).StartArchive), ctx, taskId)
+}
+
 // UploadLogs mocks base method.
 func (m *MockS3Publisher) UploadLogs(ctx context.Context, taskId string, logs []log.Log) error {
 	m.ctrl.T.Helper()
@@ -54,3 +70,69 @@ func (mr *MockS3PublisherMockRecorder) UploadLogs(ctx, taskId, logs any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLogs", reflect.TypeOf((*MockS3Publisher)(nil).UploadLogs), ctx, taskId, logs)
 }
+
+// MockLogArchive is a mock of LogArchive interface.
+type MockLogArchive struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogArchiveMockRecorder
+	isgomock struct{}
+}
+
+// MockLogArchiveMockRecorder is the mock recorder for MockLogArchive.
+type MockLogArchiveMockRecorder struct {
+	mock *MockLogArchive
+}
+
+// NewMockLogArchive creates a new mock instance.
+func NewMockLogArchive(ctrl *gomock.Controller) *MockLogArchive {
+	mock := &MockLogArchive{ctrl: ctrl}
+	mock.recorder = &MockLogArchiveMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogArchive) EXPECT() *MockLogArchiveMockRecorder {
+	return m.recorder
+}
+
+// Abort mocks base method.
+func (m *MockLogArchive) Abort(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Abort", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Abort indicates an expected call of Abort.
+func (mr *MockLogArchiveMockRecorder) Abort(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockLogArchive)(nil).Abort), ctx)
+}
+
+// Complete mocks base method.
+func (m *MockLogArchive) Complete(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockLogArchiveMockRecorder) Complete(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockLogArchive)(nil).Complete), ctx)
+}
+
+// Write mocks base method.
+func (m *MockLogArchive) Write(ctx context.Context, logs []log.Log) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, logs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockLogArchiveMockRecorder) Write(ctx, logs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockLogArchive)(nil).Write), ctx, logs)
+}
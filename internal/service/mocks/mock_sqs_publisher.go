@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	log "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	ops "github.com/Haevnen/audit-logging-api/internal/entity/ops"
 	service "github.com/Haevnen/audit-logging-api/internal/service"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -99,6 +100,63 @@ func (mr *MockSQSPublisherMockRecorder) PublishIndexMessage(ctx, taskId, logs an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishIndexMessage", reflect.TypeOf((*MockSQSPublisher)(nil).PublishIndexMessage), ctx, taskId, logs)
 }
 
+// PublishIndexRebuildMessage mocks base method.
+func (m *MockSQSPublisher) PublishIndexRebuildMessage(ctx context.Context, taskId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishIndexRebuildMessage", ctx, taskId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishIndexRebuildMessage indicates an expected call of PublishIndexRebuildMessage.
+func (mr *MockSQSPublisherMockRecorder) PublishIndexRebuildMessage(ctx, taskId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishIndexRebuildMessage", reflect.TypeOf((*MockSQSPublisher)(nil).PublishIndexRebuildMessage), ctx, taskId)
+}
+
+// PublishReindexMessage mocks base method.
+func (m *MockSQSPublisher) PublishReindexMessage(ctx context.Context, taskId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishReindexMessage", ctx, taskId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishReindexMessage indicates an expected call of PublishReindexMessage.
+func (mr *MockSQSPublisherMockRecorder) PublishReindexMessage(ctx, taskId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishReindexMessage", reflect.TypeOf((*MockSQSPublisher)(nil).PublishReindexMessage), ctx, taskId)
+}
+
+// PublishTenantDeletionMessage mocks base method.
+func (m *MockSQSPublisher) PublishTenantDeletionMessage(ctx context.Context, taskId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishTenantDeletionMessage", ctx, taskId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishTenantDeletionMessage indicates an expected call of PublishTenantDeletionMessage.
+func (mr *MockSQSPublisherMockRecorder) PublishTenantDeletionMessage(ctx, taskId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishTenantDeletionMessage", reflect.TypeOf((*MockSQSPublisher)(nil).PublishTenantDeletionMessage), ctx, taskId)
+}
+
+// QueueStats mocks base method.
+func (m *MockSQSPublisher) QueueStats(ctx context.Context) ([]ops.QueueStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueStats", ctx)
+	ret0, _ := ret[0].([]ops.QueueStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueStats indicates an expected call of QueueStats.
+func (mr *MockSQSPublisherMockRecorder) QueueStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueStats", reflect.TypeOf((*MockSQSPublisher)(nil).QueueStats), ctx)
+}
+
 // ReceiveMessages mocks base method.
 func (m *MockSQSPublisher) ReceiveMessages(ctx context.Context, queueURL string, maxMessages, waitTimeSeconds int32) ([]service.ReceiveMessage, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,498 @@
+package service
+
+//go:generate mockgen -source=opensearch_index.go -destination=./mocks/mock_opensearch_index.go -package=mocks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// LogsReadAlias spans every index holding logs, searches and deletes go through it. It is also the name of
+	// the single index the logs were written to before the indices were managed, which Bootstrap migrates.
+	LogsReadAlias = "logs"
+	// LogsWriteAlias points at the one index new logs are written to, a new index takes over at every rollover
+	LogsWriteAlias = "logs-write"
+	// LogsRebuildAlias points at the index of a rebuild until it takes the other aliases, the logs are written
+	// to it as well meanwhile
+	LogsRebuildAlias = "logs-rebuild"
+
+	// LogsIndexVersion is the version of logsMapping, indices are named logs-v<version>-<n>. It is bumped with
+	// every change to the mapping, the indices of the previous version are then rebuilt by an index rebuild task.
+	LogsIndexVersion = 1
+	// maxManagedIndices bounds the indices DropExpired looks at
+	maxManagedIndices = 1000
+)
+
+// IndexSettings are the settings of every index created from the template
+type IndexSettings struct {
+	Shards   int
+	Replicas int
+}
+
+// SearchIndexManager owns the layout of the logs in OpenSearch: an index template with the mapping of log.Log,
+// time-based indices behind a write alias rolled over as they age, and a read alias spanning all of them so
+// that expired indices are simply dropped.
+type SearchIndexManager interface {
+	// Bootstrap installs the index template and creates the first index and the aliases when missing. The legacy
+	// logs index is copied into the first index then replaced by the read alias in one step.
+	Bootstrap(ctx context.Context) error
+	// Rollover moves the write alias to a new index once the current one is older than maxAge, it returns
+	// the new index, empty when the current one is kept
+	Rollover(ctx context.Context, maxAge time.Duration) (string, error)
+	// DropExpired deletes the indices, the write index aside, whose newest log is older than retention,
+	// along with the empty ones. It returns the deleted indices.
+	DropExpired(ctx context.Context, retention time.Duration) ([]string, error)
+
+	// LiveVersion returns the version of the index behind the write alias
+	LiveVersion(ctx context.Context) (int, error)
+	// StartRebuild creates the first index of LogsIndexVersion behind the rebuild alias, with refreshes off until
+	// FinishRebuild. The index left by an interrupted rebuild is started over. It fails with
+	// ErrSearchIndexUpToDate when the live version is LogsIndexVersion already.
+	StartRebuild(ctx context.Context) (string, error)
+	// FinishRebuild moves the read and write aliases to the index of the rebuild in one step, then drops the
+	// indices of the previous version. It returns the dropped indices.
+	FinishRebuild(ctx context.Context, index string) ([]string, error)
+	// AbortRebuild drops the index of the rebuild, the logs stop being written to it
+	AbortRebuild(ctx context.Context, index string) error
+}
+
+var ErrSearchIndexUpToDate = errors.New("search index up to date")
+
+type openSearchIndexManager struct {
+	baseURL  string
+	settings IndexSettings
+	client   *http.Client
+}
+
+func NewSearchIndexManager(baseURL string, settings IndexSettings) SearchIndexManager {
+	return &openSearchIndexManager{
+		baseURL:  baseURL,
+		settings: settings,
+		client:   &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)},
+	}
+}
+
+// openSearchError is a response of OpenSearch with an error status
+type openSearchError struct {
+	Status int
+	Type   string
+	Body   string
+}
+
+func (e *openSearchError) Error() string {
+	return fmt.Sprintf("opensearch error: status %d - %s", e.Status, e.Body)
+}
+
+func isOpenSearchError(err error, status int, errType string) bool {
+	var osErr *openSearchError
+	return errors.As(err, &osErr) && osErr.Status == status && (len(errType) == 0 || osErr.Type == errType)
+}
+
+func logsIndexTemplate(version int) string {
+	return fmt.Sprintf("logs-v%d", version)
+}
+
+func logsIndexPattern(version int) string {
+	return fmt.Sprintf("logs-v%d-*", version)
+}
+
+func firstLogsIndex(version int) string {
+	return fmt.Sprintf("logs-v%d-000001", version)
+}
+
+// logsMapping maps the fields of log.Log as encoding/json writes them: ids and enums are keywords, matched
+// exactly, the message is full text and the states stay dynamic objects searched by the multi_match of Search
+func logsMapping() map[string]interface{} {
+	keyword := map[string]interface{}{"type": "keyword"}
+	longKeyword := map[string]interface{}{"type": "keyword", "ignore_above": 1024}
+	disabled := map[string]interface{}{"type": "object", "enabled": false}
+	object := map[string]interface{}{"type": "object"}
+
+	return map[string]interface{}{
+		"dynamic": true,
+		"properties": map[string]interface{}{
+			"ID":             keyword,
+			"TenantID":       keyword,
+			"UserID":         keyword,
+			"Action":         keyword,
+			"Severity":       keyword,
+			"EventTimestamp": map[string]interface{}{"type": "date"},
+			"Message":        map[string]interface{}{"type": "text"},
+			"SessionID":      keyword,
+			"Resource":       keyword,
+			"ResourceID":     keyword,
+			// an address that isn't one is left out of the index rather than failing the document
+			"IPAddress":        map[string]interface{}{"type": "ip", "ignore_malformed": true},
+			"UserAgent":        map[string]interface{}{"type": "text"},
+			"BeforeState":      object,
+			"AfterState":       object,
+			"Metadata":         object,
+			"SchemaID":         keyword,
+			"SchemaViolations": disabled,
+			"Redactions":       disabled,
+			"Diff": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"path":      keyword,
+					"op":        keyword,
+					"old_value": longKeyword,
+					"new_value": longKeyword,
+				},
+			},
+			"System": map[string]interface{}{"type": "boolean"},
+		},
+	}
+}
+
+// putTemplate installs the template of the indices of the version, with the current mapping
+func (m *openSearchIndexManager) putTemplate(ctx context.Context, version int) error {
+	if err := m.do(ctx, http.MethodPut, "/_index_template/"+logsIndexTemplate(version), map[string]interface{}{
+		"index_patterns": []string{logsIndexPattern(version)},
+		"priority":       100,
+		"template": map[string]interface{}{
+			"settings": map[string]interface{}{
+				"number_of_shards":   m.settings.Shards,
+				"number_of_replicas": m.settings.Replicas,
+			},
+			"mappings": logsMapping(),
+		},
+	}, nil); err != nil {
+		return fmt.Errorf("put index template: %w", err)
+	}
+	return nil
+}
+
+func (m *openSearchIndexManager) Bootstrap(ctx context.Context) error {
+	version := LogsIndexVersion
+	if err := m.putTemplate(ctx, version); err != nil {
+		return err
+	}
+
+	// the write alias only goes missing on a new cluster, once created it follows the rollovers
+	writeIndices, err := m.aliasIndices(ctx, LogsWriteAlias)
+	if err != nil {
+		return err
+	}
+	if len(writeIndices) == 0 {
+		err := m.do(ctx, http.MethodPut, "/"+firstLogsIndex(version), map[string]interface{}{
+			"aliases": map[string]interface{}{LogsWriteAlias: map[string]interface{}{"is_write_index": true}},
+		}, nil)
+		if err != nil && !isOpenSearchError(err, http.StatusBadRequest, "resource_already_exists_exception") {
+			return fmt.Errorf("create index %s: %w", firstLogsIndex(version), err)
+		}
+	}
+
+	readIndices, err := m.aliasIndices(ctx, LogsReadAlias)
+	if err != nil {
+		return err
+	}
+	if len(readIndices) > 0 {
+		return nil
+	}
+
+	actions := []map[string]interface{}{
+		{"add": map[string]interface{}{"index": logsIndexPattern(version), "alias": LogsReadAlias}},
+	}
+	legacy, err := m.indexExists(ctx, LogsReadAlias)
+	if err != nil {
+		return err
+	}
+	if legacy {
+		if err := m.copyLegacyIndex(ctx); err != nil {
+			return err
+		}
+		// the legacy index is dropped and its name taken by the read alias at once, searches never miss
+		actions = append([]map[string]interface{}{{"remove_index": map[string]interface{}{"index": LogsReadAlias}}}, actions...)
+	}
+	if err := m.do(ctx, http.MethodPost, "/_aliases", map[string]interface{}{"actions": actions}, nil); err != nil {
+		return fmt.Errorf("create read alias: %w", err)
+	}
+	return nil
+}
+
+// copyLegacyIndex copies the documents of the legacy logs index through the write alias, so they get the mapping
+// of the template. The documents already copied by an interrupted run are kept.
+func (m *openSearchIndexManager) copyLegacyIndex(ctx context.Context) error {
+	var res struct {
+		Failures []json.RawMessage `json:"failures"`
+	}
+	err := m.do(ctx, http.MethodPost, "/_reindex?wait_for_completion=true&refresh=true", map[string]interface{}{
+		"conflicts": "proceed",
+		"source":    map[string]interface{}{"index": LogsReadAlias},
+		"dest":      map[string]interface{}{"index": LogsWriteAlias, "op_type": "create"},
+	}, &res)
+	if err != nil {
+		return fmt.Errorf("copy legacy index: %w", err)
+	}
+	if len(res.Failures) > 0 {
+		return fmt.Errorf("copy legacy index: %d documents failed, first: %s", len(res.Failures), res.Failures[0])
+	}
+	return nil
+}
+
+func (m *openSearchIndexManager) Rollover(ctx context.Context, maxAge time.Duration) (string, error) {
+	var res struct {
+		RolledOver bool   `json:"rolled_over"`
+		NewIndex   string `json:"new_index"`
+	}
+	err := m.do(ctx, http.MethodPost, "/"+LogsWriteAlias+"/_rollover", map[string]interface{}{
+		"conditions": map[string]interface{}{"max_age": fmt.Sprintf("%ds", int64(maxAge.Seconds()))},
+		// the new index joins the read alias as it is created
+		"aliases": map[string]interface{}{LogsReadAlias: map[string]interface{}{}},
+	}, &res)
+	if err != nil {
+		return "", fmt.Errorf("rollover: %w", err)
+	}
+	if !res.RolledOver {
+		return "", nil
+	}
+	return res.NewIndex, nil
+}
+
+func (m *openSearchIndexManager) DropExpired(ctx context.Context, retention time.Duration) ([]string, error) {
+	indices, err := m.aliasIndices(ctx, LogsReadAlias)
+	if err != nil {
+		return nil, err
+	}
+	writeIndices, err := m.aliasIndices(ctx, LogsWriteAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Aggregations struct {
+			Indices struct {
+				Buckets []struct {
+					Key    string `json:"key"`
+					Newest struct {
+						Value *float64 `json:"value"`
+					} `json:"newest"`
+				} `json:"buckets"`
+			} `json:"indices"`
+		} `json:"aggregations"`
+	}
+	err = m.do(ctx, http.MethodPost, "/"+LogsReadAlias+"/_search", map[string]interface{}{
+		"size": 0,
+		"aggs": map[string]interface{}{
+			"indices": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "_index", "size": maxManagedIndices},
+				"aggs":  map[string]interface{}{"newest": map[string]interface{}{"max": map[string]interface{}{"field": "EventTimestamp"}}},
+			},
+		},
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("find newest logs: %w", err)
+	}
+	newest := make(map[string]time.Time, len(res.Aggregations.Indices.Buckets))
+	for _, b := range res.Aggregations.Indices.Buckets {
+		if b.Newest.Value != nil {
+			newest[b.Key] = time.UnixMilli(int64(*b.Newest.Value))
+		}
+	}
+
+	cutoff := time.Now().Add(-retention)
+	expired := []string{}
+	for index := range indices {
+		if _, ok := writeIndices[index]; ok {
+			continue
+		}
+		if t, ok := newest[index]; !ok || t.Before(cutoff) {
+			expired = append(expired, index)
+		}
+	}
+	if len(expired) == 0 {
+		return expired, nil
+	}
+	sort.Strings(expired)
+
+	if err := m.do(ctx, http.MethodDelete, "/"+strings.Join(expired, ","), nil, nil); err != nil {
+		return nil, fmt.Errorf("delete indices: %w", err)
+	}
+	return expired, nil
+}
+
+func (m *openSearchIndexManager) LiveVersion(ctx context.Context) (int, error) {
+	indices, err := m.aliasIndices(ctx, LogsWriteAlias)
+	if err != nil {
+		return 0, err
+	}
+	for index := range indices {
+		var version int
+		if _, err := fmt.Sscanf(index, "logs-v%d-", &version); err != nil {
+			return 0, fmt.Errorf("write index %s has no version", index)
+		}
+		return version, nil
+	}
+	return 0, fmt.Errorf("no index behind %s", LogsWriteAlias)
+}
+
+func (m *openSearchIndexManager) StartRebuild(ctx context.Context) (string, error) {
+	version := LogsIndexVersion
+	live, err := m.LiveVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+	// the first index of the version is live, it must not be dropped below
+	if live >= version {
+		return "", fmt.Errorf("%w: the logs are indexed with version %d", ErrSearchIndexUpToDate, live)
+	}
+	if err := m.putTemplate(ctx, version); err != nil {
+		return "", err
+	}
+
+	index := firstLogsIndex(version)
+	if err := m.AbortRebuild(ctx, index); err != nil {
+		return "", err
+	}
+	// the backfill writes in bulk, the index is refreshed once at the end
+	err = m.do(ctx, http.MethodPut, "/"+index, map[string]interface{}{
+		"settings": map[string]interface{}{"index": map[string]interface{}{"refresh_interval": "-1"}},
+		"aliases":  map[string]interface{}{LogsRebuildAlias: map[string]interface{}{}},
+	}, nil)
+	if err != nil {
+		return "", fmt.Errorf("create index %s: %w", index, err)
+	}
+	return index, nil
+}
+
+func (m *openSearchIndexManager) FinishRebuild(ctx context.Context, index string) ([]string, error) {
+	live, err := m.LiveVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.do(ctx, http.MethodPut, "/"+index+"/_settings", map[string]interface{}{
+		"index": map[string]interface{}{"refresh_interval": nil},
+	}, nil); err != nil {
+		return nil, fmt.Errorf("restore refresh of %s: %w", index, err)
+	}
+	if err := m.do(ctx, http.MethodPost, "/"+index+"/_refresh", nil, nil); err != nil {
+		return nil, fmt.Errorf("refresh %s: %w", index, err)
+	}
+
+	// searches and writes see either every index of the previous version or the new one, never both
+	previous := logsIndexPattern(live)
+	if err := m.do(ctx, http.MethodPost, "/_aliases", map[string]interface{}{"actions": []map[string]interface{}{
+		{"remove": map[string]interface{}{"index": previous, "alias": LogsWriteAlias}},
+		{"remove": map[string]interface{}{"index": previous, "alias": LogsReadAlias}},
+		{"remove": map[string]interface{}{"index": index, "alias": LogsRebuildAlias}},
+		{"add": map[string]interface{}{"index": index, "alias": LogsWriteAlias, "is_write_index": true}},
+		{"add": map[string]interface{}{"index": index, "alias": LogsReadAlias}},
+	}}, nil); err != nil {
+		return nil, fmt.Errorf("switch aliases to %s: %w", index, err)
+	}
+
+	var res []struct {
+		Index string `json:"index"`
+	}
+	if err := m.do(ctx, http.MethodGet, "/_cat/indices/"+previous+"?format=json&h=index", nil, &res); err != nil {
+		return nil, fmt.Errorf("list indices %s: %w", previous, err)
+	}
+	dropped := make([]string, 0, len(res))
+	for _, r := range res {
+		dropped = append(dropped, r.Index)
+	}
+	if len(dropped) == 0 {
+		return dropped, nil
+	}
+	sort.Strings(dropped)
+
+	if err := m.do(ctx, http.MethodDelete, "/"+strings.Join(dropped, ","), nil, nil); err != nil {
+		return nil, fmt.Errorf("delete indices: %w", err)
+	}
+	return dropped, nil
+}
+
+func (m *openSearchIndexManager) AbortRebuild(ctx context.Context, index string) error {
+	writeIndices, err := m.aliasIndices(ctx, LogsWriteAlias)
+	if err != nil {
+		return err
+	}
+	if _, ok := writeIndices[index]; ok {
+		return fmt.Errorf("index %s is live", index)
+	}
+
+	err = m.do(ctx, http.MethodDelete, "/"+index, nil, nil)
+	if err != nil && !isOpenSearchError(err, http.StatusNotFound, "") {
+		return fmt.Errorf("delete index %s: %w", index, err)
+	}
+	return nil
+}
+
+// aliasIndices returns the indices behind the alias, none when it doesn't exist
+func (m *openSearchIndexManager) aliasIndices(ctx context.Context, alias string) (map[string]struct{}, error) {
+	var res map[string]json.RawMessage
+	err := m.do(ctx, http.MethodGet, "/_alias/"+alias, nil, &res)
+	if isOpenSearchError(err, http.StatusNotFound, "") {
+		return map[string]struct{}{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get alias %s: %w", alias, err)
+	}
+
+	indices := make(map[string]struct{}, len(res))
+	for index := range res {
+		indices[index] = struct{}{}
+	}
+	return indices, nil
+}
+
+func (m *openSearchIndexManager) indexExists(ctx context.Context, index string) (bool, error) {
+	err := m.do(ctx, http.MethodHead, "/"+index, nil, nil)
+	if isOpenSearchError(err, http.StatusNotFound, "") {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get index %s: %w", index, err)
+	}
+	return true, nil
+}
+
+// do sends body as JSON and decodes the response into out, an error status is returned as an openSearchError
+func (m *openSearchIndexManager) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, m.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		var errBody struct {
+			Error struct {
+				Type string `json:"type"`
+			} `json:"error"`
+		}
+		_ = json.Unmarshal(data, &errBody)
+		return &openSearchError{Status: resp.StatusCode, Type: errBody.Error.Type, Body: string(data)}
+	}
+	if out == nil || method == http.MethodHead {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
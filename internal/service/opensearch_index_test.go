@@ -0,0 +1,223 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Haevnen/audit-logging-api/internal/service"
+)
+
+// fakeOpenSearch answers the requests of the index manager from canned responses keyed by method and path,
+// anything else is a 404
+type fakeOpenSearch struct {
+	responses map[string]string
+	calls     []string
+	bodies    map[string]map[string]interface{}
+}
+
+func newFakeOpenSearch(t *testing.T, responses map[string]string) (*fakeOpenSearch, *httptest.Server) {
+	f := &fakeOpenSearch{responses: responses, bodies: map[string]map[string]interface{}{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		f.calls = append(f.calls, call)
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			var body map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &body))
+			f.bodies[call] = body
+		}
+
+		resp, ok := f.responses[call]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"type":"index_not_found_exception"},"status":404}`))
+			return
+		}
+		_, _ = w.Write([]byte(resp))
+	}))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func TestSearchIndexManager_Bootstrap_NewCluster(t *testing.T) {
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"PUT /_index_template/logs-v1": `{"acknowledged":true}`,
+		"PUT /logs-v1-000001":          `{"acknowledged":true}`,
+		"POST /_aliases":               `{"acknowledged":true}`,
+	})
+
+	err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{Shards: 1, Replicas: 0}).Bootstrap(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"PUT /_index_template/logs-v1",
+		"GET /_alias/logs-write",
+		"PUT /logs-v1-000001",
+		"GET /_alias/logs",
+		"HEAD /logs",
+		"POST /_aliases",
+	}, f.calls)
+
+	template := f.bodies["PUT /_index_template/logs-v1"]
+	assert.Equal(t, []interface{}{"logs-v1-*"}, template["index_patterns"])
+	properties := template["template"].(map[string]interface{})["mappings"].(map[string]interface{})["properties"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "keyword"}, properties["TenantID"])
+	assert.Equal(t, map[string]interface{}{"type": "date"}, properties["EventTimestamp"])
+
+	assert.Equal(t, map[string]interface{}{"logs-write": map[string]interface{}{"is_write_index": true}}, f.bodies["PUT /logs-v1-000001"]["aliases"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"add": map[string]interface{}{"index": "logs-v1-*", "alias": "logs"}},
+	}, f.bodies["POST /_aliases"]["actions"])
+}
+
+func TestSearchIndexManager_Bootstrap_LegacyIndex(t *testing.T) {
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"PUT /_index_template/logs-v1": `{"acknowledged":true}`,
+		"PUT /logs-v1-000001":          `{"acknowledged":true}`,
+		"HEAD /logs":                   ``,
+		"POST /_reindex":               `{"created":42,"failures":[]}`,
+		"POST /_aliases":               `{"acknowledged":true}`,
+	})
+
+	err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{Shards: 1, Replicas: 0}).Bootstrap(context.Background())
+
+	require.NoError(t, err)
+	assert.Contains(t, f.calls, "POST /_reindex")
+	assert.Equal(t, map[string]interface{}{"index": "logs-write", "op_type": "create"}, f.bodies["POST /_reindex"]["dest"])
+	// the legacy index gives its name to the read alias in one step
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"remove_index": map[string]interface{}{"index": "logs"}},
+		map[string]interface{}{"add": map[string]interface{}{"index": "logs-v1-*", "alias": "logs"}},
+	}, f.bodies["POST /_aliases"]["actions"])
+}
+
+func TestSearchIndexManager_Bootstrap_Done(t *testing.T) {
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"PUT /_index_template/logs-v1": `{"acknowledged":true}`,
+		"GET /_alias/logs-write":       `{"logs-v1-000003":{"aliases":{"logs-write":{"is_write_index":true}}}}`,
+		"GET /_alias/logs":             `{"logs-v1-000002":{},"logs-v1-000003":{}}`,
+	})
+
+	err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{}).Bootstrap(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PUT /_index_template/logs-v1", "GET /_alias/logs-write", "GET /_alias/logs"}, f.calls)
+}
+
+func TestSearchIndexManager_Rollover(t *testing.T) {
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"POST /logs-write/_rollover": `{"rolled_over":true,"old_index":"logs-v1-000001","new_index":"logs-v1-000002"}`,
+	})
+
+	index, err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{}).Rollover(context.Background(), 24*time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, "logs-v1-000002", index)
+	assert.Equal(t, map[string]interface{}{"max_age": "86400s"}, f.bodies["POST /logs-write/_rollover"]["conditions"])
+}
+
+func TestSearchIndexManager_DropExpired(t *testing.T) {
+	old := float64(time.Now().AddDate(0, 0, -40).UnixMilli())
+	recent := float64(time.Now().AddDate(0, 0, -2).UnixMilli())
+	aggs, _ := json.Marshal(map[string]interface{}{
+		"aggregations": map[string]interface{}{"indices": map[string]interface{}{"buckets": []interface{}{
+			map[string]interface{}{"key": "logs-v1-000001", "newest": map[string]interface{}{"value": old}},
+			map[string]interface{}{"key": "logs-v1-000002", "newest": map[string]interface{}{"value": recent}},
+			map[string]interface{}{"key": "logs-v1-000004", "newest": map[string]interface{}{"value": old}},
+		}}},
+	})
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"GET /_alias/logs":                      `{"logs-v1-000001":{},"logs-v1-000002":{},"logs-v1-000003":{},"logs-v1-000004":{}}`,
+		"GET /_alias/logs-write":                `{"logs-v1-000004":{}}`,
+		"POST /logs/_search":                    string(aggs),
+		"DELETE /logs-v1-000001,logs-v1-000003": `{"acknowledged":true}`,
+	})
+
+	dropped, err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{}).DropExpired(context.Background(), 30*24*time.Hour)
+
+	require.NoError(t, err)
+	// 000003 is empty, 000004 takes the writes however old its logs
+	assert.Equal(t, []string{"logs-v1-000001", "logs-v1-000003"}, dropped)
+	assert.Contains(t, f.calls, "DELETE /logs-v1-000001,logs-v1-000003")
+}
+
+func TestSearchIndexManager_StartRebuild(t *testing.T) {
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"GET /_alias/logs-write":       `{"logs-v0-000007":{}}`,
+		"PUT /_index_template/logs-v1": `{"acknowledged":true}`,
+		"PUT /logs-v1-000001":          `{"acknowledged":true}`,
+	})
+
+	index, err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{}).StartRebuild(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "logs-v1-000001", index)
+	// nothing was left by an earlier rebuild
+	assert.Contains(t, f.calls, "DELETE /logs-v1-000001")
+	assert.Equal(t, map[string]interface{}{"logs-rebuild": map[string]interface{}{}}, f.bodies["PUT /logs-v1-000001"]["aliases"])
+	assert.Equal(t, map[string]interface{}{"index": map[string]interface{}{"refresh_interval": "-1"}}, f.bodies["PUT /logs-v1-000001"]["settings"])
+}
+
+func TestSearchIndexManager_StartRebuild_UpToDate(t *testing.T) {
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"GET /_alias/logs-write": `{"logs-v1-000003":{}}`,
+	})
+
+	_, err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{}).StartRebuild(context.Background())
+
+	assert.ErrorIs(t, err, service.ErrSearchIndexUpToDate)
+	assert.Equal(t, []string{"GET /_alias/logs-write"}, f.calls)
+}
+
+func TestSearchIndexManager_FinishRebuild(t *testing.T) {
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"GET /_alias/logs-write":                `{"logs-v0-000007":{}}`,
+		"PUT /logs-v1-000001/_settings":         `{"acknowledged":true}`,
+		"POST /logs-v1-000001/_refresh":         `{}`,
+		"POST /_aliases":                        `{"acknowledged":true}`,
+		"GET /_cat/indices/logs-v0-*":           `[{"index":"logs-v0-000007"},{"index":"logs-v0-000006"}]`,
+		"DELETE /logs-v0-000006,logs-v0-000007": `{"acknowledged":true}`,
+	})
+
+	dropped, err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{}).FinishRebuild(context.Background(), "logs-v1-000001")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"logs-v0-000006", "logs-v0-000007"}, dropped)
+	assert.Equal(t, map[string]interface{}{"refresh_interval": nil}, f.bodies["PUT /logs-v1-000001/_settings"]["index"])
+	// both aliases move in a single request
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"remove": map[string]interface{}{"index": "logs-v0-*", "alias": "logs-write"}},
+		map[string]interface{}{"remove": map[string]interface{}{"index": "logs-v0-*", "alias": "logs"}},
+		map[string]interface{}{"remove": map[string]interface{}{"index": "logs-v1-000001", "alias": "logs-rebuild"}},
+		map[string]interface{}{"add": map[string]interface{}{"index": "logs-v1-000001", "alias": "logs-write", "is_write_index": true}},
+		map[string]interface{}{"add": map[string]interface{}{"index": "logs-v1-000001", "alias": "logs"}},
+	}, f.bodies["POST /_aliases"]["actions"])
+	assert.Less(t, indexOf(f.calls, "POST /_aliases"), indexOf(f.calls, "DELETE /logs-v0-000006,logs-v0-000007"))
+}
+
+func TestSearchIndexManager_AbortRebuild_Live(t *testing.T) {
+	f, srv := newFakeOpenSearch(t, map[string]string{
+		"GET /_alias/logs-write": `{"logs-v1-000001":{}}`,
+	})
+
+	err := service.NewSearchIndexManager(srv.URL, service.IndexSettings{}).AbortRebuild(context.Background(), "logs-v1-000001")
+
+	assert.Error(t, err)
+	assert.NotContains(t, f.calls, "DELETE /logs-v1-000001")
+}
+
+func indexOf(calls []string, call string) int {
+	for i, c := range calls {
+		if c == call {
+			return i
+		}
+	}
+	return -1
+}
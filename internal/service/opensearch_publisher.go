@@ -6,10 +6,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
 )
 
 type OpenSearchPublisher interface {
@@ -18,28 +26,96 @@ type OpenSearchPublisher interface {
 	DeleteLogsBulk(ctx context.Context, ids []string) error
 }
 
+// RebuildCheckInterval is how often a publisher looks for a rebuild in progress, a rebuild starting or ending
+// is followed by its writes within it
+const RebuildCheckInterval = 30 * time.Second
+
+// openSearchPublisher writes the logs through writeIndex and deletes them through readIndex, the logs of
+// the indices rolled over are only reached by the read alias. While rebuildIndex exists the logs are written
+// to and deleted from it as well, so that the index of a rebuild misses nothing done during the backfill.
 type openSearchPublisher struct {
-	baseURL   string
-	indexName string
-	client    *http.Client
+	baseURL      string
+	writeIndex   string
+	readIndex    string
+	rebuildIndex string
+	client       *http.Client
+
+	mu         sync.Mutex
+	rebuilding bool
+	checkedAt  time.Time
 }
 
-func NewOpenSearchPublisher(baseURL, indexName string) OpenSearchPublisher {
+// NewOpenSearchPublisher writes to aliases, they are never created as indices when missing. An empty
+// rebuildIndex turns the dual writes off.
+func NewOpenSearchPublisher(baseURL, writeIndex, readIndex, rebuildIndex string) OpenSearchPublisher {
 	return &openSearchPublisher{
-		baseURL:   baseURL,
-		indexName: indexName,
-		client:    &http.Client{},
+		baseURL:      baseURL,
+		writeIndex:   writeIndex,
+		readIndex:    readIndex,
+		rebuildIndex: rebuildIndex,
+		client:       &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)},
+	}
+}
+
+// targets returns the indices the logs are written to, rebuildIndex is looked up every RebuildCheckInterval
+func (p *openSearchPublisher) targets(ctx context.Context) []string {
+	if len(p.rebuildIndex) == 0 {
+		return []string{p.writeIndex}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if time.Since(p.checkedAt) >= RebuildCheckInterval {
+		p.rebuilding = p.aliasExists(ctx, p.rebuildIndex)
+		p.checkedAt = time.Now()
+	}
+	if p.rebuilding {
+		return []string{p.writeIndex, p.rebuildIndex}
+	}
+	return []string{p.writeIndex}
+}
+
+// aliasExists tells whether the alias exists, a failed lookup counts as existing: a write to a missing alias
+// only fails for that alias
+func (p *openSearchPublisher) aliasExists(ctx context.Context, alias string) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, fmt.Sprintf("%s/_alias/%s", p.baseURL, alias), nil)
+	if err != nil {
+		return true
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return true
 	}
+	defer resp.Body.Close()
+	return resp.StatusCode != http.StatusNotFound
 }
 
-func (p *openSearchPublisher) IndexLog(ctx context.Context, l log.Log) error {
-	url := fmt.Sprintf("%s/%s/_doc/%s", p.baseURL, p.indexName, l.ID)
+func (p *openSearchPublisher) IndexLog(ctx context.Context, l log.Log) (err error) {
+	defer func(start time.Time) { metrics.ObserveOpenSearch("index", start, err) }(time.Now())
 
 	body, err := json.Marshal(l)
 	if err != nil {
 		return fmt.Errorf("marshal log: %w", err)
 	}
 
+	for i, index := range p.targets(ctx) {
+		err := p.indexDoc(ctx, index, l.ID, body)
+		// the rebuild may have ended since it was looked up
+		if i > 0 && errors.Is(err, errAliasMissing) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errAliasMissing is returned for a write to an alias that doesn't exist
+var errAliasMissing = errors.New("alias missing")
+
+func (p *openSearchPublisher) indexDoc(ctx context.Context, index, id string, body []byte) error {
+	url := fmt.Sprintf("%s/%s/_doc/%s?require_alias=true", p.baseURL, index, id)
 	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("new request: %w", err)
@@ -52,25 +128,31 @@ func (p *openSearchPublisher) IndexLog(ctx context.Context, l log.Log) error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("opensearch index error: %s: %w", index, errAliasMissing)
+	}
 	if resp.StatusCode >= 300 {
 		return fmt.Errorf("opensearch index error: status %s", resp.Status)
 	}
 	return nil
 }
 
-func (p *openSearchPublisher) IndexLogsBulk(ctx context.Context, logs []log.Log) error {
-	url := fmt.Sprintf("%s/%s/_bulk", p.baseURL, p.indexName)
+func (p *openSearchPublisher) IndexLogsBulk(ctx context.Context, logs []log.Log) (err error) {
+	defer func(start time.Time) { metrics.ObserveOpenSearch("bulk_index", start, err) }(time.Now())
+	url := fmt.Sprintf("%s/%s/_bulk?require_alias=true", p.baseURL, p.writeIndex)
 
+	targets := p.targets(ctx)
 	var buf bytes.Buffer
 	for _, l := range logs {
-		meta := fmt.Sprintf(`{ "index": { "_id": "%s" } }%s`, l.ID, "\n")
 		body, err := json.Marshal(l)
 		if err != nil {
 			return fmt.Errorf("marshal bulk log: %w", err)
 		}
-		buf.WriteString(meta)
-		buf.Write(body)
-		buf.WriteString("\n")
+		for _, index := range targets {
+			fmt.Fprintf(&buf, `{ "index": { "_index": "%s", "_id": "%s" } }%s`, index, l.ID, "\n")
+			buf.Write(body)
+			buf.WriteString("\n")
+		}
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url, &buf)
@@ -88,6 +170,44 @@ func (p *openSearchPublisher) IndexLogsBulk(ctx context.Context, logs []log.Log)
 	if resp.StatusCode >= 300 {
 		return fmt.Errorf("bulk opensearch index error: status %s", resp.Status)
 	}
+	return p.bulkFailure(resp.Body)
+}
+
+// bulkFailure returns the first document a bulk request failed to write, the failures of the writes to a
+// rebuild that ended since it was looked up aside
+func (p *openSearchPublisher) bulkFailure(body io.Reader) error {
+	var res struct {
+		Errors bool `json:"errors"`
+		Items  []struct {
+			Index struct {
+				Index  string          `json:"_index"`
+				ID     string          `json:"_id"`
+				Status int             `json:"status"`
+				Error  json.RawMessage `json:"error"`
+			} `json:"index"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(body).Decode(&res); err != nil {
+		return fmt.Errorf("decode bulk response: %w", err)
+	}
+	if !res.Errors {
+		return nil
+	}
+
+	failed := 0
+	var first error
+	for _, item := range res.Items {
+		if item.Index.Status < 300 || (item.Index.Index == p.rebuildIndex && item.Index.Status == http.StatusNotFound) {
+			continue
+		}
+		if first == nil {
+			first = fmt.Errorf("log %s: status %d: %s", item.Index.ID, item.Index.Status, item.Index.Error)
+		}
+		failed++
+	}
+	if first != nil {
+		return fmt.Errorf("bulk opensearch index error: %d documents failed, first: %w", failed, first)
+	}
 	return nil
 }
 
@@ -110,20 +230,31 @@ func (p *openSearchPublisher) DeleteLogsBulk(ctx context.Context, ids []string)
 	return nil
 }
 
-func (p *openSearchPublisher) deleteLogsChunk(ctx context.Context, ids []string) error {
-	url := fmt.Sprintf("%s/%s/_bulk", p.baseURL, p.indexName)
+// deleteTargets returns the indices the logs are deleted from, the read alias and a rebuild in progress
+func (p *openSearchPublisher) deleteTargets(ctx context.Context) []string {
+	if len(p.targets(ctx)) > 1 {
+		return []string{p.readIndex, p.rebuildIndex}
+	}
+	return []string{p.readIndex}
+}
 
-	var buf bytes.Buffer
-	for _, id := range ids {
-		meta := fmt.Sprintf(`{ "delete": { "_id": "%s" } }%s`, id, "\n")
-		buf.WriteString(meta)
+func (p *openSearchPublisher) deleteLogsChunk(ctx context.Context, ids []string) (err error) {
+	defer func(start time.Time) { metrics.ObserveOpenSearch("bulk_delete", start, err) }(time.Now())
+	// a bulk delete only reaches the write index, the documents may sit in any index of the read alias
+	url := fmt.Sprintf("%s/%s/_delete_by_query?conflicts=proceed&ignore_unavailable=true", p.baseURL, strings.Join(p.deleteTargets(ctx), ","))
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{"ids": map[string]interface{}{"values": ids}},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal delete query: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &buf)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("new bulk delete request: %w", err)
 	}
-	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := p.client.Do(req)
 	if err != nil {
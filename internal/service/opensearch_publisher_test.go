@@ -0,0 +1,100 @@
+package service_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+)
+
+// bulkTargets serves the bulk requests with bulkResponse and records the index of every action
+func bulkTargets(t *testing.T, rebuilding bool, bulkResponse string) (*[]string, *httptest.Server) {
+	targets := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead && r.URL.Path == "/_alias/logs-rebuild":
+			if !rebuilding {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/logs-write/_bulk":
+			assert.Equal(t, "true", r.URL.Query().Get("require_alias"))
+			scanner := bufio.NewScanner(r.Body)
+			for i := 0; scanner.Scan(); i++ {
+				if i%2 == 1 {
+					continue
+				}
+				var action struct {
+					Index struct {
+						Index string `json:"_index"`
+					} `json:"index"`
+				}
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &action))
+				targets = append(targets, action.Index.Index)
+			}
+			_, _ = w.Write([]byte(bulkResponse))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return &targets, srv
+}
+
+func TestOpenSearchPublisher_IndexLogsBulk_DualWrites(t *testing.T) {
+	logs := []log.Log{{ID: "l1"}, {ID: "l2"}}
+
+	t.Run("no rebuild", func(t *testing.T) {
+		targets, srv := bulkTargets(t, false, `{"errors":false,"items":[]}`)
+		p := service.NewOpenSearchPublisher(srv.URL, service.LogsWriteAlias, service.LogsReadAlias, service.LogsRebuildAlias)
+
+		require.NoError(t, p.IndexLogsBulk(context.Background(), logs))
+		assert.Equal(t, []string{"logs-write", "logs-write"}, *targets)
+	})
+
+	t.Run("rebuild in progress", func(t *testing.T) {
+		targets, srv := bulkTargets(t, true, `{"errors":false,"items":[]}`)
+		p := service.NewOpenSearchPublisher(srv.URL, service.LogsWriteAlias, service.LogsReadAlias, service.LogsRebuildAlias)
+
+		require.NoError(t, p.IndexLogsBulk(context.Background(), logs))
+		assert.Equal(t, []string{"logs-write", "logs-rebuild", "logs-write", "logs-rebuild"}, *targets)
+	})
+}
+
+func TestOpenSearchPublisher_IndexLogsBulk_Failures(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  string
+	}{
+		{
+			name:     "rebuild ended meanwhile",
+			response: `{"errors":true,"items":[{"index":{"_index":"logs-v1-000001","_id":"l1","status":201}},{"index":{"_index":"logs-rebuild","_id":"l1","status":404,"error":{"type":"index_not_found_exception"}}}]}`,
+		},
+		{
+			name:     "document rejected",
+			response: `{"errors":true,"items":[{"index":{"_index":"logs-v1-000001","_id":"l1","status":400,"error":{"type":"mapper_parsing_exception"}}}]}`,
+			wantErr:  "log l1: status 400",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, srv := bulkTargets(t, true, tt.response)
+			p := service.NewOpenSearchPublisher(srv.URL, service.LogsWriteAlias, service.LogsReadAlias, service.LogsRebuildAlias)
+
+			err := p.IndexLogsBulk(context.Background(), []log.Log{{ID: "l1"}})
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
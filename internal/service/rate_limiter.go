@@ -0,0 +1,139 @@
+package service
+
+//go:generate mockgen -source=rate_limiter.go -destination=./mocks/mock_rate_limiter.go -package=mocks
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+// RateLimitResult is the outcome of one request against a bucket
+type RateLimitResult struct {
+	Allowed bool
+	// Limit is the size of the bucket
+	Limit     int
+	Remaining int
+	// RetryAfter is how long to wait before the request could be allowed, zero when allowed
+	RetryAfter time.Duration
+	// ResetAfter is how long until the bucket is full again
+	ResetAfter time.Duration
+}
+
+// RateLimiter takes one request from the token bucket of the key, the bucket refills at r.RPS up to r.Burst.
+// A change of r applies to the existing bucket.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, r tenant_limit.Rate) (RateLimitResult, error)
+}
+
+// buckets unused for this long are dropped, a returning tenant starts with a full bucket
+const localLimiterIdleTTL = 10 * time.Minute
+
+// LocalRateLimiter keeps the buckets in memory, each API instance limits on its own
+type LocalRateLimiter struct {
+	mu        sync.Mutex
+	limiters  map[string]*localLimiterEntry
+	lastSweep time.Time
+}
+
+type localLimiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func NewLocalRateLimiter() *LocalRateLimiter {
+	return &LocalRateLimiter{limiters: map[string]*localLimiterEntry{}, lastSweep: time.Now()}
+}
+
+func (l *LocalRateLimiter) Allow(_ context.Context, key string, r tenant_limit.Rate) (RateLimitResult, error) {
+	now := time.Now()
+	limiter := l.get(key, r, now)
+
+	reservation := limiter.ReserveN(now, 1)
+	result := RateLimitResult{Allowed: true, Limit: r.Burst}
+	if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+		result.Allowed, result.RetryAfter = false, time.Second
+		if reservation.OK() {
+			result.RetryAfter = reservation.DelayFrom(now)
+			reservation.CancelAt(now)
+		}
+	}
+
+	tokens := math.Max(limiter.TokensAt(now), 0)
+	result.Remaining = int(math.Floor(tokens))
+	if r.RPS > 0 && tokens < float64(r.Burst) {
+		result.ResetAfter = time.Duration((float64(r.Burst) - tokens) / float64(r.RPS) * float64(time.Second))
+	}
+	return result, nil
+}
+
+// get returns the bucket of the key, updated in place when the limits changed
+func (l *LocalRateLimiter) get(key string, r tenant_limit.Rate, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= localLimiterIdleTTL {
+		for k, e := range l.limiters {
+			if now.Sub(e.lastSeen) >= localLimiterIdleTTL {
+				delete(l.limiters, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	e, ok := l.limiters[key]
+	if !ok {
+		e = &localLimiterEntry{limiter: rate.NewLimiter(rate.Limit(r.RPS), r.Burst)}
+		l.limiters[key] = e
+	}
+	if e.limiter.Limit() != rate.Limit(r.RPS) {
+		e.limiter.SetLimitAt(now, rate.Limit(r.RPS))
+	}
+	if e.limiter.Burst() != r.Burst {
+		e.limiter.SetBurstAt(now, r.Burst)
+	}
+	e.lastSeen = now
+	return e.limiter
+}
+
+// once the primary limiter failed it is left alone for this long, so an unreachable Redis doesn't
+// add its timeouts to every request
+const rateLimiterRetryInterval = 5 * time.Second
+
+// FallbackRateLimiter uses the primary limiter and falls back to the other one while the primary fails
+type FallbackRateLimiter struct {
+	Primary  RateLimiter
+	Fallback RateLimiter
+
+	// unix nanos until which the primary is skipped
+	skipUntil atomic.Int64
+}
+
+func NewFallbackRateLimiter(primary, fallback RateLimiter) *FallbackRateLimiter {
+	return &FallbackRateLimiter{Primary: primary, Fallback: fallback}
+}
+
+func (l *FallbackRateLimiter) Allow(ctx context.Context, key string, r tenant_limit.Rate) (RateLimitResult, error) {
+	if time.Now().UnixNano() >= l.skipUntil.Load() {
+		result, err := l.Primary.Allow(ctx, key, r)
+		if err == nil {
+			if l.skipUntil.Swap(0) != 0 {
+				logger.GetLogger().Info("rate limiter available again")
+			}
+			return result, nil
+		}
+
+		// logged once per outage
+		if l.skipUntil.Swap(time.Now().Add(rateLimiterRetryInterval).UnixNano()) == 0 {
+			logger.GetLogger().WithField("error", err).Warn("rate limiter unavailable, limiting per instance")
+		}
+	}
+	return l.Fallback.Allow(ctx, key, r)
+}
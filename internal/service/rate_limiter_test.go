@@ -0,0 +1,152 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/internal/service/mocks"
+)
+
+func TestRedisRateLimiter_Allow(t *testing.T) {
+	mr := miniredis.RunT(t)
+	now := time.Now()
+	mr.SetTime(now)
+	ctx := context.Background()
+	r := tenant_limit.Rate{RPS: 2, Burst: 3}
+
+	// two API instances share the bucket
+	a, b := service.NewRedisRateLimiter(redis.NewClient(&redis.Options{Addr: mr.Addr()})), service.NewRedisRateLimiter(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+
+	res, err := a.Allow(ctx, "tenant-1:read", r)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 3, res.Limit)
+	assert.Equal(t, 2, res.Remaining)
+	assert.Equal(t, 500*time.Millisecond, res.ResetAfter)
+
+	res, err = b.Allow(ctx, "tenant-1:read", r)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 1, res.Remaining)
+
+	res, err = a.Allow(ctx, "tenant-1:read", r)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+
+	res, err = b.Allow(ctx, "tenant-1:read", r)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+	assert.Equal(t, 1500*time.Millisecond, res.ResetAfter)
+
+	// other keys have their own bucket
+	res, err = b.Allow(ctx, "tenant-1:write", r)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// one token is back after 1/RPS
+	mr.SetTime(now.Add(500 * time.Millisecond))
+	res, err = a.Allow(ctx, "tenant-1:read", r)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+}
+
+func TestRedisRateLimiter_Allow_LimitsChange(t *testing.T) {
+	mr := miniredis.RunT(t)
+	mr.SetTime(time.Now())
+	ctx := context.Background()
+	l := service.NewRedisRateLimiter(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+
+	res, err := l.Allow(ctx, "tenant-1:read", tenant_limit.Rate{RPS: 1, Burst: 1})
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	res, err = l.Allow(ctx, "tenant-1:read", tenant_limit.Rate{RPS: 1, Burst: 1})
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	// a larger burst applies to the existing bucket
+	res, err = l.Allow(ctx, "tenant-1:read", tenant_limit.Rate{RPS: 1, Burst: 5})
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 5, res.Limit)
+	assert.Equal(t, 3, res.Remaining)
+}
+
+func TestRedisRateLimiter_Allow_Unreachable(t *testing.T) {
+	mr := miniredis.RunT(t)
+	l := service.NewRedisRateLimiter(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	mr.Close()
+
+	_, err := l.Allow(context.Background(), "tenant-1:read", tenant_limit.Rate{RPS: 1, Burst: 1})
+	assert.Error(t, err)
+}
+
+func TestLocalRateLimiter_Allow(t *testing.T) {
+	l := service.NewLocalRateLimiter()
+	ctx := context.Background()
+	r := tenant_limit.Rate{RPS: 1, Burst: 2}
+
+	res, err := l.Allow(ctx, "tenant-1:read", r)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 2, res.Limit)
+	assert.Equal(t, 1, res.Remaining)
+
+	res, _ = l.Allow(ctx, "tenant-1:read", r)
+	assert.True(t, res.Allowed)
+
+	res, _ = l.Allow(ctx, "tenant-1:read", r)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Greater(t, res.RetryAfter, time.Duration(0))
+}
+
+func TestFallbackRateLimiter_Allow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	primary := mocks.NewMockRateLimiter(ctrl)
+	fallback := mocks.NewMockRateLimiter(ctrl)
+	l := service.NewFallbackRateLimiter(primary, fallback)
+	ctx := context.Background()
+	r := tenant_limit.Rate{RPS: 1, Burst: 1}
+
+	primary.EXPECT().Allow(gomock.Any(), "k", r).Return(service.RateLimitResult{Allowed: true, Remaining: 7}, nil)
+	res, err := l.Allow(ctx, "k", r)
+	require.NoError(t, err)
+	assert.Equal(t, 7, res.Remaining)
+
+	// once the primary fails it is skipped for a while
+	primary.EXPECT().Allow(gomock.Any(), "k", r).Return(service.RateLimitResult{}, assert.AnError).Times(1)
+	fallback.EXPECT().Allow(gomock.Any(), "k", r).Return(service.RateLimitResult{Allowed: true, Remaining: 3}, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		res, err = l.Allow(ctx, "k", r)
+		require.NoError(t, err)
+		assert.Equal(t, 3, res.Remaining)
+	}
+}
+
+func TestFallbackRateLimiter_Allow_RedisDown(t *testing.T) {
+	mr := miniredis.RunT(t)
+	l := service.NewFallbackRateLimiter(service.NewRedisRateLimiter(redis.NewClient(&redis.Options{Addr: mr.Addr()})), service.NewLocalRateLimiter())
+	mr.Close()
+
+	res, err := l.Allow(context.Background(), "tenant-1:read", tenant_limit.Rate{RPS: 1, Burst: 1})
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	res, err = l.Allow(context.Background(), "tenant-1:read", tenant_limit.Rate{RPS: 1, Burst: 1})
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
 )
 
 type PubSub interface {
@@ -17,21 +18,30 @@ type PubSub interface {
 	Subscribe(ctx context.Context, channel string) *redis.PubSub
 	BroadcastLogs(ctx context.Context, logs []log.Log) error
 	BroadcastLog(ctx context.Context, logRecord log.Log) error
+	CloseTenantChannel(ctx context.Context, tenantId string) (int64, error)
+}
+
+// TenantChannelClosed is published on a tenant channel when the tenant is deleted, subscribers disconnect on it
+const TenantChannelClosed = "__tenant_channel_closed__"
+
+func TenantChannel(tenantId string) string {
+	return "logs:" + tenantId
 }
 
 type PubSubImpl struct {
 	client *redis.Client
 }
 
-func NewPubSubImpl(addr string) *PubSubImpl {
-	rdb := redis.NewClient(&redis.Options{
-		Addr: addr, // e.g. "redis:6379"
-	})
-	return &PubSubImpl{client: rdb}
+func NewPubSubImpl(client *redis.Client) *PubSubImpl {
+	return &PubSubImpl{client: client}
 }
 
 func (r *PubSubImpl) Publish(ctx context.Context, channel string, message string) error {
-	return r.client.Publish(ctx, channel, message).Err()
+	err := r.client.Publish(ctx, channel, message).Err()
+	if err != nil {
+		metrics.RedisPublishFailed(channel)
+	}
+	return err
 }
 
 func (r *PubSubImpl) Subscribe(ctx context.Context, channel string) *redis.PubSub {
@@ -57,7 +67,7 @@ func (r *PubSubImpl) BroadcastLog(ctx context.Context, logRecord log.Log) error
 
 	// Tenant-specific channel
 	if len(logRecord.TenantID) > 0 {
-		tenantChannel := "logs:" + logRecord.TenantID
+		tenantChannel := TenantChannel(logRecord.TenantID)
 		if err := r.Publish(ctx, tenantChannel, string(payload)); err != nil {
 			return fmt.Errorf("publish to tenant channel: %w", err)
 		}
@@ -70,3 +80,12 @@ func (r *PubSubImpl) BroadcastLog(ctx context.Context, logRecord log.Log) error
 
 	return nil
 }
+
+// CloseTenantChannel disconnects the streams of the tenant, it returns how many subscribers were told to close
+func (r *PubSubImpl) CloseTenantChannel(ctx context.Context, tenantId string) (int64, error) {
+	n, err := r.client.Publish(ctx, TenantChannel(tenantId), TenantChannelClosed).Result()
+	if err != nil {
+		metrics.RedisPublishFailed(TenantChannel(tenantId))
+	}
+	return n, err
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+)
+
+const rateLimitKeyPrefix = "ratelimit:"
+
+// gcraScript implements GCRA: the key holds the theoretical arrival time (TAT) of the next request in
+// microseconds, a request is allowed while the TAT is less than a burst ahead of now. The clock of Redis
+// is used so that every API instance agrees on it.
+//
+// KEYS[1] bucket, ARGV[1] requests per second, ARGV[2] burst
+// returns allowed (0/1), remaining, retry after and reset after in microseconds
+var gcraScript = redis.NewScript(`
+local rps = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+
+local interval = 1000000 / rps
+local tolerance = interval * burst
+
+local tat = tonumber(redis.call("GET", KEYS[1]) or now)
+if tat < now then
+  tat = now
+end
+
+local new_tat = tat + interval
+local diff = now - (new_tat - tolerance)
+if diff < 0 then
+  return {0, 0, math.ceil(-diff), math.ceil(tat - now)}
+end
+
+local reset_after = new_tat - now
+redis.call("SET", KEYS[1], string.format("%d", new_tat), "PX", math.ceil(reset_after / 1000))
+return {1, math.floor(diff / interval), 0, math.ceil(reset_after)}
+`)
+
+// redisRateLimitTimeout bounds a check of the shared client, retries included
+const redisRateLimitTimeout = 500 * time.Millisecond
+
+// RedisRateLimiter shares the buckets between the API instances through Redis
+type RedisRateLimiter struct {
+	client *redis.Client
+}
+
+func NewRedisRateLimiter(client *redis.Client) *RedisRateLimiter {
+	return &RedisRateLimiter{client: client}
+}
+
+func (l *RedisRateLimiter) Allow(ctx context.Context, key string, r tenant_limit.Rate) (RateLimitResult, error) {
+	if r.RPS <= 0 || r.Burst <= 0 {
+		return RateLimitResult{Limit: r.Burst, RetryAfter: time.Second}, nil
+	}
+
+	// every request waits on the limiter, give up early and fall back
+	ctx, cancel := context.WithTimeout(ctx, redisRateLimitTimeout)
+	defer cancel()
+	res, err := gcraScript.Run(ctx, l.client, []string{rateLimitKeyPrefix + key}, r.RPS, r.Burst).Int64Slice()
+	if err != nil {
+		return RateLimitResult{}, err
+	}
+
+	return RateLimitResult{
+		Allowed:    res[0] == 1,
+		Limit:      r.Burst,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Microsecond,
+		ResetAfter: time.Duration(res[3]) * time.Microsecond,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+//go:generate mockgen -source=redis_revocation.go -destination=./mocks/mock_redis_revocation.go -package=mocks
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+)
+
+const (
+	revokedTokenKeyPrefix   = "revoked:jti:"
+	revokedSessionKeyPrefix = "revoked:"
+	// set once the cache holds everything from the database, missing after a Redis flush or restart. Bumped
+	// when the keys change so that the first check after a deploy reloads them
+	revocationLoadedKey = "revoked:loaded:v2"
+)
+
+// RevocationCache mirrors the revocations stored in Postgres so that every request can be checked cheaply
+type RevocationCache interface {
+	IsLoaded(ctx context.Context) (bool, error)
+	MarkLoaded(ctx context.Context) error
+	ResetLoaded(ctx context.Context) error
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	SetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId string, subjectId string, before time.Time) error
+	GetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId string, subjectId string) (*time.Time, error)
+}
+
+type RevocationCacheImpl struct {
+	client *redis.Client
+}
+
+func NewRevocationCacheImpl(client *redis.Client) *RevocationCacheImpl {
+	return &RevocationCacheImpl{client: client}
+}
+
+func (r *RevocationCacheImpl) IsLoaded(ctx context.Context) (bool, error) {
+	n, err := r.client.Exists(ctx, revocationLoadedKey).Result()
+	return n > 0, err
+}
+
+func (r *RevocationCacheImpl) MarkLoaded(ctx context.Context) error {
+	return r.client.Set(ctx, revocationLoadedKey, time.Now().Unix(), 0).Err()
+}
+
+// ResetLoaded forces the next check to reload the cache from the database
+func (r *RevocationCacheImpl) ResetLoaded(ctx context.Context) error {
+	return r.client.Del(ctx, revocationLoadedKey).Err()
+}
+
+// RevokeToken keeps the jti until the token expires, there is nothing to reject afterwards
+func (r *RevocationCacheImpl) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	return r.client.Set(ctx, revokedTokenKeyPrefix+jti, 1, ttl).Err()
+}
+
+func (r *RevocationCacheImpl) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	n, err := r.client.Exists(ctx, revokedTokenKeyPrefix+jti).Result()
+	return n > 0, err
+}
+
+func (r *RevocationCacheImpl) SetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId string, subjectId string, before time.Time) error {
+	return r.client.Set(ctx, sessionKey(subjectType, tenantId, subjectId), before.UnixNano(), 0).Err()
+}
+
+func (r *RevocationCacheImpl) GetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId string, subjectId string) (*time.Time, error) {
+	v, err := r.client.Get(ctx, sessionKey(subjectType, tenantId, subjectId)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nanos, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	t := time.Unix(0, nanos)
+	return &t, nil
+}
+
+// sessionKey scopes a user to its tenant, the same user id in another tenant is another user
+func sessionKey(subjectType token_revocation.SubjectType, tenantId string, subjectId string) string {
+	if subjectType == token_revocation.SubjectUser {
+		return revokedSessionKeyPrefix + string(subjectType) + ":" + tenantId + ":" + subjectId
+	}
+	return revokedSessionKeyPrefix + string(subjectType) + ":" + subjectId
+}
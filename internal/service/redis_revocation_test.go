@@ -0,0 +1,39 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+)
+
+func TestRevocationCache_RevokedBefore_OtherTenant(t *testing.T) {
+	mr := miniredis.RunT(t)
+	cache := service.NewRevocationCacheImpl(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	ctx := context.Background()
+	cutoff := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	require.NoError(t, cache.SetRevokedBefore(ctx, token_revocation.SubjectUser, "t1", "u1", cutoff))
+
+	before, err := cache.GetRevokedBefore(ctx, token_revocation.SubjectUser, "t1", "u1")
+	require.NoError(t, err)
+	require.NotNil(t, before)
+	assert.True(t, cutoff.Equal(*before))
+
+	// the same user id in another tenant, or without a tenant, is another user
+	for _, tenantId := range []string{"t2", ""} {
+		before, err = cache.GetRevokedBefore(ctx, token_revocation.SubjectUser, tenantId, "u1")
+		require.NoError(t, err)
+		assert.Nil(t, before, tenantId)
+	}
+	before, err = cache.GetRevokedBefore(ctx, token_revocation.SubjectTenant, "", "t1")
+	require.NoError(t, err)
+	assert.Nil(t, before)
+}
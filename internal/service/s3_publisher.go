@@ -13,12 +13,26 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
 )
 
+// archivePartSize is the size of the parts of a multipart archive, S3 rejects smaller ones but the last
+const archivePartSize = 5 << 20
+
 type S3Publisher interface {
 	UploadLogs(ctx context.Context, taskId string, logs []log.Log) error
+	// StartArchive opens an archive written a page of logs at a time, for more logs than fit in memory
+	StartArchive(ctx context.Context, taskId string) (LogArchive, error)
+}
+
+// LogArchive is an archive in the format of UploadLogs uploaded in parts as it is written. It is only visible
+// in the bucket once completed, an aborted one leaves nothing behind.
+type LogArchive interface {
+	Write(ctx context.Context, logs []log.Log) error
+	Complete(ctx context.Context) error
+	Abort(ctx context.Context) error
 }
 
 type S3PublisherImpl struct {
@@ -51,7 +65,7 @@ func (s *S3PublisherImpl) UploadLogs(ctx context.Context, taskId string, logs []
 	}
 
 	// Generate archive key
-	key := filepath.Join("archives", fmt.Sprintf("%s_%d.json.gz", taskId, time.Now().Unix()))
+	key := archiveKey(taskId)
 
 	// Upload to S3
 	_, err = s.s3Client.PutObject(ctx, &s3.PutObjectInput{
@@ -65,3 +79,112 @@ func (s *S3PublisherImpl) UploadLogs(ctx context.Context, taskId string, logs []
 
 	return nil
 }
+
+func (s *S3PublisherImpl) StartArchive(ctx context.Context, taskId string) (LogArchive, error) {
+	key := archiveKey(taskId)
+	out, err := s.s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start S3 upload: %w", err)
+	}
+
+	a := &s3LogArchive{s3Client: s.s3Client, bucketName: s.bucketName, key: key, uploadId: out.UploadId}
+	a.gw = gzip.NewWriter(&a.buf)
+	return a, nil
+}
+
+func archiveKey(taskId string) string {
+	return filepath.Join("archives", fmt.Sprintf("%s_%d.json.gz", taskId, time.Now().Unix()))
+}
+
+// s3LogArchive writes the logs as the indented JSON array of UploadLogs, an element at a time, and uploads the
+// gzipped stream every archivePartSize bytes
+type s3LogArchive struct {
+	s3Client   *s3.Client
+	bucketName string
+	key        string
+	uploadId   *string
+
+	buf   bytes.Buffer
+	gw    *gzip.Writer
+	count int
+	parts []types.CompletedPart
+}
+
+func (a *s3LogArchive) Write(ctx context.Context, logs []log.Log) error {
+	for _, l := range logs {
+		data, err := json.MarshalIndent(l, "  ", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal logs: %w", err)
+		}
+		sep := ",\n  "
+		if a.count == 0 {
+			sep = "[\n  "
+		}
+		if _, err := a.gw.Write(append([]byte(sep), data...)); err != nil {
+			return fmt.Errorf("failed to gzip data: %w", err)
+		}
+		a.count++
+	}
+
+	if a.buf.Len() < archivePartSize {
+		return nil
+	}
+	return a.uploadPart(ctx)
+}
+
+func (a *s3LogArchive) Complete(ctx context.Context) error {
+	end := "\n]"
+	if a.count == 0 {
+		end = "[]"
+	}
+	if _, err := a.gw.Write([]byte(end)); err != nil {
+		return fmt.Errorf("failed to gzip data: %w", err)
+	}
+	if err := a.gw.Close(); err != nil {
+		return fmt.Errorf("failed to close gzip: %w", err)
+	}
+	if err := a.uploadPart(ctx); err != nil {
+		return err
+	}
+
+	_, err := a.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(a.bucketName),
+		Key:             aws.String(a.key),
+		UploadId:        a.uploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: a.parts},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete S3 upload: %w", err)
+	}
+	return nil
+}
+
+func (a *s3LogArchive) Abort(ctx context.Context) error {
+	_, err := a.s3Client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(a.bucketName),
+		Key:      aws.String(a.key),
+		UploadId: a.uploadId,
+	})
+	return err
+}
+
+// uploadPart uploads what was gzipped so far as the next part
+func (a *s3LogArchive) uploadPart(ctx context.Context) error {
+	number := aws.Int32(int32(len(a.parts) + 1))
+	out, err := a.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(a.bucketName),
+		Key:        aws.String(a.key),
+		UploadId:   a.uploadId,
+		PartNumber: number,
+		Body:       bytes.NewReader(a.buf.Bytes()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload logs to S3: %w", err)
+	}
+	a.parts = append(a.parts, types.CompletedPart{ETag: out.ETag, PartNumber: number})
+	a.buf.Reset()
+	return nil
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+)
+
+// fakeMultipartS3 keeps the parts of a single multipart upload, the object is their concatenation once completed
+type fakeMultipartS3 struct {
+	parts     map[int][]byte
+	object    []byte
+	completed bool
+}
+
+func newFakeMultipartS3(t *testing.T) (*fakeMultipartS3, *s3.Client) {
+	f := &fakeMultipartS3{parts: map[int][]byte{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.Method == http.MethodPost && q.Has("uploads"):
+			_, _ = w.Write([]byte(`<InitiateMultipartUploadResult><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`))
+		case r.Method == http.MethodPut && q.Get("uploadId") == "upload-1":
+			number, _ := strconv.Atoi(q.Get("partNumber"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			f.parts[number] = body
+			w.Header().Set("ETag", fmt.Sprintf(`"etag-%d"`, number))
+		case r.Method == http.MethodPost && q.Get("uploadId") == "upload-1":
+			for i := 1; i <= len(f.parts); i++ {
+				f.object = append(f.object, f.parts[i]...)
+			}
+			f.completed = true
+			_, _ = w.Write([]byte(`<CompleteMultipartUploadResult><ETag>"etag"</ETag></CompleteMultipartUploadResult>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client := s3.New(s3.Options{
+		Region:                     "us-east-1",
+		BaseEndpoint:               aws.String(srv.URL),
+		UsePathStyle:               true,
+		Credentials:                credentials.NewStaticCredentialsProvider("key", "secret", ""),
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+	})
+	return f, client
+}
+
+func gunzip(t *testing.T, data []byte) []byte {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	out, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	return out
+}
+
+func TestS3Publisher_StartArchive(t *testing.T) {
+	f, client := newFakeMultipartS3(t)
+	ctx := context.Background()
+
+	// random messages barely compress, the archive takes a few parts
+	logs := make([]log.Log, 0, 600)
+	for i := 0; i < 600; i++ {
+		message := make([]byte, 8<<10)
+		_, _ = rand.Read(message)
+		logs = append(logs, log.Log{ID: fmt.Sprintf("l%d", i), TenantID: "t1", Message: hex.EncodeToString(message)})
+	}
+
+	archive, err := service.NewS3PublisherImpl(client, "bucket").StartArchive(ctx, "task-1")
+	require.NoError(t, err)
+	for i := 0; i < len(logs); i += 100 {
+		require.NoError(t, archive.Write(ctx, logs[i:i+100]))
+	}
+	require.NoError(t, archive.Complete(ctx))
+
+	require.True(t, f.completed)
+	assert.Greater(t, len(f.parts), 1)
+	// the same document as UploadLogs
+	expected, err := json.MarshalIndent(logs, "", "  ")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(gunzip(t, f.object)))
+}
+
+func TestS3Publisher_StartArchive_Empty(t *testing.T) {
+	f, client := newFakeMultipartS3(t)
+	ctx := context.Background()
+
+	archive, err := service.NewS3PublisherImpl(client, "bucket").StartArchive(ctx, "task-1")
+	require.NoError(t, err)
+	require.NoError(t, archive.Complete(ctx))
+
+	require.True(t, f.completed)
+	assert.Equal(t, "[]", string(gunzip(t, f.object)))
+}
@@ -6,20 +6,35 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/ops"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
 type SQSPublisher interface {
 	PublishArchiveMessage(ctx context.Context, taskId string, beforeDate time.Time) error
 	PublishCleanUpMessage(ctx context.Context, taskId string, beforeDate time.Time) error
 	PublishIndexMessage(ctx context.Context, taskId string, logs []log.Log) error
+	PublishTenantDeletionMessage(ctx context.Context, taskId string) error
+	PublishReindexMessage(ctx context.Context, taskId string) error
+	PublishIndexRebuildMessage(ctx context.Context, taskId string) error
 	ReceiveMessages(ctx context.Context, queueURL string, maxMessages int32, waitTimeSeconds int32) ([]ReceiveMessage, error)
 	DeleteMessage(ctx context.Context, queueURL string, receiptHandle *string) error
+	// QueueStats returns the approximate backlog of every queue
+	QueueStats(ctx context.Context) ([]ops.QueueStatus, error)
 }
 
 type Message struct {
@@ -31,21 +46,34 @@ type Message struct {
 type ReceiveMessage struct {
 	Message       Message
 	ReceiveHandle *string
+	// trace context of the request that sent the message, see tracing.Extract
+	TraceContext map[string]string
+	// id of the request that sent the message, empty for the messages sent outside a request
+	RequestID string
 }
 
+// requestIDAttribute is the message attribute carrying the id of the request that sent the message
+const requestIDAttribute = "request_id"
+
 type SQSPublisherImpl struct {
 	sqsClient       *sqs.Client
 	archiveQueueURL string
 	cleanUpQueueURL string
 	indexQueueURL   string
+	tenantQueueURL  string
+	reindexQueueURL string
+	rebuildQueueURL string
 }
 
-func NewSQSPublisherImpl(sqsClient *sqs.Client, archiveQueueURL string, cleanUpQueueURL string, indexQueueURL string) *SQSPublisherImpl {
+func NewSQSPublisherImpl(sqsClient *sqs.Client, archiveQueueURL string, cleanUpQueueURL string, indexQueueURL string, tenantQueueURL string, reindexQueueURL string, rebuildQueueURL string) *SQSPublisherImpl {
 	return &SQSPublisherImpl{
 		sqsClient:       sqsClient,
 		archiveQueueURL: archiveQueueURL,
 		cleanUpQueueURL: cleanUpQueueURL,
 		indexQueueURL:   indexQueueURL,
+		tenantQueueURL:  tenantQueueURL,
+		reindexQueueURL: reindexQueueURL,
+		rebuildQueueURL: rebuildQueueURL,
 	}
 }
 
@@ -70,26 +98,67 @@ func (p *SQSPublisherImpl) PublishIndexMessage(ctx context.Context, taskId strin
 	})
 }
 
-func (p *SQSPublisherImpl) sendMessage(ctx context.Context, queueURL string, msg Message) error {
+func (p *SQSPublisherImpl) PublishTenantDeletionMessage(ctx context.Context, taskId string) error {
+	return p.sendMessage(ctx, p.tenantQueueURL, Message{
+		ID: taskId,
+	})
+}
+
+func (p *SQSPublisherImpl) PublishReindexMessage(ctx context.Context, taskId string) error {
+	return p.sendMessage(ctx, p.reindexQueueURL, Message{
+		ID: taskId,
+	})
+}
+
+func (p *SQSPublisherImpl) PublishIndexRebuildMessage(ctx context.Context, taskId string) error {
+	return p.sendMessage(ctx, p.rebuildQueueURL, Message{
+		ID: taskId,
+	})
+}
+
+func (p *SQSPublisherImpl) sendMessage(ctx context.Context, queueURL string, msg Message) (err error) {
+	ctx, span := tracing.Start(ctx, "sqs.send", trace.WithSpanKind(trace.SpanKindProducer), trace.WithAttributes(
+		attribute.String("messaging.system", "aws_sqs"),
+		attribute.String("messaging.destination.name", path.Base(queueURL)),
+		attribute.String("messaging.message.id", msg.ID),
+	))
+	defer func() { tracing.End(span, err) }()
+
 	msgBody, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
-	if _, err := p.sqsClient.SendMessage(ctx, &sqs.SendMessageInput{
-		MessageBody: aws.String(string(msgBody)),
-		QueueUrl:    aws.String(queueURL),
-	}); err != nil {
+	// the workers continue the trace and log the request id from the message attributes
+	carrier := map[string]string{}
+	tracing.Inject(ctx, carrier)
+	if requestId := logger.RequestID(ctx); len(requestId) > 0 {
+		carrier[requestIDAttribute] = requestId
+	}
+	attributes := make(map[string]types.MessageAttributeValue, len(carrier))
+	for k, v := range carrier {
+		attributes[k] = types.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+	}
+
+	_, err = p.sqsClient.SendMessage(ctx, &sqs.SendMessageInput{
+		MessageBody:       aws.String(string(msgBody)),
+		MessageAttributes: attributes,
+		QueueUrl:          aws.String(queueURL),
+	})
+	metrics.ObserveSQS(queueURL, "send", 1, err)
+	if err != nil {
 		return fmt.Errorf("failed to send message: %w", err)
 	}
 	return nil
 }
 
 func (p *SQSPublisherImpl) DeleteMessage(ctx context.Context, queueURL string, receiptHandle *string) error {
-	if _, err := p.sqsClient.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+	_, err := p.sqsClient.DeleteMessage(ctx, &sqs.DeleteMessageInput{
 		QueueUrl:      aws.String(queueURL),
 		ReceiptHandle: receiptHandle,
-	}); err != nil {
+	})
+	metrics.ObserveSQS(queueURL, "delete", 1, err)
+	if err != nil {
 		return fmt.Errorf("failed to delete message: %w", err)
 	}
 	return nil
@@ -97,24 +166,81 @@ func (p *SQSPublisherImpl) DeleteMessage(ctx context.Context, queueURL string, r
 
 func (p *SQSPublisherImpl) ReceiveMessages(ctx context.Context, queueURL string, maxMessages int32, waitTimeSeconds int32) ([]ReceiveMessage, error) {
 	out, err := p.sqsClient.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
-		QueueUrl:            aws.String(queueURL),
-		MaxNumberOfMessages: maxMessages,
-		WaitTimeSeconds:     waitTimeSeconds,
+		QueueUrl:              aws.String(queueURL),
+		MaxNumberOfMessages:   maxMessages,
+		WaitTimeSeconds:       waitTimeSeconds,
+		MessageAttributeNames: []string{"All"},
 	})
 	if err != nil {
+		metrics.ObserveSQS(queueURL, "receive", 0, err)
 		return nil, fmt.Errorf("failed to receive messages: %w", err)
 	}
 
+	metrics.ObserveSQS(queueURL, "receive", len(out.Messages), nil)
+
 	resp := make([]ReceiveMessage, 0, len(out.Messages))
 	for _, msg := range out.Messages {
 		var m Message
 		if err := json.Unmarshal([]byte(*msg.Body), &m); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal message: %w", err)
 		}
+		traceContext := map[string]string{}
+		for k, v := range msg.MessageAttributes {
+			if v.StringValue != nil {
+				traceContext[k] = *v.StringValue
+			}
+		}
+		requestId := traceContext[requestIDAttribute]
+		delete(traceContext, requestIDAttribute)
 		resp = append(resp, ReceiveMessage{
 			Message:       m,
 			ReceiveHandle: msg.ReceiptHandle,
+			TraceContext:  traceContext,
+			RequestID:     requestId,
 		})
 	}
 	return resp, nil
 }
+
+func (p *SQSPublisherImpl) QueueStats(ctx context.Context) ([]ops.QueueStatus, error) {
+	queues := []struct {
+		url      string
+		taskType async_task.AsyncTaskType
+	}{
+		{p.archiveQueueURL, async_task.TaskArchive},
+		{p.cleanUpQueueURL, async_task.TaskLogCleanup},
+		{p.indexQueueURL, async_task.TaskIndex},
+		{p.tenantQueueURL, async_task.TaskTenantDeletion},
+		{p.reindexQueueURL, async_task.TaskReindex},
+		{p.rebuildQueueURL, async_task.TaskIndexRebuild},
+	}
+
+	stats := make([]ops.QueueStatus, 0, len(queues))
+	for _, q := range queues {
+		out, err := p.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+			QueueUrl: aws.String(q.url),
+			AttributeNames: []types.QueueAttributeName{
+				types.QueueAttributeNameApproximateNumberOfMessages,
+				types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+				types.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attributes of queue %s: %w", path.Base(q.url), err)
+		}
+
+		stats = append(stats, ops.QueueStatus{
+			Name:     path.Base(q.url),
+			TaskType: q.taskType,
+			Messages: attributeCount(out.Attributes, types.QueueAttributeNameApproximateNumberOfMessages),
+			InFlight: attributeCount(out.Attributes, types.QueueAttributeNameApproximateNumberOfMessagesNotVisible),
+			Delayed:  attributeCount(out.Attributes, types.QueueAttributeNameApproximateNumberOfMessagesDelayed),
+		})
+	}
+	return stats, nil
+}
+
+func attributeCount(attributes map[string]string, name types.QueueAttributeName) int64 {
+	n, _ := strconv.ParseInt(attributes[string(name)], 10, 64)
+	return n
+}
@@ -0,0 +1,57 @@
+package apikey
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+// last_used_at is only written when older than this, so busy producers don't write on every request
+const lastUsedResolution = time.Minute
+
+type AuthenticateAPIKeyUseCase struct {
+	Repo repository.APIKeyRepository
+}
+
+func NewAuthenticateAPIKeyUseCase(repo repository.APIKeyRepository) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{Repo: repo}
+}
+
+// Execute returns the active key matching rawKey and records its use
+func (uc *AuthenticateAPIKeyUseCase) Execute(ctx context.Context, rawKey string) (*api_key.APIKey, error) {
+	prefix, ok := parsePrefix(rawKey)
+	if !ok {
+		return nil, ErrUnauthorizedKey
+	}
+
+	key, err := uc.Repo.GetByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUnauthorizedKey
+		}
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashKey(rawKey)), []byte(key.KeyHash)) != 1 {
+		return nil, ErrUnauthorizedKey
+	}
+
+	now := time.Now().UTC()
+	if !key.IsActive(now) {
+		return nil, ErrUnauthorizedKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		if err := uc.Repo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			return nil, err
+		}
+		key.LastUsedAt = &now
+	}
+	return key, nil
+}
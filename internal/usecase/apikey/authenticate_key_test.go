@@ -0,0 +1,97 @@
+package apikey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestAuthenticateAPIKeyUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, raw := issueKey(t, ctrl)
+
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockRepo.EXPECT().GetByPrefix(gomock.Any(), stored.Prefix).Return(stored, nil)
+	mockRepo.EXPECT().TouchLastUsed(gomock.Any(), stored.ID, gomock.Any()).Return(nil)
+
+	key, err := uc.NewAuthenticateAPIKeyUseCase(mockRepo).Execute(context.Background(), raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "tenant-1", key.TenantID)
+	assert.NotNil(t, key.LastUsedAt)
+}
+
+func TestAuthenticateAPIKeyUseCase_Execute_RecentlyUsed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, raw := issueKey(t, ctrl)
+	recent := time.Now().UTC().Add(-10 * time.Second)
+	stored.LastUsedAt = &recent
+
+	// last_used_at is fresh enough, no write expected
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockRepo.EXPECT().GetByPrefix(gomock.Any(), stored.Prefix).Return(stored, nil)
+
+	_, err := uc.NewAuthenticateAPIKeyUseCase(mockRepo).Execute(context.Background(), raw)
+	assert.NoError(t, err)
+}
+
+func TestAuthenticateAPIKeyUseCase_Execute_Rejected(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name   string
+		tamper func(raw string) string
+		revoke bool
+		expire bool
+	}{
+		{"Wrong Secret", func(raw string) string { return raw[:len(raw)-2] + "xx" }, false, false},
+		{"Revoked", func(raw string) string { return raw }, true, false},
+		{"Expired", func(raw string) string { return raw }, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stored, raw := issueKey(t, ctrl)
+			if tt.revoke {
+				stored.RevokedAt = &past
+			}
+			if tt.expire {
+				stored.ExpiresAt = &past
+			}
+
+			mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+			mockRepo.EXPECT().GetByPrefix(gomock.Any(), stored.Prefix).Return(stored, nil)
+
+			_, err := uc.NewAuthenticateAPIKeyUseCase(mockRepo).Execute(context.Background(), tt.tamper(raw))
+			assert.ErrorIs(t, err, uc.ErrUnauthorizedKey)
+		})
+	}
+}
+
+func TestAuthenticateAPIKeyUseCase_Execute_Unknown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockRepo.EXPECT().GetByPrefix(gomock.Any(), "abcdefghijkl").Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.NewAuthenticateAPIKeyUseCase(mockRepo).Execute(context.Background(), "alk_abcdefghijklmnopqrstuvwxyz")
+	assert.ErrorIs(t, err, uc.ErrUnauthorizedKey)
+
+	_, err = uc.NewAuthenticateAPIKeyUseCase(mockRepo).Execute(context.Background(), "not-a-key")
+	assert.ErrorIs(t, err, uc.ErrUnauthorizedKey)
+}
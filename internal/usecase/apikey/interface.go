@@ -0,0 +1,33 @@
+package apikey
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+)
+
+// IssueAPIKeyUseCaseInterface defines behavior for issuing API keys, the raw key is only returned here.
+type IssueAPIKeyUseCaseInterface interface {
+	Execute(ctx context.Context, userId string, key api_key.APIKey) (*api_key.APIKey, string, error)
+}
+
+// ListAPIKeysUseCaseInterface defines behavior for listing API keys.
+type ListAPIKeysUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string) ([]api_key.APIKey, error)
+}
+
+// RotateAPIKeyUseCaseInterface defines behavior for replacing the secret of an API key.
+type RotateAPIKeyUseCaseInterface interface {
+	Execute(ctx context.Context, userId, id, tenantId string) (*api_key.APIKey, string, error)
+}
+
+// RevokeAPIKeyUseCaseInterface defines behavior for revoking API keys.
+type RevokeAPIKeyUseCaseInterface interface {
+	Execute(ctx context.Context, userId, id, tenantId string) error
+}
+
+// AuthenticateAPIKeyUseCaseInterface defines behavior for resolving the key sent by a caller.
+type AuthenticateAPIKeyUseCaseInterface interface {
+	Execute(ctx context.Context, rawKey string) (*api_key.APIKey, error)
+}
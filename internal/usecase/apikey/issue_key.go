@@ -0,0 +1,82 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+)
+
+type IssueAPIKeyUseCase struct {
+	Repo      repository.APIKeyRepository
+	TxManager interactor.TxManager
+	CreateLog log.CreateLogUseCaseInterface
+}
+
+func NewIssueAPIKeyUseCase(repo repository.APIKeyRepository, txManager interactor.TxManager, createLog log.CreateLogUseCaseInterface) *IssueAPIKeyUseCase {
+	return &IssueAPIKeyUseCase{Repo: repo, TxManager: txManager, CreateLog: createLog}
+}
+
+// Execute stores a new key for the tenant and returns it with the raw key, which cannot be retrieved later.
+func (uc *IssueAPIKeyUseCase) Execute(ctx context.Context, userId string, key api_key.APIKey) (*api_key.APIKey, string, error) {
+	if err := validateKey(key); err != nil {
+		return nil, "", err
+	}
+
+	raw, prefix, hash, err := generateKey()
+	if err != nil {
+		return nil, "", err
+	}
+	key.ID = uuid.New().String()
+	key.Prefix = prefix
+	key.KeyHash = hash
+	key.CreatedBy = userId
+	key.LastUsedAt = nil
+	key.RevokedAt = nil
+
+	var created *api_key.APIKey
+	if err := uc.TxManager.TransactionExec(ctx, func(txCtx context.Context) error {
+		db := uc.TxManager.GetTx(txCtx)
+		if created, err = uc.Repo.Create(txCtx, db, &key); err != nil {
+			return err
+		}
+		return auditKeyOperation(txCtx, uc.CreateLog, userId, *created, entitylog.ActionCreate, entitylog.SeverityInfo, "API key issued")
+	}); err != nil {
+		return nil, "", err
+	}
+	return created, raw, nil
+}
+
+func validateKey(key api_key.APIKey) error {
+	if len(key.TenantID) == 0 || len(key.Name) == 0 {
+		return fmt.Errorf("%w: tenant id and name are required", ErrInvalidKeyRequest)
+	}
+
+	// keys belong to a single tenant, admin access stays with user identities
+	role := auth.Role(key.Role)
+	if role != auth.RoleUser && role != auth.RoleAuditor {
+		return fmt.Errorf("%w: role must be user or auditor", ErrInvalidKeyRequest)
+	}
+
+	if len(key.Scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidKeyRequest)
+	}
+	for _, s := range key.Scopes {
+		if !s.IsValid() {
+			return fmt.Errorf("%w: unknown scope %q", ErrInvalidKeyRequest, s)
+		}
+	}
+
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("%w: expiry must be in the future", ErrInvalidKeyRequest)
+	}
+	return nil
+}
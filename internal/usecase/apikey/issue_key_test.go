@@ -0,0 +1,139 @@
+package apikey_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+
+	intMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	logMocks "github.com/Haevnen/audit-logging-api/internal/usecase/log/mocks"
+)
+
+func expectTx(mockTx *intMocks.MockTxManager) {
+	mockTx.EXPECT().
+		TransactionExec(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+			return fn(context.Background())
+		})
+	mockTx.EXPECT().GetTx(gomock.Any()).Return(&gorm.DB{})
+}
+
+func validKey() api_key.APIKey {
+	return api_key.APIKey{
+		TenantID: "tenant-1",
+		Name:     "billing-service",
+		Role:     "user",
+		Scopes:   []api_key.Scope{api_key.ScopeLogsWrite},
+	}
+}
+
+// issueKey issues a key through the use case and returns what was stored with the raw key
+func issueKey(t *testing.T, ctrl *gomock.Controller) (*api_key.APIKey, string) {
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	expectTx(mockTx)
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *gorm.DB, k *api_key.APIKey) (*api_key.APIKey, error) {
+			return k, nil
+		})
+	mockLog.EXPECT().Execute(gomock.Any(), "tenant-1", "admin-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, l entitylog.Log) (*entitylog.Log, error) {
+			return &l, nil
+		})
+
+	created, raw, err := uc.NewIssueAPIKeyUseCase(mockRepo, mockTx, mockLog).Execute(context.Background(), "admin-1", validKey())
+	assert.NoError(t, err)
+	return created, raw
+}
+
+func TestIssueAPIKeyUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	expectTx(mockTx)
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *gorm.DB, k *api_key.APIKey) (*api_key.APIKey, error) {
+			return k, nil
+		})
+
+	var audited entitylog.Log
+	mockLog.EXPECT().Execute(gomock.Any(), "tenant-1", "admin-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, l entitylog.Log) (*entitylog.Log, error) {
+			audited = l
+			return &l, nil
+		})
+
+	created, raw, err := uc.NewIssueAPIKeyUseCase(mockRepo, mockTx, mockLog).Execute(context.Background(), "admin-1", validKey())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "admin-1", created.CreatedBy)
+	assert.True(t, strings.HasPrefix(raw, "alk_"+created.Prefix))
+	assert.NotContains(t, created.KeyHash, raw)
+
+	assert.Equal(t, entitylog.ActionCreate, audited.Action)
+	assert.Equal(t, "api_key", *audited.Resource)
+	assert.Equal(t, created.ID, *audited.ResourceID)
+	assert.NotContains(t, string(*audited.Metadata), raw)
+	assert.NotContains(t, string(*audited.Metadata), created.KeyHash)
+}
+
+func TestIssueAPIKeyUseCase_Execute_Invalid(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name   string
+		mutate func(*api_key.APIKey)
+	}{
+		{"Admin Role", func(k *api_key.APIKey) { k.Role = "admin" }},
+		{"No Scope", func(k *api_key.APIKey) { k.Scopes = nil }},
+		{"Unknown Scope", func(k *api_key.APIKey) { k.Scopes = []api_key.Scope{"tenants:manage"} }},
+		{"Expired", func(k *api_key.APIKey) { k.ExpiresAt = &past }},
+		{"No Name", func(k *api_key.APIKey) { k.Name = "" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := validKey()
+			tt.mutate(&key)
+
+			_, _, err := uc.NewIssueAPIKeyUseCase(nil, nil, nil).Execute(context.Background(), "admin-1", key)
+			assert.ErrorIs(t, err, uc.ErrInvalidKeyRequest)
+		})
+	}
+}
+
+func TestIssueAPIKeyUseCase_Execute_AuditFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	expectTx(mockTx)
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *gorm.DB, k *api_key.APIKey) (*api_key.APIKey, error) {
+			return k, nil
+		})
+	mockLog.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+
+	_, raw, err := uc.NewIssueAPIKeyUseCase(mockRepo, mockTx, mockLog).Execute(context.Background(), "admin-1", validKey())
+	assert.Error(t, err)
+	assert.Empty(t, raw)
+}
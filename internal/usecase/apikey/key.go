@@ -0,0 +1,94 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+)
+
+const (
+	// keys look like alk_<prefix><secret>, the prefix is stored in clear to find the record
+	keyMarker    = "alk_"
+	prefixLength = 12
+	secretBytes  = 32
+
+	auditResource = "api_key"
+)
+
+var (
+	ErrInvalidKeyRequest = errors.New("invalid api key request")
+	ErrUnauthorizedKey   = errors.New("api key is invalid, expired or revoked")
+)
+
+// generateKey returns the raw key handed to the caller and the values stored for it
+func generateKey() (raw, prefix, hash string, err error) {
+	b := make([]byte, secretBytes)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+
+	body := base64.RawURLEncoding.EncodeToString(b)
+	raw = keyMarker + body
+	return raw, body[:prefixLength], hashKey(raw), nil
+}
+
+func parsePrefix(raw string) (string, bool) {
+	body, ok := strings.CutPrefix(raw, keyMarker)
+	if !ok || len(body) <= prefixLength {
+		return "", false
+	}
+	return body[:prefixLength], true
+}
+
+// hashKey uses a plain SHA-256, keys are random so they don't need a slow password hash
+func hashKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// auditKeyOperation records a key operation as an audit log of the key's tenant.
+// The raw key and its hash are never part of the entry. Called inside the transaction of the operation, the
+// entry is stored in it and commits with the key or not at all.
+func auditKeyOperation(ctx context.Context, createLog log.CreateLogUseCaseInterface, userId string, key api_key.APIKey, action entitylog.ActionType, severity entitylog.Severity, message string) error {
+	audit.Annotate(ctx, audit.KeyTenantID, key.TenantID)
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"name":       key.Name,
+		"prefix":     key.Prefix,
+		"role":       key.Role,
+		"scopes":     key.Scopes,
+		"expires_at": key.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	m := datatypes.JSON(metadata)
+	resource := auditResource
+	resourceId := key.ID
+
+	_, err = createLog.Execute(ctx, key.TenantID, userId, entitylog.Log{
+		TenantID:       key.TenantID,
+		UserID:         userId,
+		Action:         action,
+		Severity:       severity,
+		EventTimestamp: time.Now().UTC(),
+		Message:        message,
+		Resource:       &resource,
+		ResourceID:     &resourceId,
+		Metadata:       &m,
+	})
+	return err
+}
@@ -0,0 +1,21 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type ListAPIKeysUseCase struct {
+	Repo repository.APIKeyRepository
+}
+
+func NewListAPIKeysUseCase(repo repository.APIKeyRepository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{Repo: repo}
+}
+
+// Execute lists the keys of a tenant, or of every tenant when tenantId is empty
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context, tenantId string) ([]api_key.APIKey, error) {
+	return uc.Repo.List(ctx, tenantId)
+}
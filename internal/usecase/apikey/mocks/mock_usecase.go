@@ -0,0 +1,214 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	api_key "github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIssueAPIKeyUseCaseInterface is a mock of IssueAPIKeyUseCaseInterface interface.
+type MockIssueAPIKeyUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssueAPIKeyUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockIssueAPIKeyUseCaseInterfaceMockRecorder is the mock recorder for MockIssueAPIKeyUseCaseInterface.
+type MockIssueAPIKeyUseCaseInterfaceMockRecorder struct {
+	mock *MockIssueAPIKeyUseCaseInterface
+}
+
+// NewMockIssueAPIKeyUseCaseInterface creates a new mock instance.
+func NewMockIssueAPIKeyUseCaseInterface(ctrl *gomock.Controller) *MockIssueAPIKeyUseCaseInterface {
+	mock := &MockIssueAPIKeyUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockIssueAPIKeyUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssueAPIKeyUseCaseInterface) EXPECT() *MockIssueAPIKeyUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIssueAPIKeyUseCaseInterface) Execute(ctx context.Context, userId string, key api_key.APIKey) (*api_key.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, userId, key)
+	ret0, _ := ret[0].(*api_key.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIssueAPIKeyUseCaseInterfaceMockRecorder) Execute(ctx, userId, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIssueAPIKeyUseCaseInterface)(nil).Execute), ctx, userId, key)
+}
+
+// MockListAPIKeysUseCaseInterface is a mock of ListAPIKeysUseCaseInterface interface.
+type MockListAPIKeysUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListAPIKeysUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockListAPIKeysUseCaseInterfaceMockRecorder is the mock recorder for MockListAPIKeysUseCaseInterface.
+type MockListAPIKeysUseCaseInterfaceMockRecorder struct {
+	mock *MockListAPIKeysUseCaseInterface
+}
+
+// NewMockListAPIKeysUseCaseInterface creates a new mock instance.
+func NewMockListAPIKeysUseCaseInterface(ctrl *gomock.Controller) *MockListAPIKeysUseCaseInterface {
+	mock := &MockListAPIKeysUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListAPIKeysUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListAPIKeysUseCaseInterface) EXPECT() *MockListAPIKeysUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListAPIKeysUseCaseInterface) Execute(ctx context.Context, tenantId string) ([]api_key.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId)
+	ret0, _ := ret[0].([]api_key.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListAPIKeysUseCaseInterfaceMockRecorder) Execute(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListAPIKeysUseCaseInterface)(nil).Execute), ctx, tenantId)
+}
+
+// MockRotateAPIKeyUseCaseInterface is a mock of RotateAPIKeyUseCaseInterface interface.
+type MockRotateAPIKeyUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRotateAPIKeyUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRotateAPIKeyUseCaseInterfaceMockRecorder is the mock recorder for MockRotateAPIKeyUseCaseInterface.
+type MockRotateAPIKeyUseCaseInterfaceMockRecorder struct {
+	mock *MockRotateAPIKeyUseCaseInterface
+}
+
+// NewMockRotateAPIKeyUseCaseInterface creates a new mock instance.
+func NewMockRotateAPIKeyUseCaseInterface(ctrl *gomock.Controller) *MockRotateAPIKeyUseCaseInterface {
+	mock := &MockRotateAPIKeyUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRotateAPIKeyUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRotateAPIKeyUseCaseInterface) EXPECT() *MockRotateAPIKeyUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRotateAPIKeyUseCaseInterface) Execute(ctx context.Context, userId, id, tenantId string) (*api_key.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, userId, id, tenantId)
+	ret0, _ := ret[0].(*api_key.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRotateAPIKeyUseCaseInterfaceMockRecorder) Execute(ctx, userId, id, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRotateAPIKeyUseCaseInterface)(nil).Execute), ctx, userId, id, tenantId)
+}
+
+// MockRevokeAPIKeyUseCaseInterface is a mock of RevokeAPIKeyUseCaseInterface interface.
+type MockRevokeAPIKeyUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeAPIKeyUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRevokeAPIKeyUseCaseInterfaceMockRecorder is the mock recorder for MockRevokeAPIKeyUseCaseInterface.
+type MockRevokeAPIKeyUseCaseInterfaceMockRecorder struct {
+	mock *MockRevokeAPIKeyUseCaseInterface
+}
+
+// NewMockRevokeAPIKeyUseCaseInterface creates a new mock instance.
+func NewMockRevokeAPIKeyUseCaseInterface(ctrl *gomock.Controller) *MockRevokeAPIKeyUseCaseInterface {
+	mock := &MockRevokeAPIKeyUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRevokeAPIKeyUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeAPIKeyUseCaseInterface) EXPECT() *MockRevokeAPIKeyUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeAPIKeyUseCaseInterface) Execute(ctx context.Context, userId, id, tenantId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, userId, id, tenantId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeAPIKeyUseCaseInterfaceMockRecorder) Execute(ctx, userId, id, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeAPIKeyUseCaseInterface)(nil).Execute), ctx, userId, id, tenantId)
+}
+
+// MockAuthenticateAPIKeyUseCaseInterface is a mock of AuthenticateAPIKeyUseCaseInterface interface.
+type MockAuthenticateAPIKeyUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthenticateAPIKeyUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthenticateAPIKeyUseCaseInterfaceMockRecorder is the mock recorder for MockAuthenticateAPIKeyUseCaseInterface.
+type MockAuthenticateAPIKeyUseCaseInterfaceMockRecorder struct {
+	mock *MockAuthenticateAPIKeyUseCaseInterface
+}
+
+// NewMockAuthenticateAPIKeyUseCaseInterface creates a new mock instance.
+func NewMockAuthenticateAPIKeyUseCaseInterface(ctrl *gomock.Controller) *MockAuthenticateAPIKeyUseCaseInterface {
+	mock := &MockAuthenticateAPIKeyUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockAuthenticateAPIKeyUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticateAPIKeyUseCaseInterface) EXPECT() *MockAuthenticateAPIKeyUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAuthenticateAPIKeyUseCaseInterface) Execute(ctx context.Context, rawKey string) (*api_key.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, rawKey)
+	ret0, _ := ret[0].(*api_key.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAuthenticateAPIKeyUseCaseInterfaceMockRecorder) Execute(ctx, rawKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAuthenticateAPIKeyUseCaseInterface)(nil).Execute), ctx, rawKey)
+}
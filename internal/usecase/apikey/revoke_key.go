@@ -0,0 +1,41 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+)
+
+type RevokeAPIKeyUseCase struct {
+	Repo      repository.APIKeyRepository
+	TxManager interactor.TxManager
+	CreateLog log.CreateLogUseCaseInterface
+}
+
+func NewRevokeAPIKeyUseCase(repo repository.APIKeyRepository, txManager interactor.TxManager, createLog log.CreateLogUseCaseInterface) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{Repo: repo, TxManager: txManager, CreateLog: createLog}
+}
+
+// Execute revokes the key. The record is kept so past usage stays attributable, revoking twice is a no-op.
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, userId, id, tenantId string) error {
+	key, err := uc.Repo.GetByID(ctx, id, tenantId)
+	if err != nil {
+		return err
+	}
+	if key.RevokedAt != nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	key.RevokedAt = &now
+	return uc.TxManager.TransactionExec(ctx, func(txCtx context.Context) error {
+		if err := uc.Repo.Update(txCtx, uc.TxManager.GetTx(txCtx), key); err != nil {
+			return err
+		}
+		return auditKeyOperation(txCtx, uc.CreateLog, userId, *key, entitylog.ActionDelete, entitylog.SeverityWarning, "API key revoked")
+	})
+}
@@ -0,0 +1,59 @@
+package apikey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+
+	intMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	logMocks "github.com/Haevnen/audit-logging-api/internal/usecase/log/mocks"
+)
+
+func TestRevokeAPIKeyUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	expectTx(mockTx)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), "key-1", "").
+		Return(&api_key.APIKey{ID: "key-1", TenantID: "tenant-1", Role: "user"}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *gorm.DB, k *api_key.APIKey) error {
+			assert.NotNil(t, k.RevokedAt)
+			return nil
+		})
+	mockLog.EXPECT().Execute(gomock.Any(), "tenant-1", "admin-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, l entitylog.Log) (*entitylog.Log, error) {
+			assert.Equal(t, entitylog.ActionDelete, l.Action)
+			assert.Equal(t, "key-1", *l.ResourceID)
+			return &l, nil
+		})
+
+	err := uc.NewRevokeAPIKeyUseCase(mockRepo, mockTx, mockLog).Execute(context.Background(), "admin-1", "key-1", "")
+	assert.NoError(t, err)
+}
+
+func TestRevokeAPIKeyUseCase_Execute_AlreadyRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	revokedAt := time.Now()
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "key-1", "").
+		Return(&api_key.APIKey{ID: "key-1", TenantID: "tenant-1", RevokedAt: &revokedAt}, nil)
+
+	err := uc.NewRevokeAPIKeyUseCase(mockRepo, nil, nil).Execute(context.Background(), "admin-1", "key-1", "")
+	assert.NoError(t, err)
+}
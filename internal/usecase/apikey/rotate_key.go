@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+)
+
+type RotateAPIKeyUseCase struct {
+	Repo      repository.APIKeyRepository
+	TxManager interactor.TxManager
+	CreateLog log.CreateLogUseCaseInterface
+}
+
+func NewRotateAPIKeyUseCase(repo repository.APIKeyRepository, txManager interactor.TxManager, createLog log.CreateLogUseCaseInterface) *RotateAPIKeyUseCase {
+	return &RotateAPIKeyUseCase{Repo: repo, TxManager: txManager, CreateLog: createLog}
+}
+
+// Execute replaces the secret of the key, the previous raw key stops working immediately.
+// Name, role, scopes and expiry are kept.
+func (uc *RotateAPIKeyUseCase) Execute(ctx context.Context, userId, id, tenantId string) (*api_key.APIKey, string, error) {
+	key, err := uc.Repo.GetByID(ctx, id, tenantId)
+	if err != nil {
+		return nil, "", err
+	}
+	if !key.IsActive(time.Now()) {
+		return nil, "", fmt.Errorf("%w: key is revoked or expired", ErrInvalidKeyRequest)
+	}
+
+	raw, prefix, hash, err := generateKey()
+	if err != nil {
+		return nil, "", err
+	}
+	key.Prefix = prefix
+	key.KeyHash = hash
+	key.LastUsedAt = nil
+
+	if err := uc.TxManager.TransactionExec(ctx, func(txCtx context.Context) error {
+		if err := uc.Repo.Update(txCtx, uc.TxManager.GetTx(txCtx), key); err != nil {
+			return err
+		}
+		return auditKeyOperation(txCtx, uc.CreateLog, userId, *key, entitylog.ActionUpdate, entitylog.SeverityInfo, "API key rotated")
+	}); err != nil {
+		return nil, "", err
+	}
+	return key, raw, nil
+}
@@ -0,0 +1,72 @@
+package apikey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/api_key"
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/apikey"
+
+	intMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	logMocks "github.com/Haevnen/audit-logging-api/internal/usecase/log/mocks"
+)
+
+func TestRotateAPIKeyUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, oldRaw := issueKey(t, ctrl)
+	oldPrefix, oldHash := stored.Prefix, stored.KeyHash
+
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	expectTx(mockTx)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), stored.ID, "").Return(stored, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockLog.EXPECT().Execute(gomock.Any(), "tenant-1", "admin-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, l entitylog.Log) (*entitylog.Log, error) {
+			assert.Equal(t, entitylog.ActionUpdate, l.Action)
+			return &l, nil
+		})
+
+	rotated, raw, err := uc.NewRotateAPIKeyUseCase(mockRepo, mockTx, mockLog).Execute(context.Background(), "admin-1", stored.ID, "")
+	assert.NoError(t, err)
+	assert.Equal(t, stored.ID, rotated.ID)
+	assert.NotEqual(t, oldRaw, raw)
+	assert.NotEqual(t, oldPrefix, rotated.Prefix)
+	assert.NotEqual(t, oldHash, rotated.KeyHash)
+	assert.Equal(t, []api_key.Scope{api_key.ScopeLogsWrite}, []api_key.Scope(rotated.Scopes))
+}
+
+func TestRotateAPIKeyUseCase_Execute_Revoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	revokedAt := time.Now()
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "key-1", "").
+		Return(&api_key.APIKey{ID: "key-1", TenantID: "tenant-1", RevokedAt: &revokedAt}, nil)
+
+	_, _, err := uc.NewRotateAPIKeyUseCase(mockRepo, nil, nil).Execute(context.Background(), "admin-1", "key-1", "")
+	assert.ErrorIs(t, err, uc.ErrInvalidKeyRequest)
+}
+
+func TestRotateAPIKeyUseCase_Execute_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAPIKeyRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "key-1", "").Return(nil, gorm.ErrRecordNotFound)
+
+	_, _, err := uc.NewRotateAPIKeyUseCase(mockRepo, nil, nil).Execute(context.Background(), "admin-1", "key-1", "")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
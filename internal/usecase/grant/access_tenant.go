@@ -0,0 +1,39 @@
+package grant
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+)
+
+type AccessTenantUseCase struct {
+	Repo      repository.AccessGrantRepository
+	CreateLog log.CreateLogUseCaseInterface
+}
+
+func NewAccessTenantUseCase(repo repository.AccessGrantRepository, createLog log.CreateLogUseCaseInterface) *AccessTenantUseCase {
+	return &AccessTenantUseCase{Repo: repo, CreateLog: createLog}
+}
+
+// Execute finds the active grant letting the grantee of the home tenant read the tenant and records the
+// access in the tenant's own logs. The read is refused when it can't be recorded.
+func (uc *AccessTenantUseCase) Execute(ctx context.Context, granteeTenantId, granteeId, tenantId, operation string, details map[string]string) (*access_grant.AccessGrant, error) {
+	g, err := uc.Repo.FindActive(ctx, granteeTenantId, granteeId, tenantId, time.Now().UTC())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNoGrant
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auditAccess(ctx, uc.CreateLog, *g, tenantId, operation, details); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
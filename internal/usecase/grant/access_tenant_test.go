@@ -0,0 +1,97 @@
+package grant_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/grant"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	logMocks "github.com/Haevnen/audit-logging-api/internal/usecase/log/mocks"
+)
+
+func activeGrant() *access_grant.AccessGrant {
+	return &access_grant.AccessGrant{
+		ID:              "grant-1",
+		GranteeTenantID: "tenant-9",
+		GranteeID:       "auditor-1",
+		TenantIDs:       []string{"tenant-2"},
+		Reason:          "SOC2 audit",
+		StartsAt:        time.Now().Add(-time.Hour),
+		ExpiresAt:       time.Now().Add(time.Hour),
+	}
+}
+
+func TestAccessTenantUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-9", "auditor-1", "tenant-2", gomock.Any()).Return(activeGrant(), nil)
+	mockLog.EXPECT().Execute(gomock.Any(), "tenant-2", "auditor-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, l entitylog.Log) (*entitylog.Log, error) {
+			assert.Equal(t, entitylog.ActionView, l.Action)
+			assert.Equal(t, "access_grant", *l.Resource)
+			assert.Equal(t, "grant-1", *l.ResourceID)
+
+			var metadata map[string]interface{}
+			assert.NoError(t, json.Unmarshal(*l.Metadata, &metadata))
+			assert.Equal(t, "search", metadata["operation"])
+			assert.Equal(t, "login", metadata["query"])
+			assert.Equal(t, "grant-1", metadata["grant_id"])
+			return &l, nil
+		})
+
+	g, err := uc.NewAccessTenantUseCase(mockRepo, mockLog).Execute(context.Background(), "tenant-9", "auditor-1", "tenant-2", "search",
+		map[string]string{"query": "login", "grant_id": "spoofed"})
+	assert.NoError(t, err)
+	assert.Equal(t, "grant-1", g.ID)
+}
+
+func TestAccessTenantUseCase_Execute_NoGrant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-9", "auditor-1", "tenant-3", gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.NewAccessTenantUseCase(mockRepo, logMocks.NewMockCreateLogUseCaseInterface(ctrl)).
+		Execute(context.Background(), "tenant-9", "auditor-1", "tenant-3", "search", nil)
+	assert.ErrorIs(t, err, uc.ErrNoGrant)
+}
+
+func TestAccessTenantUseCase_Execute_AuditFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-9", "auditor-1", "tenant-2", gomock.Any()).Return(activeGrant(), nil)
+	mockLog.EXPECT().Execute(gomock.Any(), "tenant-2", "auditor-1", gomock.Any()).Return(nil, errors.New("db error"))
+
+	_, err := uc.NewAccessTenantUseCase(mockRepo, mockLog).Execute(context.Background(), "tenant-9", "auditor-1", "tenant-2", "get", nil)
+	assert.EqualError(t, err, "db error")
+}
+
+func TestAccessTenantUseCase_Execute_SameUserOfAnotherTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the grant is for auditor-1 of tenant-9, auditor-1 of tenant-5 is another user
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-5", "auditor-1", "tenant-2", gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.NewAccessTenantUseCase(mockRepo, logMocks.NewMockCreateLogUseCaseInterface(ctrl)).
+		Execute(context.Background(), "tenant-5", "auditor-1", "tenant-2", "search", nil)
+	assert.ErrorIs(t, err, uc.ErrNoGrant)
+}
@@ -0,0 +1,67 @@
+package grant
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type CreateGrantUseCase struct {
+	Repo repository.AccessGrantRepository
+}
+
+func NewCreateGrantUseCase(repo repository.AccessGrantRepository) *CreateGrantUseCase {
+	return &CreateGrantUseCase{Repo: repo}
+}
+
+// Execute grants the grantee of the home tenant read access to the tenants until ExpiresAt. Grants are
+// always time-boxed, they start right away unless StartsAt is set.
+func (uc *CreateGrantUseCase) Execute(ctx context.Context, createdBy string, g access_grant.AccessGrant) (*access_grant.AccessGrant, error) {
+	g.GranteeTenantID = strings.TrimSpace(g.GranteeTenantID)
+	if len(g.GranteeTenantID) == 0 {
+		return nil, fmt.Errorf("%w: grantee_tenant_id is required", ErrInvalidGrant)
+	}
+	g.GranteeID = strings.TrimSpace(g.GranteeID)
+	if len(g.GranteeID) == 0 {
+		return nil, fmt.Errorf("%w: grantee_id is required", ErrInvalidGrant)
+	}
+	g.Reason = strings.TrimSpace(g.Reason)
+	if len(g.Reason) == 0 {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidGrant)
+	}
+
+	tenants := make([]string, 0, len(g.TenantIDs))
+	for _, t := range g.TenantIDs {
+		t = strings.TrimSpace(t)
+		if len(t) > 0 && !slices.Contains(tenants, t) {
+			tenants = append(tenants, t)
+		}
+	}
+	if len(tenants) == 0 {
+		return nil, fmt.Errorf("%w: at least one tenant is required", ErrInvalidGrant)
+	}
+	g.TenantIDs = tenants
+
+	now := time.Now().UTC()
+	if g.StartsAt.IsZero() {
+		g.StartsAt = now
+	}
+	if g.ExpiresAt.IsZero() || !g.ExpiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expires_at must be in the future", ErrInvalidGrant)
+	}
+	if !g.ExpiresAt.After(g.StartsAt) {
+		return nil, fmt.Errorf("%w: expires_at must be after starts_at", ErrInvalidGrant)
+	}
+
+	g.ID = uuid.New().String()
+	g.CreatedBy = createdBy
+	g.RevokedAt = nil
+	return uc.Repo.Create(ctx, &g)
+}
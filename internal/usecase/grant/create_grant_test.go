@@ -0,0 +1,86 @@
+package grant_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/grant"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestCreateGrantUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, g *access_grant.AccessGrant) (*access_grant.AccessGrant, error) {
+			assert.NotEmpty(t, g.ID)
+			assert.Equal(t, "admin-1", g.CreatedBy)
+			assert.False(t, g.StartsAt.IsZero())
+			return g, nil
+		})
+
+	g, err := uc.NewCreateGrantUseCase(mockRepo).Execute(context.Background(), "admin-1", access_grant.AccessGrant{
+		GranteeTenantID: " tenant-9 ",
+		GranteeID:       " auditor-1 ",
+		TenantIDs:       []string{"tenant-1", "tenant-2", "tenant-1", " "},
+		Reason:          "SOC2 audit",
+		ExpiresAt:       expiresAt,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "tenant-9", g.GranteeTenantID)
+	assert.Equal(t, "auditor-1", g.GranteeID)
+	assert.Equal(t, []string{"tenant-1", "tenant-2"}, []string(g.TenantIDs))
+}
+
+func TestCreateGrantUseCase_Execute_Invalid(t *testing.T) {
+	now := time.Now()
+	valid := access_grant.AccessGrant{GranteeTenantID: "tenant-9", GranteeID: "auditor-1", TenantIDs: []string{"tenant-1"}, Reason: "audit", ExpiresAt: now.Add(time.Hour)}
+
+	tests := []struct {
+		name   string
+		modify func(g *access_grant.AccessGrant)
+	}{
+		{"Missing Grantee Tenant", func(g *access_grant.AccessGrant) { g.GranteeTenantID = " " }},
+		{"Missing Grantee", func(g *access_grant.AccessGrant) { g.GranteeID = "" }},
+		{"Missing Reason", func(g *access_grant.AccessGrant) { g.Reason = " " }},
+		{"No Tenant", func(g *access_grant.AccessGrant) { g.TenantIDs = nil }},
+		{"No Expiry", func(g *access_grant.AccessGrant) { g.ExpiresAt = time.Time{} }},
+		{"Expired", func(g *access_grant.AccessGrant) { g.ExpiresAt = now.Add(-time.Minute) }},
+		{"Ends Before Start", func(g *access_grant.AccessGrant) { g.StartsAt = now.Add(2 * time.Hour) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			g := valid
+			tt.modify(&g)
+			_, err := uc.NewCreateGrantUseCase(repoMocks.NewMockAccessGrantRepository(ctrl)).Execute(context.Background(), "admin-1", g)
+			assert.ErrorIs(t, err, uc.ErrInvalidGrant)
+		})
+	}
+}
+
+func TestCreateGrantUseCase_Execute_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+	_, err := uc.NewCreateGrantUseCase(mockRepo).Execute(context.Background(), "admin-1", access_grant.AccessGrant{
+		GranteeTenantID: "tenant-9", GranteeID: "auditor-1", TenantIDs: []string{"tenant-1"}, Reason: "audit", ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.EqualError(t, err, "db error")
+}
@@ -0,0 +1,57 @@
+package grant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+)
+
+const auditResource = "access_grant"
+
+var (
+	ErrInvalidGrant = errors.New("invalid access grant")
+	ErrNoGrant      = errors.New("no active access grant")
+)
+
+// auditAccess records a read made under the grant as an audit log of the tenant being read, so the
+// tenant sees who looked at its logs and why.
+func auditAccess(ctx context.Context, createLog log.CreateLogUseCaseInterface, g access_grant.AccessGrant, tenantId, operation string, details map[string]string) error {
+	payload := map[string]interface{}{
+		"grant_id":   g.ID,
+		"operation":  operation,
+		"reason":     g.Reason,
+		"expires_at": g.ExpiresAt,
+	}
+	for k, v := range details {
+		if _, ok := payload[k]; !ok {
+			payload[k] = v
+		}
+	}
+	metadata, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	m := datatypes.JSON(metadata)
+	resource := auditResource
+	resourceId := g.ID
+
+	_, err = createLog.Execute(ctx, tenantId, g.GranteeID, entitylog.Log{
+		TenantID:       tenantId,
+		UserID:         g.GranteeID,
+		Action:         entitylog.ActionView,
+		Severity:       entitylog.SeverityInfo,
+		EventTimestamp: time.Now().UTC(),
+		Message:        "Logs accessed under access grant: " + operation,
+		Resource:       &resource,
+		ResourceID:     &resourceId,
+		Metadata:       &m,
+	})
+	return err
+}
@@ -0,0 +1,28 @@
+package grant
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+)
+
+// CreateGrantUseCaseInterface defines behavior for granting cross-tenant read access.
+type CreateGrantUseCaseInterface interface {
+	Execute(ctx context.Context, createdBy string, g access_grant.AccessGrant) (*access_grant.AccessGrant, error)
+}
+
+// ListGrantsUseCaseInterface defines behavior for listing access grants.
+type ListGrantsUseCaseInterface interface {
+	Execute(ctx context.Context, granteeId, tenantId string) ([]access_grant.AccessGrant, error)
+}
+
+// RevokeGrantUseCaseInterface defines behavior for ending an access grant early.
+type RevokeGrantUseCaseInterface interface {
+	Execute(ctx context.Context, id string) (*access_grant.AccessGrant, error)
+}
+
+// AccessTenantUseCaseInterface defines behavior for checking and recording a read of another tenant.
+type AccessTenantUseCaseInterface interface {
+	Execute(ctx context.Context, granteeTenantId, granteeId, tenantId, operation string, details map[string]string) (*access_grant.AccessGrant, error)
+}
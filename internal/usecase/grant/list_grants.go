@@ -0,0 +1,20 @@
+package grant
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type ListGrantsUseCase struct {
+	Repo repository.AccessGrantRepository
+}
+
+func NewListGrantsUseCase(repo repository.AccessGrantRepository) *ListGrantsUseCase {
+	return &ListGrantsUseCase{Repo: repo}
+}
+
+func (uc *ListGrantsUseCase) Execute(ctx context.Context, granteeId, tenantId string) ([]access_grant.AccessGrant, error) {
+	return uc.Repo.List(ctx, granteeId, tenantId)
+}
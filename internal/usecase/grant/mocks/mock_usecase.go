@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	access_grant "github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateGrantUseCaseInterface is a mock of CreateGrantUseCaseInterface interface.
+type MockCreateGrantUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateGrantUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateGrantUseCaseInterfaceMockRecorder is the mock recorder for MockCreateGrantUseCaseInterface.
+type MockCreateGrantUseCaseInterfaceMockRecorder struct {
+	mock *MockCreateGrantUseCaseInterface
+}
+
+// NewMockCreateGrantUseCaseInterface creates a new mock instance.
+func NewMockCreateGrantUseCaseInterface(ctrl *gomock.Controller) *MockCreateGrantUseCaseInterface {
+	mock := &MockCreateGrantUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateGrantUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateGrantUseCaseInterface) EXPECT() *MockCreateGrantUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateGrantUseCaseInterface) Execute(ctx context.Context, createdBy string, g access_grant.AccessGrant) (*access_grant.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, createdBy, g)
+	ret0, _ := ret[0].(*access_grant.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateGrantUseCaseInterfaceMockRecorder) Execute(ctx, createdBy, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateGrantUseCaseInterface)(nil).Execute), ctx, createdBy, g)
+}
+
+// MockListGrantsUseCaseInterface is a mock of ListGrantsUseCaseInterface interface.
+type MockListGrantsUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListGrantsUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockListGrantsUseCaseInterfaceMockRecorder is the mock recorder for MockListGrantsUseCaseInterface.
+type MockListGrantsUseCaseInterfaceMockRecorder struct {
+	mock *MockListGrantsUseCaseInterface
+}
+
+// NewMockListGrantsUseCaseInterface creates a new mock instance.
+func NewMockListGrantsUseCaseInterface(ctrl *gomock.Controller) *MockListGrantsUseCaseInterface {
+	mock := &MockListGrantsUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListGrantsUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListGrantsUseCaseInterface) EXPECT() *MockListGrantsUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListGrantsUseCaseInterface) Execute(ctx context.Context, granteeId, tenantId string) ([]access_grant.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, granteeId, tenantId)
+	ret0, _ := ret[0].([]access_grant.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListGrantsUseCaseInterfaceMockRecorder) Execute(ctx, granteeId, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListGrantsUseCaseInterface)(nil).Execute), ctx, granteeId, tenantId)
+}
+
+// MockRevokeGrantUseCaseInterface is a mock of RevokeGrantUseCaseInterface interface.
+type MockRevokeGrantUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeGrantUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRevokeGrantUseCaseInterfaceMockRecorder is the mock recorder for MockRevokeGrantUseCaseInterface.
+type MockRevokeGrantUseCaseInterfaceMockRecorder struct {
+	mock *MockRevokeGrantUseCaseInterface
+}
+
+// NewMockRevokeGrantUseCaseInterface creates a new mock instance.
+func NewMockRevokeGrantUseCaseInterface(ctrl *gomock.Controller) *MockRevokeGrantUseCaseInterface {
+	mock := &MockRevokeGrantUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRevokeGrantUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeGrantUseCaseInterface) EXPECT() *MockRevokeGrantUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeGrantUseCaseInterface) Execute(ctx context.Context, id string) (*access_grant.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id)
+	ret0, _ := ret[0].(*access_grant.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeGrantUseCaseInterfaceMockRecorder) Execute(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeGrantUseCaseInterface)(nil).Execute), ctx, id)
+}
+
+// MockAccessTenantUseCaseInterface is a mock of AccessTenantUseCaseInterface interface.
+type MockAccessTenantUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTenantUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessTenantUseCaseInterfaceMockRecorder is the mock recorder for MockAccessTenantUseCaseInterface.
+type MockAccessTenantUseCaseInterfaceMockRecorder struct {
+	mock *MockAccessTenantUseCaseInterface
+}
+
+// NewMockAccessTenantUseCaseInterface creates a new mock instance.
+func NewMockAccessTenantUseCaseInterface(ctrl *gomock.Controller) *MockAccessTenantUseCaseInterface {
+	mock := &MockAccessTenantUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockAccessTenantUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTenantUseCaseInterface) EXPECT() *MockAccessTenantUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAccessTenantUseCaseInterface) Execute(ctx context.Context, granteeTenantId, granteeId, tenantId, operation string, details map[string]string) (*access_grant.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, granteeTenantId, granteeId, tenantId, operation, details)
+	ret0, _ := ret[0].(*access_grant.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAccessTenantUseCaseInterfaceMockRecorder) Execute(ctx, granteeTenantId, granteeId, tenantId, operation, details any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAccessTenantUseCaseInterface)(nil).Execute), ctx, granteeTenantId, granteeId, tenantId, operation, details)
+}
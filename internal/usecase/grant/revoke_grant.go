@@ -0,0 +1,35 @@
+package grant
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type RevokeGrantUseCase struct {
+	Repo repository.AccessGrantRepository
+}
+
+func NewRevokeGrantUseCase(repo repository.AccessGrantRepository) *RevokeGrantUseCase {
+	return &RevokeGrantUseCase{Repo: repo}
+}
+
+// Execute ends the grant now, revoking an already revoked grant keeps the first revocation time
+func (uc *RevokeGrantUseCase) Execute(ctx context.Context, id string) (*access_grant.AccessGrant, error) {
+	g, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g.RevokedAt != nil {
+		return g, nil
+	}
+
+	now := time.Now().UTC()
+	g.RevokedAt = &now
+	if err := uc.Repo.Update(ctx, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
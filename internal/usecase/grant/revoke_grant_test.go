@@ -0,0 +1,57 @@
+package grant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/access_grant"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/grant"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestRevokeGrantUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "grant-1").Return(&access_grant.AccessGrant{ID: "grant-1"}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, g *access_grant.AccessGrant) error {
+			assert.NotNil(t, g.RevokedAt)
+			return nil
+		})
+
+	g, err := uc.NewRevokeGrantUseCase(mockRepo).Execute(context.Background(), "grant-1")
+	assert.NoError(t, err)
+	assert.False(t, g.IsActive(time.Now()))
+}
+
+func TestRevokeGrantUseCase_Execute_AlreadyRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	revokedAt := time.Now().Add(-time.Hour)
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "grant-1").Return(&access_grant.AccessGrant{ID: "grant-1", RevokedAt: &revokedAt}, nil)
+
+	g, err := uc.NewRevokeGrantUseCase(mockRepo).Execute(context.Background(), "grant-1")
+	assert.NoError(t, err)
+	assert.Equal(t, revokedAt, *g.RevokedAt)
+}
+
+func TestRevokeGrantUseCase_Execute_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAccessGrantRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "grant-1").Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.NewRevokeGrantUseCase(mockRepo).Execute(context.Background(), "grant-1")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/Haevnen/audit-logging-api/internal/interactor"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
 	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/redaction"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/schema"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
 type CreateLogUseCase struct {
@@ -18,17 +21,27 @@ type CreateLogUseCase struct {
 	TxManager      interactor.TxManager
 	QueuePublisher service.SQSPublisher
 	PubSub         service.PubSub
+	Redactor       redaction.RedactLogUseCaseInterface
+	// Validator checks the logs against the schemas of their tenant, nil for the logs written by the service
+	Validator schema.ValidateLogUseCaseInterface
 }
 
-func NewCreateLogUseCase(repo repository.LogRepository, txManager interactor.TxManager, queuePublisher service.SQSPublisher, pubSub service.PubSub, asyncTaskRepo repository.AsyncTaskRepository) *CreateLogUseCase {
-	return &CreateLogUseCase{Repo: repo, TxManager: txManager, QueuePublisher: queuePublisher, PubSub: pubSub, AsyncTaskRepo: asyncTaskRepo}
+func NewCreateLogUseCase(repo repository.LogRepository, txManager interactor.TxManager, queuePublisher service.SQSPublisher, pubSub service.PubSub, asyncTaskRepo repository.AsyncTaskRepository, redactor redaction.RedactLogUseCaseInterface, validator schema.ValidateLogUseCaseInterface) *CreateLogUseCase {
+	return &CreateLogUseCase{Repo: repo, TxManager: txManager, QueuePublisher: queuePublisher, PubSub: pubSub, AsyncTaskRepo: asyncTaskRepo, Redactor: redactor, Validator: validator}
 }
 
-func (uc *CreateLogUseCase) Execute(ctx context.Context, tenantId, userId string, log entitylog.Log) (*entitylog.Log, error) {
+func (uc *CreateLogUseCase) Execute(ctx context.Context, tenantId, userId string, log entitylog.Log) (_ *entitylog.Log, err error) {
+	ctx, span := tracing.Start(ctx, "CreateLogUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
 	if log.ID == "" {
 		log.ID = uuid.New().String()
 	}
 
+	if err := uc.prepare(ctx, &log); err != nil {
+		return nil, err
+	}
+
 	// Start a transaction to write log to db, publish SQS message to worker to index opensearch
 	if err := uc.TxManager.TransactionExec(ctx, func(txCtx context.Context) error {
 		db := uc.TxManager.GetTx(txCtx)
@@ -40,7 +53,7 @@ func (uc *CreateLogUseCase) Execute(ctx context.Context, tenantId, userId string
 		// 2. Create a corresponding task in asyncTask table
 		task := &async_task.AsyncTask{
 			TaskID:   uuid.New().String(),
-			TaskType: async_task.TaskReindex,
+			TaskType: async_task.TaskIndex,
 			Status:   async_task.StatusPending,
 			UserID:   userId,
 		}
@@ -59,16 +72,25 @@ func (uc *CreateLogUseCase) Execute(ctx context.Context, tenantId, userId string
 		return nil, err
 	}
 
-	// Broadcast log to redis
-	_ = uc.PubSub.BroadcastLog(ctx, log)
+	// Broadcast log to redis, the service's own audit trail is not streamed to tenants
+	if !log.System {
+		_ = uc.PubSub.BroadcastLog(ctx, log)
+	}
 	return &log, nil
 }
 
-func (uc *CreateLogUseCase) ExecuteBulk(ctx context.Context, tenantId, userId string, logs []entitylog.Log) ([]entitylog.Log, error) {
+func (uc *CreateLogUseCase) ExecuteBulk(ctx context.Context, tenantId, userId string, logs []entitylog.Log) (_ []entitylog.Log, err error) {
+	ctx, span := tracing.Start(ctx, "CreateLogUseCase.ExecuteBulk")
+	defer func() { tracing.End(span, err) }()
+
 	for i := range logs {
 		if logs[i].ID == "" {
 			logs[i].ID = uuid.New().String()
 		}
+
+		if err := uc.prepare(ctx, &logs[i]); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := uc.TxManager.TransactionExec(ctx, func(txCtx context.Context) error {
@@ -80,7 +102,7 @@ func (uc *CreateLogUseCase) ExecuteBulk(ctx context.Context, tenantId, userId st
 		// 2. Create a corresponding task in asyncTask table
 		task := &async_task.AsyncTask{
 			TaskID:   uuid.New().String(),
-			TaskType: async_task.TaskReindex,
+			TaskType: async_task.TaskIndex,
 			Status:   async_task.StatusPending,
 			UserID:   userId,
 		}
@@ -100,8 +122,37 @@ func (uc *CreateLogUseCase) ExecuteBulk(ctx context.Context, tenantId, userId st
 	}
 
 	// Broadcast logs to redis
-	_ = uc.PubSub.BroadcastLogs(ctx, logs)
+	if streamed := streamedLogs(logs); len(streamed) > 0 {
+		_ = uc.PubSub.BroadcastLogs(ctx, streamed)
+	}
 
 	return logs, nil
 
 }
+
+// streamedLogs leaves out the service's own audit trail like Execute does, it is not streamed to tenants
+func streamedLogs(logs []entitylog.Log) []entitylog.Log {
+	streamed := make([]entitylog.Log, 0, len(logs))
+	for _, l := range logs {
+		if !l.System {
+			streamed = append(streamed, l)
+		}
+	}
+	return streamed
+}
+
+// prepare redacts the log before anything is persisted, indexed or streamed.
+// The schema is checked against the redacted log, the one stored, and a violation
+// comes back as a schema.ViolationError. The diff is computed afterwards so it never
+// carries unredacted values.
+func (uc *CreateLogUseCase) prepare(ctx context.Context, log *entitylog.Log) error {
+	if err := uc.Redactor.Execute(ctx, log); err != nil {
+		return err
+	}
+	if uc.Validator != nil {
+		if err := uc.Validator.Execute(ctx, log); err != nil {
+			return err
+		}
+	}
+	return attachDiff(log)
+}
@@ -2,20 +2,26 @@ package log_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
 	uc "github.com/Haevnen/audit-logging-api/internal/usecase/log"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/schema"
 
 	intMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
 	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
 	svcMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+	redactionMocks "github.com/Haevnen/audit-logging-api/internal/usecase/redaction/mocks"
+	schemaMocks "github.com/Haevnen/audit-logging-api/internal/usecase/schema/mocks"
 )
 
 func TestCreateLogUseCase_Execute_Success(t *testing.T) {
@@ -27,6 +33,10 @@ func TestCreateLogUseCase_Execute_Success(t *testing.T) {
 	mockTx := intMocks.NewMockTxManager(ctrl)
 	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
 	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	ctx := context.Background()
 	logEntry := entitylog.Log{Message: "test"}
@@ -44,7 +54,7 @@ func TestCreateLogUseCase_Execute_Success(t *testing.T) {
 	mockSQS.EXPECT().PublishIndexMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	mockPub.EXPECT().BroadcastLog(gomock.Any(), gomock.Any()).Return(nil)
 
-	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync)
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
 
 	result, err := ucase.Execute(ctx, "tenant-1", "user-1", logEntry)
 	assert.NoError(t, err)
@@ -52,6 +62,103 @@ func TestCreateLogUseCase_Execute_Success(t *testing.T) {
 	assert.NotEmpty(t, result.ID)
 }
 
+func TestCreateLogUseCase_Execute_SystemNotBroadcast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	mockAsync := repoMocks.NewMockAsyncTaskRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
+	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	mockTx.EXPECT().
+		TransactionExec(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+			return fn(context.Background())
+		})
+	mockTx.EXPECT().GetTx(gomock.Any()).Return(&gorm.DB{})
+
+	mockRepo.EXPECT().CreateBulk(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockAsync.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&async_task.AsyncTask{TaskID: uuid.New().String()}, nil)
+	mockSQS.EXPECT().PublishIndexMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	// no BroadcastLog: system entries are not streamed to tenants
+
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
+
+	result, err := ucase.Execute(context.Background(), "tenant-1", "user-1", entitylog.Log{Message: "GET /logs answered 200", System: true})
+	assert.NoError(t, err)
+	assert.True(t, result.System)
+}
+
+func TestCreateLogUseCase_Execute_AttachesDiff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	mockAsync := repoMocks.NewMockAsyncTaskRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
+	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	before := datatypes.JSON(`{"plan":{"tier":"basic"}}`)
+	after := datatypes.JSON(`{"plan":{"tier":"pro"}}`)
+	logEntry := entitylog.Log{Message: "plan upgraded", Action: entitylog.ActionUpdate, BeforeState: &before, AfterState: &after}
+
+	mockTx.EXPECT().
+		TransactionExec(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+			return fn(context.Background())
+		})
+	mockTx.EXPECT().GetTx(gomock.Any()).Return(&gorm.DB{})
+
+	mockRepo.EXPECT().CreateBulk(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *gorm.DB, logs []entitylog.Log) error {
+			assert.NotNil(t, logs[0].Diff)
+			return nil
+		})
+	mockAsync.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&async_task.AsyncTask{TaskID: uuid.New().String()}, nil)
+	mockSQS.EXPECT().PublishIndexMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockPub.EXPECT().BroadcastLog(gomock.Any(), gomock.Any()).Return(nil)
+
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
+
+	result, err := ucase.Execute(context.Background(), "tenant-1", "user-1", logEntry)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"path":"plan.tier","op":"changed","old_value":"\"basic\"","new_value":"\"pro\""}]`, string(*result.Diff))
+}
+
+func TestCreateLogUseCase_Execute_Fail_Redaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	mockAsync := repoMocks.NewMockAsyncTaskRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
+	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(assert.AnError)
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
+
+	result, err := ucase.Execute(context.Background(), "tenant-1", "user-1", entitylog.Log{Message: "secret"})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
 func TestCreateLogUseCase_Execute_Fail_Repo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -61,6 +168,10 @@ func TestCreateLogUseCase_Execute_Fail_Repo(t *testing.T) {
 	mockTx := intMocks.NewMockTxManager(ctrl)
 	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
 	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	ctx := context.Background()
 	logEntry := entitylog.Log{Message: "fail repo"}
@@ -74,7 +185,7 @@ func TestCreateLogUseCase_Execute_Fail_Repo(t *testing.T) {
 
 	mockRepo.EXPECT().CreateBulk(gomock.Any(), gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync)
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
 
 	result, err := ucase.Execute(ctx, "tenant-1", "user-1", logEntry)
 	assert.Error(t, err)
@@ -90,6 +201,10 @@ func TestCreateLogUseCase_Execute_Fail_AsyncTask(t *testing.T) {
 	mockTx := intMocks.NewMockTxManager(ctrl)
 	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
 	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	ctx := context.Background()
 	logEntry := entitylog.Log{Message: "fail async"}
@@ -104,7 +219,7 @@ func TestCreateLogUseCase_Execute_Fail_AsyncTask(t *testing.T) {
 	mockRepo.EXPECT().CreateBulk(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	mockAsync.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
 
-	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync)
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
 
 	result, err := ucase.Execute(ctx, "tenant-1", "user-1", logEntry)
 	assert.Error(t, err)
@@ -120,6 +235,10 @@ func TestCreateLogUseCase_Execute_Fail_SQS(t *testing.T) {
 	mockTx := intMocks.NewMockTxManager(ctrl)
 	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
 	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	ctx := context.Background()
 	logEntry := entitylog.Log{Message: "fail sqs"}
@@ -136,7 +255,7 @@ func TestCreateLogUseCase_Execute_Fail_SQS(t *testing.T) {
 		Return(&async_task.AsyncTask{TaskID: uuid.New().String()}, nil)
 	mockSQS.EXPECT().PublishIndexMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync)
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
 
 	result, err := ucase.Execute(ctx, "tenant-1", "user-1", logEntry)
 	assert.Error(t, err)
@@ -152,6 +271,10 @@ func TestCreateLogUseCase_ExecuteBulk_Success(t *testing.T) {
 	mockTx := intMocks.NewMockTxManager(ctrl)
 	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
 	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	ctx := context.Background()
 	logs := []entitylog.Log{{Message: "bulk1"}, {Message: "bulk2"}}
@@ -169,10 +292,87 @@ func TestCreateLogUseCase_ExecuteBulk_Success(t *testing.T) {
 	mockSQS.EXPECT().PublishIndexMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	mockPub.EXPECT().BroadcastLogs(gomock.Any(), gomock.Any()).Return(nil)
 
-	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync)
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
 
 	result, err := ucase.ExecuteBulk(ctx, "tenant-1", "user-1", logs)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	assert.NotEmpty(t, result[0].ID)
 }
+
+func TestCreateLogUseCase_ExecuteBulk_SystemNotBroadcast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	mockAsync := repoMocks.NewMockAsyncTaskRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
+	mockPub := svcMocks.NewMockPubSub(ctrl)
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockValidator := schemaMocks.NewMockValidateLogUseCaseInterface(ctrl)
+	mockValidator.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	mockTx.EXPECT().
+		TransactionExec(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+			return fn(context.Background())
+		}).Times(2)
+	mockTx.EXPECT().GetTx(gomock.Any()).Return(&gorm.DB{}).Times(2)
+
+	mockRepo.EXPECT().CreateBulk(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockAsync.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&async_task.AsyncTask{TaskID: uuid.New().String()}, nil).Times(2)
+	mockSQS.EXPECT().PublishIndexMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	// only the tenant's entry is streamed, a batch of system entries isn't broadcast at all
+	mockPub.EXPECT().BroadcastLogs(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, logs []entitylog.Log) error {
+			assert.Len(t, logs, 1)
+			assert.Equal(t, "tenant entry", logs[0].Message)
+			return nil
+		})
+
+	ucase := uc.NewCreateLogUseCase(mockRepo, mockTx, mockSQS, mockPub, mockAsync, mockRedactor, mockValidator)
+
+	result, err := ucase.ExecuteBulk(context.Background(), "tenant-1", "user-1", []entitylog.Log{
+		{Message: "GET /logs answered 200", System: true},
+		{Message: "tenant entry"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	_, err = ucase.ExecuteBulk(context.Background(), "tenant-1", "user-1", []entitylog.Log{
+		{Message: "GET /logs answered 200", System: true},
+	})
+	assert.NoError(t, err)
+}
+
+func TestCreateLogUseCase_Execute_SchemaCheckedAfterRedaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// a drop rule removes the field the reject schema requires, the log that would be stored doesn't match
+	mockRedactor := redactionMocks.NewMockRedactLogUseCaseInterface(ctrl)
+	mockRedactor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, l *entitylog.Log) error {
+		redacted := datatypes.JSON(`{"path":"/login"}`)
+		l.Metadata = &redacted
+		return nil
+	})
+	mockSchemaRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	required := datatypes.JSON(`{"type":"object","required":["request_id"]}`)
+	mockSchemaRepo.EXPECT().FindActive(gomock.Any(), "tenant-1", nil).Return(&log_schema.LogSchema{
+		ID: "schema-1", TenantID: "tenant-1", Version: 1, Enforcement: log_schema.EnforcementReject, IsActive: true, MetadataSchema: &required,
+	}, nil)
+
+	// nothing is written
+	ucase := uc.NewCreateLogUseCase(repoMocks.NewMockLogRepository(ctrl), intMocks.NewMockTxManager(ctrl), svcMocks.NewMockSQSPublisher(ctrl),
+		svcMocks.NewMockPubSub(ctrl), repoMocks.NewMockAsyncTaskRepository(ctrl), mockRedactor, schema.NewValidateLogUseCase(mockSchemaRepo))
+
+	metadata := datatypes.JSON(`{"request_id":"r-1","path":"/login"}`)
+	_, err := ucase.Execute(context.Background(), "tenant-1", "user-1", entitylog.Log{TenantID: "tenant-1", Message: "login", Metadata: &metadata})
+
+	var violation *schema.ViolationError
+	assert.True(t, errors.As(err, &violation))
+	assert.Contains(t, violation.Error(), "request_id")
+}
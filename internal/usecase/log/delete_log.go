@@ -6,10 +6,12 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/Haevnen/audit-logging-api/internal/audit"
 	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	"github.com/Haevnen/audit-logging-api/internal/interactor"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
 	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
 type DeleteLogUseCase struct {
@@ -26,7 +28,10 @@ func NewDeleteLogUseCase(asyncTaskRepo repository.AsyncTaskRepository, queuePubl
 	}
 }
 
-func (uc *DeleteLogUseCase) Execute(ctx context.Context, tenantId, userId string, beforeDate time.Time) error {
+func (uc *DeleteLogUseCase) Execute(ctx context.Context, tenantId, userId string, beforeDate time.Time) (err error) {
+	ctx, span := tracing.Start(ctx, "DeleteLogUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
 	// We need to create an entry in asyncTask table and publish message to
 	// archival log queue in one transaction
 
@@ -48,6 +53,7 @@ func (uc *DeleteLogUseCase) Execute(ctx context.Context, tenantId, userId string
 		if err != nil {
 			return err
 		}
+		audit.Annotate(ctx, audit.KeyTaskID, task.TaskID)
 
 		return uc.QueuePublisher.PublishArchiveMessage(txCtx, task.TaskID, beforeDate)
 	})
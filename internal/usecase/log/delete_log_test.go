@@ -10,6 +10,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 
+	"github.com/Haevnen/audit-logging-api/internal/audit"
 	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	uc "github.com/Haevnen/audit-logging-api/internal/usecase/log"
 
@@ -50,6 +51,29 @@ func TestDeleteLogUseCase_Execute_Success(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDeleteLogUseCase_Execute_AnnotatesTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAsync := repoMocks.NewMockAsyncTaskRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	mockSQS := svcMocks.NewMockSQSPublisher(ctrl)
+
+	ctx, trail := audit.NewTrail(context.Background())
+	mockTx.EXPECT().
+		TransactionExec(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	mockTx.EXPECT().GetTx(gomock.Any()).Return(&gorm.DB{})
+	mockAsync.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(&async_task.AsyncTask{TaskID: "task-1"}, nil)
+	mockSQS.EXPECT().PublishArchiveMessage(gomock.Any(), "task-1", gomock.Any()).Return(nil)
+
+	err := uc.NewDeleteLogUseCase(mockAsync, mockSQS, mockTx).Execute(ctx, "tenant-1", "user-1", time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, "task-1", trail.Values()[audit.KeyTaskID])
+}
+
 func TestDeleteLogUseCase_Execute_Fail_AsyncTask(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,154 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"gorm.io/datatypes"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+)
+
+// attachDiff computes the field-level diff of an entry carrying both states and stores it on the log.
+func attachDiff(l *entitylog.Log) error {
+	if l.BeforeState == nil || l.AfterState == nil {
+		return nil
+	}
+
+	entries, err := ComputeDiff(*l.BeforeState, *l.AfterState)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	diff := datatypes.JSON(data)
+	l.Diff = &diff
+	return nil
+}
+
+// ComputeDiff returns the added, removed and changed paths between two JSON documents,
+// ordered by path. Objects are compared key by key and arrays index by index.
+func ComputeDiff(before, after datatypes.JSON) ([]entitylog.DiffEntry, error) {
+	var b, a interface{}
+	if err := json.Unmarshal(before, &b); err != nil {
+		return nil, fmt.Errorf("decode before state: %w", err)
+	}
+	if err := json.Unmarshal(after, &a); err != nil {
+		return nil, fmt.Errorf("decode after state: %w", err)
+	}
+
+	entries := []entitylog.DiffEntry{}
+	if err := diffValue("", b, a, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func diffValue(path string, before, after interface{}, entries *[]entitylog.DiffEntry) error {
+	switch b := before.(type) {
+	case map[string]interface{}:
+		if a, ok := after.(map[string]interface{}); ok {
+			return diffObject(path, b, a, entries)
+		}
+	case []interface{}:
+		if a, ok := after.([]interface{}); ok {
+			return diffArray(path, b, a, entries)
+		}
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+	return appendEntry(entries, path, entitylog.DiffChanged, before, after)
+}
+
+func diffObject(path string, before, after map[string]interface{}, entries *[]entitylog.DiffEntry) error {
+	keys := make([]string, 0, len(before)+len(after))
+	for k := range before {
+		keys = append(keys, k)
+	}
+	for k := range after {
+		if _, ok := before[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p := k
+		if len(path) > 0 {
+			p = path + "." + k
+		}
+
+		b, inBefore := before[k]
+		a, inAfter := after[k]
+		var err error
+		switch {
+		case !inAfter:
+			err = appendEntry(entries, p, entitylog.DiffRemoved, b, nil)
+		case !inBefore:
+			err = appendEntry(entries, p, entitylog.DiffAdded, nil, a)
+		default:
+			err = diffValue(p, b, a, entries)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func diffArray(path string, before, after []interface{}, entries *[]entitylog.DiffEntry) error {
+	for i := 0; i < len(before) || i < len(after); i++ {
+		p := fmt.Sprintf("%s[%d]", path, i)
+
+		var err error
+		switch {
+		case i >= len(after):
+			err = appendEntry(entries, p, entitylog.DiffRemoved, before[i], nil)
+		case i >= len(before):
+			err = appendEntry(entries, p, entitylog.DiffAdded, nil, after[i])
+		default:
+			err = diffValue(p, before[i], after[i], entries)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendEntry(entries *[]entitylog.DiffEntry, path string, op entitylog.DiffOp, before, after interface{}) error {
+	e := entitylog.DiffEntry{Path: path, Op: op}
+
+	if op != entitylog.DiffAdded {
+		v, err := encodeValue(before)
+		if err != nil {
+			return err
+		}
+		e.OldValue = &v
+	}
+	if op != entitylog.DiffRemoved {
+		v, err := encodeValue(after)
+		if err != nil {
+			return err
+		}
+		e.NewValue = &v
+	}
+
+	*entries = append(*entries, e)
+	return nil
+}
+
+func encodeValue(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,56 @@
+package log_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/log"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+func TestComputeDiff_NestedObject(t *testing.T) {
+	before := datatypes.JSON(`{"plan":{"tier":"basic","seats":5},"name":"acme","legacy":true}`)
+	after := datatypes.JSON(`{"plan":{"tier":"pro","seats":5},"name":"acme","owner":{"id":1}}`)
+
+	diff, err := uc.ComputeDiff(before, after)
+	assert.NoError(t, err)
+	assert.Equal(t, []entitylog.DiffEntry{
+		{Path: "legacy", Op: entitylog.DiffRemoved, OldValue: utils.Ptr("true")},
+		{Path: "owner", Op: entitylog.DiffAdded, NewValue: utils.Ptr(`{"id":1}`)},
+		{Path: "plan.tier", Op: entitylog.DiffChanged, OldValue: utils.Ptr(`"basic"`), NewValue: utils.Ptr(`"pro"`)},
+	}, diff)
+}
+
+func TestComputeDiff_Array(t *testing.T) {
+	before := datatypes.JSON(`{"tags":["a","b","c"]}`)
+	after := datatypes.JSON(`{"tags":["a","x"]}`)
+
+	diff, err := uc.ComputeDiff(before, after)
+	assert.NoError(t, err)
+	assert.Equal(t, []entitylog.DiffEntry{
+		{Path: "tags[1]", Op: entitylog.DiffChanged, OldValue: utils.Ptr(`"b"`), NewValue: utils.Ptr(`"x"`)},
+		{Path: "tags[2]", Op: entitylog.DiffRemoved, OldValue: utils.Ptr(`"c"`)},
+	}, diff)
+}
+
+func TestComputeDiff_TypeChange(t *testing.T) {
+	diff, err := uc.ComputeDiff(datatypes.JSON(`{"limit":{"max":1}}`), datatypes.JSON(`{"limit":10}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []entitylog.DiffEntry{
+		{Path: "limit", Op: entitylog.DiffChanged, OldValue: utils.Ptr(`{"max":1}`), NewValue: utils.Ptr("10")},
+	}, diff)
+}
+
+func TestComputeDiff_NoChange(t *testing.T) {
+	diff, err := uc.ComputeDiff(datatypes.JSON(`{"a":1}`), datatypes.JSON(`{"a":1}`))
+	assert.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestComputeDiff_InvalidJSON(t *testing.T) {
+	_, err := uc.ComputeDiff(datatypes.JSON(`{`), datatypes.JSON(`{}`))
+	assert.Error(t, err)
+}
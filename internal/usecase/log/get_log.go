@@ -5,6 +5,7 @@ import (
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
 type GetLogUseCase struct {
@@ -15,6 +16,9 @@ func NewGetLogUseCase(repo repository.LogRepository) *GetLogUseCase {
 	return &GetLogUseCase{Repo: repo}
 }
 
-func (uc *GetLogUseCase) Execute(ctx context.Context, id string, tenantId string) (*log.Log, error) {
+func (uc *GetLogUseCase) Execute(ctx context.Context, id string, tenantId string) (_ *log.Log, err error) {
+	ctx, span := tracing.Start(ctx, "GetLogUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
 	return uc.Repo.GetByID(ctx, id, tenantId)
 }
@@ -23,7 +23,7 @@ func TestGetLogUseCase_Execute_Success(t *testing.T) {
 	expected := &entitylog.Log{ID: "log-123", TenantID: "tenant-1"}
 
 	mockRepo.EXPECT().
-		GetByID(ctx, "log-123", "tenant-1").
+		GetByID(gomock.Any(), "log-123", "tenant-1").
 		Return(expected, nil)
 
 	ucase := uc.NewGetLogUseCase(mockRepo)
@@ -42,7 +42,7 @@ func TestGetLogUseCase_Execute_Fail(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepo.EXPECT().
-		GetByID(ctx, "bad-id", "tenant-1").
+		GetByID(gomock.Any(), "bad-id", "tenant-1").
 		Return(nil, assert.AnError)
 
 	ucase := uc.NewGetLogUseCase(mockRepo)
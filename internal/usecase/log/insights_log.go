@@ -0,0 +1,188 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
+)
+
+const (
+	defaultTopLimit = 10
+	maxTopLimit     = 100
+
+	defaultBaselineDays = 14
+	// the aggregates keep 90 days, the baseline and the period have to fit in them
+	maxBaselineDays  = 60
+	maxAnomalyRange  = 31 * 24 * time.Hour
+	defaultThreshold = 3.0
+	// days with fewer logs are never spikes, whatever the baseline
+	minSpikeCount = 10
+)
+
+var ErrInvalidInsightQuery = errors.New("invalid insight query")
+
+type GetTopActivityUseCase struct {
+	Repo repository.LogRepository
+}
+
+func NewGetTopActivityUseCase(repo repository.LogRepository) *GetTopActivityUseCase {
+	return &GetTopActivityUseCase{Repo: repo}
+}
+
+// Execute ranks the values of the dimension by their number of logs, 10 values by default
+func (uc *GetTopActivityUseCase) Execute(ctx context.Context, q log.TopQuery) (_ []log.TopEntry, err error) {
+	ctx, span := tracing.Start(ctx, "GetTopActivityUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
+	switch q.Dimension {
+	case log.InsightUser, log.InsightResource, log.InsightIPAddress:
+	default:
+		return nil, fmt.Errorf("%w: unknown dimension %q", ErrInvalidInsightQuery, q.Dimension)
+	}
+	if q.EndTime.Before(q.StartTime) {
+		return nil, fmt.Errorf("%w: end date must be after start date", ErrInvalidInsightQuery)
+	}
+	if q.Limit == 0 {
+		q.Limit = defaultTopLimit
+	}
+	if q.Limit < 0 || q.Limit > maxTopLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidInsightQuery, maxTopLimit)
+	}
+
+	return uc.Repo.GetTopActivity(ctx, q)
+}
+
+type GetAnomaliesUseCase struct {
+	Repo repository.LogRepository
+}
+
+func NewGetAnomaliesUseCase(repo repository.LogRepository) *GetAnomaliesUseCase {
+	return &GetAnomaliesUseCase{Repo: repo}
+}
+
+// Execute compares each day of the period to the trailing baseline of the user. A day is a spike when its
+// z-score reaches the threshold, an address is new when the user sent logs during the baseline but never from it.
+func (uc *GetAnomaliesUseCase) Execute(ctx context.Context, q log.AnomalyQuery) (_ *log.Anomalies, err error) {
+	ctx, span := tracing.Start(ctx, "GetAnomaliesUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
+	if q.BaselineDays == 0 {
+		q.BaselineDays = defaultBaselineDays
+	}
+	if q.Threshold == 0 {
+		q.Threshold = defaultThreshold
+	}
+	if err := validateAnomalyQuery(q); err != nil {
+		return nil, err
+	}
+
+	firstDay := q.StartTime.UTC().Truncate(24 * time.Hour)
+	baselineStart := firstDay.AddDate(0, 0, -q.BaselineDays)
+
+	points, err := uc.Repo.GetStats(ctx, log.StatsQuery{
+		TenantID:  q.TenantID,
+		StartTime: baselineStart,
+		EndTime:   q.EndTime,
+		Interval:  log.IntervalDay,
+		GroupBy:   log.GroupByUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ips, err := uc.Repo.ListUserIPs(ctx, q.TenantID, baselineStart, q.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &log.Anomalies{
+		Query:  q,
+		Spikes: findSpikes(points, firstDay, q),
+		NewIPs: findNewIPs(ips, q.StartTime),
+	}, nil
+}
+
+func validateAnomalyQuery(q log.AnomalyQuery) error {
+	if q.EndTime.Before(q.StartTime) {
+		return fmt.Errorf("%w: end date must be after start date", ErrInvalidInsightQuery)
+	}
+	if q.EndTime.Sub(q.StartTime) > maxAnomalyRange {
+		return fmt.Errorf("%w: the period covers at most %s", ErrInvalidInsightQuery, maxAnomalyRange)
+	}
+	if q.BaselineDays < 1 || q.BaselineDays > maxBaselineDays {
+		return fmt.Errorf("%w: baseline must be between 1 and %d days", ErrInvalidInsightQuery, maxBaselineDays)
+	}
+	if q.Threshold < 0 {
+		return fmt.Errorf("%w: threshold must be positive", ErrInvalidInsightQuery)
+	}
+	return nil
+}
+
+// findSpikes scores the days of the period from firstDay, days without logs count as zero in the baselines
+func findSpikes(points []log.StatPoint, firstDay time.Time, q log.AnomalyQuery) []log.ActivitySpike {
+	daily := map[string]map[time.Time]int64{}
+	for _, p := range points {
+		if daily[p.Group] == nil {
+			daily[p.Group] = map[time.Time]int64{}
+		}
+		daily[p.Group][p.Bucket.UTC()] += p.Count
+	}
+
+	spikes := []log.ActivitySpike{}
+	for user, counts := range daily {
+		for day := firstDay; !day.After(q.EndTime); day = day.AddDate(0, 0, 1) {
+			count := counts[day]
+			if count < minSpikeCount {
+				continue
+			}
+
+			var sum, sumSquares float64
+			for i := 1; i <= q.BaselineDays; i++ {
+				c := float64(counts[day.AddDate(0, 0, -i)])
+				sum += c
+				sumSquares += c * c
+			}
+			mean := sum / float64(q.BaselineDays)
+			stdDev := math.Sqrt(math.Max(sumSquares/float64(q.BaselineDays)-mean*mean, 0))
+
+			// a flat baseline would make any change infinitely unusual
+			z := (float64(count) - mean) / math.Max(stdDev, 1)
+			if z >= q.Threshold {
+				spikes = append(spikes, log.ActivitySpike{UserID: user, Day: day, Count: count, Mean: mean, StdDev: stdDev, ZScore: z})
+			}
+		}
+	}
+
+	sort.Slice(spikes, func(i, j int) bool {
+		if spikes[i].ZScore != spikes[j].ZScore {
+			return spikes[i].ZScore > spikes[j].ZScore
+		}
+		return spikes[i].UserID < spikes[j].UserID
+	})
+	return spikes
+}
+
+// findNewIPs keeps the addresses first seen from start by users already seen before it
+func findNewIPs(ips []log.UserIP, start time.Time) []log.UserIP {
+	known := map[string]bool{}
+	for _, ip := range ips {
+		if ip.FirstSeen.Before(start) {
+			known[ip.UserID] = true
+		}
+	}
+
+	newIPs := []log.UserIP{}
+	for _, ip := range ips {
+		if known[ip.UserID] && !ip.FirstSeen.Before(start) {
+			newIPs = append(newIPs, ip)
+		}
+	}
+	return newIPs
+}
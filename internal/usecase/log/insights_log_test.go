@@ -0,0 +1,143 @@
+package log_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/log"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestGetTopActivityUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	ctx := context.Background()
+	end := time.Now()
+	q := entitylog.TopQuery{TenantID: "tenant-1", StartTime: end.Add(-24 * time.Hour), EndTime: end, Dimension: entitylog.InsightUser, Action: "DELETE"}
+
+	expected := q
+	expected.Limit = 10
+	mockRepo.EXPECT().GetTopActivity(gomock.Any(), expected).Return([]entitylog.TopEntry{{Key: "user-1", Count: 12}}, nil)
+
+	entries, err := uc.NewGetTopActivityUseCase(mockRepo).Execute(ctx, q)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entitylog.TopEntry{{Key: "user-1", Count: 12}}, entries)
+}
+
+func TestGetTopActivityUseCase_Execute_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucase := uc.NewGetTopActivityUseCase(repoMocks.NewMockLogRepository(ctrl))
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		q    entitylog.TopQuery
+	}{
+		{name: "dimension", q: entitylog.TopQuery{StartTime: now.Add(-time.Hour), EndTime: now, Dimension: "session_id"}},
+		{name: "range", q: entitylog.TopQuery{StartTime: now, EndTime: now.Add(-time.Hour), Dimension: entitylog.InsightResource}},
+		{name: "limit", q: entitylog.TopQuery{StartTime: now.Add(-time.Hour), EndTime: now, Dimension: entitylog.InsightIPAddress, Limit: 1000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ucase.Execute(context.Background(), tt.q)
+			assert.ErrorIs(t, err, uc.ErrInvalidInsightQuery)
+		})
+	}
+}
+
+func TestGetAnomaliesUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	ctx := context.Background()
+	today := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	start, end := today, today.Add(12*time.Hour)
+	baselineStart := today.AddDate(0, 0, -7)
+
+	// user-1 usually sends 10 or 12 logs a day, user-2 always about 100
+	points := []entitylog.StatPoint{}
+	for i := 1; i <= 7; i++ {
+		points = append(points,
+			entitylog.StatPoint{Bucket: today.AddDate(0, 0, -i), Group: "user-1", Count: int64(10 + 2*(i%2))},
+			entitylog.StatPoint{Bucket: today.AddDate(0, 0, -i), Group: "user-2", Count: int64(100 + i%2)},
+		)
+	}
+	points = append(points,
+		entitylog.StatPoint{Bucket: today, Group: "user-1", Count: 80},
+		entitylog.StatPoint{Bucket: today, Group: "user-2", Count: 101},
+	)
+	mockRepo.EXPECT().GetStats(gomock.Any(), entitylog.StatsQuery{
+		TenantID: "tenant-1", StartTime: baselineStart, EndTime: end, Interval: entitylog.IntervalDay, GroupBy: entitylog.GroupByUserID,
+	}).Return(points, nil)
+
+	mockRepo.EXPECT().ListUserIPs(gomock.Any(), "tenant-1", baselineStart, end).Return([]entitylog.UserIP{
+		{UserID: "user-1", IPAddress: "10.0.0.1", FirstSeen: today.AddDate(0, 0, -5)},
+		{UserID: "user-1", IPAddress: "203.0.113.7", FirstSeen: today.Add(time.Hour), Count: 3},
+		// nothing to compare a new user to
+		{UserID: "user-3", IPAddress: "10.0.0.9", FirstSeen: today.Add(time.Hour)},
+	}, nil)
+
+	anomalies, err := uc.NewGetAnomaliesUseCase(mockRepo).Execute(ctx, entitylog.AnomalyQuery{TenantID: "tenant-1", StartTime: start, EndTime: end, BaselineDays: 7})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, anomalies.Query.Threshold)
+	require.Len(t, anomalies.Spikes, 1)
+	spike := anomalies.Spikes[0]
+	assert.Equal(t, "user-1", spike.UserID)
+	assert.Equal(t, today, spike.Day)
+	assert.Equal(t, int64(80), spike.Count)
+	assert.InDelta(t, 78.0/7, spike.Mean, 0.001)
+	assert.Greater(t, spike.ZScore, 3.0)
+
+	require.Len(t, anomalies.NewIPs, 1)
+	assert.Equal(t, "203.0.113.7", anomalies.NewIPs[0].IPAddress)
+}
+
+func TestGetAnomaliesUseCase_Execute_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucase := uc.NewGetAnomaliesUseCase(repoMocks.NewMockLogRepository(ctrl))
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		q    entitylog.AnomalyQuery
+	}{
+		{name: "range", q: entitylog.AnomalyQuery{StartTime: now, EndTime: now.Add(-time.Hour)}},
+		{name: "period", q: entitylog.AnomalyQuery{StartTime: now.AddDate(0, 0, -40), EndTime: now}},
+		{name: "baseline", q: entitylog.AnomalyQuery{StartTime: now.Add(-time.Hour), EndTime: now, BaselineDays: 90}},
+		{name: "threshold", q: entitylog.AnomalyQuery{StartTime: now.Add(-time.Hour), EndTime: now, Threshold: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ucase.Execute(context.Background(), tt.q)
+			assert.ErrorIs(t, err, uc.ErrInvalidInsightQuery)
+		})
+	}
+}
+
+func TestGetAnomaliesUseCase_Execute_Fail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	mockRepo.EXPECT().GetStats(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+	now := time.Now()
+	_, err := uc.NewGetAnomaliesUseCase(mockRepo).Execute(context.Background(), entitylog.AnomalyQuery{StartTime: now.Add(-time.Hour), EndTime: now})
+	assert.ErrorIs(t, err, assert.AnError)
+}
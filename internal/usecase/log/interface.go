@@ -24,7 +24,15 @@ type DeleteLogUseCaseInterface interface {
 }
 
 type GetStatsUseCaseInterface interface {
-	Execute(ctx context.Context, tenantId string, start, end time.Time) ([]entitylog.LogStats, error)
+	Execute(ctx context.Context, q entitylog.StatsQuery) (*entitylog.Stats, error)
+}
+
+type GetTopActivityUseCaseInterface interface {
+	Execute(ctx context.Context, q entitylog.TopQuery) ([]entitylog.TopEntry, error)
+}
+
+type GetAnomaliesUseCaseInterface interface {
+	Execute(ctx context.Context, q entitylog.AnomalyQuery) (*entitylog.Anomalies, error)
 }
 
 type SearchLogsUseCaseInterface interface {
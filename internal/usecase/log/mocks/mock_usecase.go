@@ -175,18 +175,96 @@ func (m *MockGetStatsUseCaseInterface) EXPECT() *MockGetStatsUseCaseInterfaceMoc
 }
 
 // Execute mocks base method.
-func (m *MockGetStatsUseCaseInterface) Execute(ctx context.Context, tenantId string, start, end time.Time) ([]log.LogStats, error) {
+func (m *MockGetStatsUseCaseInterface) Execute(ctx context.Context, q log.StatsQuery) (*log.Stats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, tenantId, start, end)
-	ret0, _ := ret[0].([]log.LogStats)
+	ret := m.ctrl.Call(m, "Execute", ctx, q)
+	ret0, _ := ret[0].(*log.Stats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockGetStatsUseCaseInterfaceMockRecorder) Execute(ctx, tenantId, start, end any) *gomock.Call {
+func (mr *MockGetStatsUseCaseInterfaceMockRecorder) Execute(ctx, q any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetStatsUseCaseInterface)(nil).Execute), ctx, tenantId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetStatsUseCaseInterface)(nil).Execute), ctx, q)
+}
+
+// MockGetTopActivityUseCaseInterface is a mock of GetTopActivityUseCaseInterface interface.
+type MockGetTopActivityUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTopActivityUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetTopActivityUseCaseInterfaceMockRecorder is the mock recorder for MockGetTopActivityUseCaseInterface.
+type MockGetTopActivityUseCaseInterfaceMockRecorder struct {
+	mock *MockGetTopActivityUseCaseInterface
+}
+
+// NewMockGetTopActivityUseCaseInterface creates a new mock instance.
+func NewMockGetTopActivityUseCaseInterface(ctrl *gomock.Controller) *MockGetTopActivityUseCaseInterface {
+	mock := &MockGetTopActivityUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetTopActivityUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTopActivityUseCaseInterface) EXPECT() *MockGetTopActivityUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetTopActivityUseCaseInterface) Execute(ctx context.Context, q log.TopQuery) ([]log.TopEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, q)
+	ret0, _ := ret[0].([]log.TopEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetTopActivityUseCaseInterfaceMockRecorder) Execute(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTopActivityUseCaseInterface)(nil).Execute), ctx, q)
+}
+
+// MockGetAnomaliesUseCaseInterface is a mock of GetAnomaliesUseCaseInterface interface.
+type MockGetAnomaliesUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAnomaliesUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetAnomaliesUseCaseInterfaceMockRecorder is the mock recorder for MockGetAnomaliesUseCaseInterface.
+type MockGetAnomaliesUseCaseInterfaceMockRecorder struct {
+	mock *MockGetAnomaliesUseCaseInterface
+}
+
+// NewMockGetAnomaliesUseCaseInterface creates a new mock instance.
+func NewMockGetAnomaliesUseCaseInterface(ctrl *gomock.Controller) *MockGetAnomaliesUseCaseInterface {
+	mock := &MockGetAnomaliesUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetAnomaliesUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAnomaliesUseCaseInterface) EXPECT() *MockGetAnomaliesUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetAnomaliesUseCaseInterface) Execute(ctx context.Context, q log.AnomalyQuery) (*log.Anomalies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, q)
+	ret0, _ := ret[0].(*log.Anomalies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetAnomaliesUseCaseInterfaceMockRecorder) Execute(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetAnomaliesUseCaseInterface)(nil).Execute), ctx, q)
 }
 
 // MockSearchLogsUseCaseInterface is a mock of SearchLogsUseCaseInterface interface.
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
 type SearchLogsUseCase struct {
@@ -15,10 +16,16 @@ func NewSearchLogsUseCase(repo repository.LogSearchRepository) *SearchLogsUseCas
 	return &SearchLogsUseCase{Repo: repo}
 }
 
-func (uc *SearchLogsUseCase) Execute(ctx context.Context, filters repository.LogSearchFilters) (*repository.SearchResult, error) {
+func (uc *SearchLogsUseCase) Execute(ctx context.Context, filters repository.LogSearchFilters) (_ *repository.SearchResult, err error) {
+	ctx, span := tracing.Start(ctx, "SearchLogsUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
 	return uc.Repo.Search(ctx, filters)
 }
 
-func (uc *SearchLogsUseCase) Stream(ctx context.Context, filters repository.LogSearchFilters, fn func(log.Log) error) error {
+func (uc *SearchLogsUseCase) Stream(ctx context.Context, filters repository.LogSearchFilters, fn func(log.Log) error) (err error) {
+	ctx, span := tracing.Start(ctx, "SearchLogsUseCase.Stream")
+	defer func() { tracing.End(span, err) }()
+
 	return uc.Repo.Stream(ctx, filters, fn)
 }
@@ -25,7 +25,7 @@ func TestSearchLogsUseCase_Execute_Success(t *testing.T) {
 	expected := &repository.SearchResult{Total: 1}
 
 	mockRepo.EXPECT().
-		Search(ctx, filters).
+		Search(gomock.Any(), filters).
 		Return(expected, nil)
 
 	ucase := uc.NewSearchLogsUseCase(mockRepo)
@@ -44,7 +44,7 @@ func TestSearchLogsUseCase_Execute_Fail(t *testing.T) {
 	filters := repository.LogSearchFilters{}
 
 	mockRepo.EXPECT().
-		Search(ctx, filters).
+		Search(gomock.Any(), filters).
 		Return(nil, assert.AnError)
 
 	ucase := uc.NewSearchLogsUseCase(mockRepo)
@@ -63,7 +63,7 @@ func TestSearchLogsUseCase_Stream_Success(t *testing.T) {
 	filters := repository.LogSearchFilters{}
 
 	mockRepo.EXPECT().
-		Stream(ctx, filters, gomock.Any()).
+		Stream(gomock.Any(), filters, gomock.Any()).
 		DoAndReturn(func(_ context.Context, _ repository.LogSearchFilters, fn func(entitylog.Log) error) error {
 			return fn(entitylog.Log{ID: "log-1"})
 		})
@@ -86,7 +86,7 @@ func TestSearchLogsUseCase_Stream_Fail(t *testing.T) {
 	filters := repository.LogSearchFilters{}
 
 	mockRepo.EXPECT().
-		Stream(ctx, filters, gomock.Any()).
+		Stream(gomock.Any(), filters, gomock.Any()).
 		Return(assert.AnError)
 
 	ucase := uc.NewSearchLogsUseCase(mockRepo)
@@ -2,12 +2,23 @@ package log
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/log"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
 )
 
+// minute buckets are counted from the raw logs, their range is kept short
+const maxMinuteStatsRange = 24 * time.Hour
+
+// the key of the single series of stats not grouped
+const totalSeriesKey = "total"
+
+var ErrInvalidStatsQuery = errors.New("invalid stats query")
+
 type GetStatsUseCase struct {
 	Repo repository.LogRepository
 }
@@ -16,6 +27,61 @@ func NewGetStatsUseCase(repo repository.LogRepository) *GetStatsUseCase {
 	return &GetStatsUseCase{Repo: repo}
 }
 
-func (uc *GetStatsUseCase) Execute(ctx context.Context, tenantId string, startTime, endTime time.Time) ([]log.LogStats, error) {
-	return uc.Repo.GetStats((ctx), tenantId, startTime, endTime)
+// Execute counts the logs of the query per bucket, one series per group. The interval defaults to a day.
+func (uc *GetStatsUseCase) Execute(ctx context.Context, q log.StatsQuery) (_ *log.Stats, err error) {
+	ctx, span := tracing.Start(ctx, "GetStatsUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
+	if len(q.Interval) == 0 {
+		q.Interval = log.IntervalDay
+	}
+	if err := validateStatsQuery(q); err != nil {
+		return nil, err
+	}
+
+	points, err := uc.Repo.GetStats(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	// points come ordered by group
+	series := []log.StatSeries{}
+	for _, p := range points {
+		if q.GroupBy == log.GroupByNone {
+			p.Group = totalSeriesKey
+		}
+		if len(series) == 0 || series[len(series)-1].Key != p.Group {
+			series = append(series, log.StatSeries{Key: p.Group, Points: []log.StatPoint{}})
+		}
+		last := &series[len(series)-1]
+		last.Total += p.Count
+		last.Points = append(last.Points, p)
+	}
+
+	freshness, err := uc.Repo.GetStatsFreshness(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &log.Stats{Series: series, Freshness: freshness}, nil
+}
+
+func validateStatsQuery(q log.StatsQuery) error {
+	switch q.Interval {
+	case log.IntervalMinute, log.IntervalHour, log.IntervalDay, log.IntervalWeek:
+	default:
+		return fmt.Errorf("%w: unknown interval %q", ErrInvalidStatsQuery, q.Interval)
+	}
+	switch q.GroupBy {
+	case log.GroupByNone, log.GroupByAction, log.GroupBySeverity, log.GroupByResource, log.GroupByUserID:
+	default:
+		return fmt.Errorf("%w: can't group by %q", ErrInvalidStatsQuery, q.GroupBy)
+	}
+
+	if q.EndTime.Before(q.StartTime) {
+		return fmt.Errorf("%w: end date must be after start date", ErrInvalidStatsQuery)
+	}
+	if q.Interval == log.IntervalMinute && q.EndTime.Sub(q.StartTime) > maxMinuteStatsRange {
+		return fmt.Errorf("%w: minute buckets cover at most %s", ErrInvalidStatsQuery, maxMinuteStatsRange)
+	}
+	return nil
 }
@@ -14,33 +14,84 @@ import (
 	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
 )
 
-func TestGetStatsUseCase_Execute_Success(t *testing.T) {
+func TestGetStatsUseCase_Execute_Grouped(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := repoMocks.NewMockLogRepository(ctrl)
 	ctx := context.Background()
-	start := time.Now().Add(-24 * time.Hour)
 	end := time.Now()
+	start := end.Add(-2 * time.Hour)
+	h1, h2 := start.Truncate(time.Hour), start.Truncate(time.Hour).Add(time.Hour)
+	q := entitylog.StatsQuery{TenantID: "tenant-1", StartTime: start, EndTime: end, Interval: entitylog.IntervalHour, GroupBy: entitylog.GroupByAction}
 
-	expected := []entitylog.LogStats{
-		{
-			Day:          start,
-			Total:        7,
-			ActionCreate: 5,
-			ActionDelete: 2,
-		},
-	}
+	mockRepo.EXPECT().GetStats(gomock.Any(), q).Return([]entitylog.StatPoint{
+		{Bucket: h1, Group: "CREATE", Count: 5},
+		{Bucket: h2, Group: "CREATE", Count: 2},
+		{Bucket: h2, Group: "DELETE", Count: 1},
+	}, nil)
+	freshness := end.Truncate(time.Hour).Add(-time.Hour)
+	mockRepo.EXPECT().GetStatsFreshness(gomock.Any(), q).Return(&freshness, nil)
 
-	mockRepo.EXPECT().
-		GetStats(ctx, "tenant-1", start, end).
-		Return(expected, nil)
+	stats, err := uc.NewGetStatsUseCase(mockRepo).Execute(ctx, q)
 
-	ucase := uc.NewGetStatsUseCase(mockRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, &freshness, stats.Freshness)
+	series := stats.Series
+	assert.Len(t, series, 2)
+	assert.Equal(t, "CREATE", series[0].Key)
+	assert.Equal(t, int64(7), series[0].Total)
+	assert.Len(t, series[0].Points, 2)
+	assert.Equal(t, "DELETE", series[1].Key)
+	assert.Equal(t, int64(1), series[1].Total)
+}
+
+func TestGetStatsUseCase_Execute_DefaultsToDailyTotal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	ctx := context.Background()
+	end := time.Now()
+	start := end.Add(-48 * time.Hour)
+
+	mockRepo.EXPECT().GetStats(gomock.Any(), entitylog.StatsQuery{TenantID: "tenant-1", StartTime: start, EndTime: end, Interval: entitylog.IntervalDay}).
+		Return([]entitylog.StatPoint{{Bucket: start, Count: 3}, {Bucket: end, Count: 4}}, nil)
+	mockRepo.EXPECT().GetStatsFreshness(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	stats, err := uc.NewGetStatsUseCase(mockRepo).Execute(ctx, entitylog.StatsQuery{TenantID: "tenant-1", StartTime: start, EndTime: end})
 
-	result, err := ucase.Execute(ctx, "tenant-1", start, end)
 	assert.NoError(t, err)
-	assert.Equal(t, expected, result)
+	assert.Nil(t, stats.Freshness)
+	series := stats.Series
+	assert.Len(t, series, 1)
+	assert.Equal(t, "total", series[0].Key)
+	assert.Equal(t, int64(7), series[0].Total)
+}
+
+func TestGetStatsUseCase_Execute_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucase := uc.NewGetStatsUseCase(repoMocks.NewMockLogRepository(ctrl))
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		q    entitylog.StatsQuery
+	}{
+		{name: "interval", q: entitylog.StatsQuery{StartTime: now.Add(-time.Hour), EndTime: now, Interval: "month"}},
+		{name: "group", q: entitylog.StatsQuery{StartTime: now.Add(-time.Hour), EndTime: now, GroupBy: "message"}},
+		{name: "range", q: entitylog.StatsQuery{StartTime: now, EndTime: now.Add(-time.Hour)}},
+		{name: "minute range", q: entitylog.StatsQuery{StartTime: now.Add(-48 * time.Hour), EndTime: now, Interval: entitylog.IntervalMinute}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ucase.Execute(ctx, tt.q)
+			assert.ErrorIs(t, err, uc.ErrInvalidStatsQuery)
+		})
+	}
 }
 
 func TestGetStatsUseCase_Execute_Fail(t *testing.T) {
@@ -53,12 +104,28 @@ func TestGetStatsUseCase_Execute_Fail(t *testing.T) {
 	end := time.Now()
 
 	mockRepo.EXPECT().
-		GetStats(ctx, "tenant-1", start, end).
+		GetStats(gomock.Any(), gomock.Any()).
 		Return(nil, assert.AnError)
 
 	ucase := uc.NewGetStatsUseCase(mockRepo)
 
-	result, err := ucase.Execute(ctx, "tenant-1", start, end)
+	result, err := ucase.Execute(ctx, entitylog.StatsQuery{TenantID: "tenant-1", StartTime: start, EndTime: end})
 	assert.Error(t, err)
 	assert.Nil(t, result)
 }
+
+func TestGetStatsUseCase_Execute_FreshnessFail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogRepository(ctrl)
+	ctx := context.Background()
+	end := time.Now()
+
+	mockRepo.EXPECT().GetStats(gomock.Any(), gomock.Any()).Return([]entitylog.StatPoint{}, nil)
+	mockRepo.EXPECT().GetStatsFreshness(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+	stats, err := uc.NewGetStatsUseCase(mockRepo).Execute(ctx, entitylog.StatsQuery{StartTime: end.Add(-time.Hour), EndTime: end})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, stats)
+}
@@ -0,0 +1,125 @@
+package ops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/ops"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
+)
+
+const (
+	defaultLagLimit = 50
+	maxLagLimit     = 500
+	// the counts of both stores are full scans of the window, it is kept short
+	maxLagWindow = 7 * 24 * time.Hour
+)
+
+var ErrInvalidDashboardQuery = errors.New("invalid dashboard query")
+
+type GetDashboardUseCase struct {
+	QueuePublisher service.SQSPublisher
+	TaskRepo       repository.AsyncTaskRepository
+	LogRepo        repository.LogRepository
+	SearchRepo     repository.LogSearchRepository
+}
+
+func NewGetDashboardUseCase(queuePublisher service.SQSPublisher, taskRepo repository.AsyncTaskRepository, logRepo repository.LogRepository, searchRepo repository.LogSearchRepository) *GetDashboardUseCase {
+	return &GetDashboardUseCase{QueuePublisher: queuePublisher, TaskRepo: taskRepo, LogRepo: logRepo, SearchRepo: searchRepo}
+}
+
+// Execute reports the backlog of the queues, the open tasks and, for the logs since the given time, how many
+// documents each tenant misses in OpenSearch, largest gaps first. limit bounds the tenants, 50 by default.
+func (uc *GetDashboardUseCase) Execute(ctx context.Context, since time.Time, limit int) (_ *ops.Dashboard, err error) {
+	ctx, span := tracing.Start(ctx, "GetDashboardUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
+	now := time.Now().UTC()
+	if !since.Before(now) || now.Sub(since) > maxLagWindow {
+		return nil, fmt.Errorf("%w: since must be within the last %s", ErrInvalidDashboardQuery, maxLagWindow)
+	}
+	if limit == 0 {
+		limit = defaultLagLimit
+	}
+	if limit < 0 || limit > maxLagLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidDashboardQuery, maxLagLimit)
+	}
+
+	queues, err := uc.QueuePublisher.QueueStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := uc.TaskRepo.CountOpen(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+	for i := range queues {
+		queues[i].OldestPendingTask = oldestPending(tasks, queues[i].TaskType)
+	}
+
+	stored, err := uc.LogRepo.CountByTenant(ctx, since, now)
+	if err != nil {
+		return nil, err
+	}
+	indexed, err := uc.SearchRepo.CountByTenant(ctx, since, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ops.Dashboard{
+		GeneratedAt: now,
+		Queues:      queues,
+		Tasks:       tasks,
+		Since:       since,
+		IndexLag:    indexLag(stored, indexed, limit),
+	}, nil
+}
+
+func oldestPending(tasks []async_task.TaskCount, taskType async_task.AsyncTaskType) *time.Time {
+	for _, t := range tasks {
+		if t.TaskType == taskType && t.Status == async_task.StatusPending {
+			oldest := t.OldestCreatedAt
+			return &oldest
+		}
+	}
+	return nil
+}
+
+// indexLag compares the counts of every tenant found in either store, the largest gaps first
+func indexLag(stored, indexed map[string]int64, limit int) []ops.TenantIndexLag {
+	lags := []ops.TenantIndexLag{}
+	for tenantId, n := range stored {
+		lags = append(lags, ops.TenantIndexLag{TenantID: tenantId, Stored: n, Indexed: indexed[tenantId]})
+	}
+	for tenantId, n := range indexed {
+		if _, ok := stored[tenantId]; !ok {
+			lags = append(lags, ops.TenantIndexLag{TenantID: tenantId, Indexed: n})
+		}
+	}
+
+	sort.Slice(lags, func(i, j int) bool {
+		di, dj := abs(lags[i].Missing()), abs(lags[j].Missing())
+		if di != dj {
+			return di > dj
+		}
+		return lags[i].TenantID < lags[j].TenantID
+	})
+	if len(lags) > limit {
+		lags = lags[:limit]
+	}
+	return lags
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
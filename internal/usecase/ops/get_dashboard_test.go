@@ -0,0 +1,118 @@
+package ops_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/ops"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+)
+
+type dashboardMocks struct {
+	queue  *serviceMocks.MockSQSPublisher
+	tasks  *repoMocks.MockAsyncTaskRepository
+	logs   *repoMocks.MockLogRepository
+	search *repoMocks.MockLogSearchRepository
+}
+
+func newDashboardUseCase(t *testing.T) (*uc.GetDashboardUseCase, dashboardMocks) {
+	ctrl := gomock.NewController(t)
+	m := dashboardMocks{
+		queue:  serviceMocks.NewMockSQSPublisher(ctrl),
+		tasks:  repoMocks.NewMockAsyncTaskRepository(ctrl),
+		logs:   repoMocks.NewMockLogRepository(ctrl),
+		search: repoMocks.NewMockLogSearchRepository(ctrl),
+	}
+	return uc.NewGetDashboardUseCase(m.queue, m.tasks, m.logs, m.search), m
+}
+
+func TestGetDashboardUseCase_Execute(t *testing.T) {
+	ucase, m := newDashboardUseCase(t)
+	since := time.Now().UTC().Add(-time.Hour)
+	oldest := since.Add(-10 * time.Minute)
+
+	m.queue.EXPECT().QueueStats(gomock.Any()).Return([]ops.QueueStatus{
+		{Name: "index-q", TaskType: async_task.TaskIndex, Messages: 42, InFlight: 5},
+		{Name: "archive-q", TaskType: async_task.TaskArchive},
+	}, nil)
+	tasks := []async_task.TaskCount{
+		{TaskType: async_task.TaskIndex, Status: async_task.StatusPending, Count: 40, OldestCreatedAt: oldest},
+		{TaskType: async_task.TaskIndex, Status: async_task.StatusFailed, Count: 2, OldestCreatedAt: since},
+	}
+	m.tasks.EXPECT().CountOpen(gomock.Any(), since).Return(tasks, nil)
+	m.logs.EXPECT().CountByTenant(gomock.Any(), since, gomock.Any()).Return(map[string]int64{"t1": 100, "t2": 10, "t3": 7}, nil)
+	m.search.EXPECT().CountByTenant(gomock.Any(), since, gomock.Any()).Return(map[string]int64{"t1": 60, "t2": 10, "t4": 3}, nil)
+
+	d, err := ucase.Execute(context.Background(), since, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, since, d.Since)
+	assert.Equal(t, tasks, d.Tasks)
+	require.Len(t, d.Queues, 2)
+	assert.Equal(t, &oldest, d.Queues[0].OldestPendingTask)
+	assert.Nil(t, d.Queues[1].OldestPendingTask)
+	assert.Equal(t, []ops.TenantIndexLag{
+		{TenantID: "t1", Stored: 100, Indexed: 60},
+		{TenantID: "t3", Stored: 7},
+		{TenantID: "t4", Indexed: 3},
+		{TenantID: "t2", Stored: 10, Indexed: 10},
+	}, d.IndexLag)
+}
+
+func TestGetDashboardUseCase_Execute_Limit(t *testing.T) {
+	ucase, m := newDashboardUseCase(t)
+	since := time.Now().Add(-time.Hour)
+
+	m.queue.EXPECT().QueueStats(gomock.Any()).Return([]ops.QueueStatus{}, nil)
+	m.tasks.EXPECT().CountOpen(gomock.Any(), since).Return(nil, nil)
+	m.logs.EXPECT().CountByTenant(gomock.Any(), since, gomock.Any()).Return(map[string]int64{"t1": 5, "t2": 1}, nil)
+	m.search.EXPECT().CountByTenant(gomock.Any(), since, gomock.Any()).Return(map[string]int64{}, nil)
+
+	d, err := ucase.Execute(context.Background(), since, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []ops.TenantIndexLag{{TenantID: "t1", Stored: 5}}, d.IndexLag)
+}
+
+func TestGetDashboardUseCase_Execute_Invalid(t *testing.T) {
+	ucase, _ := newDashboardUseCase(t)
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		since time.Time
+		limit int
+	}{
+		{name: "future", since: now.Add(time.Hour)},
+		{name: "too old", since: now.Add(-8 * 24 * time.Hour)},
+		{name: "limit", since: now.Add(-time.Hour), limit: 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ucase.Execute(context.Background(), tt.since, tt.limit)
+			assert.ErrorIs(t, err, uc.ErrInvalidDashboardQuery)
+		})
+	}
+}
+
+func TestGetDashboardUseCase_Execute_SearchFail(t *testing.T) {
+	ucase, m := newDashboardUseCase(t)
+	since := time.Now().Add(-time.Hour)
+
+	m.queue.EXPECT().QueueStats(gomock.Any()).Return([]ops.QueueStatus{}, nil)
+	m.tasks.EXPECT().CountOpen(gomock.Any(), since).Return(nil, nil)
+	m.logs.EXPECT().CountByTenant(gomock.Any(), since, gomock.Any()).Return(map[string]int64{}, nil)
+	m.search.EXPECT().CountByTenant(gomock.Any(), since, gomock.Any()).Return(nil, errors.New("opensearch down"))
+
+	_, err := ucase.Execute(context.Background(), since, 0)
+	assert.EqualError(t, err, "opensearch down")
+}
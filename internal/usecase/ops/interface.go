@@ -0,0 +1,25 @@
+package ops
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/ops"
+)
+
+// GetDashboardUseCaseInterface defines behavior for reporting the queue backlog, open tasks and index lag.
+type GetDashboardUseCaseInterface interface {
+	Execute(ctx context.Context, since time.Time, limit int) (*ops.Dashboard, error)
+}
+
+// StartReindexUseCaseInterface defines behavior for queuing the reconciliation of OpenSearch with Postgres.
+type StartReindexUseCaseInterface interface {
+	Execute(ctx context.Context, req ReindexRequest) (*async_task.AsyncTask, error)
+}
+
+// StartIndexRebuildUseCaseInterface defines behavior for queuing the rebuild of the search index into a new version.
+type StartIndexRebuildUseCaseInterface interface {
+	Execute(ctx context.Context, userId string) (*async_task.AsyncTask, error)
+}
@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	async_task "github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	ops "github.com/Haevnen/audit-logging-api/internal/entity/ops"
+	ops0 "github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetDashboardUseCaseInterface is a mock of GetDashboardUseCaseInterface interface.
+type MockGetDashboardUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDashboardUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetDashboardUseCaseInterfaceMockRecorder is the mock recorder for MockGetDashboardUseCaseInterface.
+type MockGetDashboardUseCaseInterfaceMockRecorder struct {
+	mock *MockGetDashboardUseCaseInterface
+}
+
+// NewMockGetDashboardUseCaseInterface creates a new mock instance.
+func NewMockGetDashboardUseCaseInterface(ctrl *gomock.Controller) *MockGetDashboardUseCaseInterface {
+	mock := &MockGetDashboardUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetDashboardUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDashboardUseCaseInterface) EXPECT() *MockGetDashboardUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetDashboardUseCaseInterface) Execute(ctx context.Context, since time.Time, limit int) (*ops.Dashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, since, limit)
+	ret0, _ := ret[0].(*ops.Dashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetDashboardUseCaseInterfaceMockRecorder) Execute(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetDashboardUseCaseInterface)(nil).Execute), ctx, since, limit)
+}
+
+// MockStartReindexUseCaseInterface is a mock of StartReindexUseCaseInterface interface.
+type MockStartReindexUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartReindexUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockStartReindexUseCaseInterfaceMockRecorder is the mock recorder for MockStartReindexUseCaseInterface.
+type MockStartReindexUseCaseInterfaceMockRecorder struct {
+	mock *MockStartReindexUseCaseInterface
+}
+
+// NewMockStartReindexUseCaseInterface creates a new mock instance.
+func NewMockStartReindexUseCaseInterface(ctrl *gomock.Controller) *MockStartReindexUseCaseInterface {
+	mock := &MockStartReindexUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockStartReindexUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartReindexUseCaseInterface) EXPECT() *MockStartReindexUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockStartReindexUseCaseInterface) Execute(ctx context.Context, req ops0.ReindexRequest) (*async_task.AsyncTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, req)
+	ret0, _ := ret[0].(*async_task.AsyncTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockStartReindexUseCaseInterfaceMockRecorder) Execute(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartReindexUseCaseInterface)(nil).Execute), ctx, req)
+}
+
+// MockStartIndexRebuildUseCaseInterface is a mock of StartIndexRebuildUseCaseInterface interface.
+type MockStartIndexRebuildUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartIndexRebuildUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockStartIndexRebuildUseCaseInterfaceMockRecorder is the mock recorder for MockStartIndexRebuildUseCaseInterface.
+type MockStartIndexRebuildUseCaseInterfaceMockRecorder struct {
+	mock *MockStartIndexRebuildUseCaseInterface
+}
+
+// NewMockStartIndexRebuildUseCaseInterface creates a new mock instance.
+func NewMockStartIndexRebuildUseCaseInterface(ctrl *gomock.Controller) *MockStartIndexRebuildUseCaseInterface {
+	mock := &MockStartIndexRebuildUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockStartIndexRebuildUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartIndexRebuildUseCaseInterface) EXPECT() *MockStartIndexRebuildUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockStartIndexRebuildUseCaseInterface) Execute(ctx context.Context, userId string) (*async_task.AsyncTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, userId)
+	ret0, _ := ret[0].(*async_task.AsyncTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockStartIndexRebuildUseCaseInterfaceMockRecorder) Execute(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartIndexRebuildUseCaseInterface)(nil).Execute), ctx, userId)
+}
@@ -0,0 +1,93 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
+)
+
+// rebuildStaleAfter is how long an open rebuild task may go without progress before it is taken for dead, e.g.
+// its worker was stopped, and queued again
+const rebuildStaleAfter = 15 * time.Minute
+
+type StartIndexRebuildUseCase struct {
+	TaskRepo       repository.AsyncTaskRepository
+	QueuePublisher service.SQSPublisher
+	Indexes        service.SearchIndexManager
+	TxManager      interactor.TxManager
+}
+
+func NewStartIndexRebuildUseCase(taskRepo repository.AsyncTaskRepository, queuePublisher service.SQSPublisher, indexes service.SearchIndexManager, txManager interactor.TxManager) *StartIndexRebuildUseCase {
+	return &StartIndexRebuildUseCase{TaskRepo: taskRepo, QueuePublisher: queuePublisher, Indexes: indexes, TxManager: txManager}
+}
+
+// Execute queues the rebuild of the search index into service.LogsIndexVersion, it fails with
+// service.ErrSearchIndexUpToDate when the logs are indexed with that version already. There is a single task per
+// version: a request while it runs returns it, a request after it failed or stalled queues it again.
+func (uc *StartIndexRebuildUseCase) Execute(ctx context.Context, userId string) (_ *async_task.AsyncTask, err error) {
+	ctx, span := tracing.Start(ctx, "StartIndexRebuildUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
+	version := service.LogsIndexVersion
+	live, err := uc.Indexes.LiveVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if live >= version {
+		return nil, fmt.Errorf("%w: the logs are indexed with version %d", service.ErrSearchIndexUpToDate, live)
+	}
+
+	payload, err := json.Marshal(async_task.IndexRebuildPayload{Version: version})
+	if err != nil {
+		return nil, err
+	}
+	task := &async_task.AsyncTask{
+		TaskID:   uuid.NewSHA1(runKeyNamespace, []byte(fmt.Sprintf("index-rebuild:v%d", version))).String(),
+		Status:   async_task.StatusPending,
+		TaskType: async_task.TaskIndexRebuild,
+		UserID:   userId,
+		Payload:  (*datatypes.JSON)(&payload),
+	}
+	audit.Annotate(ctx, audit.KeyTaskID, task.TaskID)
+
+	err = uc.TxManager.TransactionExec(ctx, func(txCtx context.Context) error {
+		db := uc.TxManager.GetTx(txCtx)
+
+		created, err := uc.TaskRepo.CreateIfAbsent(txCtx, db, task)
+		if err != nil {
+			return err
+		}
+		if !created {
+			existing, err := uc.TaskRepo.GetByID(txCtx, task.TaskID)
+			if err != nil {
+				return err
+			}
+			stalled := existing.Status != async_task.StatusSucceeded && time.Since(existing.UpdatedAt) > rebuildStaleAfter
+			if existing.Status != async_task.StatusFailed && !stalled {
+				task = existing
+				return nil
+			}
+			if err := uc.TaskRepo.UpdateStatus(txCtx, db, task.TaskID, async_task.StatusPending, nil); err != nil {
+				return err
+			}
+			existing.Status = async_task.StatusPending
+			task = existing
+		}
+		return uc.QueuePublisher.PublishIndexRebuildMessage(txCtx, task.TaskID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
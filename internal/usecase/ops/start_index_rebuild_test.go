@@ -0,0 +1,121 @@
+package ops_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	interactorMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+)
+
+type rebuildMocks struct {
+	tasks   *repoMocks.MockAsyncTaskRepository
+	queue   *serviceMocks.MockSQSPublisher
+	indexes *serviceMocks.MockSearchIndexManager
+}
+
+func newStartIndexRebuildUseCase(t *testing.T) (*uc.StartIndexRebuildUseCase, rebuildMocks) {
+	ctrl := gomock.NewController(t)
+	m := rebuildMocks{
+		tasks:   repoMocks.NewMockAsyncTaskRepository(ctrl),
+		queue:   serviceMocks.NewMockSQSPublisher(ctrl),
+		indexes: serviceMocks.NewMockSearchIndexManager(ctrl),
+	}
+	tx := interactorMocks.NewMockTxManager(ctrl)
+	tx.EXPECT().TransactionExec(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+	tx.EXPECT().GetTx(gomock.Any()).Return(nil).AnyTimes()
+	return uc.NewStartIndexRebuildUseCase(m.tasks, m.queue, m.indexes, tx), m
+}
+
+func TestStartIndexRebuildUseCase_Execute(t *testing.T) {
+	ucase, m := newStartIndexRebuildUseCase(t)
+
+	m.indexes.EXPECT().LiveVersion(gomock.Any()).Return(service.LogsIndexVersion-1, nil)
+	var created *async_task.AsyncTask
+	m.tasks.EXPECT().CreateIfAbsent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *gorm.DB, task *async_task.AsyncTask) (bool, error) {
+			created = task
+			return true, nil
+		})
+	m.queue.EXPECT().PublishIndexRebuildMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, taskId string) error {
+			assert.Equal(t, created.TaskID, taskId)
+			return nil
+		})
+
+	task, err := ucase.Execute(context.Background(), "admin-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, async_task.TaskIndexRebuild, task.TaskType)
+	assert.Equal(t, async_task.StatusPending, task.Status)
+	assert.Equal(t, "admin-1", task.UserID)
+	assert.Nil(t, task.TenantUID)
+
+	var payload async_task.IndexRebuildPayload
+	require.NoError(t, json.Unmarshal(*task.Payload, &payload))
+	assert.Equal(t, service.LogsIndexVersion, payload.Version)
+}
+
+func TestStartIndexRebuildUseCase_Execute_UpToDate(t *testing.T) {
+	ucase, m := newStartIndexRebuildUseCase(t)
+
+	m.indexes.EXPECT().LiveVersion(gomock.Any()).Return(service.LogsIndexVersion, nil)
+
+	_, err := ucase.Execute(context.Background(), "admin-1")
+	assert.ErrorIs(t, err, service.ErrSearchIndexUpToDate)
+}
+
+func TestStartIndexRebuildUseCase_Execute_Existing(t *testing.T) {
+	tests := []struct {
+		name    string
+		task    async_task.AsyncTask
+		requeue bool
+	}{
+		{name: "running", task: async_task.AsyncTask{Status: async_task.StatusRunning, UpdatedAt: time.Now()}},
+		{name: "pending", task: async_task.AsyncTask{Status: async_task.StatusPending, UpdatedAt: time.Now()}},
+		{name: "failed", task: async_task.AsyncTask{Status: async_task.StatusFailed, UpdatedAt: time.Now()}, requeue: true},
+		{name: "stalled", task: async_task.AsyncTask{Status: async_task.StatusRunning, UpdatedAt: time.Now().Add(-time.Hour)}, requeue: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ucase, m := newStartIndexRebuildUseCase(t)
+			existing := tt.task
+
+			m.indexes.EXPECT().LiveVersion(gomock.Any()).Return(service.LogsIndexVersion-1, nil)
+			m.tasks.EXPECT().CreateIfAbsent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *gorm.DB, task *async_task.AsyncTask) (bool, error) {
+					existing.TaskID = task.TaskID
+					return false, nil
+				})
+			m.tasks.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&existing, nil)
+			if tt.requeue {
+				m.tasks.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), gomock.Any(), async_task.StatusPending, nil).Return(nil)
+				m.queue.EXPECT().PublishIndexRebuildMessage(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			task, err := ucase.Execute(context.Background(), "admin-1")
+
+			require.NoError(t, err)
+			assert.Same(t, &existing, task)
+			if tt.requeue {
+				assert.Equal(t, async_task.StatusPending, task.Status)
+			} else {
+				assert.Equal(t, tt.task.Status, task.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
+)
+
+const (
+	// maxReindexRange bounds the range of a reindex task, longer ranges are split by the caller
+	maxReindexRange = 31 * 24 * time.Hour
+	// ReindexGrace keeps the latest logs out of a reindex: a log is queued for indexing inside the transaction
+	// storing it, its document may exist before its row commits and must not be taken for an orphan
+	ReindexGrace = 5 * time.Minute
+)
+
+var ErrInvalidReindexRequest = errors.New("invalid reindex request")
+
+// runKeyNamespace derives the task id of the requests carrying a run key
+var runKeyNamespace = uuid.MustParse("5c1f6b2e-8a0d-4f5b-9a57-1d3c0e4b7a21")
+
+// ReindexRequest asks to reconcile the logs with an event timestamp in [StartTime, EndTime), of every tenant
+// when TenantID is empty
+type ReindexRequest struct {
+	TenantID  string
+	StartTime time.Time
+	EndTime   time.Time
+	UserID    string
+	// requests with the same run key queue a single task, e.g. the nightly reindex run by every async-task replica
+	RunKey string
+}
+
+type StartReindexUseCase struct {
+	TenantRepo     repository.TenantRepository
+	TaskRepo       repository.AsyncTaskRepository
+	QueuePublisher service.SQSPublisher
+	TxManager      interactor.TxManager
+}
+
+func NewStartReindexUseCase(tenantRepo repository.TenantRepository, taskRepo repository.AsyncTaskRepository, queuePublisher service.SQSPublisher, txManager interactor.TxManager) *StartReindexUseCase {
+	return &StartReindexUseCase{TenantRepo: tenantRepo, TaskRepo: taskRepo, QueuePublisher: queuePublisher, TxManager: txManager}
+}
+
+// Execute queues a reindex task, the range is cut to whole seconds since OpenSearch keeps milliseconds and an
+// end later than ReindexGrace ago is brought back to it. A request whose run key was seen returns the task
+// queued first.
+func (uc *StartReindexUseCase) Execute(ctx context.Context, req ReindexRequest) (_ *async_task.AsyncTask, err error) {
+	ctx, span := tracing.Start(ctx, "StartReindexUseCase.Execute")
+	defer func() { tracing.End(span, err) }()
+
+	start := req.StartTime.UTC().Truncate(time.Second)
+	end := req.EndTime.UTC().Truncate(time.Second)
+	if latest := time.Now().UTC().Add(-ReindexGrace).Truncate(time.Second); end.After(latest) {
+		end = latest
+	}
+	if !start.Before(end) || end.Sub(start) > maxReindexRange {
+		return nil, fmt.Errorf("%w: start_time must be before end_time, at most %s apart", ErrInvalidReindexRequest, maxReindexRange)
+	}
+
+	task := &async_task.AsyncTask{
+		TaskID:   uuid.New().String(),
+		Status:   async_task.StatusPending,
+		TaskType: async_task.TaskReindex,
+		UserID:   req.UserID,
+	}
+	if len(req.RunKey) > 0 {
+		task.TaskID = uuid.NewSHA1(runKeyNamespace, []byte(req.RunKey)).String()
+	}
+	if len(req.TenantID) > 0 {
+		audit.Annotate(ctx, audit.KeyTenantID, req.TenantID)
+		if _, err := uc.TenantRepo.GetByID(ctx, req.TenantID); err != nil {
+			return nil, err
+		}
+		task.TenantUID = &req.TenantID
+	}
+
+	payload, err := json.Marshal(async_task.ReindexPayload{StartTime: start, EndTime: end})
+	if err != nil {
+		return nil, err
+	}
+	task.Payload = (*datatypes.JSON)(&payload)
+
+	created := false
+	err = uc.TxManager.TransactionExec(ctx, func(txCtx context.Context) error {
+		db := uc.TxManager.GetTx(txCtx)
+
+		var err error
+		if created, err = uc.TaskRepo.CreateIfAbsent(txCtx, db, task); err != nil || !created {
+			return err
+		}
+		return uc.QueuePublisher.PublishReindexMessage(txCtx, task.TaskID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return uc.TaskRepo.GetByID(ctx, task.TaskID)
+	}
+
+	audit.Annotate(ctx, audit.KeyTaskID, task.TaskID)
+	return task, nil
+}
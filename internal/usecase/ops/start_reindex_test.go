@@ -0,0 +1,152 @@
+package ops_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	interactorMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+)
+
+type reindexMocks struct {
+	tenants *repoMocks.MockTenantRepository
+	tasks   *repoMocks.MockAsyncTaskRepository
+	queue   *serviceMocks.MockSQSPublisher
+}
+
+func newStartReindexUseCase(t *testing.T) (*uc.StartReindexUseCase, reindexMocks) {
+	ctrl := gomock.NewController(t)
+	m := reindexMocks{
+		tenants: repoMocks.NewMockTenantRepository(ctrl),
+		tasks:   repoMocks.NewMockAsyncTaskRepository(ctrl),
+		queue:   serviceMocks.NewMockSQSPublisher(ctrl),
+	}
+	tx := interactorMocks.NewMockTxManager(ctrl)
+	tx.EXPECT().TransactionExec(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+	tx.EXPECT().GetTx(gomock.Any()).Return(nil).AnyTimes()
+	return uc.NewStartReindexUseCase(m.tenants, m.tasks, m.queue, tx), m
+}
+
+func TestStartReindexUseCase_Execute(t *testing.T) {
+	ucase, m := newStartReindexUseCase(t)
+	start := time.Date(2026, 10, 18, 0, 0, 0, 500, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	m.tenants.EXPECT().GetByID(gomock.Any(), "t1").Return(&tenant.Tenant{ID: "t1"}, nil)
+	var created *async_task.AsyncTask
+	m.tasks.EXPECT().CreateIfAbsent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *gorm.DB, task *async_task.AsyncTask) (bool, error) {
+			created = task
+			return true, nil
+		})
+	m.queue.EXPECT().PublishReindexMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, taskId string) error {
+			assert.Equal(t, created.TaskID, taskId)
+			return nil
+		})
+
+	task, err := ucase.Execute(context.Background(), uc.ReindexRequest{TenantID: "t1", StartTime: start, EndTime: end, UserID: "admin-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, async_task.TaskReindex, task.TaskType)
+	assert.Equal(t, async_task.StatusPending, task.Status)
+	assert.Equal(t, "t1", *task.TenantUID)
+	assert.Equal(t, "admin-1", task.UserID)
+
+	var payload async_task.ReindexPayload
+	require.NoError(t, json.Unmarshal(*task.Payload, &payload))
+	assert.Equal(t, start.Truncate(time.Second), payload.StartTime)
+	assert.Equal(t, end.Truncate(time.Second), payload.EndTime)
+}
+
+func TestStartReindexUseCase_Execute_RunKey(t *testing.T) {
+	ucase, m := newStartReindexUseCase(t)
+	end := time.Now().UTC().Add(-time.Hour)
+	req := uc.ReindexRequest{StartTime: end.Add(-48 * time.Hour), EndTime: end, UserID: "scheduler", RunKey: "reindex:2026-10-19"}
+
+	var ids []string
+	m.tasks.EXPECT().CreateIfAbsent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *gorm.DB, task *async_task.AsyncTask) (bool, error) {
+			ids = append(ids, task.TaskID)
+			return len(ids) == 1, nil
+		}).Times(2)
+	m.queue.EXPECT().PublishReindexMessage(gomock.Any(), gomock.Any()).Return(nil)
+	existing := &async_task.AsyncTask{TaskID: "first", Status: async_task.StatusRunning}
+	m.tasks.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(existing, nil)
+
+	first, err := ucase.Execute(context.Background(), req)
+	require.NoError(t, err)
+	assert.Nil(t, first.TenantUID)
+
+	// another replica runs the same schedule, nothing new is queued
+	second, err := ucase.Execute(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, existing, second)
+	assert.Equal(t, ids[0], ids[1])
+}
+
+func TestStartReindexUseCase_Execute_Invalid(t *testing.T) {
+	ucase, _ := newStartReindexUseCase(t)
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+	}{
+		{name: "reversed", start: now.Add(-time.Hour), end: now.Add(-2 * time.Hour)},
+		{name: "future", start: now.Add(time.Hour), end: now.Add(2 * time.Hour)},
+		{name: "within grace", start: now.Add(-time.Minute), end: now},
+		{name: "too long", start: now.AddDate(0, -2, 0), end: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ucase.Execute(context.Background(), uc.ReindexRequest{StartTime: tt.start, EndTime: tt.end})
+			assert.ErrorIs(t, err, uc.ErrInvalidReindexRequest)
+		})
+	}
+}
+
+func TestStartReindexUseCase_Execute_Grace(t *testing.T) {
+	ucase, m := newStartReindexUseCase(t)
+	now := time.Now().UTC()
+
+	var created *async_task.AsyncTask
+	m.tasks.EXPECT().CreateIfAbsent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *gorm.DB, task *async_task.AsyncTask) (bool, error) {
+			created = task
+			return true, nil
+		})
+	m.queue.EXPECT().PublishReindexMessage(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := ucase.Execute(context.Background(), uc.ReindexRequest{StartTime: now.Add(-time.Hour), EndTime: now})
+	require.NoError(t, err)
+
+	// the logs being committed are left to the next run
+	var payload async_task.ReindexPayload
+	require.NoError(t, json.Unmarshal(*created.Payload, &payload))
+	assert.False(t, payload.EndTime.After(now.Add(-uc.ReindexGrace)))
+}
+
+func TestStartReindexUseCase_Execute_UnknownTenant(t *testing.T) {
+	ucase, m := newStartReindexUseCase(t)
+	now := time.Now().UTC()
+
+	m.tenants.EXPECT().GetByID(gomock.Any(), "missing").Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := ucase.Execute(context.Background(), uc.ReindexRequest{TenantID: "missing", StartTime: now.Add(-time.Hour), EndTime: now})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
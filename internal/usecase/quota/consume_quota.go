@@ -0,0 +1,52 @@
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type ConsumeQuotaUseCase struct {
+	Repo   repository.TenantLimitRepository
+	Limits ResolveLimitsUseCaseInterface
+}
+
+func NewConsumeQuotaUseCase(repo repository.TenantLimitRepository, limits ResolveLimitsUseCaseInterface) *ConsumeQuotaUseCase {
+	return &ConsumeQuotaUseCase{Repo: repo, Limits: limits}
+}
+
+// Execute counts the events and bytes about to be ingested against the daily quotas of the tenant.
+// Nothing is counted when a quota would be exceeded, a *QuotaExceededError is returned instead.
+func (uc *ConsumeQuotaUseCase) Execute(ctx context.Context, tenantId string, events, bytes int64) error {
+	limits, err := uc.Limits.Execute(ctx, tenantId)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	day := tenant_usage.Day(now)
+	ok, err := uc.Repo.ConsumeUsage(ctx, tenantId, day, events, bytes, limits.DailyEvents, limits.DailyBytes)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	usage, err := uc.Repo.GetUsage(ctx, tenantId, day)
+	if err != nil {
+		return err
+	}
+	exceeded := &QuotaExceededError{Quota: "bytes", Limit: limits.DailyBytes, Used: usage.Bytes, ResetAt: nextDay(now)}
+	if limits.DailyEvents > 0 && usage.Events+events > limits.DailyEvents {
+		exceeded.Quota, exceeded.Limit, exceeded.Used = "events", limits.DailyEvents, usage.Events
+	}
+	return exceeded
+}
+
+// Refund takes back the events and bytes consumed today for logs that ended up not being written
+func (uc *ConsumeQuotaUseCase) Refund(ctx context.Context, tenantId string, events, bytes int64) error {
+	return uc.Repo.RefundUsage(ctx, tenantId, tenant_usage.Day(time.Now()), events, bytes)
+}
@@ -0,0 +1,96 @@
+package quota_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	quotaMocks "github.com/Haevnen/audit-logging-api/internal/usecase/quota/mocks"
+)
+
+func TestConsumeQuotaUseCase_Execute_Consumed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	mockLimits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+	ucase := uc.NewConsumeQuotaUseCase(mockRepo, mockLimits)
+
+	limits := defaults
+	limits.DailyEvents, limits.DailyBytes = 100, 0
+	mockLimits.EXPECT().Execute(gomock.Any(), "t1").Return(limits, nil)
+	mockRepo.EXPECT().ConsumeUsage(gomock.Any(), "t1", tenant_usage.Day(time.Now()), int64(2), int64(300), int64(100), int64(0)).Return(true, nil)
+
+	assert.NoError(t, ucase.Execute(context.Background(), "t1", 2, 300))
+}
+
+func TestConsumeQuotaUseCase_Execute_Exceeded(t *testing.T) {
+	tests := []struct {
+		name  string
+		usage tenant_usage.DailyUsage
+		quota string
+		limit int64
+		used  int64
+	}{
+		{name: "events", usage: tenant_usage.DailyUsage{Events: 99, Bytes: 10}, quota: "events", limit: 100, used: 99},
+		{name: "bytes", usage: tenant_usage.DailyUsage{Events: 10, Bytes: 900}, quota: "bytes", limit: 1000, used: 900},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+			mockLimits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+			ucase := uc.NewConsumeQuotaUseCase(mockRepo, mockLimits)
+
+			limits := defaults
+			limits.DailyEvents, limits.DailyBytes = 100, 1000
+			mockLimits.EXPECT().Execute(gomock.Any(), "t1").Return(limits, nil)
+			mockRepo.EXPECT().ConsumeUsage(gomock.Any(), "t1", gomock.Any(), int64(2), int64(200), int64(100), int64(1000)).Return(false, nil)
+			mockRepo.EXPECT().GetUsage(gomock.Any(), "t1", gomock.Any()).Return(&tt.usage, nil)
+
+			err := ucase.Execute(context.Background(), "t1", 2, 200)
+
+			var exceeded *uc.QuotaExceededError
+			assert.True(t, errors.As(err, &exceeded))
+			assert.Equal(t, tt.quota, exceeded.Quota)
+			assert.Equal(t, tt.limit, exceeded.Limit)
+			assert.Equal(t, tt.used, exceeded.Used)
+			assert.True(t, exceeded.ResetAt.After(time.Now()))
+			assert.Equal(t, tenant_usage.Day(exceeded.ResetAt), exceeded.ResetAt)
+		})
+	}
+}
+
+func TestConsumeQuotaUseCase_Execute_LimitsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLimits := quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl)
+	mockLimits.EXPECT().Execute(gomock.Any(), "t1").Return(tenant_limit.Limits{}, assert.AnError)
+
+	err := uc.NewConsumeQuotaUseCase(repoMocks.NewMockTenantLimitRepository(ctrl), mockLimits).Execute(context.Background(), "t1", 1, 1)
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestConsumeQuotaUseCase_Refund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	ucase := uc.NewConsumeQuotaUseCase(mockRepo, quotaMocks.NewMockResolveLimitsUseCaseInterface(ctrl))
+
+	mockRepo.EXPECT().RefundUsage(gomock.Any(), "t1", tenant_usage.Day(time.Now()), int64(2), int64(300)).Return(nil)
+
+	assert.NoError(t, ucase.Refund(context.Background(), "t1", 2, 300))
+}
@@ -0,0 +1,26 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type GetLimitsUseCase struct {
+	Repo       repository.TenantLimitRepository
+	TenantRepo repository.TenantRepository
+	Defaults   tenant_limit.Limits
+}
+
+func NewGetLimitsUseCase(repo repository.TenantLimitRepository, tenantRepo repository.TenantRepository, defaults tenant_limit.Limits) *GetLimitsUseCase {
+	return &GetLimitsUseCase{Repo: repo, TenantRepo: tenantRepo, Defaults: defaults}
+}
+
+// Execute reads the limits of the tenant from the database, bypassing the cache of the rate limiter
+func (uc *GetLimitsUseCase) Execute(ctx context.Context, tenantId string) (tenant_limit.Limits, error) {
+	if _, err := uc.TenantRepo.GetByID(ctx, tenantId); err != nil {
+		return tenant_limit.Limits{}, err
+	}
+	return loadLimits(ctx, uc.Repo, uc.Defaults, tenantId)
+}
@@ -0,0 +1,88 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+// MaxUsageDays is the longest range of days the usage is read over at once
+const MaxUsageDays = 366
+
+var ErrInvalidUsageRange = errors.New("invalid usage range")
+
+// Usage is what the tenant ingested and read on each day of a range, against the limits in effect
+type Usage struct {
+	TenantID string
+	From, To time.Time
+	// a row per day from From to To, zero on the days without usage
+	Days    []tenant_usage.DailyUsage
+	Limits  tenant_limit.Limits
+	ResetAt time.Time
+}
+
+type GetUsageUseCase struct {
+	Repo       repository.TenantLimitRepository
+	TenantRepo repository.TenantRepository
+	Defaults   tenant_limit.Limits
+}
+
+func NewGetUsageUseCase(repo repository.TenantLimitRepository, tenantRepo repository.TenantRepository, defaults tenant_limit.Limits) *GetUsageUseCase {
+	return &GetUsageUseCase{Repo: repo, TenantRepo: tenantRepo, Defaults: defaults}
+}
+
+// Execute returns the daily usage of the tenant from the day of from to the day of to included, a zero from or
+// to is today. ResetAt is when today's counters start over.
+func (uc *GetUsageUseCase) Execute(ctx context.Context, tenantId string, from, to time.Time) (*Usage, error) {
+	audit.Annotate(ctx, audit.KeyTenantID, tenantId)
+
+	now := time.Now()
+	if from.IsZero() {
+		from = now
+	}
+	if to.IsZero() {
+		to = now
+	}
+	from, to = tenant_usage.Day(from), tenant_usage.Day(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: from is after to", ErrInvalidUsageRange)
+	}
+	if days := int(to.Sub(from)/(24*time.Hour)) + 1; days > MaxUsageDays {
+		return nil, fmt.Errorf("%w: at most %d days at once", ErrInvalidUsageRange, MaxUsageDays)
+	}
+
+	if _, err := uc.TenantRepo.GetByID(ctx, tenantId); err != nil {
+		return nil, err
+	}
+
+	limits, err := loadLimits(ctx, uc.Repo, uc.Defaults, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := uc.Repo.ListUsage(ctx, tenantId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[time.Time]tenant_usage.DailyUsage, len(rows))
+	for _, u := range rows {
+		stored[tenant_usage.Day(u.Day)] = u
+	}
+
+	days := []tenant_usage.DailyUsage{}
+	for day := from; !day.After(to); day = day.Add(24 * time.Hour) {
+		u, ok := stored[day]
+		if !ok {
+			u = tenant_usage.DailyUsage{TenantID: tenantId}
+		}
+		u.Day = day
+		days = append(days, u)
+	}
+	return &Usage{TenantID: tenantId, From: from, To: to, Days: days, Limits: limits, ResetAt: nextDay(now)}, nil
+}
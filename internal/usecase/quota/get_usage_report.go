@@ -0,0 +1,22 @@
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type GetUsageReportUseCase struct {
+	Repo repository.TenantLimitRepository
+}
+
+func NewGetUsageReportUseCase(repo repository.TenantLimitRepository) *GetUsageReportUseCase {
+	return &GetUsageReportUseCase{Repo: repo}
+}
+
+// Execute sums the usage of every tenant over the UTC month of month, tenants without usage are left out
+func (uc *GetUsageReportUseCase) Execute(ctx context.Context, month time.Time) ([]tenant_usage.MonthlyUsage, error) {
+	return uc.Repo.ListMonthlyUsage(ctx, tenant_usage.Month(month))
+}
@@ -0,0 +1,95 @@
+package quota_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+)
+
+func TestGetUsageUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	mockTenantRepo := repoMocks.NewMockTenantRepository(ctrl)
+	ucase := uc.NewGetUsageUseCase(mockRepo, mockTenantRepo, defaults)
+
+	day := tenant_usage.Day(time.Now())
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(&entitytenant.Tenant{ID: "t1"}, nil)
+	mockRepo.EXPECT().Get(gomock.Any(), "t1").Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.EXPECT().ListUsage(gomock.Any(), "t1", day, day).
+		Return([]tenant_usage.DailyUsage{{TenantID: "t1", Day: day, Events: 7, Bytes: 700, StoredBytes: 600}}, nil)
+
+	usage, err := ucase.Execute(context.Background(), "t1", time.Time{}, time.Time{})
+
+	assert.NoError(t, err)
+	assert.Len(t, usage.Days, 1)
+	assert.Equal(t, int64(7), usage.Days[0].Events)
+	assert.Equal(t, int64(600), usage.Days[0].StoredBytes)
+	assert.Equal(t, defaults, usage.Limits)
+	assert.Equal(t, day.Add(24*time.Hour), usage.ResetAt)
+}
+
+func TestGetUsageUseCase_Execute_Range(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	mockTenantRepo := repoMocks.NewMockTenantRepository(ctrl)
+	ucase := uc.NewGetUsageUseCase(mockRepo, mockTenantRepo, defaults)
+
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC)
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(&entitytenant.Tenant{ID: "t1"}, nil)
+	mockRepo.EXPECT().Get(gomock.Any(), "t1").Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.EXPECT().ListUsage(gomock.Any(), "t1", from, to).Return([]tenant_usage.DailyUsage{
+		{TenantID: "t1", Day: from, Events: 1},
+		{TenantID: "t1", Day: to, Events: 3, Searches: 2},
+	}, nil)
+
+	usage, err := ucase.Execute(context.Background(), "t1", from.Add(5*time.Hour), to.Add(23*time.Hour))
+
+	assert.NoError(t, err)
+	assert.Equal(t, from, usage.From)
+	assert.Equal(t, to, usage.To)
+	// the day without usage is there, zero
+	assert.Equal(t, []tenant_usage.DailyUsage{
+		{TenantID: "t1", Day: from, Events: 1},
+		{TenantID: "t1", Day: from.Add(24 * time.Hour)},
+		{TenantID: "t1", Day: to, Events: 3, Searches: 2},
+	}, usage.Days)
+}
+
+func TestGetUsageUseCase_Execute_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucase := uc.NewGetUsageUseCase(repoMocks.NewMockTenantLimitRepository(ctrl), repoMocks.NewMockTenantRepository(ctrl), defaults)
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := ucase.Execute(context.Background(), "t1", day, day.Add(-24*time.Hour))
+	assert.ErrorIs(t, err, uc.ErrInvalidUsageRange)
+
+	_, err = ucase.Execute(context.Background(), "t1", day, day.AddDate(0, 0, uc.MaxUsageDays))
+	assert.ErrorIs(t, err, uc.ErrInvalidUsageRange)
+}
+
+func TestGetUsageUseCase_Execute_TenantNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTenantRepo := repoMocks.NewMockTenantRepository(ctrl)
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.NewGetUsageUseCase(repoMocks.NewMockTenantLimitRepository(ctrl), mockTenantRepo, defaults).Execute(context.Background(), "t1", time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
@@ -0,0 +1,47 @@
+package quota
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+)
+
+// ResolveLimitsUseCaseInterface defines behavior for resolving the cached limits of a tenant.
+type ResolveLimitsUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string) (tenant_limit.Limits, error)
+}
+
+// GetLimitsUseCaseInterface defines behavior for reading the current limits of a tenant.
+type GetLimitsUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string) (tenant_limit.Limits, error)
+}
+
+// SetLimitsUseCaseInterface defines behavior for overriding the limits of a tenant.
+type SetLimitsUseCaseInterface interface {
+	Execute(ctx context.Context, l tenant_limit.TenantLimit) (tenant_limit.Limits, error)
+}
+
+// ConsumeQuotaUseCaseInterface defines behavior for counting ingestion against the daily quotas.
+type ConsumeQuotaUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string, events, bytes int64) error
+	Refund(ctx context.Context, tenantId string, events, bytes int64) error
+}
+
+// GetUsageUseCaseInterface defines behavior for reading the daily usage of a tenant.
+type GetUsageUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string, from, to time.Time) (*Usage, error)
+}
+
+// RecordActivityUseCaseInterface defines behavior for metering the stored bytes, searches and exports of a tenant.
+type RecordActivityUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string, activity tenant_usage.Activity) error
+	RecordStored(ctx context.Context, tenantId string, bytes int64) error
+}
+
+// GetUsageReportUseCaseInterface defines behavior for summing the usage of every tenant over a month.
+type GetUsageReportUseCaseInterface interface {
+	Execute(ctx context.Context, month time.Time) ([]tenant_usage.MonthlyUsage, error)
+}
@@ -0,0 +1,320 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	tenant_limit "github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	tenant_usage "github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	quota "github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResolveLimitsUseCaseInterface is a mock of ResolveLimitsUseCaseInterface interface.
+type MockResolveLimitsUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockResolveLimitsUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockResolveLimitsUseCaseInterfaceMockRecorder is the mock recorder for MockResolveLimitsUseCaseInterface.
+type MockResolveLimitsUseCaseInterfaceMockRecorder struct {
+	mock *MockResolveLimitsUseCaseInterface
+}
+
+// NewMockResolveLimitsUseCaseInterface creates a new mock instance.
+func NewMockResolveLimitsUseCaseInterface(ctrl *gomock.Controller) *MockResolveLimitsUseCaseInterface {
+	mock := &MockResolveLimitsUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockResolveLimitsUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResolveLimitsUseCaseInterface) EXPECT() *MockResolveLimitsUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResolveLimitsUseCaseInterface) Execute(ctx context.Context, tenantId string) (tenant_limit.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId)
+	ret0, _ := ret[0].(tenant_limit.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResolveLimitsUseCaseInterfaceMockRecorder) Execute(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResolveLimitsUseCaseInterface)(nil).Execute), ctx, tenantId)
+}
+
+// MockGetLimitsUseCaseInterface is a mock of GetLimitsUseCaseInterface interface.
+type MockGetLimitsUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetLimitsUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetLimitsUseCaseInterfaceMockRecorder is the mock recorder for MockGetLimitsUseCaseInterface.
+type MockGetLimitsUseCaseInterfaceMockRecorder struct {
+	mock *MockGetLimitsUseCaseInterface
+}
+
+// NewMockGetLimitsUseCaseInterface creates a new mock instance.
+func NewMockGetLimitsUseCaseInterface(ctrl *gomock.Controller) *MockGetLimitsUseCaseInterface {
+	mock := &MockGetLimitsUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetLimitsUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetLimitsUseCaseInterface) EXPECT() *MockGetLimitsUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetLimitsUseCaseInterface) Execute(ctx context.Context, tenantId string) (tenant_limit.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId)
+	ret0, _ := ret[0].(tenant_limit.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetLimitsUseCaseInterfaceMockRecorder) Execute(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetLimitsUseCaseInterface)(nil).Execute), ctx, tenantId)
+}
+
+// MockSetLimitsUseCaseInterface is a mock of SetLimitsUseCaseInterface interface.
+type MockSetLimitsUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetLimitsUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockSetLimitsUseCaseInterfaceMockRecorder is the mock recorder for MockSetLimitsUseCaseInterface.
+type MockSetLimitsUseCaseInterfaceMockRecorder struct {
+	mock *MockSetLimitsUseCaseInterface
+}
+
+// NewMockSetLimitsUseCaseInterface creates a new mock instance.
+func NewMockSetLimitsUseCaseInterface(ctrl *gomock.Controller) *MockSetLimitsUseCaseInterface {
+	mock := &MockSetLimitsUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockSetLimitsUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetLimitsUseCaseInterface) EXPECT() *MockSetLimitsUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSetLimitsUseCaseInterface) Execute(ctx context.Context, l tenant_limit.TenantLimit) (tenant_limit.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, l)
+	ret0, _ := ret[0].(tenant_limit.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSetLimitsUseCaseInterfaceMockRecorder) Execute(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSetLimitsUseCaseInterface)(nil).Execute), ctx, l)
+}
+
+// MockConsumeQuotaUseCaseInterface is a mock of ConsumeQuotaUseCaseInterface interface.
+type MockConsumeQuotaUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockConsumeQuotaUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockConsumeQuotaUseCaseInterfaceMockRecorder is the mock recorder for MockConsumeQuotaUseCaseInterface.
+type MockConsumeQuotaUseCaseInterfaceMockRecorder struct {
+	mock *MockConsumeQuotaUseCaseInterface
+}
+
+// NewMockConsumeQuotaUseCaseInterface creates a new mock instance.
+func NewMockConsumeQuotaUseCaseInterface(ctrl *gomock.Controller) *MockConsumeQuotaUseCaseInterface {
+	mock := &MockConsumeQuotaUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockConsumeQuotaUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConsumeQuotaUseCaseInterface) EXPECT() *MockConsumeQuotaUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockConsumeQuotaUseCaseInterface) Execute(ctx context.Context, tenantId string, events, bytes int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId, events, bytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockConsumeQuotaUseCaseInterfaceMockRecorder) Execute(ctx, tenantId, events, bytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockConsumeQuotaUseCaseInterface)(nil).Execute), ctx, tenantId, events, bytes)
+}
+
+// Refund mocks base method.
+func (m *MockConsumeQuotaUseCaseInterface) Refund(ctx context.Context, tenantId string, events, bytes int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, tenantId, events, bytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockConsumeQuotaUseCaseInterfaceMockRecorder) Refund(ctx, tenantId, events, bytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockConsumeQuotaUseCaseInterface)(nil).Refund), ctx, tenantId, events, bytes)
+}
+
+// MockGetUsageUseCaseInterface is a mock of GetUsageUseCaseInterface interface.
+type MockGetUsageUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUsageUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetUsageUseCaseInterfaceMockRecorder is the mock recorder for MockGetUsageUseCaseInterface.
+type MockGetUsageUseCaseInterfaceMockRecorder struct {
+	mock *MockGetUsageUseCaseInterface
+}
+
+// NewMockGetUsageUseCaseInterface creates a new mock instance.
+func NewMockGetUsageUseCaseInterface(ctrl *gomock.Controller) *MockGetUsageUseCaseInterface {
+	mock := &MockGetUsageUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetUsageUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUsageUseCaseInterface) EXPECT() *MockGetUsageUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUsageUseCaseInterface) Execute(ctx context.Context, tenantId string, from, to time.Time) (*quota.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId, from, to)
+	ret0, _ := ret[0].(*quota.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUsageUseCaseInterfaceMockRecorder) Execute(ctx, tenantId, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUsageUseCaseInterface)(nil).Execute), ctx, tenantId, from, to)
+}
+
+// MockRecordActivityUseCaseInterface is a mock of RecordActivityUseCaseInterface interface.
+type MockRecordActivityUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordActivityUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRecordActivityUseCaseInterfaceMockRecorder is the mock recorder for MockRecordActivityUseCaseInterface.
+type MockRecordActivityUseCaseInterfaceMockRecorder struct {
+	mock *MockRecordActivityUseCaseInterface
+}
+
+// NewMockRecordActivityUseCaseInterface creates a new mock instance.
+func NewMockRecordActivityUseCaseInterface(ctrl *gomock.Controller) *MockRecordActivityUseCaseInterface {
+	mock := &MockRecordActivityUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRecordActivityUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordActivityUseCaseInterface) EXPECT() *MockRecordActivityUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRecordActivityUseCaseInterface) Execute(ctx context.Context, tenantId string, activity tenant_usage.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRecordActivityUseCaseInterfaceMockRecorder) Execute(ctx, tenantId, activity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRecordActivityUseCaseInterface)(nil).Execute), ctx, tenantId, activity)
+}
+
+// RecordStored mocks base method.
+func (m *MockRecordActivityUseCaseInterface) RecordStored(ctx context.Context, tenantId string, bytes int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordStored", ctx, tenantId, bytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordStored indicates an expected call of RecordStored.
+func (mr *MockRecordActivityUseCaseInterfaceMockRecorder) RecordStored(ctx, tenantId, bytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordStored", reflect.TypeOf((*MockRecordActivityUseCaseInterface)(nil).RecordStored), ctx, tenantId, bytes)
+}
+
+// MockGetUsageReportUseCaseInterface is a mock of GetUsageReportUseCaseInterface interface.
+type MockGetUsageReportUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUsageReportUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetUsageReportUseCaseInterfaceMockRecorder is the mock recorder for MockGetUsageReportUseCaseInterface.
+type MockGetUsageReportUseCaseInterfaceMockRecorder struct {
+	mock *MockGetUsageReportUseCaseInterface
+}
+
+// NewMockGetUsageReportUseCaseInterface creates a new mock instance.
+func NewMockGetUsageReportUseCaseInterface(ctrl *gomock.Controller) *MockGetUsageReportUseCaseInterface {
+	mock := &MockGetUsageReportUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetUsageReportUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUsageReportUseCaseInterface) EXPECT() *MockGetUsageReportUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUsageReportUseCaseInterface) Execute(ctx context.Context, month time.Time) ([]tenant_usage.MonthlyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, month)
+	ret0, _ := ret[0].([]tenant_usage.MonthlyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUsageReportUseCaseInterfaceMockRecorder) Execute(ctx, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUsageReportUseCaseInterface)(nil).Execute), ctx, month)
+}
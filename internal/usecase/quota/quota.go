@@ -0,0 +1,45 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+var ErrInvalidLimits = errors.New("rates and bursts must be positive, quotas can't be negative")
+
+// QuotaExceededError is returned when ingesting would go over a daily quota
+type QuotaExceededError struct {
+	// Quota is "events" or "bytes"
+	Quota   string
+	Limit   int64
+	Used    int64
+	ResetAt time.Time
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("daily %s quota of %d exceeded, %d used", e.Quota, e.Limit, e.Used)
+}
+
+// loadLimits applies the tenant's stored overrides to the defaults
+func loadLimits(ctx context.Context, repo repository.TenantLimitRepository, defaults tenant_limit.Limits, tenantId string) (tenant_limit.Limits, error) {
+	l, err := repo.Get(ctx, tenantId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return defaults, nil
+	}
+	if err != nil {
+		return tenant_limit.Limits{}, err
+	}
+	return l.Apply(defaults), nil
+}
+
+// nextDay is when the usage of the day of t is reset
+func nextDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
@@ -0,0 +1,130 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+// ActivityFlushInterval is how often the stored bytes, searches and exports counted by an API instance are
+// written, the usage of the day lags behind by up to this long
+const ActivityFlushInterval = 10 * time.Second
+
+// RecordActivityUseCase meters the searches and exports off the read path: they are counted in memory and
+// written a batch at a time by Run, a read never waits on the database nor fails because of the metering.
+// The usage is a counter of its own rather than a continuous aggregate since searches and exports leave no
+// rows to aggregate, and the ingestion is counted by ConsumeQuotaUseCase which must enforce the quotas.
+// The size of the logs stored, known once they are redacted and written, is metered the same way.
+type RecordActivityUseCase struct {
+	Repo repository.TenantLimitRepository
+
+	mu      sync.Mutex
+	pending map[activityKey]*tenant_usage.DailyUsage
+}
+
+type activityKey struct {
+	tenantId string
+	day      time.Time
+}
+
+func NewRecordActivityUseCase(repo repository.TenantLimitRepository) *RecordActivityUseCase {
+	return &RecordActivityUseCase{Repo: repo, pending: map[activityKey]*tenant_usage.DailyUsage{}}
+}
+
+// Execute counts one search or export of the tenant in today's usage
+func (uc *RecordActivityUseCase) Execute(ctx context.Context, tenantId string, activity tenant_usage.Activity) error {
+	if activity != tenant_usage.ActivitySearch && activity != tenant_usage.ActivityExport {
+		return fmt.Errorf("unknown activity %q", activity)
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	usage := uc.usage(tenantId, tenant_usage.Day(time.Now()))
+	if activity == tenant_usage.ActivitySearch {
+		usage.Searches++
+	} else {
+		usage.Exports++
+	}
+	return nil
+}
+
+// RecordStored counts the size of logs of the tenant stored today
+func (uc *RecordActivityUseCase) RecordStored(ctx context.Context, tenantId string, bytes int64) error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.usage(tenantId, tenant_usage.Day(time.Now())).StoredBytes += bytes
+	return nil
+}
+
+// Run writes the counted activity every interval until ctx is done, then a last time
+func (uc *RecordActivityUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := uc.Flush(context.WithoutCancel(ctx)); err != nil {
+				logger.FromContext(ctx).WithField("error", err).Error("failed to write the metered usage, it is lost")
+			}
+			return
+		case <-ticker.C:
+			if err := uc.Flush(ctx); err != nil {
+				logger.FromContext(ctx).WithField("error", err).Warn("failed to write the metered usage, retrying with the next batch")
+			}
+		}
+	}
+}
+
+// Flush writes the activity counted since the last flush, it is counted again on failure so that the next
+// flush writes it
+func (uc *RecordActivityUseCase) Flush(ctx context.Context) error {
+	uc.mu.Lock()
+	pending := uc.pending
+	uc.pending = map[activityKey]*tenant_usage.DailyUsage{}
+	uc.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// the same order on every instance, concurrent batches lock the rows without deadlocking
+	usages := make([]tenant_usage.DailyUsage, 0, len(pending))
+	for _, u := range pending {
+		usages = append(usages, *u)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].TenantID != usages[j].TenantID {
+			return usages[i].TenantID < usages[j].TenantID
+		}
+		return usages[i].Day.Before(usages[j].Day)
+	})
+
+	if err := uc.Repo.RecordActivity(ctx, usages); err != nil {
+		uc.mu.Lock()
+		defer uc.mu.Unlock()
+		for _, u := range usages {
+			usage := uc.usage(u.TenantID, u.Day)
+			usage.StoredBytes += u.StoredBytes
+			usage.Searches += u.Searches
+			usage.Exports += u.Exports
+		}
+		return err
+	}
+	return nil
+}
+
+// usage returns the pending usage of the tenant on the day, uc.mu must be held
+func (uc *RecordActivityUseCase) usage(tenantId string, day time.Time) *tenant_usage.DailyUsage {
+	key := activityKey{tenantId: tenantId, day: day}
+	usage, ok := uc.pending[key]
+	if !ok {
+		usage = &tenant_usage.DailyUsage{TenantID: tenantId, Day: day}
+		uc.pending[key] = usage
+	}
+	return usage
+}
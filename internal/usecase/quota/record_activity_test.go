@@ -0,0 +1,100 @@
+package quota_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_usage"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+)
+
+func TestRecordActivityUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	meter := uc.NewRecordActivityUseCase(mockRepo)
+	ctx := context.Background()
+	today := tenant_usage.Day(time.Now())
+
+	// nothing reaches the database until the flush, which writes every count at once
+	for _, call := range []struct {
+		tenantId string
+		activity tenant_usage.Activity
+	}{
+		{"t2", tenant_usage.ActivitySearch},
+		{"t1", tenant_usage.ActivityExport},
+		{"t2", tenant_usage.ActivitySearch},
+		{"t2", tenant_usage.ActivityExport},
+	} {
+		require.NoError(t, meter.Execute(ctx, call.tenantId, call.activity))
+	}
+	assert.Error(t, meter.Execute(ctx, "t1", tenant_usage.Activity("events")))
+	require.NoError(t, meter.RecordStored(ctx, "t1", 300))
+	require.NoError(t, meter.RecordStored(ctx, "t1", 200))
+
+	mockRepo.EXPECT().RecordActivity(gomock.Any(), []tenant_usage.DailyUsage{
+		{TenantID: "t1", Day: today, StoredBytes: 500, Exports: 1},
+		{TenantID: "t2", Day: today, Searches: 2, Exports: 1},
+	}).Return(nil)
+	require.NoError(t, meter.Flush(ctx))
+
+	// and only once
+	require.NoError(t, meter.Flush(ctx))
+}
+
+func TestRecordActivityUseCase_Flush_Fails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	meter := uc.NewRecordActivityUseCase(mockRepo)
+	ctx := context.Background()
+	today := tenant_usage.Day(time.Now())
+
+	require.NoError(t, meter.Execute(ctx, "t1", tenant_usage.ActivitySearch))
+	mockRepo.EXPECT().RecordActivity(gomock.Any(), gomock.Any()).Return(assert.AnError)
+	assert.ErrorIs(t, meter.Flush(ctx), assert.AnError)
+
+	// the counts of the failed flush are written with the next one
+	require.NoError(t, meter.Execute(ctx, "t1", tenant_usage.ActivitySearch))
+	mockRepo.EXPECT().RecordActivity(gomock.Any(), []tenant_usage.DailyUsage{{TenantID: "t1", Day: today, Searches: 2}}).Return(nil)
+	require.NoError(t, meter.Flush(ctx))
+}
+
+func TestRecordActivityUseCase_Run_FlushesOnShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	meter := uc.NewRecordActivityUseCase(mockRepo)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	require.NoError(t, meter.Execute(ctx, "t1", tenant_usage.ActivityExport))
+	mockRepo.EXPECT().RecordActivity(gomock.Any(), []tenant_usage.DailyUsage{{TenantID: "t1", Day: tenant_usage.Day(time.Now()), Exports: 1}}).Return(nil)
+
+	cancel()
+	meter.Run(ctx, time.Hour)
+}
+
+func TestGetUsageReportUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	month := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	expected := []tenant_usage.MonthlyUsage{{TenantID: "t1", Month: month, Events: 10}}
+
+	// any time of the month reports the whole month
+	mockRepo.EXPECT().ListMonthlyUsage(gomock.Any(), month).Return(expected, nil)
+
+	usages, err := uc.NewGetUsageReportUseCase(mockRepo).Execute(context.Background(), time.Date(2026, 10, 19, 15, 4, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, usages)
+}
@@ -0,0 +1,61 @@
+package quota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+// limits are reloaded from the database at most once per interval per tenant, so a change
+// takes up to this long to reach every API instance
+const limitCacheTTL = 30 * time.Second
+
+// expired entries are dropped once the cache holds more tenants than this
+const limitCacheSweepSize = 10000
+
+type ResolveLimitsUseCase struct {
+	Repo     repository.TenantLimitRepository
+	Defaults tenant_limit.Limits
+
+	mu    sync.Mutex
+	cache map[string]cachedLimits
+}
+
+type cachedLimits struct {
+	limits   tenant_limit.Limits
+	loadedAt time.Time
+}
+
+func NewResolveLimitsUseCase(repo repository.TenantLimitRepository, defaults tenant_limit.Limits) *ResolveLimitsUseCase {
+	return &ResolveLimitsUseCase{Repo: repo, Defaults: defaults, cache: map[string]cachedLimits{}}
+}
+
+// Execute returns the limits in effect for the tenant
+func (uc *ResolveLimitsUseCase) Execute(ctx context.Context, tenantId string) (tenant_limit.Limits, error) {
+	uc.mu.Lock()
+	cached, ok := uc.cache[tenantId]
+	uc.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < limitCacheTTL {
+		return cached.limits, nil
+	}
+
+	limits, err := loadLimits(ctx, uc.Repo, uc.Defaults, tenantId)
+	if err != nil {
+		return tenant_limit.Limits{}, err
+	}
+
+	uc.mu.Lock()
+	if len(uc.cache) >= limitCacheSweepSize {
+		for k, v := range uc.cache {
+			if time.Since(v.loadedAt) >= limitCacheTTL {
+				delete(uc.cache, k)
+			}
+		}
+	}
+	uc.cache[tenantId] = cachedLimits{limits: limits, loadedAt: time.Now()}
+	uc.mu.Unlock()
+	return limits, nil
+}
@@ -0,0 +1,59 @@
+package quota_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+var defaults = tenant_limit.Limits{
+	Read:   tenant_limit.Rate{RPS: 10, Burst: 20},
+	Write:  tenant_limit.Rate{RPS: 10, Burst: 20},
+	Export: tenant_limit.Rate{RPS: 1, Burst: 5},
+}
+
+func TestResolveLimitsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	ucase := uc.NewResolveLimitsUseCase(mockRepo, defaults)
+	ctx := context.Background()
+
+	// limits are cached, each tenant is loaded once
+	mockRepo.EXPECT().Get(gomock.Any(), "default").Return(nil, gorm.ErrRecordNotFound).Times(1)
+	mockRepo.EXPECT().Get(gomock.Any(), "custom").
+		Return(&tenant_limit.TenantLimit{TenantID: "custom", WriteBurst: utils.Ptr(100), DailyEventQuota: utils.Ptr(int64(5000))}, nil).Times(1)
+
+	for i := 0; i < 2; i++ {
+		l, err := ucase.Execute(ctx, "default")
+		assert.NoError(t, err)
+		assert.Equal(t, defaults, l)
+
+		l, err = ucase.Execute(ctx, "custom")
+		assert.NoError(t, err)
+		assert.Equal(t, tenant_limit.Rate{RPS: 10, Burst: 100}, l.Write)
+		assert.Equal(t, defaults.Read, l.Read)
+		assert.Equal(t, int64(5000), l.DailyEvents)
+		assert.Zero(t, l.DailyBytes)
+	}
+}
+
+func TestResolveLimitsUseCase_Execute_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	mockRepo.EXPECT().Get(gomock.Any(), "t1").Return(nil, assert.AnError)
+
+	_, err := uc.NewResolveLimitsUseCase(mockRepo, defaults).Execute(context.Background(), "t1")
+	assert.ErrorIs(t, err, assert.AnError)
+}
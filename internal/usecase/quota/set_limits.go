@@ -0,0 +1,48 @@
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type SetLimitsUseCase struct {
+	Repo       repository.TenantLimitRepository
+	TenantRepo repository.TenantRepository
+	Defaults   tenant_limit.Limits
+}
+
+func NewSetLimitsUseCase(repo repository.TenantLimitRepository, tenantRepo repository.TenantRepository, defaults tenant_limit.Limits) *SetLimitsUseCase {
+	return &SetLimitsUseCase{Repo: repo, TenantRepo: tenantRepo, Defaults: defaults}
+}
+
+// Execute replaces the overrides of the tenant, the fields left nil go back to the defaults.
+// It returns the limits now in effect, rate limiters pick them up within the cache interval.
+func (uc *SetLimitsUseCase) Execute(ctx context.Context, l tenant_limit.TenantLimit) (tenant_limit.Limits, error) {
+	audit.Annotate(ctx, audit.KeyTenantID, l.TenantID)
+
+	for _, v := range []*int{l.ReadRPS, l.ReadBurst, l.WriteRPS, l.WriteBurst, l.ExportRPS, l.ExportBurst} {
+		if v != nil && *v <= 0 {
+			return tenant_limit.Limits{}, ErrInvalidLimits
+		}
+	}
+	for _, v := range []*int64{l.DailyEventQuota, l.DailyByteQuota} {
+		if v != nil && *v < 0 {
+			return tenant_limit.Limits{}, ErrInvalidLimits
+		}
+	}
+
+	if _, err := uc.TenantRepo.GetByID(ctx, l.TenantID); err != nil {
+		return tenant_limit.Limits{}, err
+	}
+
+	now := time.Now().UTC()
+	l.CreatedAt, l.UpdatedAt = now, now
+	if err := uc.Repo.Upsert(ctx, &l); err != nil {
+		return tenant_limit.Limits{}, err
+	}
+	return l.Apply(uc.Defaults), nil
+}
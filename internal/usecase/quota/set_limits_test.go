@@ -0,0 +1,66 @@
+package quota_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant_limit"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/quota"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+func TestSetLimitsUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantLimitRepository(ctrl)
+	mockTenantRepo := repoMocks.NewMockTenantRepository(ctrl)
+	ucase := uc.NewSetLimitsUseCase(mockRepo, mockTenantRepo, defaults)
+
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(&entitytenant.Tenant{ID: "t1"}, nil)
+	mockRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, l *tenant_limit.TenantLimit) error {
+			assert.Equal(t, "t1", l.TenantID)
+			assert.False(t, l.UpdatedAt.IsZero())
+			return nil
+		})
+
+	limits, err := ucase.Execute(context.Background(), tenant_limit.TenantLimit{
+		TenantID: "t1", ExportRPS: utils.Ptr(3), DailyByteQuota: utils.Ptr(int64(0)),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, tenant_limit.Rate{RPS: 3, Burst: 5}, limits.Export)
+	assert.Equal(t, defaults.Read, limits.Read)
+}
+
+func TestSetLimitsUseCase_Execute_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucase := uc.NewSetLimitsUseCase(repoMocks.NewMockTenantLimitRepository(ctrl), repoMocks.NewMockTenantRepository(ctrl), defaults)
+
+	_, err := ucase.Execute(context.Background(), tenant_limit.TenantLimit{TenantID: "t1", ReadBurst: utils.Ptr(0)})
+	assert.ErrorIs(t, err, uc.ErrInvalidLimits)
+
+	_, err = ucase.Execute(context.Background(), tenant_limit.TenantLimit{TenantID: "t1", DailyEventQuota: utils.Ptr(int64(-1))})
+	assert.ErrorIs(t, err, uc.ErrInvalidLimits)
+}
+
+func TestSetLimitsUseCase_Execute_TenantNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTenantRepo := repoMocks.NewMockTenantRepository(ctrl)
+	ucase := uc.NewSetLimitsUseCase(repoMocks.NewMockTenantLimitRepository(ctrl), mockTenantRepo, defaults)
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := ucase.Execute(context.Background(), tenant_limit.TenantLimit{TenantID: "t1"})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
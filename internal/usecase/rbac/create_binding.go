@@ -0,0 +1,58 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type CreateRoleBindingUseCase struct {
+	Repo repository.RoleRepository
+}
+
+func NewCreateRoleBindingUseCase(repo repository.RoleRepository) *CreateRoleBindingUseCase {
+	return &CreateRoleBindingUseCase{Repo: repo}
+}
+
+// Execute grants the role to the user. A tenant role can only be bound within its tenant, and users
+// of a tenant only get roles acting within it. Binding the same role twice returns the existing binding.
+func (uc *CreateRoleBindingUseCase) Execute(ctx context.Context, createdBy string, b role.Binding) (*role.Binding, error) {
+	b.UserID = strings.TrimSpace(b.UserID)
+	if len(b.UserID) == 0 {
+		return nil, fmt.Errorf("%w: user_id is required", ErrInvalidGrant)
+	}
+
+	r, err := uc.Repo.GetByID(ctx, b.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	if r.TenantID != nil && !sameTenant(r.TenantID, b.TenantID) {
+		return nil, fmt.Errorf("%w: role %q belongs to another tenant", ErrInvalidGrant, r.Name)
+	}
+	if b.TenantID != nil && !r.IsTenantScoped() {
+		return nil, fmt.Errorf("%w: role %q can't be granted within a tenant", ErrInvalidGrant, r.Name)
+	}
+
+	tenantId := ""
+	if b.TenantID != nil {
+		tenantId = *b.TenantID
+	}
+	existing, err := uc.Repo.ListBindings(ctx, tenantId, b.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range existing {
+		if e.RoleID == b.RoleID && sameTenant(e.TenantID, b.TenantID) {
+			return &e, nil
+		}
+	}
+
+	b.ID = uuid.New().String()
+	b.CreatedBy = createdBy
+	return uc.Repo.CreateBinding(ctx, &b)
+}
@@ -0,0 +1,88 @@
+package rbac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/rbac"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestCreateRoleBindingUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "role-1").
+		Return(&role.Role{ID: "role-1", TenantID: ptr("tenant-1"), Permissions: []auth.Permission{auth.PermissionLogsExport}}, nil)
+	mockRepo.EXPECT().ListBindings(gomock.Any(), "tenant-1", "u1").Return(nil, nil)
+	mockRepo.EXPECT().CreateBinding(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, b *role.Binding) (*role.Binding, error) {
+			assert.NotEmpty(t, b.ID)
+			assert.Equal(t, "admin-1", b.CreatedBy)
+			return b, nil
+		})
+
+	b, err := uc.NewCreateRoleBindingUseCase(mockRepo).Execute(context.Background(), "admin-1",
+		role.Binding{TenantID: ptr("tenant-1"), UserID: "u1", RoleID: "role-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", b.UserID)
+}
+
+func TestCreateRoleBindingUseCase_Execute_Existing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "role-1").
+		Return(&role.Role{ID: "role-1", Permissions: []auth.Permission{auth.PermissionLogsRead}}, nil)
+	mockRepo.EXPECT().ListBindings(gomock.Any(), "", "admin-2").
+		Return([]role.Binding{
+			{ID: "other-tenant", TenantID: ptr("tenant-1"), UserID: "admin-2", RoleID: "role-1"},
+			{ID: "binding-1", UserID: "admin-2", RoleID: "role-1"},
+		}, nil)
+
+	b, err := uc.NewCreateRoleBindingUseCase(mockRepo).Execute(context.Background(), "admin-1",
+		role.Binding{UserID: "admin-2", RoleID: "role-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "binding-1", b.ID)
+}
+
+func TestCreateRoleBindingUseCase_Execute_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    role.Role
+		binding role.Binding
+	}{
+		{"Role Of Another Tenant", role.Role{ID: "role-1", TenantID: ptr("tenant-2"), Permissions: []auth.Permission{auth.PermissionLogsRead}},
+			role.Binding{TenantID: ptr("tenant-1"), UserID: "u1", RoleID: "role-1"}},
+		{"Tenant Role To Platform User", role.Role{ID: "role-1", TenantID: ptr("tenant-1"), Permissions: []auth.Permission{auth.PermissionLogsRead}},
+			role.Binding{UserID: "u1", RoleID: "role-1"}},
+		{"Platform Role In Tenant", role.Role{ID: "role-1", Name: "admin", BuiltIn: true, Permissions: auth.DefaultPermissions(auth.RoleAdmin)},
+			role.Binding{TenantID: ptr("tenant-1"), UserID: "u1", RoleID: "role-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), "role-1").Return(&tt.role, nil)
+
+			_, err := uc.NewCreateRoleBindingUseCase(mockRepo).Execute(context.Background(), "admin-1", tt.binding)
+			assert.ErrorIs(t, err, uc.ErrInvalidGrant)
+		})
+	}
+}
+
+func TestCreateRoleBindingUseCase_Execute_NoUser(t *testing.T) {
+	_, err := uc.NewCreateRoleBindingUseCase(nil).Execute(context.Background(), "admin-1", role.Binding{RoleID: "role-1"})
+	assert.ErrorIs(t, err, uc.ErrInvalidGrant)
+}
@@ -0,0 +1,39 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type CreateRoleUseCase struct {
+	Repo repository.RoleRepository
+}
+
+func NewCreateRoleUseCase(repo repository.RoleRepository) *CreateRoleUseCase {
+	return &CreateRoleUseCase{Repo: repo}
+}
+
+// Execute stores a custom role, global when it has no tenant
+func (uc *CreateRoleUseCase) Execute(ctx context.Context, r role.Role) (*role.Role, error) {
+	r.Name = strings.TrimSpace(r.Name)
+	if len(r.Name) == 0 {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidRole)
+	}
+	if auth.Role(r.Name).IsValid() {
+		return nil, fmt.Errorf("%w: %q is a built-in role", ErrInvalidRole, r.Name)
+	}
+	if err := validatePermissions(&r); err != nil {
+		return nil, err
+	}
+
+	r.ID = uuid.New().String()
+	r.BuiltIn = false
+	return uc.Repo.Create(ctx, &r)
+}
@@ -0,0 +1,23 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type DeleteRoleBindingUseCase struct {
+	Repo repository.RoleRepository
+}
+
+func NewDeleteRoleBindingUseCase(repo repository.RoleRepository) *DeleteRoleBindingUseCase {
+	return &DeleteRoleBindingUseCase{Repo: repo}
+}
+
+func (uc *DeleteRoleBindingUseCase) Execute(ctx context.Context, id string) error {
+	b, err := uc.Repo.GetBindingByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.DeleteBinding(ctx, b.ID)
+}
@@ -0,0 +1,27 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type DeleteRoleUseCase struct {
+	Repo repository.RoleRepository
+}
+
+func NewDeleteRoleUseCase(repo repository.RoleRepository) *DeleteRoleUseCase {
+	return &DeleteRoleUseCase{Repo: repo}
+}
+
+// Execute removes a custom role together with its bindings
+func (uc *DeleteRoleUseCase) Execute(ctx context.Context, id string) error {
+	r, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if r.BuiltIn {
+		return ErrBuiltInRole
+	}
+	return uc.Repo.Delete(ctx, r.ID)
+}
@@ -0,0 +1,49 @@
+package rbac
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+)
+
+// CreateRoleUseCaseInterface defines behavior for creating custom roles.
+type CreateRoleUseCaseInterface interface {
+	Execute(ctx context.Context, r role.Role) (*role.Role, error)
+}
+
+// UpdateRoleUseCaseInterface defines behavior for changing the permissions of a custom role.
+type UpdateRoleUseCaseInterface interface {
+	Execute(ctx context.Context, id string, description *string, permissions []auth.Permission) (*role.Role, error)
+}
+
+// DeleteRoleUseCaseInterface defines behavior for deleting custom roles.
+type DeleteRoleUseCaseInterface interface {
+	Execute(ctx context.Context, id string) error
+}
+
+// ListRolesUseCaseInterface defines behavior for listing roles.
+type ListRolesUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string) ([]role.Role, error)
+}
+
+// CreateRoleBindingUseCaseInterface defines behavior for granting a role to a user.
+type CreateRoleBindingUseCaseInterface interface {
+	Execute(ctx context.Context, createdBy string, b role.Binding) (*role.Binding, error)
+}
+
+// DeleteRoleBindingUseCaseInterface defines behavior for removing a role from a user.
+type DeleteRoleBindingUseCaseInterface interface {
+	Execute(ctx context.Context, id string) error
+}
+
+// ListRoleBindingsUseCaseInterface defines behavior for listing role bindings.
+type ListRoleBindingsUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId, userId string) ([]role.Binding, error)
+}
+
+// ResolvePermissionsUseCaseInterface defines behavior for computing the permissions of a caller.
+type ResolvePermissionsUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId, userId string, r auth.Role) ([]auth.Permission, error)
+}
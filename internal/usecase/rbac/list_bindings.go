@@ -0,0 +1,20 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type ListRoleBindingsUseCase struct {
+	Repo repository.RoleRepository
+}
+
+func NewListRoleBindingsUseCase(repo repository.RoleRepository) *ListRoleBindingsUseCase {
+	return &ListRoleBindingsUseCase{Repo: repo}
+}
+
+func (uc *ListRoleBindingsUseCase) Execute(ctx context.Context, tenantId, userId string) ([]role.Binding, error) {
+	return uc.Repo.ListBindings(ctx, tenantId, userId)
+}
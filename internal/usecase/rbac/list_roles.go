@@ -0,0 +1,20 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type ListRolesUseCase struct {
+	Repo repository.RoleRepository
+}
+
+func NewListRolesUseCase(repo repository.RoleRepository) *ListRolesUseCase {
+	return &ListRolesUseCase{Repo: repo}
+}
+
+func (uc *ListRolesUseCase) Execute(ctx context.Context, tenantId string) ([]role.Role, error) {
+	return uc.Repo.List(ctx, tenantId)
+}
@@ -0,0 +1,329 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	auth "github.com/Haevnen/audit-logging-api/internal/auth"
+	role "github.com/Haevnen/audit-logging-api/internal/entity/role"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateRoleUseCaseInterface is a mock of CreateRoleUseCaseInterface interface.
+type MockCreateRoleUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRoleUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateRoleUseCaseInterfaceMockRecorder is the mock recorder for MockCreateRoleUseCaseInterface.
+type MockCreateRoleUseCaseInterfaceMockRecorder struct {
+	mock *MockCreateRoleUseCaseInterface
+}
+
+// NewMockCreateRoleUseCaseInterface creates a new mock instance.
+func NewMockCreateRoleUseCaseInterface(ctrl *gomock.Controller) *MockCreateRoleUseCaseInterface {
+	mock := &MockCreateRoleUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateRoleUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRoleUseCaseInterface) EXPECT() *MockCreateRoleUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateRoleUseCaseInterface) Execute(ctx context.Context, r role.Role) (*role.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, r)
+	ret0, _ := ret[0].(*role.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateRoleUseCaseInterfaceMockRecorder) Execute(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateRoleUseCaseInterface)(nil).Execute), ctx, r)
+}
+
+// MockUpdateRoleUseCaseInterface is a mock of UpdateRoleUseCaseInterface interface.
+type MockUpdateRoleUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRoleUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateRoleUseCaseInterfaceMockRecorder is the mock recorder for MockUpdateRoleUseCaseInterface.
+type MockUpdateRoleUseCaseInterfaceMockRecorder struct {
+	mock *MockUpdateRoleUseCaseInterface
+}
+
+// NewMockUpdateRoleUseCaseInterface creates a new mock instance.
+func NewMockUpdateRoleUseCaseInterface(ctrl *gomock.Controller) *MockUpdateRoleUseCaseInterface {
+	mock := &MockUpdateRoleUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockUpdateRoleUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateRoleUseCaseInterface) EXPECT() *MockUpdateRoleUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateRoleUseCaseInterface) Execute(ctx context.Context, id string, description *string, permissions []auth.Permission) (*role.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id, description, permissions)
+	ret0, _ := ret[0].(*role.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateRoleUseCaseInterfaceMockRecorder) Execute(ctx, id, description, permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateRoleUseCaseInterface)(nil).Execute), ctx, id, description, permissions)
+}
+
+// MockDeleteRoleUseCaseInterface is a mock of DeleteRoleUseCaseInterface interface.
+type MockDeleteRoleUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRoleUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteRoleUseCaseInterfaceMockRecorder is the mock recorder for MockDeleteRoleUseCaseInterface.
+type MockDeleteRoleUseCaseInterfaceMockRecorder struct {
+	mock *MockDeleteRoleUseCaseInterface
+}
+
+// NewMockDeleteRoleUseCaseInterface creates a new mock instance.
+func NewMockDeleteRoleUseCaseInterface(ctrl *gomock.Controller) *MockDeleteRoleUseCaseInterface {
+	mock := &MockDeleteRoleUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteRoleUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRoleUseCaseInterface) EXPECT() *MockDeleteRoleUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteRoleUseCaseInterface) Execute(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteRoleUseCaseInterfaceMockRecorder) Execute(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteRoleUseCaseInterface)(nil).Execute), ctx, id)
+}
+
+// MockListRolesUseCaseInterface is a mock of ListRolesUseCaseInterface interface.
+type MockListRolesUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListRolesUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockListRolesUseCaseInterfaceMockRecorder is the mock recorder for MockListRolesUseCaseInterface.
+type MockListRolesUseCaseInterfaceMockRecorder struct {
+	mock *MockListRolesUseCaseInterface
+}
+
+// NewMockListRolesUseCaseInterface creates a new mock instance.
+func NewMockListRolesUseCaseInterface(ctrl *gomock.Controller) *MockListRolesUseCaseInterface {
+	mock := &MockListRolesUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListRolesUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListRolesUseCaseInterface) EXPECT() *MockListRolesUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListRolesUseCaseInterface) Execute(ctx context.Context, tenantId string) ([]role.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId)
+	ret0, _ := ret[0].([]role.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListRolesUseCaseInterfaceMockRecorder) Execute(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListRolesUseCaseInterface)(nil).Execute), ctx, tenantId)
+}
+
+// MockCreateRoleBindingUseCaseInterface is a mock of CreateRoleBindingUseCaseInterface interface.
+type MockCreateRoleBindingUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRoleBindingUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateRoleBindingUseCaseInterfaceMockRecorder is the mock recorder for MockCreateRoleBindingUseCaseInterface.
+type MockCreateRoleBindingUseCaseInterfaceMockRecorder struct {
+	mock *MockCreateRoleBindingUseCaseInterface
+}
+
+// NewMockCreateRoleBindingUseCaseInterface creates a new mock instance.
+func NewMockCreateRoleBindingUseCaseInterface(ctrl *gomock.Controller) *MockCreateRoleBindingUseCaseInterface {
+	mock := &MockCreateRoleBindingUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateRoleBindingUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRoleBindingUseCaseInterface) EXPECT() *MockCreateRoleBindingUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateRoleBindingUseCaseInterface) Execute(ctx context.Context, createdBy string, b role.Binding) (*role.Binding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, createdBy, b)
+	ret0, _ := ret[0].(*role.Binding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateRoleBindingUseCaseInterfaceMockRecorder) Execute(ctx, createdBy, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateRoleBindingUseCaseInterface)(nil).Execute), ctx, createdBy, b)
+}
+
+// MockDeleteRoleBindingUseCaseInterface is a mock of DeleteRoleBindingUseCaseInterface interface.
+type MockDeleteRoleBindingUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRoleBindingUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteRoleBindingUseCaseInterfaceMockRecorder is the mock recorder for MockDeleteRoleBindingUseCaseInterface.
+type MockDeleteRoleBindingUseCaseInterfaceMockRecorder struct {
+	mock *MockDeleteRoleBindingUseCaseInterface
+}
+
+// NewMockDeleteRoleBindingUseCaseInterface creates a new mock instance.
+func NewMockDeleteRoleBindingUseCaseInterface(ctrl *gomock.Controller) *MockDeleteRoleBindingUseCaseInterface {
+	mock := &MockDeleteRoleBindingUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteRoleBindingUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRoleBindingUseCaseInterface) EXPECT() *MockDeleteRoleBindingUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteRoleBindingUseCaseInterface) Execute(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteRoleBindingUseCaseInterfaceMockRecorder) Execute(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteRoleBindingUseCaseInterface)(nil).Execute), ctx, id)
+}
+
+// MockListRoleBindingsUseCaseInterface is a mock of ListRoleBindingsUseCaseInterface interface.
+type MockListRoleBindingsUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListRoleBindingsUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockListRoleBindingsUseCaseInterfaceMockRecorder is the mock recorder for MockListRoleBindingsUseCaseInterface.
+type MockListRoleBindingsUseCaseInterfaceMockRecorder struct {
+	mock *MockListRoleBindingsUseCaseInterface
+}
+
+// NewMockListRoleBindingsUseCaseInterface creates a new mock instance.
+func NewMockListRoleBindingsUseCaseInterface(ctrl *gomock.Controller) *MockListRoleBindingsUseCaseInterface {
+	mock := &MockListRoleBindingsUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListRoleBindingsUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListRoleBindingsUseCaseInterface) EXPECT() *MockListRoleBindingsUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListRoleBindingsUseCaseInterface) Execute(ctx context.Context, tenantId, userId string) ([]role.Binding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId, userId)
+	ret0, _ := ret[0].([]role.Binding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListRoleBindingsUseCaseInterfaceMockRecorder) Execute(ctx, tenantId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListRoleBindingsUseCaseInterface)(nil).Execute), ctx, tenantId, userId)
+}
+
+// MockResolvePermissionsUseCaseInterface is a mock of ResolvePermissionsUseCaseInterface interface.
+type MockResolvePermissionsUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockResolvePermissionsUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockResolvePermissionsUseCaseInterfaceMockRecorder is the mock recorder for MockResolvePermissionsUseCaseInterface.
+type MockResolvePermissionsUseCaseInterfaceMockRecorder struct {
+	mock *MockResolvePermissionsUseCaseInterface
+}
+
+// NewMockResolvePermissionsUseCaseInterface creates a new mock instance.
+func NewMockResolvePermissionsUseCaseInterface(ctrl *gomock.Controller) *MockResolvePermissionsUseCaseInterface {
+	mock := &MockResolvePermissionsUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockResolvePermissionsUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResolvePermissionsUseCaseInterface) EXPECT() *MockResolvePermissionsUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResolvePermissionsUseCaseInterface) Execute(ctx context.Context, tenantId, userId string, r auth.Role) ([]auth.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId, userId, r)
+	ret0, _ := ret[0].([]auth.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResolvePermissionsUseCaseInterfaceMockRecorder) Execute(ctx, tenantId, userId, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResolvePermissionsUseCaseInterface)(nil).Execute), ctx, tenantId, userId, r)
+}
@@ -0,0 +1,80 @@
+package rbac
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+// bindings are reloaded from the database at most once per interval per user, so a change
+// takes up to this long to reach every API instance
+const bindingCacheTTL = 30 * time.Second
+
+// expired entries are dropped once the cache holds more users than this
+const bindingCacheSweepSize = 10000
+
+type ResolvePermissionsUseCase struct {
+	Repo repository.RoleRepository
+
+	mu    sync.Mutex
+	cache map[string]cachedRoles
+}
+
+type cachedRoles struct {
+	roles    []role.Role
+	loadedAt time.Time
+}
+
+func NewResolvePermissionsUseCase(repo repository.RoleRepository) *ResolvePermissionsUseCase {
+	return &ResolvePermissionsUseCase{Repo: repo, cache: map[string]cachedRoles{}}
+}
+
+// Execute returns the permissions of a token without a permissions claim: those of the roles bound
+// to the user when there are any, else the defaults of the built-in role of the token.
+func (uc *ResolvePermissionsUseCase) Execute(ctx context.Context, tenantId, userId string, r auth.Role) ([]auth.Permission, error) {
+	roles, err := uc.getRoles(ctx, tenantId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return auth.DefaultPermissions(r), nil
+	}
+
+	var perms []auth.Permission
+	for _, bound := range roles {
+		perms = append(perms, bound.Permissions...)
+	}
+	return auth.ScopePermissions(r, perms), nil
+}
+
+func (uc *ResolvePermissionsUseCase) getRoles(ctx context.Context, tenantId, userId string) ([]role.Role, error) {
+	key := tenantId + "/" + userId
+
+	uc.mu.Lock()
+	cached, ok := uc.cache[key]
+	uc.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < bindingCacheTTL {
+		return cached.roles, nil
+	}
+
+	roles, err := uc.Repo.ListRolesOfUser(ctx, tenantId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.mu.Lock()
+	if len(uc.cache) >= bindingCacheSweepSize {
+		for k, v := range uc.cache {
+			if time.Since(v.loadedAt) >= bindingCacheTTL {
+				delete(uc.cache, k)
+			}
+		}
+	}
+	uc.cache[key] = cachedRoles{roles: roles, loadedAt: time.Now()}
+	uc.mu.Unlock()
+	return roles, nil
+}
@@ -0,0 +1,71 @@
+package rbac_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/rbac"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestResolvePermissionsUseCase_Execute_Defaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().ListRolesOfUser(gomock.Any(), "tenant-1", "u1").Return(nil, nil)
+
+	perms, err := uc.NewResolvePermissionsUseCase(mockRepo).Execute(context.Background(), "tenant-1", "u1", auth.RoleAuditor)
+	assert.NoError(t, err)
+	assert.Equal(t, auth.DefaultPermissions(auth.RoleAuditor), perms)
+}
+
+func TestResolvePermissionsUseCase_Execute_BindingsReplaceRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	// loaded once, the second call is served from the cache
+	mockRepo.EXPECT().ListRolesOfUser(gomock.Any(), "tenant-1", "u1").Return([]role.Role{
+		{Name: "exporter", Permissions: []auth.Permission{auth.PermissionLogsExport}},
+		{Name: "reader", Permissions: []auth.Permission{auth.PermissionLogsRead, auth.PermissionLogsExport}},
+	}, nil).Times(1)
+
+	resolver := uc.NewResolvePermissionsUseCase(mockRepo)
+	for i := 0; i < 2; i++ {
+		perms, err := resolver.Execute(context.Background(), "tenant-1", "u1", auth.RoleUser)
+		assert.NoError(t, err)
+		assert.Equal(t, []auth.Permission{auth.PermissionLogsExport, auth.PermissionLogsRead}, perms)
+	}
+}
+
+func TestResolvePermissionsUseCase_Execute_ReadOnlyAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().ListRolesOfUser(gomock.Any(), "", "admin-2").
+		Return([]role.Role{{Name: "read-only-admin", Permissions: []auth.Permission{auth.PermissionLogsRead, auth.PermissionTenantsManage}}}, nil)
+
+	perms, err := uc.NewResolvePermissionsUseCase(mockRepo).Execute(context.Background(), "", "admin-2", auth.RoleAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, []auth.Permission{auth.PermissionLogsRead, auth.PermissionTenantsManage}, perms)
+}
+
+func TestResolvePermissionsUseCase_Execute_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().ListRolesOfUser(gomock.Any(), "tenant-1", "u1").Return(nil, errors.New("db error"))
+
+	_, err := uc.NewResolvePermissionsUseCase(mockRepo).Execute(context.Background(), "tenant-1", "u1", auth.RoleUser)
+	assert.EqualError(t, err, "db error")
+}
@@ -0,0 +1,46 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+)
+
+var (
+	ErrInvalidRole  = errors.New("invalid role")
+	ErrBuiltInRole  = errors.New("built-in roles can't be changed")
+	ErrInvalidGrant = errors.New("invalid role binding")
+)
+
+// validatePermissions checks the permissions and removes duplicates. Tenant roles only take
+// permissions acting within the tenant, platform permissions are reserved to global roles.
+func validatePermissions(r *role.Role) error {
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("%w: at least one permission is required", ErrInvalidRole)
+	}
+
+	perms := make([]auth.Permission, 0, len(r.Permissions))
+	for _, p := range r.Permissions {
+		if !p.IsValid() {
+			return fmt.Errorf("%w: unknown permission %q", ErrInvalidRole, p)
+		}
+		if r.TenantID != nil && !p.IsTenantScoped() {
+			return fmt.Errorf("%w: %q can't be granted by a tenant role", ErrInvalidRole, p)
+		}
+		if !slices.Contains(perms, p) {
+			perms = append(perms, p)
+		}
+	}
+	r.Permissions = perms
+	return nil
+}
+
+func sameTenant(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,119 @@
+package rbac_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/rbac"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func ptr(s string) *string { return &s }
+
+func TestCreateRoleUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, r *role.Role) (*role.Role, error) {
+			assert.NotEmpty(t, r.ID)
+			assert.False(t, r.BuiltIn)
+			assert.Equal(t, "exporter", r.Name)
+			assert.Equal(t, []auth.Permission{auth.PermissionLogsExport}, []auth.Permission(r.Permissions))
+			return r, nil
+		})
+
+	created, err := uc.NewCreateRoleUseCase(mockRepo).Execute(context.Background(), role.Role{
+		TenantID:    ptr("tenant-1"),
+		Name:        " exporter ",
+		Permissions: []auth.Permission{auth.PermissionLogsExport, auth.PermissionLogsExport},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, created)
+}
+
+func TestCreateRoleUseCase_Execute_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		role role.Role
+	}{
+		{"No Name", role.Role{Permissions: []auth.Permission{auth.PermissionLogsRead}}},
+		{"Built-in Name", role.Role{Name: "admin", Permissions: []auth.Permission{auth.PermissionLogsRead}}},
+		{"No Permissions", role.Role{Name: "empty"}},
+		{"Unknown Permission", role.Role{Name: "x", Permissions: []auth.Permission{"logs:delete"}}},
+		{"Platform Permission In Tenant", role.Role{Name: "x", TenantID: ptr("tenant-1"), Permissions: []auth.Permission{auth.PermissionTenantsManage}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.NewCreateRoleUseCase(nil).Execute(context.Background(), tt.role)
+			assert.ErrorIs(t, err, uc.ErrInvalidRole)
+		})
+	}
+}
+
+func TestCreateRoleUseCase_Execute_GlobalPlatformRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, r *role.Role) (*role.Role, error) { return r, nil })
+
+	_, err := uc.NewCreateRoleUseCase(mockRepo).Execute(context.Background(), role.Role{
+		Name:        "tenant-operator",
+		Permissions: []auth.Permission{auth.PermissionTenantsManage, auth.PermissionLogsRead},
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateRoleUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "role-1").
+		Return(&role.Role{ID: "role-1", TenantID: ptr("tenant-1"), Name: "exporter", Permissions: []auth.Permission{auth.PermissionLogsExport}}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	updated, err := uc.NewUpdateRoleUseCase(mockRepo).Execute(context.Background(), "role-1", ptr("reads too"),
+		[]auth.Permission{auth.PermissionLogsExport, auth.PermissionLogsRead})
+	assert.NoError(t, err)
+	assert.Equal(t, "reads too", *updated.Description)
+	assert.Len(t, updated.Permissions, 2)
+}
+
+func TestUpdateRoleUseCase_Execute_BuiltIn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "role-1").Return(&role.Role{ID: "role-1", Name: "user", BuiltIn: true}, nil)
+
+	_, err := uc.NewUpdateRoleUseCase(mockRepo).Execute(context.Background(), "role-1", nil, []auth.Permission{auth.PermissionLogsRead})
+	assert.ErrorIs(t, err, uc.ErrBuiltInRole)
+}
+
+func TestDeleteRoleUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRoleRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "role-1").Return(&role.Role{ID: "role-1"}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), "role-1").Return(nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "role-2").Return(&role.Role{ID: "role-2", BuiltIn: true}, nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "role-3").Return(nil, errors.New("db error"))
+
+	deleteUC := uc.NewDeleteRoleUseCase(mockRepo)
+	assert.NoError(t, deleteUC.Execute(context.Background(), "role-1"))
+	assert.ErrorIs(t, deleteUC.Execute(context.Background(), "role-2"), uc.ErrBuiltInRole)
+	assert.EqualError(t, deleteUC.Execute(context.Background(), "role-3"), "db error")
+}
@@ -0,0 +1,41 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/role"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type UpdateRoleUseCase struct {
+	Repo repository.RoleRepository
+}
+
+func NewUpdateRoleUseCase(repo repository.RoleRepository) *UpdateRoleUseCase {
+	return &UpdateRoleUseCase{Repo: repo}
+}
+
+// Execute replaces the permissions of a custom role, the name and tenant stay as they are
+func (uc *UpdateRoleUseCase) Execute(ctx context.Context, id string, description *string, permissions []auth.Permission) (*role.Role, error) {
+	r, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.BuiltIn {
+		return nil, ErrBuiltInRole
+	}
+
+	r.Permissions = permissions
+	if err := validatePermissions(r); err != nil {
+		return nil, err
+	}
+	if description != nil {
+		r.Description = description
+	}
+
+	if err := uc.Repo.Update(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
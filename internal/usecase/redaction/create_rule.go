@@ -0,0 +1,28 @@
+package redaction
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type CreateRedactionRuleUseCase struct {
+	Repo repository.RedactionRuleRepository
+}
+
+func NewCreateRedactionRuleUseCase(repo repository.RedactionRuleRepository) *CreateRedactionRuleUseCase {
+	return &CreateRedactionRuleUseCase{Repo: repo}
+}
+
+// Execute checks the rule compiles before storing it.
+func (uc *CreateRedactionRuleUseCase) Execute(ctx context.Context, rule redaction_rule.RedactionRule) (*redaction_rule.RedactionRule, error) {
+	if _, err := compileRule(rule); err != nil {
+		return nil, err
+	}
+
+	rule.ID = uuid.New().String()
+	return uc.Repo.Create(ctx, &rule)
+}
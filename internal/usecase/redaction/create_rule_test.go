@@ -0,0 +1,68 @@
+package redaction_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/redaction"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestCreateRedactionRuleUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRedactionRuleRepository(ctrl)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, r *redaction_rule.RedactionRule) (*redaction_rule.RedactionRule, error) {
+			return r, nil
+		})
+
+	rule := redaction_rule.RedactionRule{
+		TenantID: "tenant-1", Name: "emails", Kind: redaction_rule.KindField,
+		Path: utils.Ptr("metadata.email"), Action: redaction_rule.ActionMask,
+	}
+	created, err := uc.NewCreateRedactionRuleUseCase(mockRepo).Execute(context.Background(), rule)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+}
+
+func TestCreateRedactionRuleUseCase_Execute_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRedactionRuleRepository(ctrl)
+	ucase := uc.NewCreateRedactionRuleUseCase(mockRepo)
+
+	for name, rule := range map[string]redaction_rule.RedactionRule{
+		"bad regex":       {Kind: redaction_rule.KindRegex, Pattern: utils.Ptr("("), Action: redaction_rule.ActionMask},
+		"missing pattern": {Kind: redaction_rule.KindRegex, Action: redaction_rule.ActionMask},
+		"unknown root":    {Kind: redaction_rule.KindField, Path: utils.Ptr("headers.auth"), Action: redaction_rule.ActionDrop},
+		"whole document":  {Kind: redaction_rule.KindField, Path: utils.Ptr("metadata"), Action: redaction_rule.ActionDrop},
+		"unknown action":  {Kind: redaction_rule.KindField, Path: utils.Ptr("metadata.a"), Action: "encrypt"},
+	} {
+		_, err := ucase.Execute(context.Background(), rule)
+		assert.ErrorIs(t, err, uc.ErrInvalidRule, name)
+	}
+}
+
+func TestDeleteRedactionRuleUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRedactionRuleRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "rule-1", "tenant-1").Return(&redaction_rule.RedactionRule{ID: "rule-1"}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), "rule-1").Return(nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "rule-2", "tenant-1").Return(nil, gorm.ErrRecordNotFound)
+
+	ucase := uc.NewDeleteRedactionRuleUseCase(mockRepo)
+	assert.NoError(t, ucase.Execute(context.Background(), "rule-1", "tenant-1"))
+	assert.ErrorIs(t, ucase.Execute(context.Background(), "rule-2", "tenant-1"), gorm.ErrRecordNotFound)
+}
@@ -0,0 +1,24 @@
+package redaction
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type DeleteRedactionRuleUseCase struct {
+	Repo repository.RedactionRuleRepository
+}
+
+func NewDeleteRedactionRuleUseCase(repo repository.RedactionRuleRepository) *DeleteRedactionRuleUseCase {
+	return &DeleteRedactionRuleUseCase{Repo: repo}
+}
+
+// Execute removes the rule, the tenant scope is checked first so users cannot delete other tenants' rules.
+func (uc *DeleteRedactionRuleUseCase) Execute(ctx context.Context, id, tenantId string) error {
+	rule, err := uc.Repo.GetByID(ctx, id, tenantId)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.Delete(ctx, rule.ID)
+}
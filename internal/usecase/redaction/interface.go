@@ -0,0 +1,29 @@
+package redaction
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+)
+
+// CreateRedactionRuleUseCaseInterface defines behavior for registering redaction rules.
+type CreateRedactionRuleUseCaseInterface interface {
+	Execute(ctx context.Context, rule redaction_rule.RedactionRule) (*redaction_rule.RedactionRule, error)
+}
+
+// ListRedactionRulesUseCaseInterface defines behavior for listing redaction rules.
+type ListRedactionRulesUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string) ([]redaction_rule.RedactionRule, error)
+}
+
+// DeleteRedactionRuleUseCaseInterface defines behavior for removing redaction rules.
+type DeleteRedactionRuleUseCaseInterface interface {
+	Execute(ctx context.Context, id, tenantId string) error
+}
+
+// RedactLogUseCaseInterface defines behavior for redacting logs before they are persisted.
+type RedactLogUseCaseInterface interface {
+	Execute(ctx context.Context, l *entitylog.Log) error
+}
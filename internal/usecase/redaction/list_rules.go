@@ -0,0 +1,20 @@
+package redaction
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type ListRedactionRulesUseCase struct {
+	Repo repository.RedactionRuleRepository
+}
+
+func NewListRedactionRulesUseCase(repo repository.RedactionRuleRepository) *ListRedactionRulesUseCase {
+	return &ListRedactionRulesUseCase{Repo: repo}
+}
+
+func (uc *ListRedactionRulesUseCase) Execute(ctx context.Context, tenantId string) ([]redaction_rule.RedactionRule, error) {
+	return uc.Repo.List(ctx, tenantId)
+}
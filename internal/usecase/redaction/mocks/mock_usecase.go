@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	log "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	redaction_rule "github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateRedactionRuleUseCaseInterface is a mock of CreateRedactionRuleUseCaseInterface interface.
+type MockCreateRedactionRuleUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRedactionRuleUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateRedactionRuleUseCaseInterfaceMockRecorder is the mock recorder for MockCreateRedactionRuleUseCaseInterface.
+type MockCreateRedactionRuleUseCaseInterfaceMockRecorder struct {
+	mock *MockCreateRedactionRuleUseCaseInterface
+}
+
+// NewMockCreateRedactionRuleUseCaseInterface creates a new mock instance.
+func NewMockCreateRedactionRuleUseCaseInterface(ctrl *gomock.Controller) *MockCreateRedactionRuleUseCaseInterface {
+	mock := &MockCreateRedactionRuleUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateRedactionRuleUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRedactionRuleUseCaseInterface) EXPECT() *MockCreateRedactionRuleUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateRedactionRuleUseCaseInterface) Execute(ctx context.Context, rule redaction_rule.RedactionRule) (*redaction_rule.RedactionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, rule)
+	ret0, _ := ret[0].(*redaction_rule.RedactionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateRedactionRuleUseCaseInterfaceMockRecorder) Execute(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateRedactionRuleUseCaseInterface)(nil).Execute), ctx, rule)
+}
+
+// MockListRedactionRulesUseCaseInterface is a mock of ListRedactionRulesUseCaseInterface interface.
+type MockListRedactionRulesUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListRedactionRulesUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockListRedactionRulesUseCaseInterfaceMockRecorder is the mock recorder for MockListRedactionRulesUseCaseInterface.
+type MockListRedactionRulesUseCaseInterfaceMockRecorder struct {
+	mock *MockListRedactionRulesUseCaseInterface
+}
+
+// NewMockListRedactionRulesUseCaseInterface creates a new mock instance.
+func NewMockListRedactionRulesUseCaseInterface(ctrl *gomock.Controller) *MockListRedactionRulesUseCaseInterface {
+	mock := &MockListRedactionRulesUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListRedactionRulesUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListRedactionRulesUseCaseInterface) EXPECT() *MockListRedactionRulesUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListRedactionRulesUseCaseInterface) Execute(ctx context.Context, tenantId string) ([]redaction_rule.RedactionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId)
+	ret0, _ := ret[0].([]redaction_rule.RedactionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListRedactionRulesUseCaseInterfaceMockRecorder) Execute(ctx, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListRedactionRulesUseCaseInterface)(nil).Execute), ctx, tenantId)
+}
+
+// MockDeleteRedactionRuleUseCaseInterface is a mock of DeleteRedactionRuleUseCaseInterface interface.
+type MockDeleteRedactionRuleUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRedactionRuleUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteRedactionRuleUseCaseInterfaceMockRecorder is the mock recorder for MockDeleteRedactionRuleUseCaseInterface.
+type MockDeleteRedactionRuleUseCaseInterfaceMockRecorder struct {
+	mock *MockDeleteRedactionRuleUseCaseInterface
+}
+
+// NewMockDeleteRedactionRuleUseCaseInterface creates a new mock instance.
+func NewMockDeleteRedactionRuleUseCaseInterface(ctrl *gomock.Controller) *MockDeleteRedactionRuleUseCaseInterface {
+	mock := &MockDeleteRedactionRuleUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteRedactionRuleUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRedactionRuleUseCaseInterface) EXPECT() *MockDeleteRedactionRuleUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteRedactionRuleUseCaseInterface) Execute(ctx context.Context, id, tenantId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id, tenantId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteRedactionRuleUseCaseInterfaceMockRecorder) Execute(ctx, id, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteRedactionRuleUseCaseInterface)(nil).Execute), ctx, id, tenantId)
+}
+
+// MockRedactLogUseCaseInterface is a mock of RedactLogUseCaseInterface interface.
+type MockRedactLogUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedactLogUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRedactLogUseCaseInterfaceMockRecorder is the mock recorder for MockRedactLogUseCaseInterface.
+type MockRedactLogUseCaseInterfaceMockRecorder struct {
+	mock *MockRedactLogUseCaseInterface
+}
+
+// NewMockRedactLogUseCaseInterface creates a new mock instance.
+func NewMockRedactLogUseCaseInterface(ctrl *gomock.Controller) *MockRedactLogUseCaseInterface {
+	mock := &MockRedactLogUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRedactLogUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedactLogUseCaseInterface) EXPECT() *MockRedactLogUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRedactLogUseCaseInterface) Execute(ctx context.Context, l *log.Log) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, l)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRedactLogUseCaseInterfaceMockRecorder) Execute(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRedactLogUseCaseInterface)(nil).Execute), ctx, l)
+}
@@ -0,0 +1,404 @@
+package redaction
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/datatypes"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+const (
+	fieldMessage     = "message"
+	fieldMetadata    = "metadata"
+	fieldBeforeState = "before_state"
+	fieldAfterState  = "after_state"
+
+	maskValue = "[REDACTED]"
+	wildcard  = "*"
+
+	// rules are reloaded from the database at most once per interval per tenant
+	ruleCacheTTL = 30 * time.Second
+)
+
+var ErrInvalidRule = errors.New("invalid redaction rule")
+
+type RedactLogUseCase struct {
+	Repo    repository.RedactionRuleRepository
+	HashKey []byte
+
+	mu    sync.Mutex
+	cache map[string]cachedRules
+}
+
+type cachedRules struct {
+	rules    []compiledRule
+	loadedAt time.Time
+}
+
+type compiledRule struct {
+	redaction_rule.RedactionRule
+	re   *regexp.Regexp
+	root string
+	path []string
+}
+
+func NewRedactLogUseCase(repo repository.RedactionRuleRepository, hashKey string) *RedactLogUseCase {
+	return &RedactLogUseCase{Repo: repo, HashKey: []byte(hashKey), cache: map[string]cachedRules{}}
+}
+
+// Execute applies the tenant's redaction rules to the message, metadata and states of the log.
+// Field rules run first, then regex rules scan every remaining string. What was redacted is
+// recorded on the log as a list of summaries, the original values are never kept.
+func (uc *RedactLogUseCase) Execute(ctx context.Context, l *entitylog.Log) error {
+	rules, err := uc.getRules(ctx, l.TenantID)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	docs := map[string]*datatypes.JSON{
+		fieldMetadata:    l.Metadata,
+		fieldBeforeState: l.BeforeState,
+		fieldAfterState:  l.AfterState,
+	}
+	decoded := map[string]interface{}{}
+	for name, raw := range docs {
+		if raw == nil || len(*raw) == 0 {
+			continue
+		}
+		v, err := decode(*raw)
+		if err != nil {
+			return fmt.Errorf("decode %s: %w", name, err)
+		}
+		decoded[name] = v
+	}
+
+	r := &redactor{hashKey: uc.HashKey, changed: map[string]bool{}}
+	for _, rule := range rules {
+		if rule.Kind == redaction_rule.KindField {
+			r.applyField(rule, l, decoded)
+		}
+	}
+	for _, rule := range rules {
+		if rule.Kind == redaction_rule.KindRegex {
+			r.applyRegex(rule, l, decoded)
+		}
+	}
+
+	if len(r.summaries) == 0 {
+		return nil
+	}
+
+	for name := range r.changed {
+		data, err := json.Marshal(decoded[name])
+		if err != nil {
+			return err
+		}
+		j := datatypes.JSON(data)
+		switch name {
+		case fieldMetadata:
+			l.Metadata = &j
+		case fieldBeforeState:
+			l.BeforeState = &j
+		case fieldAfterState:
+			l.AfterState = &j
+		}
+	}
+
+	sort.Slice(r.summaries, func(i, j int) bool {
+		if r.summaries[i].Field != r.summaries[j].Field {
+			return r.summaries[i].Field < r.summaries[j].Field
+		}
+		return r.summaries[i].RuleID < r.summaries[j].RuleID
+	})
+	data, err := json.Marshal(r.summaries)
+	if err != nil {
+		return err
+	}
+	summary := datatypes.JSON(data)
+	l.Redactions = &summary
+	return nil
+}
+
+func (uc *RedactLogUseCase) getRules(ctx context.Context, tenantId string) ([]compiledRule, error) {
+	uc.mu.Lock()
+	cached, ok := uc.cache[tenantId]
+	uc.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < ruleCacheTTL {
+		return cached.rules, nil
+	}
+
+	rules, err := uc.Repo.List(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled := make([]compiledRule, 0, len(rules))
+	for _, rule := range rules {
+		c, err := compileRule(rule)
+		if err != nil {
+			// rules are checked on creation, a broken one must not block ingestion
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+
+	uc.mu.Lock()
+	uc.cache[tenantId] = cachedRules{rules: compiled, loadedAt: time.Now()}
+	uc.mu.Unlock()
+	return compiled, nil
+}
+
+func compileRule(rule redaction_rule.RedactionRule) (compiledRule, error) {
+	if !rule.Action.IsValid() {
+		return compiledRule{}, fmt.Errorf("%w: unknown action %q", ErrInvalidRule, rule.Action)
+	}
+
+	c := compiledRule{RedactionRule: rule}
+	switch rule.Kind {
+	case redaction_rule.KindRegex:
+		if rule.Pattern == nil || len(*rule.Pattern) == 0 {
+			return compiledRule{}, fmt.Errorf("%w: pattern is required", ErrInvalidRule)
+		}
+		re, err := regexp.Compile(*rule.Pattern)
+		if err != nil {
+			return compiledRule{}, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+		}
+		c.re = re
+
+	case redaction_rule.KindField:
+		if rule.Path == nil || len(*rule.Path) == 0 {
+			return compiledRule{}, fmt.Errorf("%w: path is required", ErrInvalidRule)
+		}
+		segments := strings.Split(strings.TrimPrefix(*rule.Path, "$."), ".")
+		switch segments[0] {
+		case fieldMessage:
+			if len(segments) > 1 {
+				return compiledRule{}, fmt.Errorf("%w: message has no nested fields", ErrInvalidRule)
+			}
+		case fieldMetadata, fieldBeforeState, fieldAfterState:
+			if len(segments) == 1 {
+				return compiledRule{}, fmt.Errorf("%w: path must target a field inside %s", ErrInvalidRule, segments[0])
+			}
+		default:
+			return compiledRule{}, fmt.Errorf("%w: path must start with message, metadata, before_state or after_state", ErrInvalidRule)
+		}
+		for _, s := range segments {
+			if len(s) == 0 {
+				return compiledRule{}, fmt.Errorf("%w: empty path segment", ErrInvalidRule)
+			}
+		}
+		c.root = segments[0]
+		c.path = segments[1:]
+
+	default:
+		return compiledRule{}, fmt.Errorf("%w: unknown kind %q", ErrInvalidRule, rule.Kind)
+	}
+	return c, nil
+}
+
+// redactor holds the state of one log being redacted
+type redactor struct {
+	hashKey   []byte
+	changed   map[string]bool
+	summaries []redaction_rule.Summary
+}
+
+func (r *redactor) record(rule compiledRule, field string, count int) {
+	for i := range r.summaries {
+		if r.summaries[i].RuleID == rule.ID && r.summaries[i].Field == field {
+			r.summaries[i].Count += count
+			return
+		}
+	}
+	r.summaries = append(r.summaries, redaction_rule.Summary{
+		RuleID: rule.ID,
+		Rule:   rule.Name,
+		Field:  field,
+		Action: rule.Action,
+		Count:  count,
+	})
+}
+
+func (r *redactor) applyField(rule compiledRule, l *entitylog.Log, decoded map[string]interface{}) {
+	if rule.root == fieldMessage {
+		if len(l.Message) == 0 {
+			return
+		}
+		switch rule.Action {
+		case redaction_rule.ActionMask:
+			l.Message = maskValue
+		case redaction_rule.ActionHash:
+			l.Message = r.hash(l.Message)
+		case redaction_rule.ActionDrop:
+			l.Message = ""
+		}
+		r.record(rule, fieldMessage, 1)
+		return
+	}
+
+	doc, ok := decoded[rule.root]
+	if !ok {
+		return
+	}
+	decoded[rule.root] = r.walkField(rule, doc, rule.path, rule.root)
+}
+
+// walkField follows the path segments and applies the rule action on the values found
+func (r *redactor) walkField(rule compiledRule, node interface{}, path []string, location string) interface{} {
+	if len(path) == 0 {
+		return node
+	}
+
+	key, last := path[0], len(path) == 1
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			if key != wildcard && key != k {
+				continue
+			}
+			field := location + "." + k
+			if !last {
+				n[k] = r.walkField(rule, v, path[1:], field)
+				continue
+			}
+
+			if rule.Action == redaction_rule.ActionDrop {
+				delete(n, k)
+			} else {
+				n[k] = r.redactValue(rule.Action, v)
+			}
+			r.changed[rootOf(location)] = true
+			r.record(rule, field, 1)
+		}
+
+	case []interface{}:
+		if key != wildcard {
+			return node
+		}
+		kept := n[:0]
+		for i, v := range n {
+			field := fmt.Sprintf("%s[%d]", location, i)
+			if !last {
+				kept = append(kept, r.walkField(rule, v, path[1:], field))
+				continue
+			}
+
+			r.changed[rootOf(location)] = true
+			r.record(rule, field, 1)
+			if rule.Action != redaction_rule.ActionDrop {
+				kept = append(kept, r.redactValue(rule.Action, v))
+			}
+		}
+		return kept
+	}
+	return node
+}
+
+func (r *redactor) applyRegex(rule compiledRule, l *entitylog.Log, decoded map[string]interface{}) {
+	if msg, count := r.replace(rule, l.Message); count > 0 {
+		l.Message = msg
+		r.record(rule, fieldMessage, count)
+	}
+
+	for _, name := range []string{fieldMetadata, fieldBeforeState, fieldAfterState} {
+		doc, ok := decoded[name]
+		if !ok {
+			continue
+		}
+		decoded[name] = r.walkStrings(rule, doc, name)
+	}
+}
+
+// walkStrings scans every string value of the document with the rule pattern
+func (r *redactor) walkStrings(rule compiledRule, node interface{}, location string) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			n[k] = r.walkStrings(rule, v, location+"."+k)
+		}
+	case []interface{}:
+		for i, v := range n {
+			n[i] = r.walkStrings(rule, v, fmt.Sprintf("%s[%d]", location, i))
+		}
+	case string:
+		if s, count := r.replace(rule, n); count > 0 {
+			r.changed[rootOf(location)] = true
+			r.record(rule, location, count)
+			return s
+		}
+	}
+	return node
+}
+
+func (r *redactor) replace(rule compiledRule, s string) (string, int) {
+	count := 0
+	out := rule.re.ReplaceAllStringFunc(s, func(match string) string {
+		count++
+		switch rule.Action {
+		case redaction_rule.ActionHash:
+			return r.hash(match)
+		case redaction_rule.ActionDrop:
+			return ""
+		default:
+			return maskValue
+		}
+	})
+	return out, count
+}
+
+func (r *redactor) redactValue(action redaction_rule.Action, v interface{}) interface{} {
+	if action == redaction_rule.ActionMask {
+		return maskValue
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		data, _ := json.Marshal(v)
+		s = string(data)
+	}
+	return r.hash(s)
+}
+
+// hash keeps equal values correlatable without storing them, keyed so they cannot be brute-forced
+func (r *redactor) hash(s string) string {
+	mac := hmac.New(sha256.New, r.hashKey)
+	mac.Write([]byte(s))
+	return "hash:" + hex.EncodeToString(mac.Sum(nil))[:16]
+}
+
+func rootOf(location string) string {
+	if i := strings.IndexAny(location, ".["); i >= 0 {
+		return location[:i]
+	}
+	return location
+}
+
+// decode keeps numbers as json.Number so untouched values are written back unchanged
+func decode(raw datatypes.JSON) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
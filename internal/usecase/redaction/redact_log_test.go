@@ -0,0 +1,135 @@
+package redaction_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/redaction_rule"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/redaction"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func jsonPtr(s string) *datatypes.JSON {
+	j := datatypes.JSON(s)
+	return &j
+}
+
+func summaries(t *testing.T, l *entitylog.Log) []redaction_rule.Summary {
+	var s []redaction_rule.Summary
+	assert.NoError(t, json.Unmarshal(*l.Redactions, &s))
+	return s
+}
+
+func TestRedactLogUseCase_Execute_NoRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRedactionRuleRepository(ctrl)
+	mockRepo.EXPECT().List(gomock.Any(), "tenant-1").Return(nil, nil)
+
+	l := &entitylog.Log{TenantID: "tenant-1", Message: "mail bob@example.com"}
+	err := uc.NewRedactLogUseCase(mockRepo, "key").Execute(context.Background(), l)
+	assert.NoError(t, err)
+	assert.Equal(t, "mail bob@example.com", l.Message)
+	assert.Nil(t, l.Redactions)
+}
+
+func TestRedactLogUseCase_Execute_RegexMask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRedactionRuleRepository(ctrl)
+	mockRepo.EXPECT().List(gomock.Any(), "tenant-1").Return([]redaction_rule.RedactionRule{{
+		ID: "rule-1", TenantID: "tenant-1", Name: "emails", Kind: redaction_rule.KindRegex,
+		Pattern: utils.Ptr(redaction_rule.Detectors["email"]), Action: redaction_rule.ActionMask,
+	}}, nil)
+
+	l := &entitylog.Log{
+		TenantID: "tenant-1",
+		Message:  "invited bob@example.com and eve@example.com",
+		Metadata: jsonPtr(`{"contact":{"email":"bob@example.com"},"count":12345678901234567}`),
+	}
+	err := uc.NewRedactLogUseCase(mockRepo, "key").Execute(context.Background(), l)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "invited [REDACTED] and [REDACTED]", l.Message)
+	assert.JSONEq(t, `{"contact":{"email":"[REDACTED]"},"count":12345678901234567}`, string(*l.Metadata))
+	assert.Equal(t, []redaction_rule.Summary{
+		{RuleID: "rule-1", Rule: "emails", Field: "message", Action: redaction_rule.ActionMask, Count: 2},
+		{RuleID: "rule-1", Rule: "emails", Field: "metadata.contact.email", Action: redaction_rule.ActionMask, Count: 1},
+	}, summaries(t, l))
+	assert.NotContains(t, string(*l.Redactions), "example.com")
+}
+
+func TestRedactLogUseCase_Execute_FieldHashAndDrop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRedactionRuleRepository(ctrl)
+	mockRepo.EXPECT().List(gomock.Any(), "tenant-1").Return([]redaction_rule.RedactionRule{
+		{ID: "rule-1", Name: "user id", Kind: redaction_rule.KindField, Path: utils.Ptr("metadata.user.id"), Action: redaction_rule.ActionHash},
+		{ID: "rule-2", Name: "cards", Kind: redaction_rule.KindField, Path: utils.Ptr("after_state.cards.*.number"), Action: redaction_rule.ActionDrop},
+	}, nil)
+
+	l := &entitylog.Log{
+		TenantID:    "tenant-1",
+		Metadata:    jsonPtr(`{"user":{"id":"u-42"}}`),
+		BeforeState: jsonPtr(`{"cards":[]}`),
+		AfterState:  jsonPtr(`{"cards":[{"number":"4111111111111111","brand":"visa"}]}`),
+	}
+	err := uc.NewRedactLogUseCase(mockRepo, "key").Execute(context.Background(), l)
+	assert.NoError(t, err)
+
+	var metadata map[string]map[string]string
+	assert.NoError(t, json.Unmarshal(*l.Metadata, &metadata))
+	assert.True(t, strings.HasPrefix(metadata["user"]["id"], "hash:"))
+	assert.NotContains(t, metadata["user"]["id"], "u-42")
+
+	assert.JSONEq(t, `{"cards":[{"brand":"visa"}]}`, string(*l.AfterState))
+	assert.JSONEq(t, `{"cards":[]}`, string(*l.BeforeState))
+	assert.Equal(t, []redaction_rule.Summary{
+		{RuleID: "rule-2", Rule: "cards", Field: "after_state.cards[0].number", Action: redaction_rule.ActionDrop, Count: 1},
+		{RuleID: "rule-1", Rule: "user id", Field: "metadata.user.id", Action: redaction_rule.ActionHash, Count: 1},
+	}, summaries(t, l))
+}
+
+func TestRedactLogUseCase_Execute_HashIsStable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRedactionRuleRepository(ctrl)
+	mockRepo.EXPECT().List(gomock.Any(), "tenant-1").Return([]redaction_rule.RedactionRule{{
+		ID: "rule-1", Name: "tokens", Kind: redaction_rule.KindRegex,
+		Pattern: utils.Ptr(`tok_[a-z0-9]+`), Action: redaction_rule.ActionHash,
+	}}, nil)
+
+	// rules are cached, the repository is only hit once
+	redactor := uc.NewRedactLogUseCase(mockRepo, "key")
+	first := &entitylog.Log{TenantID: "tenant-1", Message: "used tok_abc123"}
+	second := &entitylog.Log{TenantID: "tenant-1", Message: "used tok_abc123"}
+	assert.NoError(t, redactor.Execute(context.Background(), first))
+	assert.NoError(t, redactor.Execute(context.Background(), second))
+
+	assert.NotContains(t, first.Message, "tok_abc123")
+	assert.Equal(t, first.Message, second.Message)
+}
+
+func TestRedactLogUseCase_Execute_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockRedactionRuleRepository(ctrl)
+	mockRepo.EXPECT().List(gomock.Any(), "tenant-1").Return(nil, assert.AnError)
+
+	err := uc.NewRedactLogUseCase(mockRepo, "key").Execute(context.Background(), &entitylog.Log{TenantID: "tenant-1"})
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type CreateLogSchemaUseCase struct {
+	Repo      repository.LogSchemaRepository
+	TxManager interactor.TxManager
+}
+
+func NewCreateLogSchemaUseCase(repo repository.LogSchemaRepository, txManager interactor.TxManager) *CreateLogSchemaUseCase {
+	return &CreateLogSchemaUseCase{Repo: repo, TxManager: txManager}
+}
+
+// Execute registers the schema as the next version for its tenant and resource.
+// Previous versions are deactivated but kept so older logs can still refer to them.
+func (uc *CreateLogSchemaUseCase) Execute(ctx context.Context, s log_schema.LogSchema) (*log_schema.LogSchema, error) {
+	return publishVersion(ctx, uc.Repo, uc.TxManager, s)
+}
+
+func publishVersion(ctx context.Context, repo repository.LogSchemaRepository, txManager interactor.TxManager, s log_schema.LogSchema) (*log_schema.LogSchema, error) {
+	if err := checkSchemas(s); err != nil {
+		return nil, err
+	}
+
+	var created *log_schema.LogSchema
+	if err := txManager.TransactionExec(ctx, func(txCtx context.Context) error {
+		db := txManager.GetTx(txCtx)
+
+		if err := repo.LockVersions(txCtx, db, s.TenantID, s.Resource); err != nil {
+			return err
+		}
+		latest, err := repo.GetLatestVersion(txCtx, db, s.TenantID, s.Resource)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.Deactivate(txCtx, db, s.TenantID, s.Resource); err != nil {
+			return err
+		}
+
+		s.ID = uuid.New().String()
+		s.Version = latest + 1
+		s.IsActive = true
+		created, err = repo.Create(txCtx, db, &s)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
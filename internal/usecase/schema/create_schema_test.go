@@ -0,0 +1,163 @@
+package schema_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/schema"
+
+	intMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func expectTx(mockTx *intMocks.MockTxManager) {
+	mockTx.EXPECT().
+		TransactionExec(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+			return fn(context.Background())
+		})
+	mockTx.EXPECT().GetTx(gomock.Any()).Return(&gorm.DB{})
+}
+
+func TestCreateLogSchemaUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	expectTx(mockTx)
+
+	resource := "user"
+	// the version is read under the lock, a concurrent publisher waits for this one to commit
+	gomock.InOrder(
+		mockRepo.EXPECT().LockVersions(gomock.Any(), gomock.Any(), "tenant-1", &resource).Return(nil),
+		mockRepo.EXPECT().GetLatestVersion(gomock.Any(), gomock.Any(), "tenant-1", &resource).Return(2, nil),
+	)
+	mockRepo.EXPECT().Deactivate(gomock.Any(), gomock.Any(), "tenant-1", &resource).Return(nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *gorm.DB, s *log_schema.LogSchema) (*log_schema.LogSchema, error) {
+			return s, nil
+		})
+
+	s := log_schema.LogSchema{
+		TenantID:       "tenant-1",
+		Resource:       &resource,
+		Enforcement:    log_schema.EnforcementFlag,
+		MetadataSchema: jsonPtr(`{"type":"object"}`),
+	}
+	created, err := uc.NewCreateLogSchemaUseCase(mockRepo, mockTx).Execute(context.Background(), s)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, 3, created.Version)
+	assert.True(t, created.IsActive)
+}
+
+func TestCreateLogSchemaUseCase_Execute_InvalidSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+
+	s := log_schema.LogSchema{
+		TenantID:       "tenant-1",
+		Enforcement:    log_schema.EnforcementReject,
+		MetadataSchema: jsonPtr(`{"type":"unknown"}`),
+	}
+	_, err := uc.NewCreateLogSchemaUseCase(mockRepo, mockTx).Execute(context.Background(), s)
+	assert.ErrorIs(t, err, uc.ErrInvalidSchema)
+}
+
+func TestCreateLogSchemaUseCase_Execute_ExternalRef(t *testing.T) {
+	// both refs point at a valid schema, loading either would let the schema compile
+	file := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"type":"object"}`), 0o600))
+	fetched := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetched = true
+		_, _ = w.Write([]byte(`{"type":"object"}`))
+	}))
+	defer srv.Close()
+
+	for _, ref := range []string{"file://" + file, srv.URL + "/schema.json"} {
+		t.Run(ref, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := log_schema.LogSchema{
+				TenantID:       "tenant-1",
+				Enforcement:    log_schema.EnforcementReject,
+				MetadataSchema: jsonPtr(`{"$ref":"` + ref + `"}`),
+			}
+			_, err := uc.NewCreateLogSchemaUseCase(repoMocks.NewMockLogSchemaRepository(ctrl), intMocks.NewMockTxManager(ctrl)).
+				Execute(context.Background(), s)
+			assert.ErrorIs(t, err, uc.ErrInvalidSchema)
+		})
+	}
+	assert.False(t, fetched)
+}
+
+func TestUpdateLogSchemaUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+	expectTx(mockTx)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), "schema-1", "tenant-1").
+		Return(&log_schema.LogSchema{ID: "schema-1", TenantID: "tenant-1", Version: 1}, nil)
+	mockRepo.EXPECT().LockVersions(gomock.Any(), gomock.Any(), "tenant-1", nil).Return(nil)
+	mockRepo.EXPECT().GetLatestVersion(gomock.Any(), gomock.Any(), "tenant-1", nil).Return(1, nil)
+	mockRepo.EXPECT().Deactivate(gomock.Any(), gomock.Any(), "tenant-1", nil).Return(nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *gorm.DB, s *log_schema.LogSchema) (*log_schema.LogSchema, error) {
+			return s, nil
+		})
+
+	s := log_schema.LogSchema{Enforcement: log_schema.EnforcementReject, AfterStateSchema: jsonPtr(`{"type":"object"}`)}
+	updated, err := uc.NewUpdateLogSchemaUseCase(mockRepo, mockTx).Execute(context.Background(), "schema-1", "tenant-1", s)
+	assert.NoError(t, err)
+	assert.NotEqual(t, "schema-1", updated.ID)
+	assert.Equal(t, "tenant-1", updated.TenantID)
+	assert.Equal(t, 2, updated.Version)
+}
+
+func TestUpdateLogSchemaUseCase_Execute_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockTx := intMocks.NewMockTxManager(ctrl)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), "schema-1", "tenant-1").Return(nil, gorm.ErrRecordNotFound)
+
+	s := log_schema.LogSchema{Enforcement: log_schema.EnforcementReject, AfterStateSchema: jsonPtr(`{"type":"object"}`)}
+	_, err := uc.NewUpdateLogSchemaUseCase(mockRepo, mockTx).Execute(context.Background(), "schema-1", "tenant-1", s)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestDeleteLogSchemaUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+
+	resource := "user"
+	mockRepo.EXPECT().GetByID(gomock.Any(), "schema-1", "tenant-1").
+		Return(&log_schema.LogSchema{ID: "schema-1", TenantID: "tenant-1", Resource: &resource}, nil)
+	mockRepo.EXPECT().Deactivate(gomock.Any(), nil, "tenant-1", &resource).Return(nil)
+
+	err := uc.NewDeleteLogSchemaUseCase(mockRepo).Execute(context.Background(), "schema-1", "tenant-1")
+	assert.NoError(t, err)
+}
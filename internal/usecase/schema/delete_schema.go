@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type DeleteLogSchemaUseCase struct {
+	Repo repository.LogSchemaRepository
+}
+
+func NewDeleteLogSchemaUseCase(repo repository.LogSchemaRepository) *DeleteLogSchemaUseCase {
+	return &DeleteLogSchemaUseCase{Repo: repo}
+}
+
+// Execute stops enforcing the schema. Versions are only deactivated, never removed,
+// because existing logs still reference them.
+func (uc *DeleteLogSchemaUseCase) Execute(ctx context.Context, id, tenantId string) error {
+	existing, err := uc.Repo.GetByID(ctx, id, tenantId)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.Deactivate(ctx, nil, existing.TenantID, existing.Resource)
+}
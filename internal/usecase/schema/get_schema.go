@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type GetLogSchemaUseCase struct {
+	Repo repository.LogSchemaRepository
+}
+
+func NewGetLogSchemaUseCase(repo repository.LogSchemaRepository) *GetLogSchemaUseCase {
+	return &GetLogSchemaUseCase{Repo: repo}
+}
+
+func (uc *GetLogSchemaUseCase) Execute(ctx context.Context, id, tenantId string) (*log_schema.LogSchema, error) {
+	return uc.Repo.GetByID(ctx, id, tenantId)
+}
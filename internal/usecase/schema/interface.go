@@ -0,0 +1,39 @@
+package schema
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+)
+
+// CreateLogSchemaUseCaseInterface defines behavior for registering schemas.
+type CreateLogSchemaUseCaseInterface interface {
+	Execute(ctx context.Context, s log_schema.LogSchema) (*log_schema.LogSchema, error)
+}
+
+// UpdateLogSchemaUseCaseInterface defines behavior for publishing a new schema version.
+type UpdateLogSchemaUseCaseInterface interface {
+	Execute(ctx context.Context, id, tenantId string, s log_schema.LogSchema) (*log_schema.LogSchema, error)
+}
+
+// GetLogSchemaUseCaseInterface defines behavior for fetching a schema version.
+type GetLogSchemaUseCaseInterface interface {
+	Execute(ctx context.Context, id, tenantId string) (*log_schema.LogSchema, error)
+}
+
+// ListLogSchemasUseCaseInterface defines behavior for listing schemas.
+type ListLogSchemasUseCaseInterface interface {
+	Execute(ctx context.Context, tenantId string, resource *string, allVersions bool) ([]log_schema.LogSchema, error)
+}
+
+// DeleteLogSchemaUseCaseInterface defines behavior for deactivating schemas.
+type DeleteLogSchemaUseCaseInterface interface {
+	Execute(ctx context.Context, id, tenantId string) error
+}
+
+// ValidateLogUseCaseInterface defines behavior for validating logs at ingestion.
+type ValidateLogUseCaseInterface interface {
+	Execute(ctx context.Context, l *entitylog.Log) error
+}
@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type ListLogSchemasUseCase struct {
+	Repo repository.LogSchemaRepository
+}
+
+func NewListLogSchemasUseCase(repo repository.LogSchemaRepository) *ListLogSchemasUseCase {
+	return &ListLogSchemasUseCase{Repo: repo}
+}
+
+func (uc *ListLogSchemasUseCase) Execute(ctx context.Context, tenantId string, resource *string, allVersions bool) ([]log_schema.LogSchema, error) {
+	return uc.Repo.List(ctx, tenantId, resource, allVersions)
+}
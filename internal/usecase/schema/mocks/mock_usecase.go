@@ -0,0 +1,251 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	log "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	log_schema "github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateLogSchemaUseCaseInterface is a mock of CreateLogSchemaUseCaseInterface interface.
+type MockCreateLogSchemaUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateLogSchemaUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateLogSchemaUseCaseInterfaceMockRecorder is the mock recorder for MockCreateLogSchemaUseCaseInterface.
+type MockCreateLogSchemaUseCaseInterfaceMockRecorder struct {
+	mock *MockCreateLogSchemaUseCaseInterface
+}
+
+// NewMockCreateLogSchemaUseCaseInterface creates a new mock instance.
+func NewMockCreateLogSchemaUseCaseInterface(ctrl *gomock.Controller) *MockCreateLogSchemaUseCaseInterface {
+	mock := &MockCreateLogSchemaUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateLogSchemaUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateLogSchemaUseCaseInterface) EXPECT() *MockCreateLogSchemaUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateLogSchemaUseCaseInterface) Execute(ctx context.Context, s log_schema.LogSchema) (*log_schema.LogSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, s)
+	ret0, _ := ret[0].(*log_schema.LogSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateLogSchemaUseCaseInterfaceMockRecorder) Execute(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateLogSchemaUseCaseInterface)(nil).Execute), ctx, s)
+}
+
+// MockUpdateLogSchemaUseCaseInterface is a mock of UpdateLogSchemaUseCaseInterface interface.
+type MockUpdateLogSchemaUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateLogSchemaUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateLogSchemaUseCaseInterfaceMockRecorder is the mock recorder for MockUpdateLogSchemaUseCaseInterface.
+type MockUpdateLogSchemaUseCaseInterfaceMockRecorder struct {
+	mock *MockUpdateLogSchemaUseCaseInterface
+}
+
+// NewMockUpdateLogSchemaUseCaseInterface creates a new mock instance.
+func NewMockUpdateLogSchemaUseCaseInterface(ctrl *gomock.Controller) *MockUpdateLogSchemaUseCaseInterface {
+	mock := &MockUpdateLogSchemaUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockUpdateLogSchemaUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateLogSchemaUseCaseInterface) EXPECT() *MockUpdateLogSchemaUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateLogSchemaUseCaseInterface) Execute(ctx context.Context, id, tenantId string, s log_schema.LogSchema) (*log_schema.LogSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id, tenantId, s)
+	ret0, _ := ret[0].(*log_schema.LogSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateLogSchemaUseCaseInterfaceMockRecorder) Execute(ctx, id, tenantId, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateLogSchemaUseCaseInterface)(nil).Execute), ctx, id, tenantId, s)
+}
+
+// MockGetLogSchemaUseCaseInterface is a mock of GetLogSchemaUseCaseInterface interface.
+type MockGetLogSchemaUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetLogSchemaUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetLogSchemaUseCaseInterfaceMockRecorder is the mock recorder for MockGetLogSchemaUseCaseInterface.
+type MockGetLogSchemaUseCaseInterfaceMockRecorder struct {
+	mock *MockGetLogSchemaUseCaseInterface
+}
+
+// NewMockGetLogSchemaUseCaseInterface creates a new mock instance.
+func NewMockGetLogSchemaUseCaseInterface(ctrl *gomock.Controller) *MockGetLogSchemaUseCaseInterface {
+	mock := &MockGetLogSchemaUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetLogSchemaUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetLogSchemaUseCaseInterface) EXPECT() *MockGetLogSchemaUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetLogSchemaUseCaseInterface) Execute(ctx context.Context, id, tenantId string) (*log_schema.LogSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id, tenantId)
+	ret0, _ := ret[0].(*log_schema.LogSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetLogSchemaUseCaseInterfaceMockRecorder) Execute(ctx, id, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetLogSchemaUseCaseInterface)(nil).Execute), ctx, id, tenantId)
+}
+
+// MockListLogSchemasUseCaseInterface is a mock of ListLogSchemasUseCaseInterface interface.
+type MockListLogSchemasUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListLogSchemasUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockListLogSchemasUseCaseInterfaceMockRecorder is the mock recorder for MockListLogSchemasUseCaseInterface.
+type MockListLogSchemasUseCaseInterfaceMockRecorder struct {
+	mock *MockListLogSchemasUseCaseInterface
+}
+
+// NewMockListLogSchemasUseCaseInterface creates a new mock instance.
+func NewMockListLogSchemasUseCaseInterface(ctrl *gomock.Controller) *MockListLogSchemasUseCaseInterface {
+	mock := &MockListLogSchemasUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListLogSchemasUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListLogSchemasUseCaseInterface) EXPECT() *MockListLogSchemasUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListLogSchemasUseCaseInterface) Execute(ctx context.Context, tenantId string, resource *string, allVersions bool) ([]log_schema.LogSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tenantId, resource, allVersions)
+	ret0, _ := ret[0].([]log_schema.LogSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListLogSchemasUseCaseInterfaceMockRecorder) Execute(ctx, tenantId, resource, allVersions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListLogSchemasUseCaseInterface)(nil).Execute), ctx, tenantId, resource, allVersions)
+}
+
+// MockDeleteLogSchemaUseCaseInterface is a mock of DeleteLogSchemaUseCaseInterface interface.
+type MockDeleteLogSchemaUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteLogSchemaUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteLogSchemaUseCaseInterfaceMockRecorder is the mock recorder for MockDeleteLogSchemaUseCaseInterface.
+type MockDeleteLogSchemaUseCaseInterfaceMockRecorder struct {
+	mock *MockDeleteLogSchemaUseCaseInterface
+}
+
+// NewMockDeleteLogSchemaUseCaseInterface creates a new mock instance.
+func NewMockDeleteLogSchemaUseCaseInterface(ctrl *gomock.Controller) *MockDeleteLogSchemaUseCaseInterface {
+	mock := &MockDeleteLogSchemaUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteLogSchemaUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteLogSchemaUseCaseInterface) EXPECT() *MockDeleteLogSchemaUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteLogSchemaUseCaseInterface) Execute(ctx context.Context, id, tenantId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id, tenantId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteLogSchemaUseCaseInterfaceMockRecorder) Execute(ctx, id, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteLogSchemaUseCaseInterface)(nil).Execute), ctx, id, tenantId)
+}
+
+// MockValidateLogUseCaseInterface is a mock of ValidateLogUseCaseInterface interface.
+type MockValidateLogUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidateLogUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockValidateLogUseCaseInterfaceMockRecorder is the mock recorder for MockValidateLogUseCaseInterface.
+type MockValidateLogUseCaseInterfaceMockRecorder struct {
+	mock *MockValidateLogUseCaseInterface
+}
+
+// NewMockValidateLogUseCaseInterface creates a new mock instance.
+func NewMockValidateLogUseCaseInterface(ctrl *gomock.Controller) *MockValidateLogUseCaseInterface {
+	mock := &MockValidateLogUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockValidateLogUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidateLogUseCaseInterface) EXPECT() *MockValidateLogUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockValidateLogUseCaseInterface) Execute(ctx context.Context, l *log.Log) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, l)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockValidateLogUseCaseInterfaceMockRecorder) Execute(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockValidateLogUseCaseInterface)(nil).Execute), ctx, l)
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type UpdateLogSchemaUseCase struct {
+	Repo      repository.LogSchemaRepository
+	TxManager interactor.TxManager
+}
+
+func NewUpdateLogSchemaUseCase(repo repository.LogSchemaRepository, txManager interactor.TxManager) *UpdateLogSchemaUseCase {
+	return &UpdateLogSchemaUseCase{Repo: repo, TxManager: txManager}
+}
+
+// Execute publishes a new version for the tenant and resource of the given schema id.
+func (uc *UpdateLogSchemaUseCase) Execute(ctx context.Context, id, tenantId string, s log_schema.LogSchema) (*log_schema.LogSchema, error) {
+	existing, err := uc.Repo.GetByID(ctx, id, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.TenantID = existing.TenantID
+	s.Resource = existing.Resource
+	return publishVersion(ctx, uc.Repo, uc.TxManager, s)
+}
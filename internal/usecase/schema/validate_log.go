@@ -0,0 +1,190 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"gorm.io/datatypes"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+const (
+	fieldMetadata    = "metadata"
+	fieldBeforeState = "before_state"
+	fieldAfterState  = "after_state"
+)
+
+var ErrInvalidSchema = errors.New("invalid json schema")
+
+// ViolationError is returned when a log does not conform to a schema in reject mode.
+type ViolationError struct {
+	Violations []string
+}
+
+func (e *ViolationError) Error() string {
+	return "log does not match schema: " + strings.Join(e.Violations, "; ")
+}
+
+type ValidateLogUseCase struct {
+	Repo repository.LogSchemaRepository
+
+	// compiled schemas keyed by schema id and field, versions are immutable
+	compiled sync.Map
+}
+
+func NewValidateLogUseCase(repo repository.LogSchemaRepository) *ValidateLogUseCase {
+	return &ValidateLogUseCase{Repo: repo}
+}
+
+// Execute validates metadata and before/after state against the active schema
+// of the log's tenant and resource. The schema version is recorded on the log.
+// Violations are either returned as a ViolationError (reject) or recorded on the log (flag).
+func (uc *ValidateLogUseCase) Execute(ctx context.Context, l *entitylog.Log) error {
+	s, err := uc.Repo.FindActive(ctx, l.TenantID, l.Resource)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return nil
+	}
+
+	fields := []struct {
+		name   string
+		schema *datatypes.JSON
+		value  *datatypes.JSON
+	}{
+		{fieldMetadata, s.MetadataSchema, l.Metadata},
+		{fieldBeforeState, s.BeforeStateSchema, l.BeforeState},
+		{fieldAfterState, s.AfterStateSchema, l.AfterState},
+	}
+
+	var violations []string
+	for _, f := range fields {
+		if f.schema == nil {
+			continue
+		}
+
+		compiled, err := uc.getCompiled(s.ID, f.name, f.schema)
+		if err != nil {
+			return err
+		}
+
+		v, err := decode(f.value)
+		if err != nil {
+			return err
+		}
+
+		if err := compiled.Validate(v); err != nil {
+			var ve *jsonschema.ValidationError
+			if !errors.As(err, &ve) {
+				return err
+			}
+			violations = append(violations, collectViolations(f.name, ve)...)
+		}
+	}
+
+	l.SchemaID = &s.ID
+	if len(violations) == 0 {
+		return nil
+	}
+
+	if s.Enforcement == log_schema.EnforcementReject {
+		return &ViolationError{Violations: violations}
+	}
+
+	data, err := json.Marshal(violations)
+	if err != nil {
+		return err
+	}
+	flagged := datatypes.JSON(data)
+	l.SchemaViolations = &flagged
+	return nil
+}
+
+func (uc *ValidateLogUseCase) getCompiled(schemaID, field string, raw *datatypes.JSON) (*jsonschema.Schema, error) {
+	key := schemaID + ":" + field
+	if v, ok := uc.compiled.Load(key); ok {
+		return v.(*jsonschema.Schema), nil
+	}
+
+	compiled, err := compile(field, raw)
+	if err != nil {
+		return nil, err
+	}
+	uc.compiled.Store(key, compiled)
+	return compiled, nil
+}
+
+// checkSchemas makes sure every provided schema compiles before it is stored
+func checkSchemas(s log_schema.LogSchema) error {
+	for field, raw := range map[string]*datatypes.JSON{
+		fieldMetadata:    s.MetadataSchema,
+		fieldBeforeState: s.BeforeStateSchema,
+		fieldAfterState:  s.AfterStateSchema,
+	} {
+		if raw == nil {
+			continue
+		}
+		if _, err := compile(field, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errExternalRef is returned for a $ref out of the schema, a tenant's schema must never make the server read
+// a local file or fetch a URL
+var errExternalRef = errors.New("external $ref not allowed")
+
+func compile(field string, raw *datatypes.JSON) (*jsonschema.Schema, error) {
+	url := field + ".json"
+	c := jsonschema.NewCompiler()
+	// the default loaders read file:// refs, only the refs inside the document resolve
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("%w: %s", errExternalRef, s)
+	}
+	if err := c.AddResource(url, bytes.NewReader(*raw)); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidSchema, field, err)
+	}
+
+	compiled, err := c.Compile(url)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidSchema, field, err)
+	}
+	return compiled, nil
+}
+
+// decode returns the JSON value to validate, an absent field is validated as an empty object
+func decode(value *datatypes.JSON) (interface{}, error) {
+	if value == nil || len(*value) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(*value, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func collectViolations(field string, ve *jsonschema.ValidationError) []string {
+	if len(ve.Causes) == 0 {
+		return []string{fmt.Sprintf("%s%s: %s", field, ve.InstanceLocation, ve.Message)}
+	}
+
+	var violations []string
+	for _, cause := range ve.Causes {
+		violations = append(violations, collectViolations(field, cause)...)
+	}
+	return violations
+}
@@ -0,0 +1,107 @@
+package schema_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log_schema"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/schema"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func jsonPtr(s string) *datatypes.JSON {
+	j := datatypes.JSON(s)
+	return &j
+}
+
+func metadataSchema(enforcement log_schema.Enforcement) *log_schema.LogSchema {
+	return &log_schema.LogSchema{
+		ID:             "schema-1",
+		TenantID:       "tenant-1",
+		Version:        1,
+		Enforcement:    enforcement,
+		IsActive:       true,
+		MetadataSchema: jsonPtr(`{"type":"object","required":["request_id"],"properties":{"request_id":{"type":"string"}}}`),
+	}
+}
+
+func TestValidateLogUseCase_Execute_NoSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-1", nil).Return(nil, nil)
+
+	l := &entitylog.Log{TenantID: "tenant-1"}
+	err := uc.NewValidateLogUseCase(mockRepo).Execute(context.Background(), l)
+	assert.NoError(t, err)
+	assert.Nil(t, l.SchemaID)
+}
+
+func TestValidateLogUseCase_Execute_Valid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-1", nil).Return(metadataSchema(log_schema.EnforcementReject), nil)
+
+	l := &entitylog.Log{TenantID: "tenant-1", Metadata: jsonPtr(`{"request_id":"r-1"}`)}
+	err := uc.NewValidateLogUseCase(mockRepo).Execute(context.Background(), l)
+	assert.NoError(t, err)
+	assert.Equal(t, "schema-1", *l.SchemaID)
+	assert.Nil(t, l.SchemaViolations)
+}
+
+func TestValidateLogUseCase_Execute_Reject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-1", nil).Return(metadataSchema(log_schema.EnforcementReject), nil)
+
+	l := &entitylog.Log{TenantID: "tenant-1"}
+	err := uc.NewValidateLogUseCase(mockRepo).Execute(context.Background(), l)
+
+	var violation *uc.ViolationError
+	assert.True(t, errors.As(err, &violation))
+	assert.Len(t, violation.Violations, 1)
+	assert.Contains(t, violation.Violations[0], "metadata")
+	assert.Contains(t, violation.Violations[0], "request_id")
+}
+
+func TestValidateLogUseCase_Execute_Flag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-1", nil).Return(metadataSchema(log_schema.EnforcementFlag), nil)
+
+	l := &entitylog.Log{TenantID: "tenant-1", Metadata: jsonPtr(`{"request_id":42}`)}
+	err := uc.NewValidateLogUseCase(mockRepo).Execute(context.Background(), l)
+	assert.NoError(t, err)
+	assert.Equal(t, "schema-1", *l.SchemaID)
+
+	var violations []string
+	assert.NoError(t, json.Unmarshal(*l.SchemaViolations, &violations))
+	assert.Len(t, violations, 1)
+	assert.Contains(t, violations[0], "metadata/request_id")
+}
+
+func TestValidateLogUseCase_Execute_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockLogSchemaRepository(ctrl)
+	mockRepo.EXPECT().FindActive(gomock.Any(), "tenant-1", nil).Return(nil, assert.AnError)
+
+	err := uc.NewValidateLogUseCase(mockRepo).Execute(context.Background(), &entitylog.Log{TenantID: "tenant-1"})
+	assert.ErrorIs(t, err, assert.AnError)
+}
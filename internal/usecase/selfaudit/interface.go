@@ -0,0 +1,11 @@
+package selfaudit
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+)
+
+// RecordUseCaseInterface defines behavior for writing an entry of the service's own audit trail.
+type RecordUseCaseInterface interface {
+	Execute(ctx context.Context, e Entry) error
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	selfaudit "github.com/Haevnen/audit-logging-api/internal/usecase/selfaudit"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecordUseCaseInterface is a mock of RecordUseCaseInterface interface.
+type MockRecordUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRecordUseCaseInterfaceMockRecorder is the mock recorder for MockRecordUseCaseInterface.
+type MockRecordUseCaseInterfaceMockRecorder struct {
+	mock *MockRecordUseCaseInterface
+}
+
+// NewMockRecordUseCaseInterface creates a new mock instance.
+func NewMockRecordUseCaseInterface(ctrl *gomock.Controller) *MockRecordUseCaseInterface {
+	mock := &MockRecordUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRecordUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordUseCaseInterface) EXPECT() *MockRecordUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRecordUseCaseInterface) Execute(ctx context.Context, e selfaudit.Entry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRecordUseCaseInterfaceMockRecorder) Execute(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRecordUseCaseInterface)(nil).Execute), ctx, e)
+}
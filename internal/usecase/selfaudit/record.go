@@ -0,0 +1,125 @@
+package selfaudit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gorm.io/datatypes"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/usecase/log"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+const auditResource = "audit_service"
+
+// Entry describes a request served by the API
+type Entry struct {
+	TenantID string
+	ActorID  string
+	Role     string
+	Method   string
+	Route    string
+	Status   int
+	Filters  map[string]string
+	Details  map[string]interface{}
+}
+
+type RecordUseCase struct {
+	CreateLog log.CreateLogUseCaseInterface
+}
+
+func NewRecordUseCase(createLog log.CreateLogUseCaseInterface) *RecordUseCase {
+	return &RecordUseCase{CreateLog: createLog}
+}
+
+// Execute writes the entry to the system stream of its tenant. Platform actions without a tenant,
+// and entries whose tenant can't be written to (unknown tenant id), go to the reserved system tenant.
+func (uc *RecordUseCase) Execute(ctx context.Context, e Entry) error {
+	tenantId := e.TenantID
+	if len(tenantId) == 0 {
+		tenantId = tenant.SystemTenantID
+	}
+
+	l, err := toSystemLog(e, tenantId)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.CreateLog.Execute(ctx, tenantId, e.ActorID, l)
+	if err == nil || tenantId == tenant.SystemTenantID {
+		return err
+	}
+
+	logger.FromContext(ctx).WithField("error", err).WithField("tenant_id", tenantId).
+		Warn("failed to write audit entry to the tenant, writing it to the system tenant")
+	l, err = toSystemLog(e, tenant.SystemTenantID)
+	if err != nil {
+		return err
+	}
+	_, err = uc.CreateLog.Execute(ctx, tenant.SystemTenantID, e.ActorID, l)
+	return err
+}
+
+func toSystemLog(e Entry, tenantId string) (entitylog.Log, error) {
+	payload := map[string]interface{}{
+		"actor_role": e.Role,
+		"method":     e.Method,
+		"route":      e.Route,
+		"status":     e.Status,
+	}
+	if len(e.Filters) > 0 {
+		payload["filters"] = e.Filters
+	}
+	if tenantId != e.TenantID && len(e.TenantID) > 0 {
+		payload["requested_tenant_id"] = e.TenantID
+	}
+	for k, v := range e.Details {
+		if _, ok := payload[k]; !ok {
+			payload[k] = v
+		}
+	}
+
+	metadata, err := json.Marshal(payload)
+	if err != nil {
+		return entitylog.Log{}, err
+	}
+	m := datatypes.JSON(metadata)
+	resource := auditResource
+	route := e.Method + " " + e.Route
+
+	severity := entitylog.SeverityInfo
+	if e.Status >= http.StatusBadRequest {
+		severity = entitylog.SeverityWarning
+	}
+
+	return entitylog.Log{
+		TenantID:       tenantId,
+		UserID:         e.ActorID,
+		Action:         actionOf(e.Method),
+		Severity:       severity,
+		EventTimestamp: time.Now().UTC(),
+		Message:        fmt.Sprintf("%s answered %d", route, e.Status),
+		Resource:       &resource,
+		ResourceID:     &route,
+		Metadata:       &m,
+		System:         true,
+	}, nil
+}
+
+func actionOf(method string) entitylog.ActionType {
+	switch method {
+	case http.MethodPost:
+		return entitylog.ActionCreate
+	case http.MethodPut, http.MethodPatch:
+		return entitylog.ActionUpdate
+	case http.MethodDelete:
+		return entitylog.ActionDelete
+	default:
+		return entitylog.ActionView
+	}
+}
@@ -0,0 +1,114 @@
+package selfaudit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	entitylog "github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/selfaudit"
+
+	logMocks "github.com/Haevnen/audit-logging-api/internal/usecase/log/mocks"
+)
+
+func metadataOf(t *testing.T, l entitylog.Log) map[string]interface{} {
+	var m map[string]interface{}
+	assert.NoError(t, json.Unmarshal(*l.Metadata, &m))
+	return m
+}
+
+func TestRecordUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockLog.EXPECT().Execute(gomock.Any(), "tenant-1", "user-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, l entitylog.Log) (*entitylog.Log, error) {
+			assert.True(t, l.System)
+			assert.Equal(t, entitylog.ActionView, l.Action)
+			assert.Equal(t, entitylog.SeverityInfo, l.Severity)
+			assert.Equal(t, "audit_service", *l.Resource)
+			assert.Equal(t, "GET /logs/export", *l.ResourceID)
+
+			m := metadataOf(t, l)
+			assert.Equal(t, "auditor", m["actor_role"])
+			assert.Equal(t, map[string]interface{}{"format": "csv"}, m["filters"])
+			assert.Equal(t, float64(42), m["result_count"])
+			assert.Equal(t, float64(200), m["status"])
+			return &l, nil
+		})
+
+	err := uc.NewRecordUseCase(mockLog).Execute(context.Background(), uc.Entry{
+		TenantID: "tenant-1",
+		ActorID:  "user-1",
+		Role:     "auditor",
+		Method:   http.MethodGet,
+		Route:    "/logs/export",
+		Status:   http.StatusOK,
+		Filters:  map[string]string{"format": "csv"},
+		Details:  map[string]interface{}{"result_count": 42, "status": 500},
+	})
+	assert.NoError(t, err)
+}
+
+func TestRecordUseCase_Execute_PlatformAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockLog.EXPECT().Execute(gomock.Any(), tenant.SystemTenantID, "admin-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, l entitylog.Log) (*entitylog.Log, error) {
+			assert.Equal(t, entitylog.ActionDelete, l.Action)
+			assert.Equal(t, entitylog.SeverityWarning, l.Severity)
+			assert.NotContains(t, metadataOf(t, l), "requested_tenant_id")
+			return &l, nil
+		})
+
+	err := uc.NewRecordUseCase(mockLog).Execute(context.Background(), uc.Entry{
+		ActorID: "admin-1",
+		Role:    "admin",
+		Method:  http.MethodDelete,
+		Route:   "/roles/:id",
+		Status:  http.StatusNotFound,
+	})
+	assert.NoError(t, err)
+}
+
+func TestRecordUseCase_Execute_FallsBackToSystemTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	gomock.InOrder(
+		mockLog.EXPECT().Execute(gomock.Any(), "unknown", "admin-1", gomock.Any()).Return(nil, errors.New("foreign key violation")),
+		mockLog.EXPECT().Execute(gomock.Any(), tenant.SystemTenantID, "admin-1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, l entitylog.Log) (*entitylog.Log, error) {
+				assert.Equal(t, "unknown", metadataOf(t, l)["requested_tenant_id"])
+				return &l, nil
+			}),
+	)
+
+	err := uc.NewRecordUseCase(mockLog).Execute(context.Background(), uc.Entry{
+		TenantID: "unknown", ActorID: "admin-1", Method: http.MethodGet, Route: "/logs", Status: http.StatusOK,
+	})
+	assert.NoError(t, err)
+}
+
+func TestRecordUseCase_Execute_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := logMocks.NewMockCreateLogUseCaseInterface(ctrl)
+	mockLog.EXPECT().Execute(gomock.Any(), tenant.SystemTenantID, "admin-1", gomock.Any()).Return(nil, errors.New("db error"))
+
+	err := uc.NewRecordUseCase(mockLog).Execute(context.Background(), uc.Entry{
+		ActorID: "admin-1", Method: http.MethodGet, Route: "/tenants", Status: http.StatusOK,
+	})
+	assert.EqualError(t, err, "db error")
+}
@@ -0,0 +1,117 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+// revocationStore is what a check reads, satisfied by both the cache and the database
+type revocationStore interface {
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	GetRevokedBefore(ctx context.Context, subjectType token_revocation.SubjectType, tenantId string, subjectId string) (*time.Time, error)
+}
+
+type CheckRevocationUseCase struct {
+	Repo  repository.TokenRevocationRepository
+	Cache service.RevocationCache
+}
+
+func NewCheckRevocationUseCase(repo repository.TokenRevocationRepository, cache service.RevocationCache) *CheckRevocationUseCase {
+	return &CheckRevocationUseCase{Repo: repo, Cache: cache}
+}
+
+// Execute reports whether the token was revoked by its jti, or by a revocation of its user or tenant.
+// The cache answers normally, it is reloaded from the database when empty (e.g. after a Redis restart)
+// and the database is queried directly while Redis is unreachable.
+func (uc *CheckRevocationUseCase) Execute(ctx context.Context, claims *auth.Claims) (bool, error) {
+	if err := uc.ensureLoaded(ctx); err == nil {
+		revoked, err := isRevoked(ctx, uc.Cache, claims)
+		if err == nil {
+			return revoked, nil
+		}
+		logger.FromContext(ctx).Warning("failed to read revocation cache, checking the database: ", err)
+	} else {
+		logger.FromContext(ctx).Warning("failed to load revocation cache, checking the database: ", err)
+	}
+	return isRevoked(ctx, uc.Repo, claims)
+}
+
+func (uc *CheckRevocationUseCase) ensureLoaded(ctx context.Context) error {
+	loaded, err := uc.Cache.IsLoaded(ctx)
+	if err != nil || loaded {
+		return err
+	}
+
+	tokens, err := uc.Repo.ListActiveTokens(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, t := range tokens {
+		if err := uc.Cache.RevokeToken(ctx, t.JTI, t.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	sessions, err := uc.Repo.ListSessions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		if err := uc.Cache.SetRevokedBefore(ctx, s.SubjectType, s.TenantID, s.SubjectID, s.RevokedBefore); err != nil {
+			return err
+		}
+	}
+	return uc.Cache.MarkLoaded(ctx)
+}
+
+func isRevoked(ctx context.Context, store revocationStore, claims *auth.Claims) (bool, error) {
+	if len(claims.ID) > 0 {
+		revoked, err := store.IsTokenRevoked(ctx, claims.ID)
+		if err != nil || revoked {
+			return revoked, err
+		}
+	}
+
+	// the user is looked up in the tenant of the token, a revocation in another tenant is of another user
+	subjects := []struct {
+		subjectType token_revocation.SubjectType
+		tenantId    string
+		subjectId   string
+	}{
+		{token_revocation.SubjectUser, claims.TenantID, claims.UserID},
+		{token_revocation.SubjectTenant, "", claims.TenantID},
+	}
+	for _, s := range subjects {
+		if len(s.subjectId) == 0 {
+			continue
+		}
+		before, err := store.GetRevokedBefore(ctx, s.subjectType, s.tenantId, s.subjectId)
+		if err != nil {
+			return false, err
+		}
+		if issuedBefore(claims.IssuedAt, before) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// issuedBefore compares at second precision (iat has no fractions), so a token issued within the same
+// second as the revocation is rejected too. Tokens without iat can't be placed and are rejected.
+func issuedBefore(issuedAt *jwt.NumericDate, cutoff *time.Time) bool {
+	if cutoff == nil {
+		return false
+	}
+	if issuedAt == nil {
+		return true
+	}
+	return !issuedAt.Time.After(cutoff.Truncate(time.Second))
+}
@@ -0,0 +1,176 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/session"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	svcMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+)
+
+func claimsIssuedAt(issuedAt time.Time) *auth.Claims {
+	return &auth.Claims{
+		UserID:   "u1",
+		TenantID: "t1",
+		Role:     auth.RoleUser,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       "jti-1",
+			IssuedAt: jwt.NewNumericDate(issuedAt),
+		},
+	}
+}
+
+func TestCheckRevocationUseCase_Execute_FromCache(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Minute)
+	after := now.Add(time.Minute)
+
+	tests := []struct {
+		name         string
+		jtiRevoked   bool
+		userBefore   *time.Time
+		tenantBefore *time.Time
+		expected     bool
+	}{
+		{"Not Revoked", false, nil, nil, false},
+		{"Revoked By JTI", true, nil, nil, true},
+		{"User Revoked After Issue", false, &after, nil, true},
+		{"Tenant Revoked After Issue", false, nil, &after, true},
+		{"Revoked Before Issue", false, &before, &before, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCache := svcMocks.NewMockRevocationCache(ctrl)
+			mockCache.EXPECT().IsLoaded(gomock.Any()).Return(true, nil)
+			mockCache.EXPECT().IsTokenRevoked(gomock.Any(), "jti-1").Return(tt.jtiRevoked, nil)
+			mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectUser, "t1", "u1").Return(tt.userBefore, nil).AnyTimes()
+			mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectTenant, "", "t1").Return(tt.tenantBefore, nil).AnyTimes()
+
+			revoked, err := uc.NewCheckRevocationUseCase(nil, mockCache).Execute(context.Background(), claimsIssuedAt(now))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, revoked)
+		})
+	}
+}
+
+func TestCheckRevocationUseCase_Execute_OtherTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// u1 was revoked in t2, the u1 of t1 is another user
+	cutoff := time.Now().Add(time.Minute)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+	mockCache.EXPECT().IsLoaded(gomock.Any()).Return(true, nil)
+	mockCache.EXPECT().IsTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+	mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectUser, "t2", "u1").Return(&cutoff, nil).AnyTimes()
+	mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectUser, "t1", "u1").Return(nil, nil)
+	mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectTenant, "", "t1").Return(nil, nil)
+
+	revoked, err := uc.NewCheckRevocationUseCase(nil, mockCache).Execute(context.Background(), claimsIssuedAt(time.Now()))
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+}
+
+func TestCheckRevocationUseCase_Execute_SameSecondIsRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// iat has no fractions, a token of the same second as the revocation can't be told apart
+	cutoff := time.Date(2026, 1, 1, 10, 0, 0, 500_000_000, time.UTC)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+	mockCache.EXPECT().IsLoaded(gomock.Any()).Return(true, nil)
+	mockCache.EXPECT().IsTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+	mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectUser, "t1", "u1").Return(&cutoff, nil).AnyTimes()
+	mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectTenant, "", "t1").Return(nil, nil).AnyTimes()
+
+	revoked, err := uc.NewCheckRevocationUseCase(nil, mockCache).Execute(context.Background(), claimsIssuedAt(cutoff.Add(300*time.Millisecond)))
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestCheckRevocationUseCase_Execute_WarmsUpCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTokenRevocationRepository(ctrl)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+	expiresAt := time.Now().Add(time.Hour)
+	cutoff := time.Now().Add(-time.Hour)
+
+	mockCache.EXPECT().IsLoaded(gomock.Any()).Return(false, nil)
+	mockRepo.EXPECT().ListActiveTokens(gomock.Any(), gomock.Any()).
+		Return([]token_revocation.RevokedToken{{JTI: "jti-1", ExpiresAt: expiresAt}}, nil)
+	mockCache.EXPECT().RevokeToken(gomock.Any(), "jti-1", expiresAt).Return(nil)
+	mockRepo.EXPECT().ListSessions(gomock.Any()).
+		Return([]token_revocation.SessionRevocation{{SubjectType: token_revocation.SubjectUser, TenantID: "t2", SubjectID: "u2", RevokedBefore: cutoff}}, nil)
+	mockCache.EXPECT().SetRevokedBefore(gomock.Any(), token_revocation.SubjectUser, "t2", "u2", cutoff).Return(nil)
+	mockCache.EXPECT().MarkLoaded(gomock.Any()).Return(nil)
+	mockCache.EXPECT().IsTokenRevoked(gomock.Any(), "jti-1").Return(true, nil)
+
+	revoked, err := uc.NewCheckRevocationUseCase(mockRepo, mockCache).Execute(context.Background(), claimsIssuedAt(time.Now()))
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestCheckRevocationUseCase_Execute_FallsBackToDatabase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTokenRevocationRepository(ctrl)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+	cutoff := time.Now().Add(time.Minute)
+
+	mockCache.EXPECT().IsLoaded(gomock.Any()).Return(true, nil)
+	mockCache.EXPECT().IsTokenRevoked(gomock.Any(), "jti-1").Return(false, errors.New("redis down"))
+	mockRepo.EXPECT().IsTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+	mockRepo.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectUser, "t1", "u1").Return(&cutoff, nil).AnyTimes()
+	mockRepo.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectTenant, "", "t1").Return(nil, nil).AnyTimes()
+
+	revoked, err := uc.NewCheckRevocationUseCase(mockRepo, mockCache).Execute(context.Background(), claimsIssuedAt(time.Now()))
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestCheckRevocationUseCase_Execute_DatabaseError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTokenRevocationRepository(ctrl)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+
+	mockCache.EXPECT().IsLoaded(gomock.Any()).Return(false, errors.New("redis down"))
+	mockRepo.EXPECT().IsTokenRevoked(gomock.Any(), "jti-1").Return(false, errors.New("db down"))
+
+	_, err := uc.NewCheckRevocationUseCase(mockRepo, mockCache).Execute(context.Background(), claimsIssuedAt(time.Now()))
+	assert.EqualError(t, err, "db down")
+}
+
+func TestCheckRevocationUseCase_Execute_NoIssuedAt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cutoff := time.Now().Add(-time.Hour)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+	mockCache.EXPECT().IsLoaded(gomock.Any()).Return(true, nil)
+	mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectUser, "t1", "u1").Return(&cutoff, nil).AnyTimes()
+	mockCache.EXPECT().GetRevokedBefore(gomock.Any(), token_revocation.SubjectTenant, "", "t1").Return(nil, nil).AnyTimes()
+
+	claims := &auth.Claims{UserID: "u1", TenantID: "t1"}
+	revoked, err := uc.NewCheckRevocationUseCase(nil, mockCache).Execute(context.Background(), claims)
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
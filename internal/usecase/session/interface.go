@@ -0,0 +1,24 @@
+package session
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/auth"
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+)
+
+// RevokeTokenUseCaseInterface defines behavior for revoking a single token by its jti.
+type RevokeTokenUseCaseInterface interface {
+	Execute(ctx context.Context, token token_revocation.RevokedToken) error
+}
+
+// RevokeSessionsUseCaseInterface defines behavior for revoking every token of a user or a tenant.
+type RevokeSessionsUseCaseInterface interface {
+	Execute(ctx context.Context, revokedBy string, subjectType token_revocation.SubjectType, tenantId string, subjectId string) error
+}
+
+// CheckRevocationUseCaseInterface defines behavior for checking whether a parsed token was revoked.
+type CheckRevocationUseCaseInterface interface {
+	Execute(ctx context.Context, claims *auth.Claims) (bool, error)
+}
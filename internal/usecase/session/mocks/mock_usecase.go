@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	auth "github.com/Haevnen/audit-logging-api/internal/auth"
+	token_revocation "github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRevokeTokenUseCaseInterface is a mock of RevokeTokenUseCaseInterface interface.
+type MockRevokeTokenUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeTokenUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRevokeTokenUseCaseInterfaceMockRecorder is the mock recorder for MockRevokeTokenUseCaseInterface.
+type MockRevokeTokenUseCaseInterfaceMockRecorder struct {
+	mock *MockRevokeTokenUseCaseInterface
+}
+
+// NewMockRevokeTokenUseCaseInterface creates a new mock instance.
+func NewMockRevokeTokenUseCaseInterface(ctrl *gomock.Controller) *MockRevokeTokenUseCaseInterface {
+	mock := &MockRevokeTokenUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRevokeTokenUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeTokenUseCaseInterface) EXPECT() *MockRevokeTokenUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeTokenUseCaseInterface) Execute(ctx context.Context, token token_revocation.RevokedToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeTokenUseCaseInterfaceMockRecorder) Execute(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeTokenUseCaseInterface)(nil).Execute), ctx, token)
+}
+
+// MockRevokeSessionsUseCaseInterface is a mock of RevokeSessionsUseCaseInterface interface.
+type MockRevokeSessionsUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeSessionsUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRevokeSessionsUseCaseInterfaceMockRecorder is the mock recorder for MockRevokeSessionsUseCaseInterface.
+type MockRevokeSessionsUseCaseInterfaceMockRecorder struct {
+	mock *MockRevokeSessionsUseCaseInterface
+}
+
+// NewMockRevokeSessionsUseCaseInterface creates a new mock instance.
+func NewMockRevokeSessionsUseCaseInterface(ctrl *gomock.Controller) *MockRevokeSessionsUseCaseInterface {
+	mock := &MockRevokeSessionsUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRevokeSessionsUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeSessionsUseCaseInterface) EXPECT() *MockRevokeSessionsUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeSessionsUseCaseInterface) Execute(ctx context.Context, revokedBy string, subjectType token_revocation.SubjectType, tenantId, subjectId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, revokedBy, subjectType, tenantId, subjectId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeSessionsUseCaseInterfaceMockRecorder) Execute(ctx, revokedBy, subjectType, tenantId, subjectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeSessionsUseCaseInterface)(nil).Execute), ctx, revokedBy, subjectType, tenantId, subjectId)
+}
+
+// MockCheckRevocationUseCaseInterface is a mock of CheckRevocationUseCaseInterface interface.
+type MockCheckRevocationUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckRevocationUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCheckRevocationUseCaseInterfaceMockRecorder is the mock recorder for MockCheckRevocationUseCaseInterface.
+type MockCheckRevocationUseCaseInterfaceMockRecorder struct {
+	mock *MockCheckRevocationUseCaseInterface
+}
+
+// NewMockCheckRevocationUseCaseInterface creates a new mock instance.
+func NewMockCheckRevocationUseCaseInterface(ctrl *gomock.Controller) *MockCheckRevocationUseCaseInterface {
+	mock := &MockCheckRevocationUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCheckRevocationUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckRevocationUseCaseInterface) EXPECT() *MockCheckRevocationUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCheckRevocationUseCaseInterface) Execute(ctx context.Context, claims *auth.Claims) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, claims)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCheckRevocationUseCaseInterfaceMockRecorder) Execute(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCheckRevocationUseCaseInterface)(nil).Execute), ctx, claims)
+}
@@ -0,0 +1,41 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+)
+
+type RevokeSessionsUseCase struct {
+	Repo  repository.TokenRevocationRepository
+	Cache service.RevocationCache
+}
+
+func NewRevokeSessionsUseCase(repo repository.TokenRevocationRepository, cache service.RevocationCache) *RevokeSessionsUseCase {
+	return &RevokeSessionsUseCase{Repo: repo, Cache: cache}
+}
+
+// Execute rejects every token of the subject issued up to now, tokens issued afterwards stay valid.
+// tenantId is the tenant of a user subject, empty for a tenant subject.
+func (uc *RevokeSessionsUseCase) Execute(ctx context.Context, revokedBy string, subjectType token_revocation.SubjectType, tenantId string, subjectId string) error {
+	now := time.Now().UTC()
+	revocation := &token_revocation.SessionRevocation{
+		SubjectType:   subjectType,
+		TenantID:      tenantId,
+		SubjectID:     subjectId,
+		RevokedBefore: now,
+		RevokedBy:     revokedBy,
+		UpdatedAt:     now,
+	}
+	if err := uc.Repo.RevokeSessions(ctx, revocation); err != nil {
+		return err
+	}
+
+	if err := uc.Cache.SetRevokedBefore(ctx, subjectType, tenantId, subjectId, now); err != nil {
+		resetCache(ctx, uc.Cache, err)
+	}
+	return nil
+}
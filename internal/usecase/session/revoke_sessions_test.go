@@ -0,0 +1,55 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/session"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	svcMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+)
+
+func TestRevokeSessionsUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTokenRevocationRepository(ctrl)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+
+	var stored time.Time
+	mockRepo.EXPECT().RevokeSessions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s *token_revocation.SessionRevocation) error {
+			assert.Equal(t, token_revocation.SubjectTenant, s.SubjectType)
+			assert.Equal(t, "tenant-1", s.SubjectID)
+			assert.Empty(t, s.TenantID)
+			assert.Equal(t, "admin-1", s.RevokedBy)
+			stored = s.RevokedBefore
+			return nil
+		})
+	mockCache.EXPECT().SetRevokedBefore(gomock.Any(), token_revocation.SubjectTenant, "", "tenant-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ token_revocation.SubjectType, _ string, _ string, before time.Time) error {
+			assert.Equal(t, stored, before)
+			return nil
+		})
+
+	err := uc.NewRevokeSessionsUseCase(mockRepo, mockCache).Execute(context.Background(), "admin-1", token_revocation.SubjectTenant, "", "tenant-1")
+	assert.NoError(t, err)
+}
+
+func TestRevokeSessionsUseCase_Execute_DBError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTokenRevocationRepository(ctrl)
+	mockRepo.EXPECT().RevokeSessions(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+	err := uc.NewRevokeSessionsUseCase(mockRepo, nil).Execute(context.Background(), "admin-1", token_revocation.SubjectUser, "t1", "u1")
+	assert.EqualError(t, err, "db error")
+}
@@ -0,0 +1,41 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+type RevokeTokenUseCase struct {
+	Repo  repository.TokenRevocationRepository
+	Cache service.RevocationCache
+}
+
+func NewRevokeTokenUseCase(repo repository.TokenRevocationRepository, cache service.RevocationCache) *RevokeTokenUseCase {
+	return &RevokeTokenUseCase{Repo: repo, Cache: cache}
+}
+
+// Execute stores the revocation in the database then mirrors it to the cache, revoking twice is a no-op
+func (uc *RevokeTokenUseCase) Execute(ctx context.Context, token token_revocation.RevokedToken) error {
+	token.RevokedAt = time.Now().UTC()
+	if err := uc.Repo.RevokeToken(ctx, &token); err != nil {
+		return err
+	}
+
+	if err := uc.Cache.RevokeToken(ctx, token.JTI, token.ExpiresAt); err != nil {
+		resetCache(ctx, uc.Cache, err)
+	}
+	return nil
+}
+
+// resetCache makes the next check reload from the database, the revocation is already persisted there
+func resetCache(ctx context.Context, cache service.RevocationCache, cause error) {
+	logger.FromContext(ctx).Warning("failed to write revocation to cache: ", cause)
+	if err := cache.ResetLoaded(ctx); err != nil {
+		logger.FromContext(ctx).Warning("failed to reset revocation cache: ", err)
+	}
+}
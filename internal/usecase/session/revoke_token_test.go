@@ -0,0 +1,67 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/token_revocation"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/session"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	svcMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+)
+
+func TestRevokeTokenUseCase_Execute_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTokenRevocationRepository(ctrl)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+	expiresAt := time.Now().Add(time.Hour)
+
+	mockRepo.EXPECT().RevokeToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tok *token_revocation.RevokedToken) error {
+			assert.Equal(t, "jti-1", tok.JTI)
+			assert.False(t, tok.RevokedAt.IsZero())
+			return nil
+		})
+	mockCache.EXPECT().RevokeToken(gomock.Any(), "jti-1", expiresAt).Return(nil)
+
+	err := uc.NewRevokeTokenUseCase(mockRepo, mockCache).Execute(context.Background(), token_revocation.RevokedToken{
+		JTI: "jti-1", UserID: "u1", ExpiresAt: expiresAt, RevokedBy: "u1",
+	})
+	assert.NoError(t, err)
+}
+
+func TestRevokeTokenUseCase_Execute_DBError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTokenRevocationRepository(ctrl)
+	mockRepo.EXPECT().RevokeToken(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+	err := uc.NewRevokeTokenUseCase(mockRepo, nil).Execute(context.Background(), token_revocation.RevokedToken{JTI: "jti-1"})
+	assert.EqualError(t, err, "db error")
+}
+
+func TestRevokeTokenUseCase_Execute_CacheErrorResetsCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTokenRevocationRepository(ctrl)
+	mockCache := svcMocks.NewMockRevocationCache(ctrl)
+
+	mockRepo.EXPECT().RevokeToken(gomock.Any(), gomock.Any()).Return(nil)
+	mockCache.EXPECT().RevokeToken(gomock.Any(), "jti-1", gomock.Any()).Return(errors.New("redis down"))
+	mockCache.EXPECT().ResetLoaded(gomock.Any()).Return(nil)
+
+	err := uc.NewRevokeTokenUseCase(mockRepo, mockCache).Execute(context.Background(), token_revocation.RevokedToken{
+		JTI: "jti-1", ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+}
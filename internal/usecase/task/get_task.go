@@ -0,0 +1,30 @@
+package task
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type GetTaskUseCase struct {
+	Repo repository.AsyncTaskRepository
+}
+
+func NewGetTaskUseCase(repo repository.AsyncTaskRepository) *GetTaskUseCase {
+	return &GetTaskUseCase{Repo: repo}
+}
+
+// Execute returns the task, tenant scoped callers only see the tasks of their tenant
+func (uc *GetTaskUseCase) Execute(ctx context.Context, id, tenantId string) (*async_task.AsyncTask, error) {
+	t, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(tenantId) > 0 && (t.TenantUID == nil || *t.TenantUID != tenantId) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return t, nil
+}
@@ -0,0 +1,42 @@
+package task_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/task"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestGetTaskUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAsyncTaskRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "task-1").Return(&async_task.AsyncTask{TaskID: "task-1", TenantUID: utils.Ptr("t1")}, nil).Times(3)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "task-2").Return(&async_task.AsyncTask{TaskID: "task-2"}, nil)
+
+	ucase := uc.NewGetTaskUseCase(mockRepo)
+	ctx := context.Background()
+
+	got, err := ucase.Execute(ctx, "task-1", "t1")
+	assert.NoError(t, err)
+	assert.Equal(t, "task-1", got.TaskID)
+
+	// admins see every task
+	_, err = ucase.Execute(ctx, "task-1", "")
+	assert.NoError(t, err)
+
+	// other tenants don't see it
+	_, err = ucase.Execute(ctx, "task-1", "t2")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = ucase.Execute(ctx, "task-2", "t2")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
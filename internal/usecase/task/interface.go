@@ -0,0 +1,13 @@
+package task
+
+//go:generate mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+)
+
+// GetTaskUseCaseInterface defines behavior for reading the status and progress of an async task.
+type GetTaskUseCaseInterface interface {
+	Execute(ctx context.Context, id, tenantId string) (*async_task.AsyncTask, error)
+}
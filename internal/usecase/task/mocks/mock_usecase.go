@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=./mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	async_task "github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetTaskUseCaseInterface is a mock of GetTaskUseCaseInterface interface.
+type MockGetTaskUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTaskUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetTaskUseCaseInterfaceMockRecorder is the mock recorder for MockGetTaskUseCaseInterface.
+type MockGetTaskUseCaseInterfaceMockRecorder struct {
+	mock *MockGetTaskUseCaseInterface
+}
+
+// NewMockGetTaskUseCaseInterface creates a new mock instance.
+func NewMockGetTaskUseCaseInterface(ctrl *gomock.Controller) *MockGetTaskUseCaseInterface {
+	mock := &MockGetTaskUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetTaskUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTaskUseCaseInterface) EXPECT() *MockGetTaskUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetTaskUseCaseInterface) Execute(ctx context.Context, id, tenantId string) (*async_task.AsyncTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id, tenantId)
+	ret0, _ := ret[0].(*async_task.AsyncTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetTaskUseCaseInterfaceMockRecorder) Execute(ctx, id, tenantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTaskUseCaseInterface)(nil).Execute), ctx, id, tenantId)
+}
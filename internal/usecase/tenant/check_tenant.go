@@ -0,0 +1,80 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+// statuses are reloaded from the database at most once per interval per tenant, so a suspension
+// takes up to this long to reach every API instance
+const statusCacheTTL = 30 * time.Second
+
+// expired entries are dropped once the cache holds more tenants than this
+const statusCacheSweepSize = 10000
+
+type CheckTenantUseCase struct {
+	Repo repository.TenantRepository
+
+	mu    sync.Mutex
+	cache map[string]cachedStatus
+}
+
+type cachedStatus struct {
+	status   tenant.Status
+	loadedAt time.Time
+}
+
+func NewCheckTenantUseCase(repo repository.TenantRepository) *CheckTenantUseCase {
+	return &CheckTenantUseCase{Repo: repo, cache: map[string]cachedStatus{}}
+}
+
+// Execute returns ErrTenantInactive when the tenant is suspended, being deleted or gone
+func (uc *CheckTenantUseCase) Execute(ctx context.Context, id string) error {
+	status, err := uc.getStatus(ctx, id)
+	if err != nil {
+		return err
+	}
+	if status != tenant.StatusActive {
+		return ErrTenantInactive
+	}
+	return nil
+}
+
+func (uc *CheckTenantUseCase) getStatus(ctx context.Context, id string) (tenant.Status, error) {
+	uc.mu.Lock()
+	cached, ok := uc.cache[id]
+	uc.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < statusCacheTTL {
+		return cached.status, nil
+	}
+
+	var status tenant.Status
+	t, err := uc.Repo.GetByID(ctx, id)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		// a deleted tenant is cached like a suspended one
+	case err != nil:
+		return "", err
+	default:
+		status = t.Status
+	}
+
+	uc.mu.Lock()
+	if len(uc.cache) >= statusCacheSweepSize {
+		for k, v := range uc.cache {
+			if time.Since(v.loadedAt) >= statusCacheTTL {
+				delete(uc.cache, k)
+			}
+		}
+	}
+	uc.cache[id] = cachedStatus{status: status, loadedAt: time.Now()}
+	uc.mu.Unlock()
+	return status, nil
+}
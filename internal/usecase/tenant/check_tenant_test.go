@@ -0,0 +1,46 @@
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestCheckTenantUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantRepository(ctrl)
+	ucase := uc.NewCheckTenantUseCase(mockRepo)
+	ctx := context.Background()
+
+	// statuses are cached, each tenant is loaded once
+	mockRepo.EXPECT().GetByID(gomock.Any(), "active").Return(&entitytenant.Tenant{ID: "active", Status: entitytenant.StatusActive}, nil).Times(1)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "suspended").Return(&entitytenant.Tenant{ID: "suspended", Status: entitytenant.StatusSuspended}, nil).Times(1)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "deleted").Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, ucase.Execute(ctx, "active"))
+		assert.ErrorIs(t, ucase.Execute(ctx, "suspended"), uc.ErrTenantInactive)
+		assert.ErrorIs(t, ucase.Execute(ctx, "deleted"), uc.ErrTenantInactive)
+	}
+}
+
+func TestCheckTenantUseCase_Execute_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(nil, assert.AnError)
+
+	err := uc.NewCheckTenantUseCase(mockRepo).Execute(context.Background(), "t1")
+	assert.ErrorIs(t, err, assert.AnError)
+}
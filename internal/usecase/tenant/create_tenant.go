@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 
+	"github.com/Haevnen/audit-logging-api/internal/audit"
 	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
 	"github.com/Haevnen/audit-logging-api/internal/repository"
 )
@@ -19,8 +21,11 @@ func NewCreateTenantUseCase(repo repository.TenantRepository) *CreateTenantUseCa
 
 func (uc *CreateTenantUseCase) Execute(ctx context.Context, name string) (*tenant.Tenant, error) {
 	t := &tenant.Tenant{
-		ID:   uuid.New().String(),
-		Name: name,
+		ID:       uuid.New().String(),
+		Name:     name,
+		Status:   tenant.StatusActive,
+		Settings: datatypes.JSONMap{},
 	}
+	audit.Annotate(ctx, audit.KeyTenantID, t.ID)
 	return uc.Repo.Create(ctx, t)
 }
@@ -0,0 +1,64 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+)
+
+type DeleteTenantUseCase struct {
+	Repo      repository.TenantRepository
+	TaskRepo  repository.AsyncTaskRepository
+	Queue     service.SQSPublisher
+	TxManager interactor.TxManager
+}
+
+func NewDeleteTenantUseCase(repo repository.TenantRepository, taskRepo repository.AsyncTaskRepository, queue service.SQSPublisher, txManager interactor.TxManager) *DeleteTenantUseCase {
+	return &DeleteTenantUseCase{Repo: repo, TaskRepo: taskRepo, Queue: queue, TxManager: txManager}
+}
+
+// Execute marks the tenant as deleting, which locks it out right away, and queues the task removing
+// its data. Deleting a tenant already being deleted queues a new task, e.g. to retry a failed one.
+func (uc *DeleteTenantUseCase) Execute(ctx context.Context, id, userId string) (*async_task.AsyncTask, error) {
+	audit.Annotate(ctx, audit.KeyTenantID, id)
+
+	t, err := getTenant(ctx, uc.Repo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task := &async_task.AsyncTask{
+		TaskID:    uuid.New().String(),
+		Status:    async_task.StatusPending,
+		TaskType:  async_task.TaskTenantDeletion,
+		TenantUID: &t.ID,
+		UserID:    userId,
+	}
+	err = uc.TxManager.TransactionExec(ctx, func(txCtx context.Context) error {
+		db := uc.TxManager.GetTx(txCtx)
+
+		t.Status = tenant.StatusDeleting
+		t.UpdatedAt = time.Now().UTC()
+		if err := uc.Repo.Update(txCtx, db, t); err != nil {
+			return err
+		}
+		if _, err := uc.TaskRepo.Create(txCtx, db, task); err != nil {
+			return err
+		}
+		return uc.Queue.PublishTenantDeletionMessage(txCtx, task.TaskID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.Annotate(ctx, audit.KeyTaskID, task.TaskID)
+	return task, nil
+}
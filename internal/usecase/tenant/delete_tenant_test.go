@@ -0,0 +1,77 @@
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+
+	interactorMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+)
+
+func newDeleteTenantUseCase(ctrl *gomock.Controller) (*uc.DeleteTenantUseCase, *repoMocks.MockTenantRepository, *repoMocks.MockAsyncTaskRepository, *serviceMocks.MockSQSPublisher) {
+	repo := repoMocks.NewMockTenantRepository(ctrl)
+	taskRepo := repoMocks.NewMockAsyncTaskRepository(ctrl)
+	queue := serviceMocks.NewMockSQSPublisher(ctrl)
+	tx := interactorMocks.NewMockTxManager(ctrl)
+	tx.EXPECT().TransactionExec(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+	tx.EXPECT().GetTx(gomock.Any()).Return(nil).AnyTimes()
+
+	return uc.NewDeleteTenantUseCase(repo, taskRepo, queue, tx), repo, taskRepo, queue
+}
+
+func TestDeleteTenantUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucase, repo, taskRepo, queue := newDeleteTenantUseCase(ctrl)
+
+	repo.EXPECT().GetByID(gomock.Any(), "t1").Return(&entitytenant.Tenant{ID: "t1", Status: entitytenant.StatusActive}, nil)
+	repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ interface{}, tn *entitytenant.Tenant) error {
+			assert.Equal(t, entitytenant.StatusDeleting, tn.Status)
+			return nil
+		})
+	taskRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ interface{}, task *async_task.AsyncTask) (*async_task.AsyncTask, error) {
+			assert.Equal(t, async_task.TaskTenantDeletion, task.TaskType)
+			assert.Equal(t, async_task.StatusPending, task.Status)
+			assert.Equal(t, "t1", *task.TenantUID)
+			assert.Equal(t, "admin-1", task.UserID)
+			return task, nil
+		})
+	queue.EXPECT().PublishTenantDeletionMessage(gomock.Any(), gomock.Any()).Return(nil)
+
+	task, err := ucase.Execute(context.Background(), "t1", "admin-1")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, task.TaskID)
+}
+
+func TestDeleteTenantUseCase_Execute_PublishFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucase, repo, taskRepo, queue := newDeleteTenantUseCase(ctrl)
+
+	repo.EXPECT().GetByID(gomock.Any(), "t1").Return(&entitytenant.Tenant{ID: "t1", Status: entitytenant.StatusDeleting}, nil)
+	repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	taskRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ interface{}, task *async_task.AsyncTask) (*async_task.AsyncTask, error) {
+			return task, nil
+		})
+	queue.EXPECT().PublishTenantDeletionMessage(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+	task, err := ucase.Execute(context.Background(), "t1", "admin-1")
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, task)
+}
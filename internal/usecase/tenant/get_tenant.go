@@ -0,0 +1,20 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+type GetTenantUseCase struct {
+	Repo repository.TenantRepository
+}
+
+func NewGetTenantUseCase(repo repository.TenantRepository) *GetTenantUseCase {
+	return &GetTenantUseCase{Repo: repo}
+}
+
+func (uc *GetTenantUseCase) Execute(ctx context.Context, id string) (*tenant.Tenant, error) {
+	return getTenant(ctx, uc.Repo, id)
+}
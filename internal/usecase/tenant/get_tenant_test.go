@@ -0,0 +1,32 @@
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestGetTenantUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(&entitytenant.Tenant{ID: "t1"}, nil)
+
+	ucase := uc.NewGetTenantUseCase(mockRepo)
+	got, err := ucase.Execute(context.Background(), "t1")
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", got.ID)
+
+	// the system tenant is never looked up
+	_, err = ucase.Execute(context.Background(), entitytenant.SystemTenantID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
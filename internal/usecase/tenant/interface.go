@@ -4,6 +4,7 @@ package tenant
 import (
 	"context"
 
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
 )
 
@@ -16,3 +17,23 @@ type CreateTenantUseCaseInterface interface {
 type ListTenantsUseCaseInterface interface {
 	Execute(ctx context.Context) ([]entitytenant.Tenant, error)
 }
+
+// GetTenantUseCaseInterface defines behavior for reading a tenant.
+type GetTenantUseCaseInterface interface {
+	Execute(ctx context.Context, id string) (*entitytenant.Tenant, error)
+}
+
+// UpdateTenantUseCaseInterface defines behavior for renaming, suspending or configuring a tenant.
+type UpdateTenantUseCaseInterface interface {
+	Execute(ctx context.Context, id string, input UpdateTenantInput) (*entitytenant.Tenant, error)
+}
+
+// DeleteTenantUseCaseInterface defines behavior for queuing the deletion of a tenant.
+type DeleteTenantUseCaseInterface interface {
+	Execute(ctx context.Context, id, userId string) (*async_task.AsyncTask, error)
+}
+
+// CheckTenantUseCaseInterface defines behavior for rejecting suspended or deleted tenants.
+type CheckTenantUseCaseInterface interface {
+	Execute(ctx context.Context, id string) error
+}
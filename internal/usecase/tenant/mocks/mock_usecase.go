@@ -13,7 +13,9 @@ import (
 	context "context"
 	reflect "reflect"
 
+	async_task "github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	tenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	tenant0 "github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -94,3 +96,158 @@ func (mr *MockListTenantsUseCaseInterfaceMockRecorder) Execute(ctx any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListTenantsUseCaseInterface)(nil).Execute), ctx)
 }
+
+// MockGetTenantUseCaseInterface is a mock of GetTenantUseCaseInterface interface.
+type MockGetTenantUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTenantUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGetTenantUseCaseInterfaceMockRecorder is the mock recorder for MockGetTenantUseCaseInterface.
+type MockGetTenantUseCaseInterfaceMockRecorder struct {
+	mock *MockGetTenantUseCaseInterface
+}
+
+// NewMockGetTenantUseCaseInterface creates a new mock instance.
+func NewMockGetTenantUseCaseInterface(ctrl *gomock.Controller) *MockGetTenantUseCaseInterface {
+	mock := &MockGetTenantUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockGetTenantUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTenantUseCaseInterface) EXPECT() *MockGetTenantUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetTenantUseCaseInterface) Execute(ctx context.Context, id string) (*tenant.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id)
+	ret0, _ := ret[0].(*tenant.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetTenantUseCaseInterfaceMockRecorder) Execute(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTenantUseCaseInterface)(nil).Execute), ctx, id)
+}
+
+// MockUpdateTenantUseCaseInterface is a mock of UpdateTenantUseCaseInterface interface.
+type MockUpdateTenantUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateTenantUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateTenantUseCaseInterfaceMockRecorder is the mock recorder for MockUpdateTenantUseCaseInterface.
+type MockUpdateTenantUseCaseInterfaceMockRecorder struct {
+	mock *MockUpdateTenantUseCaseInterface
+}
+
+// NewMockUpdateTenantUseCaseInterface creates a new mock instance.
+func NewMockUpdateTenantUseCaseInterface(ctrl *gomock.Controller) *MockUpdateTenantUseCaseInterface {
+	mock := &MockUpdateTenantUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockUpdateTenantUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateTenantUseCaseInterface) EXPECT() *MockUpdateTenantUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateTenantUseCaseInterface) Execute(ctx context.Context, id string, input tenant0.UpdateTenantInput) (*tenant.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id, input)
+	ret0, _ := ret[0].(*tenant.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateTenantUseCaseInterfaceMockRecorder) Execute(ctx, id, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateTenantUseCaseInterface)(nil).Execute), ctx, id, input)
+}
+
+// MockDeleteTenantUseCaseInterface is a mock of DeleteTenantUseCaseInterface interface.
+type MockDeleteTenantUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteTenantUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteTenantUseCaseInterfaceMockRecorder is the mock recorder for MockDeleteTenantUseCaseInterface.
+type MockDeleteTenantUseCaseInterfaceMockRecorder struct {
+	mock *MockDeleteTenantUseCaseInterface
+}
+
+// NewMockDeleteTenantUseCaseInterface creates a new mock instance.
+func NewMockDeleteTenantUseCaseInterface(ctrl *gomock.Controller) *MockDeleteTenantUseCaseInterface {
+	mock := &MockDeleteTenantUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteTenantUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteTenantUseCaseInterface) EXPECT() *MockDeleteTenantUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteTenantUseCaseInterface) Execute(ctx context.Context, id, userId string) (*async_task.AsyncTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id, userId)
+	ret0, _ := ret[0].(*async_task.AsyncTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteTenantUseCaseInterfaceMockRecorder) Execute(ctx, id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteTenantUseCaseInterface)(nil).Execute), ctx, id, userId)
+}
+
+// MockCheckTenantUseCaseInterface is a mock of CheckTenantUseCaseInterface interface.
+type MockCheckTenantUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckTenantUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCheckTenantUseCaseInterfaceMockRecorder is the mock recorder for MockCheckTenantUseCaseInterface.
+type MockCheckTenantUseCaseInterfaceMockRecorder struct {
+	mock *MockCheckTenantUseCaseInterface
+}
+
+// NewMockCheckTenantUseCaseInterface creates a new mock instance.
+func NewMockCheckTenantUseCaseInterface(ctrl *gomock.Controller) *MockCheckTenantUseCaseInterface {
+	mock := &MockCheckTenantUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCheckTenantUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckTenantUseCaseInterface) EXPECT() *MockCheckTenantUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCheckTenantUseCaseInterface) Execute(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCheckTenantUseCaseInterfaceMockRecorder) Execute(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCheckTenantUseCaseInterface)(nil).Execute), ctx, id)
+}
@@ -0,0 +1,25 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+var (
+	ErrInvalidStatus  = errors.New("status must be active or suspended")
+	ErrTenantDeleting = errors.New("tenant is being deleted")
+	ErrTenantInactive = errors.New("tenant is suspended or deleted")
+)
+
+// getTenant hides the reserved system tenant, it can't be read, changed nor deleted over the API
+func getTenant(ctx context.Context, repo repository.TenantRepository, id string) (*tenant.Tenant, error) {
+	if id == tenant.SystemTenantID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return repo.GetByID(ctx, id)
+}
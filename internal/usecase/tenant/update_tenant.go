@@ -0,0 +1,66 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/audit"
+	"github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+)
+
+// UpdateTenantInput holds the fields to change, nil fields are kept
+type UpdateTenantInput struct {
+	Name   *string
+	Status *tenant.Status
+	// Settings are merged into the stored ones, a nil value removes the key
+	Settings map[string]interface{}
+}
+
+type UpdateTenantUseCase struct {
+	Repo repository.TenantRepository
+}
+
+func NewUpdateTenantUseCase(repo repository.TenantRepository) *UpdateTenantUseCase {
+	return &UpdateTenantUseCase{Repo: repo}
+}
+
+// Execute renames, suspends, reactivates or changes the settings of a tenant. A tenant being deleted
+// can't be changed anymore and the deleting status is only set by the deletion.
+func (uc *UpdateTenantUseCase) Execute(ctx context.Context, id string, input UpdateTenantInput) (*tenant.Tenant, error) {
+	audit.Annotate(ctx, audit.KeyTenantID, id)
+
+	t, err := getTenant(ctx, uc.Repo, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status == tenant.StatusDeleting {
+		return nil, ErrTenantDeleting
+	}
+
+	if input.Name != nil {
+		t.Name = *input.Name
+	}
+	if input.Status != nil {
+		if *input.Status != tenant.StatusActive && *input.Status != tenant.StatusSuspended {
+			return nil, ErrInvalidStatus
+		}
+		t.Status = *input.Status
+	}
+	if t.Settings == nil {
+		t.Settings = map[string]interface{}{}
+	}
+	for k, v := range input.Settings {
+		if v == nil {
+			delete(t.Settings, k)
+			continue
+		}
+		t.Settings[k] = v
+	}
+
+	t.UpdatedAt = time.Now().UTC()
+	if err := uc.Repo.Update(ctx, nil, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
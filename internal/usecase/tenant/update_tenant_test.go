@@ -0,0 +1,57 @@
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
+
+	entitytenant "github.com/Haevnen/audit-logging-api/internal/entity/tenant"
+	uc "github.com/Haevnen/audit-logging-api/internal/usecase/tenant"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+)
+
+func TestUpdateTenantUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(&entitytenant.Tenant{
+		ID: "t1", Name: "old", Status: entitytenant.StatusActive,
+		Settings: datatypes.JSONMap{"retention_days": 90, "legacy": true},
+	}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Nil(), gomock.Any()).Return(nil)
+
+	got, err := uc.NewUpdateTenantUseCase(mockRepo).Execute(context.Background(), "t1", uc.UpdateTenantInput{
+		Name:     utils.Ptr("new"),
+		Status:   utils.Ptr(entitytenant.StatusSuspended),
+		Settings: map[string]interface{}{"retention_days": 30, "legacy": nil},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "new", got.Name)
+	assert.Equal(t, entitytenant.StatusSuspended, got.Status)
+	assert.Equal(t, datatypes.JSONMap{"retention_days": 30}, got.Settings)
+	assert.False(t, got.UpdatedAt.IsZero())
+}
+
+func TestUpdateTenantUseCase_Execute_Rejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTenantRepository(ctrl)
+	ucase := uc.NewUpdateTenantUseCase(mockRepo)
+
+	// the deleting status is only set by the deletion
+	mockRepo.EXPECT().GetByID(gomock.Any(), "t1").Return(&entitytenant.Tenant{ID: "t1", Status: entitytenant.StatusActive}, nil)
+	_, err := ucase.Execute(context.Background(), "t1", uc.UpdateTenantInput{Status: utils.Ptr(entitytenant.StatusDeleting)})
+	assert.ErrorIs(t, err, uc.ErrInvalidStatus)
+
+	// and can't be undone
+	mockRepo.EXPECT().GetByID(gomock.Any(), "t2").Return(&entitytenant.Tenant{ID: "t2", Status: entitytenant.StatusDeleting}, nil)
+	_, err = ucase.Execute(context.Background(), "t2", uc.UpdateTenantInput{Status: utils.Ptr(entitytenant.StatusActive)})
+	assert.ErrorIs(t, err, uc.ErrTenantDeleting)
+}
@@ -3,7 +3,6 @@ package worker
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 
@@ -43,34 +42,11 @@ func NewArchiveWorker(
 }
 
 func (w *ArchiveWorker) Start(ctx context.Context) {
-	logger := logger.GetLogger()
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("shutting down archive worker")
-			return
-		default:
-			// Long poll for archival tasks
-			msgs, err := w.sqsClient.ReceiveMessages(ctx, w.archiveQueue, 5, 20)
-			if err != nil {
-				logger.Warning("failed to receive", err)
-				time.Sleep(2 * time.Second)
-				continue
-			}
-
-			for _, m := range msgs {
-				if err := w.HandleMessage(ctx, m); err != nil {
-					logger.Warning("failed to handle message", err)
-				}
-				// Always delete to avoid retries storm
-				_ = w.sqsClient.DeleteMessage(ctx, w.archiveQueue, m.ReceiveHandle)
-			}
-		}
-	}
+	consume(ctx, w.sqsClient, w.archiveQueue, async_task.TaskArchive, w)
 }
 
 func (w *ArchiveWorker) HandleMessage(ctx context.Context, msg service.ReceiveMessage) error {
-	logger := logger.GetLogger()
+	logger := logger.FromContext(ctx)
 	taskId, beforeDate := msg.Message.ID, msg.Message.BeforeDate
 	logger.WithFields(map[string]interface{}{
 		"taskId":     taskId,
@@ -83,19 +59,11 @@ func (w *ArchiveWorker) HandleMessage(ctx context.Context, msg service.ReceiveMe
 		return fmt.Errorf("task fetch failed: %w", err)
 	}
 
-	if task.Status != async_task.StatusPending {
-		logger.WithFields(map[string]interface{}{
-			"taskId": taskId,
-			"status": task.Status,
-		}).Info("Already processed")
-		return nil
-	}
-
-	// Update -> RUNNING
-	if err := w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusRunning, nil); err != nil {
-		logger.WithField("error", err).Error("status update failed")
-		return fmt.Errorf("status update failed: %w", err)
+	claimed, err := claimTask(ctx, w.taskRepo, taskId, task.Status)
+	if !claimed {
+		return err
 	}
+	defer holdTask(ctx, w.taskRepo, taskId)()
 
 	// Query logs to archive (your repo should accept filters from task.Payload)
 	logs, err := w.logRepo.FindLogsForArchival(ctx, task.TenantUID, *beforeDate)
@@ -42,8 +42,8 @@ func TestArchiveWorker_Start(t *testing.T) {
 		Return(&async_task.AsyncTask{TaskID: "t1", Status: async_task.StatusPending}, nil).
 		AnyTimes()
 
-	taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "t1", async_task.StatusRunning, nil).
-		Return(nil).AnyTimes()
+	taskRepo.EXPECT().Claim(gomock.Any(), "t1", gomock.Any()).
+		Return(true, nil).AnyTimes()
 
 	logRepo.EXPECT().FindLogsForArchival(gomock.Any(), nil, gomock.Any()).
 		Return(nil, nil).AnyTimes()
@@ -72,8 +72,8 @@ func TestArchiveWorker_Start(t *testing.T) {
 		Return(nil).AnyTimes()
 
 	// Add both expectations
-	taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "t1", async_task.StatusRunning, nil).
-		Return(nil).AnyTimes()
+	taskRepo.EXPECT().Claim(gomock.Any(), "t1", gomock.Any()).
+		Return(true, nil).AnyTimes()
 	taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "t1", async_task.StatusSucceeded, nil).
 		Return(nil).AnyTimes()
 
@@ -107,7 +107,7 @@ func TestHandleMessage_Success(t *testing.T) {
 
 	// expectations
 	mockTaskRepo.EXPECT().GetByID(gomock.Any(), taskID).Return(task, nil)
-	mockTaskRepo.EXPECT().UpdateStatus(gomock.Any(), nil, taskID, async_task.StatusRunning, nil).Return(nil)
+	mockTaskRepo.EXPECT().Claim(gomock.Any(), taskID, gomock.Any()).Return(true, nil)
 	mockLogRepo.EXPECT().FindLogsForArchival(gomock.Any(), task.TenantUID, before).Return([]log.Log{{ID: "l1"}}, nil)
 	mockS3.EXPECT().UploadLogs(gomock.Any(), taskID, gomock.Any()).Return(nil)
 
@@ -160,7 +160,7 @@ func TestHandleMessage_LogQueryError(t *testing.T) {
 	task := &async_task.AsyncTask{TaskID: taskID, Status: async_task.StatusPending, TenantUID: &tenant}
 
 	mockTaskRepo.EXPECT().GetByID(gomock.Any(), taskID).Return(task, nil)
-	mockTaskRepo.EXPECT().UpdateStatus(gomock.Any(), nil, taskID, async_task.StatusRunning, nil).Return(nil)
+	mockTaskRepo.EXPECT().Claim(gomock.Any(), taskID, gomock.Any()).Return(true, nil)
 	mockLogRepo.EXPECT().FindLogsForArchival(gomock.Any(), task.TenantUID, before).Return(nil, errors.New("query fail"))
 	mockTaskRepo.EXPECT().UpdateStatus(gomock.Any(), nil, taskID, async_task.StatusFailed, gomock.Any()).Return(nil)
 
@@ -186,7 +186,7 @@ func TestHandleMessage_S3UploadError(t *testing.T) {
 	task := &async_task.AsyncTask{TaskID: taskID, Status: async_task.StatusPending, TenantUID: &tenant}
 
 	mockTaskRepo.EXPECT().GetByID(gomock.Any(), taskID).Return(task, nil)
-	mockTaskRepo.EXPECT().UpdateStatus(gomock.Any(), nil, taskID, async_task.StatusRunning, nil).Return(nil)
+	mockTaskRepo.EXPECT().Claim(gomock.Any(), taskID, gomock.Any()).Return(true, nil)
 	mockLogRepo.EXPECT().FindLogsForArchival(gomock.Any(), task.TenantUID, before).Return([]log.Log{}, nil)
 	mockS3.EXPECT().UploadLogs(gomock.Any(), taskID, gomock.Any()).Return(errors.New("s3 error"))
 	mockTaskRepo.EXPECT().UpdateStatus(gomock.Any(), nil, taskID, async_task.StatusFailed, gomock.Any()).Return(nil)
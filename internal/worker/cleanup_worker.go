@@ -3,7 +3,6 @@ package worker
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	"github.com/Haevnen/audit-logging-api/internal/interactor"
@@ -41,33 +40,11 @@ func NewCleanUpWorker(
 }
 
 func (w *CleanUpWorker) Start(ctx context.Context) {
-	logger := logger.GetLogger()
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("shutting down cleanup worker")
-			return
-		default:
-			msgs, err := w.sqsClient.ReceiveMessages(ctx, w.cleanupQueue, 5, 20)
-			if err != nil {
-				logger.Warning("failed to receive cleanup messages", err)
-				time.Sleep(2 * time.Second)
-				continue
-			}
-
-			for _, m := range msgs {
-				if err := w.HandleMessage(ctx, m); err != nil {
-					logger.Warning("failed to handle cleanup message", err)
-				}
-				// Always delete to avoid retries storm
-				_ = w.sqsClient.DeleteMessage(ctx, w.cleanupQueue, m.ReceiveHandle)
-			}
-		}
-	}
+	consume(ctx, w.sqsClient, w.cleanupQueue, async_task.TaskLogCleanup, w)
 }
 
 func (w *CleanUpWorker) HandleMessage(ctx context.Context, msg service.ReceiveMessage) error {
-	log := logger.GetLogger()
+	log := logger.FromContext(ctx)
 	taskId, beforeDate := msg.Message.ID, msg.Message.BeforeDate
 	log.WithFields(map[string]interface{}{
 		"taskId":     taskId,
@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"path"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/health"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
+)
+
+// MessageHandler handles the messages of one queue
+type MessageHandler interface {
+	HandleMessage(ctx context.Context, msg service.ReceiveMessage) error
+}
+
+// heartbeats records the last successful poll of every worker loop of the process, and keeps beating while a
+// loop handles a message
+var heartbeats = health.NewHeartbeats()
+
+// heartbeatInterval is how often a loop beats while one of its messages is handled, tasks run for longer than
+// any sensible staleAfter
+const heartbeatInterval = 10 * time.Second
+
+// Liveness fails the worker loops that haven't polled their queue for staleAfter, e.g. stuck receiving messages
+func Liveness(staleAfter time.Duration) health.Report {
+	return heartbeats.Report(staleAfter)
+}
+
+// consume long polls the queue until ctx is done. Every message is handled then deleted, the outcome and
+// duration of each task are recorded under taskType.
+func consume(ctx context.Context, sqsClient service.SQSPublisher, queue string, taskType async_task.AsyncTaskType, h MessageHandler) {
+	logger := logger.GetLogger().WithField("worker", taskType)
+	heartbeats.Beat(string(taskType))
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("shutting down worker")
+			return
+		default:
+			msgs, err := sqsClient.ReceiveMessages(ctx, queue, 5, 20)
+			if err != nil {
+				logger.Warning("failed to receive messages", err)
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			heartbeats.Beat(string(taskType))
+
+			for _, m := range msgs {
+				handle(ctx, sqsClient, queue, taskType, h, m)
+			}
+		}
+	}
+}
+
+// handle processes one message in a span continuing the trace of the request that sent it, its log lines
+// carry the id of that request and of the task
+func handle(ctx context.Context, sqsClient service.SQSPublisher, queue string, taskType async_task.AsyncTaskType, h MessageHandler, m service.ReceiveMessage) {
+	ctx = logger.With(ctx, logger.KeyRequestID, m.RequestID)
+	ctx = logger.With(ctx, logger.KeyTaskID, m.Message.ID)
+	ctx, span := tracing.Start(tracing.Extract(ctx, m.TraceContext), "process "+string(taskType),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "aws_sqs"),
+			attribute.String("messaging.destination.name", path.Base(queue)),
+			attribute.String("messaging.message.id", m.Message.ID),
+		))
+
+	start := time.Now()
+	stop := beatWhile(taskType)
+	err := h.HandleMessage(ctx, m)
+	stop()
+	if errors.Is(err, errTaskHeld) {
+		tracing.End(span, nil)
+		logger.FromContext(ctx).WithField("worker", taskType).Info("task is running on another worker, leaving its message on the queue")
+		return
+	}
+	metrics.ObserveTask(string(taskType), start, err)
+	tracing.End(span, err)
+	if err != nil {
+		logger.FromContext(ctx).WithField("worker", taskType).WithField("error", err).Warn("failed to handle message")
+	}
+
+	// Always delete to avoid retries storm
+	_ = sqsClient.DeleteMessage(ctx, queue, m.ReceiveHandle)
+}
+
+// beatWhile beats for the loop until the returned function is called
+func beatWhile(taskType async_task.AsyncTaskType) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				heartbeats.Beat(string(taskType))
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
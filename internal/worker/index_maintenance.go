@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+// IndexMaintenance rolls the write index of the logs over once it is older than rolloverAge and drops the
+// indices whose logs are past retention, every interval. Replicas running it at once are harmless: a rollover
+// checks the age of the index it replaces and deleting an index twice deletes nothing.
+type IndexMaintenance struct {
+	indexes     service.SearchIndexManager
+	rolloverAge time.Duration
+	retention   time.Duration
+	interval    time.Duration
+}
+
+func NewIndexMaintenance(indexes service.SearchIndexManager, rolloverAge, retention, interval time.Duration) *IndexMaintenance {
+	return &IndexMaintenance{
+		indexes:     indexes,
+		rolloverAge: rolloverAge,
+		retention:   retention,
+		interval:    interval,
+	}
+}
+
+func (m *IndexMaintenance) Start(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Run(ctx); err != nil {
+			logger.FromContext(ctx).WithField("error", err).Error("index maintenance failed")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run rolls over then drops the expired indices, nothing is dropped without retention
+func (m *IndexMaintenance) Run(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+
+	index, err := m.indexes.Rollover(ctx, m.rolloverAge)
+	if err != nil {
+		return err
+	}
+	if len(index) > 0 {
+		log.WithField("index", index).Info("logs index rolled over")
+	}
+
+	if m.retention <= 0 {
+		return nil
+	}
+	dropped, err := m.indexes.DropExpired(ctx, m.retention)
+	if err != nil {
+		return fmt.Errorf("drop expired indices: %w", err)
+	}
+	if len(dropped) > 0 {
+		log.WithField("indices", dropped).Info("expired logs indices dropped")
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+// rebuildPartitionSpan is the span of event timestamps of the logs of a tenant backfilled at once
+const rebuildPartitionSpan = 24 * time.Hour
+
+// IndexRebuildWorker rebuilds the search index into service.LogsIndexVersion without downtime. The new index
+// is created behind the rebuild alias and, once every publisher writes the logs to it as well, the logs already
+// stored are backfilled from Postgres a tenant and a day at a time, concurrency partitions at once. The read and
+// write aliases then move to the new index in one step and the indices of the previous version are dropped.
+type IndexRebuildWorker struct {
+	sqsClient service.SQSPublisher
+	taskRepo  repository.AsyncTaskRepository
+	logRepo   repository.LogRepository
+	indexes   service.SearchIndexManager
+	// writes through the rebuild alias
+	openSearch   service.OpenSearchPublisher
+	rebuildQueue string
+	concurrency  int
+	// logs older than retention are left out, zero keeps them all
+	retention time.Duration
+	// how long the publishers take to notice the rebuild, see service.RebuildCheckInterval
+	settle time.Duration
+}
+
+func NewIndexRebuildWorker(
+	sqsClient service.SQSPublisher,
+	taskRepo repository.AsyncTaskRepository,
+	logRepo repository.LogRepository,
+	indexes service.SearchIndexManager,
+	openSearch service.OpenSearchPublisher,
+	rebuildQueue string,
+	concurrency int,
+	retention time.Duration,
+	settle time.Duration,
+) *IndexRebuildWorker {
+	return &IndexRebuildWorker{
+		sqsClient:    sqsClient,
+		taskRepo:     taskRepo,
+		logRepo:      logRepo,
+		indexes:      indexes,
+		openSearch:   openSearch,
+		rebuildQueue: rebuildQueue,
+		concurrency:  max(concurrency, 1),
+		retention:    retention,
+		settle:       settle,
+	}
+}
+
+func (w *IndexRebuildWorker) Start(ctx context.Context) {
+	consume(ctx, w.sqsClient, w.rebuildQueue, async_task.TaskIndexRebuild, w)
+}
+
+func (w *IndexRebuildWorker) HandleMessage(ctx context.Context, msg service.ReceiveMessage) error {
+	logger := logger.FromContext(ctx)
+	taskId := msg.Message.ID
+	logger.WithField("taskId", taskId).Info("received message")
+
+	task, err := w.taskRepo.GetByID(ctx, taskId)
+	if err != nil {
+		return fmt.Errorf("task fetch failed: %w", err)
+	}
+
+	claimed, err := claimTask(ctx, w.taskRepo, taskId, task.Status)
+	if !claimed {
+		return err
+	}
+	defer holdTask(ctx, w.taskRepo, taskId)()
+
+	// a task queued by a replica of another release builds another mapping
+	var payload async_task.IndexRebuildPayload
+	if task.Payload == nil || json.Unmarshal(*task.Payload, &payload) != nil || payload.Version != service.LogsIndexVersion {
+		errMsg := fmt.Sprintf("task builds version %d, this worker builds version %d", payload.Version, service.LogsIndexVersion)
+		_ = w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusFailed, &errMsg)
+		return fmt.Errorf("task %s: %s", taskId, errMsg)
+	}
+
+	// a rebuild taken over from a gone worker starts over unless it switched already, the index it was
+	// building is dropped
+	var previous async_task.IndexRebuildProgress
+	if task.Progress != nil && json.Unmarshal(*task.Progress, &previous) == nil && len(previous.Index) > 0 {
+		if previous.Switched {
+			return w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusSucceeded, nil)
+		}
+		if err := w.indexes.AbortRebuild(ctx, previous.Index); err != nil {
+			logger.WithField("error", err).Warn("failed to drop the index of the rebuild")
+		}
+	}
+
+	progress := async_task.IndexRebuildProgress{}
+	if err := w.run(ctx, taskId, &progress); err != nil {
+		// the logs stop being written to the index of a failed rebuild, the next one starts over
+		if len(progress.Index) > 0 && !progress.Switched {
+			if abortErr := w.indexes.AbortRebuild(context.WithoutCancel(ctx), progress.Index); abortErr != nil {
+				logger.WithField("error", abortErr).Warn("failed to drop the index of the rebuild")
+			}
+		}
+		_ = w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusFailed, utils.Ptr(err.Error()))
+		return err
+	}
+
+	if err := w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusSucceeded, nil); err != nil {
+		return fmt.Errorf("final status update failed: %w", err)
+	}
+	logger.WithFields(map[string]interface{}{
+		"taskId":  taskId,
+		"index":   progress.Index,
+		"logs":    progress.IndexedLogs,
+		"dropped": progress.DroppedIndices,
+	}).Info("Index rebuild succeeded")
+	return nil
+}
+
+func (w *IndexRebuildWorker) run(ctx context.Context, taskId string, progress *async_task.IndexRebuildProgress) error {
+	index, err := w.indexes.StartRebuild(ctx)
+	if err != nil {
+		return fmt.Errorf("start rebuild: %w", err)
+	}
+	progress.Index = index
+	if err := w.saveProgress(ctx, taskId, progress); err != nil {
+		return err
+	}
+
+	// a log stored from now on is written to the new index by its index task, the ones stored before are
+	// backfilled whatever their event timestamp
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(w.settle):
+	}
+
+	var startTime time.Time
+	if w.retention > 0 {
+		startTime = time.Now().UTC().Add(-w.retention)
+	}
+	spans, err := w.logRepo.ListTenantSpans(ctx, startTime)
+	if err != nil {
+		return fmt.Errorf("log query failed: %w", err)
+	}
+	partitions := rebuildPartitions(spans)
+	progress.Partitions = len(partitions)
+	for _, span := range spans {
+		progress.TotalLogs += span.Count
+	}
+	if err := w.saveProgress(ctx, taskId, progress); err != nil {
+		return err
+	}
+
+	if err := w.backfill(ctx, taskId, partitions, progress); err != nil {
+		return err
+	}
+
+	dropped, err := w.indexes.FinishRebuild(ctx, index)
+	if err != nil {
+		return fmt.Errorf("finish rebuild: %w", err)
+	}
+	progress.Switched = true
+	progress.DroppedIndices = dropped
+	return w.saveProgress(ctx, taskId, progress)
+}
+
+// rebuildPartition is the logs of a tenant with an event timestamp in [start, end)
+type rebuildPartition struct {
+	tenantId   string
+	start, end time.Time
+}
+
+// rebuildPartitions splits the span of every tenant in days
+func rebuildPartitions(spans []log.TenantSpan) []rebuildPartition {
+	partitions := []rebuildPartition{}
+	for _, span := range spans {
+		for start := span.Oldest.UTC().Truncate(rebuildPartitionSpan); !start.After(span.Newest); start = start.Add(rebuildPartitionSpan) {
+			partitions = append(partitions, rebuildPartition{tenantId: span.TenantID, start: start, end: start.Add(rebuildPartitionSpan)})
+		}
+	}
+	return partitions
+}
+
+// backfill indexes the partitions concurrently, the first failure stops the others
+func (w *IndexRebuildWorker) backfill(ctx context.Context, taskId string, partitions []rebuildPartition, progress *async_task.IndexRebuildProgress) error {
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(w.concurrency)
+	for _, p := range partitions {
+		if gctx.Err() != nil {
+			break
+		}
+		g.Go(func() error {
+			indexed, err := w.backfillPartition(gctx, p)
+			if err != nil {
+				return fmt.Errorf("backfill %s [%s, %s): %w", p.tenantId, p.start.Format(time.RFC3339), p.end.Format(time.RFC3339), err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			progress.CompletedPartitions++
+			progress.IndexedLogs += indexed
+			return w.saveProgress(gctx, taskId, progress)
+		})
+	}
+	return g.Wait()
+}
+
+func (w *IndexRebuildWorker) backfillPartition(ctx context.Context, p rebuildPartition) (int64, error) {
+	ids, err := w.logRepo.ListIDs(ctx, &p.tenantId, p.start, p.end)
+	if err != nil {
+		return 0, fmt.Errorf("log query failed: %w", err)
+	}
+
+	var indexed int64
+	for i := 0; i < len(ids); i += reindexBatchSize {
+		logs, err := w.logRepo.FindByIDs(ctx, ids[i:min(i+reindexBatchSize, len(ids))])
+		if err != nil {
+			return indexed, fmt.Errorf("log query failed: %w", err)
+		}
+		// the logs deleted since they were listed are gone
+		if len(logs) == 0 {
+			continue
+		}
+		if err := w.openSearch.IndexLogsBulk(ctx, logs); err != nil {
+			return indexed, fmt.Errorf("opensearch index failed: %w", err)
+		}
+		indexed += int64(len(logs))
+	}
+	return indexed, nil
+}
+
+// saveProgress stores the progress on the task
+func (w *IndexRebuildWorker) saveProgress(ctx context.Context, taskId string, progress *async_task.IndexRebuildProgress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	if err := w.taskRepo.UpdateProgress(ctx, taskId, datatypes.JSON(data)); err != nil {
+		return fmt.Errorf("progress update failed: %w", err)
+	}
+	return nil
+}
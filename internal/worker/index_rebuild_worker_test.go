@@ -0,0 +1,147 @@
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/worker"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+type rebuildMocks struct {
+	taskRepo   *repoMocks.MockAsyncTaskRepository
+	logRepo    *repoMocks.MockLogRepository
+	indexes    *serviceMocks.MockSearchIndexManager
+	openSearch *serviceMocks.MockOpenSearchPublisher
+}
+
+func newIndexRebuildWorker(ctrl *gomock.Controller) (*worker.IndexRebuildWorker, rebuildMocks) {
+	m := rebuildMocks{
+		taskRepo:   repoMocks.NewMockAsyncTaskRepository(ctrl),
+		logRepo:    repoMocks.NewMockLogRepository(ctrl),
+		indexes:    serviceMocks.NewMockSearchIndexManager(ctrl),
+		openSearch: serviceMocks.NewMockOpenSearchPublisher(ctrl),
+	}
+	w := worker.NewIndexRebuildWorker(serviceMocks.NewMockSQSPublisher(ctrl), m.taskRepo, m.logRepo, m.indexes, m.openSearch, "rebuild-q", 2, 0, 0)
+	return w, m
+}
+
+func rebuildTask(version int) *async_task.AsyncTask {
+	payload, _ := json.Marshal(async_task.IndexRebuildPayload{Version: version})
+	return &async_task.AsyncTask{
+		TaskID:   "task-1",
+		Status:   async_task.StatusPending,
+		TaskType: async_task.TaskIndexRebuild,
+		Payload:  (*datatypes.JSON)(&payload),
+	}
+}
+
+func TestIndexRebuildWorker_HandleMessage_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newIndexRebuildWorker(ctrl)
+	day := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	t1, t2 := "t1", "t2"
+
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").Return(rebuildTask(service.LogsIndexVersion), nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.indexes.EXPECT().StartRebuild(gomock.Any()).Return("logs-v1-000001", nil)
+	m.logRepo.EXPECT().ListTenantSpans(gomock.Any(), time.Time{}).Return([]log.TenantSpan{
+		{TenantID: t1, Count: 3, Oldest: day.Add(10 * time.Hour), Newest: day.Add(27 * time.Hour)},
+		{TenantID: t2, Count: 1, Oldest: day.Add(5 * time.Hour), Newest: day.Add(5 * time.Hour)},
+	}, nil)
+
+	// t1 spans two days, t2 one
+	m.logRepo.EXPECT().ListIDs(gomock.Any(), &t1, day, day.Add(24*time.Hour)).Return([]string{"l1", "l2"}, nil)
+	m.logRepo.EXPECT().ListIDs(gomock.Any(), &t1, day.Add(24*time.Hour), day.Add(48*time.Hour)).Return([]string{"l3"}, nil)
+	m.logRepo.EXPECT().ListIDs(gomock.Any(), &t2, day, day.Add(24*time.Hour)).Return([]string{"l4"}, nil)
+	m.logRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, ids []string) ([]log.Log, error) {
+			logs := []log.Log{}
+			for _, id := range ids {
+				logs = append(logs, log.Log{ID: id})
+			}
+			return logs, nil
+		}).Times(3)
+	m.openSearch.EXPECT().IndexLogsBulk(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	m.indexes.EXPECT().FinishRebuild(gomock.Any(), "logs-v1-000001").Return([]string{"logs-v0-000001"}, nil)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusSucceeded, nil).Return(nil)
+
+	var mu sync.Mutex
+	var last async_task.IndexRebuildProgress
+	m.taskRepo.EXPECT().UpdateProgress(gomock.Any(), "task-1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, data datatypes.JSON) error {
+			mu.Lock()
+			defer mu.Unlock()
+			last = async_task.IndexRebuildProgress{}
+			require.NoError(t, json.Unmarshal(data, &last))
+			return nil
+		}).AnyTimes()
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, async_task.IndexRebuildProgress{
+		Index:               "logs-v1-000001",
+		Partitions:          3,
+		CompletedPartitions: 3,
+		TotalLogs:           4,
+		IndexedLogs:         4,
+		Switched:            true,
+		DroppedIndices:      []string{"logs-v0-000001"},
+	}, last)
+}
+
+func TestIndexRebuildWorker_HandleMessage_OtherVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newIndexRebuildWorker(ctrl)
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").Return(rebuildTask(service.LogsIndexVersion+1), nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusFailed, gomock.Any()).Return(nil)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.Error(t, err)
+}
+
+func TestIndexRebuildWorker_HandleMessage_BackfillFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newIndexRebuildWorker(ctrl)
+	day := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").Return(rebuildTask(service.LogsIndexVersion), nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.taskRepo.EXPECT().UpdateProgress(gomock.Any(), "task-1", gomock.Any()).Return(nil).AnyTimes()
+	m.indexes.EXPECT().StartRebuild(gomock.Any()).Return("logs-v1-000001", nil)
+	m.logRepo.EXPECT().ListTenantSpans(gomock.Any(), gomock.Any()).Return([]log.TenantSpan{
+		{TenantID: "t1", Count: 1, Oldest: day, Newest: day},
+	}, nil)
+	m.logRepo.EXPECT().ListIDs(gomock.Any(), utils.Ptr("t1"), day, day.Add(24*time.Hour)).Return([]string{"l1"}, nil)
+	m.logRepo.EXPECT().FindByIDs(gomock.Any(), []string{"l1"}).Return([]log.Log{{ID: "l1"}}, nil)
+	m.openSearch.EXPECT().IndexLogsBulk(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+	// the aliases stay on the previous version and the new index is dropped
+	m.indexes.EXPECT().AbortRebuild(gomock.Any(), "logs-v1-000001").Return(nil)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusFailed, gomock.Any()).Return(nil)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.ErrorIs(t, err, assert.AnError)
+}
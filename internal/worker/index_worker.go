@@ -3,7 +3,6 @@ package worker
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
 	"github.com/Haevnen/audit-logging-api/internal/interactor"
@@ -38,33 +37,11 @@ func NewIndexWorker(
 }
 
 func (w *IndexWorker) Start(ctx context.Context) {
-	logger := logger.GetLogger()
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("shutting down index worker")
-			return
-		default:
-			msgs, err := w.sqsClient.ReceiveMessages(ctx, w.indexQueue, 5, 20)
-			if err != nil {
-				logger.Warning("failed to receive index messages", err)
-				time.Sleep(2 * time.Second)
-				continue
-			}
-
-			for _, m := range msgs {
-				if err := w.HandleMessage(ctx, m); err != nil {
-					logger.Warning("failed to handle index message", err)
-				}
-				// Always delete to avoid retries storm
-				_ = w.sqsClient.DeleteMessage(ctx, w.indexQueue, m.ReceiveHandle)
-			}
-		}
-	}
+	consume(ctx, w.sqsClient, w.indexQueue, async_task.TaskIndex, w)
 }
 
 func (w *IndexWorker) HandleMessage(ctx context.Context, msg service.ReceiveMessage) error {
-	log := logger.GetLogger()
+	log := logger.FromContext(ctx)
 	taskId := msg.Message.ID
 	log.WithFields(map[string]interface{}{
 		"taskId": taskId,
@@ -90,8 +67,8 @@ func (w *IndexWorker) HandleMessage(ctx context.Context, msg service.ReceiveMess
 		db := w.txManager.GetTx(txCtx)
 
 		// Sync with OpenSearch
-		if err := w.openSearch.IndexLogsBulk(context.Background(), *msg.Message.Logs); err != nil {
-			logger.GetLogger().Errorf("failed to index log to opensearch: %v", err)
+		if err := w.openSearch.IndexLogsBulk(context.WithoutCancel(txCtx), *msg.Message.Logs); err != nil {
+			logger.FromContext(txCtx).WithField("error", err).Error("failed to index log to opensearch")
 			return err
 		}
 
@@ -17,6 +17,7 @@ import (
 	mockSvc "github.com/Haevnen/audit-logging-api/internal/service/mocks"
 	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
 	"github.com/Haevnen/audit-logging-api/internal/worker"
+	"github.com/Haevnen/audit-logging-api/pkg/health"
 )
 
 func TestIndexWorker_Start(t *testing.T) {
@@ -66,7 +67,10 @@ func TestIndexWorker_Start(t *testing.T) {
 	}()
 	w.Start(ctx)
 
-	assert.True(t, true)
+	// the loop polled its queue
+	report := worker.Liveness(time.Minute)
+	assert.Equal(t, health.StatusOK, report.Checks[string(async_task.TaskIndex)].Status)
+	assert.Equal(t, health.StatusFail, worker.Liveness(0).Checks[string(async_task.TaskIndex)].Status)
 }
 
 func TestHandleMessage_Success_Index(t *testing.T) {
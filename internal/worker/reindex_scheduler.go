@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+// schedulerUserID is the user of the tasks queued by the async-task process itself
+const schedulerUserID = "scheduler"
+
+// ReindexScheduler queues the reindex of every tenant over the last window once a day, at the given hour (UTC),
+// the logs of the last ops.ReindexGrace aside.
+// Every async-task replica runs it, the run key of the day lets a single task through.
+type ReindexScheduler struct {
+	reindexUC ops.StartReindexUseCaseInterface
+	hour      int
+	window    time.Duration
+}
+
+func NewReindexScheduler(reindexUC ops.StartReindexUseCaseInterface, hour int, window time.Duration) *ReindexScheduler {
+	return &ReindexScheduler{
+		reindexUC: reindexUC,
+		hour:      hour,
+		window:    window,
+	}
+}
+
+func (s *ReindexScheduler) Start(ctx context.Context) {
+	for {
+		next := nextDailyRun(time.Now().UTC(), s.hour)
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			if err := s.Run(ctx, next); err != nil {
+				logger.FromContext(ctx).WithField("error", err).Error("failed to queue the nightly reindex")
+			}
+		}
+	}
+}
+
+// Run queues the reindex of the run due at the given time
+func (s *ReindexScheduler) Run(ctx context.Context, at time.Time) error {
+	task, err := s.reindexUC.Execute(ctx, ops.ReindexRequest{
+		StartTime: at.Add(-s.window),
+		EndTime:   at.Add(-ops.ReindexGrace),
+		UserID:    schedulerUserID,
+		RunKey:    "reindex:" + at.UTC().Format(time.DateOnly),
+	})
+	if err != nil {
+		return err
+	}
+	logger.FromContext(ctx).WithField("taskId", task.TaskID).Info("nightly reindex queued")
+	return nil
+}
+
+// nextDailyRun returns the first time after now at the given hour
+func nextDailyRun(now time.Time, hour int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
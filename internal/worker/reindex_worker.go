@@ -0,0 +1,201 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+const (
+	// reindexChunk is the span of event timestamps compared at once
+	reindexChunk = time.Hour
+	// reindexBatchSize bounds the logs loaded and indexed per request
+	reindexBatchSize = 1000
+)
+
+// ReindexWorker reconciles OpenSearch with Postgres over the range of a reindex task, one chunk of time at
+// a time: the logs missing from OpenSearch are indexed and the documents without a log are deleted. Indexing
+// is idempotent, so a log indexed meanwhile by its index task is only written twice.
+type ReindexWorker struct {
+	sqsClient    service.SQSPublisher
+	taskRepo     repository.AsyncTaskRepository
+	logRepo      repository.LogRepository
+	searchRepo   repository.LogSearchRepository
+	openSearch   service.OpenSearchPublisher
+	reindexQueue string
+}
+
+func NewReindexWorker(
+	sqsClient service.SQSPublisher,
+	taskRepo repository.AsyncTaskRepository,
+	logRepo repository.LogRepository,
+	searchRepo repository.LogSearchRepository,
+	openSearch service.OpenSearchPublisher,
+	reindexQueue string,
+) *ReindexWorker {
+	return &ReindexWorker{
+		sqsClient:    sqsClient,
+		taskRepo:     taskRepo,
+		logRepo:      logRepo,
+		searchRepo:   searchRepo,
+		openSearch:   openSearch,
+		reindexQueue: reindexQueue,
+	}
+}
+
+func (w *ReindexWorker) Start(ctx context.Context) {
+	consume(ctx, w.sqsClient, w.reindexQueue, async_task.TaskReindex, w)
+}
+
+func (w *ReindexWorker) HandleMessage(ctx context.Context, msg service.ReceiveMessage) error {
+	logger := logger.FromContext(ctx)
+	taskId := msg.Message.ID
+	logger.WithField("taskId", taskId).Info("received message")
+
+	task, err := w.taskRepo.GetByID(ctx, taskId)
+	if err != nil {
+		return fmt.Errorf("task fetch failed: %w", err)
+	}
+
+	claimed, err := claimTask(ctx, w.taskRepo, taskId, task.Status)
+	if !claimed {
+		return err
+	}
+	defer holdTask(ctx, w.taskRepo, taskId)()
+
+	var payload async_task.ReindexPayload
+	if task.Payload == nil || json.Unmarshal(*task.Payload, &payload) != nil || !payload.StartTime.Before(payload.EndTime) {
+		_ = w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusFailed, utils.Ptr("task has no valid range"))
+		return fmt.Errorf("task %s has no valid range", taskId)
+	}
+
+	// a task taken over from a gone worker carries on after the last reconciled chunk
+	progress := async_task.ReindexProgress{ReconciledUntil: payload.StartTime}
+	if task.Progress != nil {
+		_ = json.Unmarshal(*task.Progress, &progress)
+	}
+	if progress.ReconciledUntil.Before(payload.StartTime) {
+		progress.ReconciledUntil = payload.StartTime
+	}
+	if err := w.run(ctx, taskId, task.TenantUID, payload, &progress); err != nil {
+		_ = w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusFailed, utils.Ptr(err.Error()))
+		return err
+	}
+
+	if err := w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusSucceeded, nil); err != nil {
+		return fmt.Errorf("final status update failed: %w", err)
+	}
+	logger.WithFields(map[string]interface{}{
+		"taskId":  taskId,
+		"missing": progress.Missing,
+		"orphans": progress.Orphans,
+	}).Info("Reindex succeeded")
+	return nil
+}
+
+func (w *ReindexWorker) run(ctx context.Context, taskId string, tenantId *string, payload async_task.ReindexPayload, progress *async_task.ReindexProgress) error {
+	for start := progress.ReconciledUntil; start.Before(payload.EndTime); {
+		end := start.Add(reindexChunk)
+		if end.After(payload.EndTime) {
+			end = payload.EndTime
+		}
+
+		if err := w.reconcile(ctx, tenantId, start, end, progress); err != nil {
+			return fmt.Errorf("reconcile [%s, %s): %w", start.Format(time.RFC3339), end.Format(time.RFC3339), err)
+		}
+
+		progress.Chunks++
+		progress.ReconciledUntil = end
+		data, err := json.Marshal(progress)
+		if err != nil {
+			return err
+		}
+		if err := w.taskRepo.UpdateProgress(ctx, taskId, datatypes.JSON(data)); err != nil {
+			return fmt.Errorf("progress update failed: %w", err)
+		}
+		start = end
+	}
+	return nil
+}
+
+// reconcile compares the ids of both stores over [start, end) and fixes OpenSearch
+func (w *ReindexWorker) reconcile(ctx context.Context, tenantId *string, start, end time.Time, progress *async_task.ReindexProgress) error {
+	stored, err := w.logRepo.ListIDs(ctx, tenantId, start, end)
+	if err != nil {
+		return fmt.Errorf("log query failed: %w", err)
+	}
+	indexed, err := w.searchRepo.ListIDs(ctx, tenantId, start, end)
+	if err != nil {
+		return fmt.Errorf("opensearch query failed: %w", err)
+	}
+	progress.StoredLogs += len(stored)
+	progress.IndexedDocs += len(indexed)
+
+	missing := idsNotIn(stored, indexed)
+	for i := 0; i < len(missing); i += reindexBatchSize {
+		batch := missing[i:min(i+reindexBatchSize, len(missing))]
+		logs, err := w.logRepo.FindByIDs(ctx, batch)
+		if err != nil {
+			return fmt.Errorf("log query failed: %w", err)
+		}
+		if err := w.openSearch.IndexLogsBulk(ctx, logs); err != nil {
+			return fmt.Errorf("opensearch index failed: %w", err)
+		}
+		progress.Missing += len(logs)
+	}
+
+	orphans, err := w.uncommitted(ctx, idsNotIn(indexed, stored))
+	if err != nil {
+		return err
+	}
+	if len(orphans) > 0 {
+		if err := w.openSearch.DeleteLogsBulk(ctx, orphans); err != nil {
+			return fmt.Errorf("opensearch delete failed: %w", err)
+		}
+		progress.Orphans += len(orphans)
+	}
+	return nil
+}
+
+// uncommitted returns the orphans still without a log, a log committed since the ids were listed is kept
+func (w *ReindexWorker) uncommitted(ctx context.Context, orphans []string) ([]string, error) {
+	if len(orphans) == 0 {
+		return orphans, nil
+	}
+
+	committed := []string{}
+	for i := 0; i < len(orphans); i += reindexBatchSize {
+		logs, err := w.logRepo.FindByIDs(ctx, orphans[i:min(i+reindexBatchSize, len(orphans))])
+		if err != nil {
+			return nil, fmt.Errorf("log query failed: %w", err)
+		}
+		for _, l := range logs {
+			committed = append(committed, l.ID)
+		}
+	}
+	return idsNotIn(orphans, committed), nil
+}
+
+// idsNotIn returns the ids of a missing from b
+func idsNotIn(a, b []string) []string {
+	seen := make(map[string]struct{}, len(b))
+	for _, id := range b {
+		seen[id] = struct{}{}
+	}
+	diff := []string{}
+	for _, id := range a {
+		if _, ok := seen[id]; !ok {
+			diff = append(diff, id)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,172 @@
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+	ucOps "github.com/Haevnen/audit-logging-api/internal/usecase/ops"
+	ucMocks "github.com/Haevnen/audit-logging-api/internal/usecase/ops/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/worker"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+type reindexMocks struct {
+	taskRepo   *repoMocks.MockAsyncTaskRepository
+	logRepo    *repoMocks.MockLogRepository
+	searchRepo *repoMocks.MockLogSearchRepository
+	openSearch *serviceMocks.MockOpenSearchPublisher
+}
+
+func newReindexWorker(ctrl *gomock.Controller) (*worker.ReindexWorker, reindexMocks) {
+	m := reindexMocks{
+		taskRepo:   repoMocks.NewMockAsyncTaskRepository(ctrl),
+		logRepo:    repoMocks.NewMockLogRepository(ctrl),
+		searchRepo: repoMocks.NewMockLogSearchRepository(ctrl),
+		openSearch: serviceMocks.NewMockOpenSearchPublisher(ctrl),
+	}
+	w := worker.NewReindexWorker(serviceMocks.NewMockSQSPublisher(ctrl), m.taskRepo, m.logRepo, m.searchRepo, m.openSearch, "reindex-q")
+	return w, m
+}
+
+func reindexTask(start, end time.Time) *async_task.AsyncTask {
+	payload, _ := json.Marshal(async_task.ReindexPayload{StartTime: start, EndTime: end})
+	return &async_task.AsyncTask{
+		TaskID:    "task-1",
+		Status:    async_task.StatusPending,
+		TaskType:  async_task.TaskReindex,
+		TenantUID: utils.Ptr("t1"),
+		Payload:   (*datatypes.JSON)(&payload),
+	}
+}
+
+func TestReindexWorker_HandleMessage_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newReindexWorker(ctrl)
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	mid, end := start.Add(time.Hour), start.Add(90*time.Minute)
+	tenantId := utils.Ptr("t1")
+
+	missing := []log.Log{{ID: "l2", TenantID: "t1"}}
+	gomock.InOrder(
+		m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").Return(reindexTask(start, end), nil),
+		m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil),
+
+		// first chunk: l2 never reached OpenSearch, o1 was left behind by a cleanup
+		m.logRepo.EXPECT().ListIDs(gomock.Any(), tenantId, start, mid).Return([]string{"l1", "l2"}, nil),
+		m.searchRepo.EXPECT().ListIDs(gomock.Any(), tenantId, start, mid).Return([]string{"l1", "o1"}, nil),
+		m.logRepo.EXPECT().FindByIDs(gomock.Any(), []string{"l2"}).Return(missing, nil),
+		m.openSearch.EXPECT().IndexLogsBulk(gomock.Any(), missing).Return(nil),
+		m.logRepo.EXPECT().FindByIDs(gomock.Any(), []string{"o1"}).Return([]log.Log{}, nil),
+		m.openSearch.EXPECT().DeleteLogsBulk(gomock.Any(), []string{"o1"}).Return(nil),
+
+		// second chunk is in sync, the range ends halfway through it
+		m.logRepo.EXPECT().ListIDs(gomock.Any(), tenantId, mid, end).Return([]string{"l3"}, nil),
+		m.searchRepo.EXPECT().ListIDs(gomock.Any(), tenantId, mid, end).Return([]string{"l3"}, nil),
+
+		m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusSucceeded, nil).Return(nil),
+	)
+
+	var progress []async_task.ReindexProgress
+	m.taskRepo.EXPECT().UpdateProgress(gomock.Any(), "task-1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, data datatypes.JSON) error {
+			var p async_task.ReindexProgress
+			require.NoError(t, json.Unmarshal(data, &p))
+			progress = append(progress, p)
+			return nil
+		}).Times(2)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+
+	require.NoError(t, err)
+	require.Len(t, progress, 2)
+	assert.Equal(t, mid, progress[0].ReconciledUntil)
+	assert.Equal(t, async_task.ReindexProgress{
+		ReconciledUntil: end, Chunks: 2, StoredLogs: 3, IndexedDocs: 3, Missing: 1, Orphans: 1,
+	}, progress[1])
+}
+
+func TestReindexWorker_HandleMessage_NoRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newReindexWorker(ctrl)
+	task := reindexTask(time.Now(), time.Now())
+	task.Payload = nil
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").Return(task, nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusFailed, gomock.Any()).Return(nil)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.Error(t, err)
+}
+
+func TestReindexWorker_HandleMessage_IndexedBeforeCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newReindexWorker(ctrl)
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").Return(reindexTask(start, start.Add(time.Hour)), nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.taskRepo.EXPECT().UpdateProgress(gomock.Any(), "task-1", gomock.Any()).Return(nil)
+	// l2 was indexed from the transaction storing it, its row committed after the ids were listed
+	m.logRepo.EXPECT().ListIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"l1"}, nil)
+	m.searchRepo.EXPECT().ListIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"l1", "l2"}, nil)
+	m.logRepo.EXPECT().FindByIDs(gomock.Any(), []string{"l2"}).Return([]log.Log{{ID: "l2"}}, nil)
+	m.openSearch.EXPECT().DeleteLogsBulk(gomock.Any(), gomock.Any()).Times(0)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusSucceeded, nil).Return(nil)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.NoError(t, err)
+}
+
+func TestReindexWorker_HandleMessage_IndexFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newReindexWorker(ctrl)
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").Return(reindexTask(start, start.Add(time.Hour)), nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.logRepo.EXPECT().ListIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"l1"}, nil)
+	m.searchRepo.EXPECT().ListIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{}, nil)
+	m.logRepo.EXPECT().FindByIDs(gomock.Any(), []string{"l1"}).Return([]log.Log{{ID: "l1"}}, nil)
+	m.openSearch.EXPECT().IndexLogsBulk(gomock.Any(), gomock.Any()).Return(assert.AnError)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusFailed, gomock.Any()).Return(nil)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestReindexScheduler_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := ucMocks.NewMockStartReindexUseCaseInterface(ctrl)
+	at := time.Date(2026, 10, 19, 2, 0, 0, 0, time.UTC)
+	mockUC.EXPECT().Execute(gomock.Any(), ucOps.ReindexRequest{
+		StartTime: at.Add(-48 * time.Hour),
+		EndTime:   at.Add(-ucOps.ReindexGrace),
+		UserID:    "scheduler",
+		RunKey:    "reindex:2026-10-19",
+	}).Return(&async_task.AsyncTask{TaskID: "task-1"}, nil)
+
+	err := worker.NewReindexScheduler(mockUC, 2, 48*time.Hour).Run(context.Background(), at)
+	assert.NoError(t, err)
+}
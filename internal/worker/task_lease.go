@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+// TaskLease is how long a running task stays with its worker without being touched. The worker touches it a few
+// times per lease, a task left untouched for longer lost its worker (e.g. the pod was killed) and is taken over
+// by the worker receiving its message again.
+const TaskLease = 2 * time.Minute
+
+// errTaskHeld tells the consumer the task is still running on another worker, its message is left on the queue
+// to come back once that worker is done or gone
+var errTaskHeld = errors.New("task is running on another worker")
+
+// claimTask marks the task running for this worker, a task still held by another worker returns errTaskHeld.
+// It returns false without an error for the tasks already done.
+func claimTask(ctx context.Context, taskRepo repository.AsyncTaskRepository, taskId string, status async_task.AsyncTaskStatus) (bool, error) {
+	if status != async_task.StatusPending && status != async_task.StatusRunning {
+		logger.FromContext(ctx).WithFields(map[string]interface{}{
+			"taskId": taskId,
+			"status": status,
+		}).Info("Already processed")
+		return false, nil
+	}
+
+	claimed, err := taskRepo.Claim(ctx, taskId, time.Now().UTC().Add(-TaskLease))
+	if err != nil {
+		return false, fmt.Errorf("status update failed: %w", err)
+	}
+	if !claimed {
+		return false, errTaskHeld
+	}
+	if status == async_task.StatusRunning {
+		logger.FromContext(ctx).WithField("taskId", taskId).Warn("taking over a task left running by its worker")
+	}
+	return true, nil
+}
+
+// holdTask touches the claimed task until the returned function is called, the task stays with this worker
+func holdTask(ctx context.Context, taskRepo repository.AsyncTaskRepository, taskId string) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(TaskLease / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := taskRepo.Touch(ctx, taskId); err != nil && ctx.Err() == nil {
+					logger.FromContext(ctx).WithField("error", err).WithField("taskId", taskId).Warn("failed to touch task")
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
@@ -0,0 +1,228 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	"github.com/Haevnen/audit-logging-api/internal/interactor"
+	"github.com/Haevnen/audit-logging-api/internal/repository"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+// tenantLogPageSize is the number of logs of the tenant read at once, a tenant may have more than fit in memory
+const tenantLogPageSize = 1000
+
+// TenantDeletionWorker removes every trace of a tenant: its logs are archived to S3, then removed
+// from OpenSearch, its live streams are closed and finally its Postgres rows are deleted. The tenant
+// row goes last so a failed task leaves the tenant in the deleting status, ready to be retried.
+type TenantDeletionWorker struct {
+	sqsClient   service.SQSPublisher
+	taskRepo    repository.AsyncTaskRepository
+	tenantRepo  repository.TenantRepository
+	logRepo     repository.LogRepository
+	s3Client    service.S3Publisher
+	openSearch  service.OpenSearchPublisher
+	pubsub      service.PubSub
+	txManager   interactor.TxManager
+	tenantQueue string
+}
+
+func NewTenantDeletionWorker(
+	sqsClient service.SQSPublisher,
+	taskRepo repository.AsyncTaskRepository,
+	tenantRepo repository.TenantRepository,
+	logRepo repository.LogRepository,
+	s3Client service.S3Publisher,
+	openSearch service.OpenSearchPublisher,
+	pubsub service.PubSub,
+	txManager interactor.TxManager,
+	tenantQueue string,
+) *TenantDeletionWorker {
+	return &TenantDeletionWorker{
+		sqsClient:   sqsClient,
+		taskRepo:    taskRepo,
+		tenantRepo:  tenantRepo,
+		logRepo:     logRepo,
+		s3Client:    s3Client,
+		openSearch:  openSearch,
+		pubsub:      pubsub,
+		txManager:   txManager,
+		tenantQueue: tenantQueue,
+	}
+}
+
+func (w *TenantDeletionWorker) Start(ctx context.Context) {
+	consume(ctx, w.sqsClient, w.tenantQueue, async_task.TaskTenantDeletion, w)
+}
+
+func (w *TenantDeletionWorker) HandleMessage(ctx context.Context, msg service.ReceiveMessage) error {
+	logger := logger.FromContext(ctx)
+	taskId := msg.Message.ID
+	logger.WithField("taskId", taskId).Info("received message")
+
+	task, err := w.taskRepo.GetByID(ctx, taskId)
+	if err != nil {
+		return fmt.Errorf("task fetch failed: %w", err)
+	}
+
+	claimed, err := claimTask(ctx, w.taskRepo, taskId, task.Status)
+	if !claimed {
+		return err
+	}
+	defer holdTask(ctx, w.taskRepo, taskId)()
+	if task.TenantUID == nil || len(*task.TenantUID) == 0 {
+		_ = w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusFailed, utils.Ptr("task has no tenant"))
+		return fmt.Errorf("task %s has no tenant", taskId)
+	}
+	tenantId := *task.TenantUID
+
+	progress := async_task.TenantDeletionProgress{CompletedSteps: []string{}}
+	if task.Progress != nil {
+		_ = json.Unmarshal(*task.Progress, &progress)
+	}
+	if err := w.run(ctx, taskId, tenantId, &progress); err != nil {
+		_ = w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusFailed, utils.Ptr(err.Error()))
+		return err
+	}
+
+	if err := w.taskRepo.UpdateStatus(ctx, nil, taskId, async_task.StatusSucceeded, nil); err != nil {
+		return fmt.Errorf("final status update failed: %w", err)
+	}
+	logger.WithFields(map[string]interface{}{
+		"taskId":   taskId,
+		"tenantId": tenantId,
+	}).Info("Tenant deleted")
+	return nil
+}
+
+// run goes through the steps not completed yet, a task taken over from a gone worker carries on where it stopped
+func (w *TenantDeletionWorker) run(ctx context.Context, taskId, tenantId string, progress *async_task.TenantDeletionProgress) error {
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{async_task.StepArchiveLogs, func() error {
+			return w.archive(ctx, taskId, tenantId, progress)
+		}},
+		// the rows are still there, they are paged through again
+		{async_task.StepDeleteDocuments, func() error {
+			return w.eachPage(ctx, tenantId, func(logs []log.Log) error {
+				ids := make([]string, 0, len(logs))
+				for _, l := range logs {
+					ids = append(ids, l.ID)
+				}
+				if err := w.openSearch.DeleteLogsBulk(ctx, ids); err != nil {
+					return fmt.Errorf("opensearch delete failed: %w", err)
+				}
+				progress.DeletedDocuments += len(ids)
+				return nil
+			})
+		}},
+		{async_task.StepPurgeChannels, func() error {
+			closed, err := w.pubsub.CloseTenantChannel(ctx, tenantId)
+			if err != nil {
+				return fmt.Errorf("channel purge failed: %w", err)
+			}
+			progress.ClosedSubscribers = closed
+			return nil
+		}},
+		{async_task.StepDeleteRows, func() error {
+			if err := w.txManager.TransactionExec(ctx, func(txCtx context.Context) error {
+				db := w.txManager.GetTx(txCtx)
+
+				deleted, err := w.logRepo.DeleteTenantLogs(txCtx, db, tenantId)
+				if err != nil {
+					return err
+				}
+				progress.DeletedLogs = deleted
+				return w.tenantRepo.Delete(txCtx, db, tenantId)
+			}); err != nil {
+				return fmt.Errorf("row delete failed: %w", err)
+			}
+			return nil
+		}},
+	}
+
+	for _, step := range steps {
+		if slices.Contains(progress.CompletedSteps, step.name) {
+			continue
+		}
+		if err := step.run(); err != nil {
+			return err
+		}
+		if err := w.completeStep(ctx, taskId, progress, step.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archive streams the logs of the tenant to a single S3 object a page at a time, a failed archive is aborted and
+// the retry starts over
+func (w *TenantDeletionWorker) archive(ctx context.Context, taskId, tenantId string, progress *async_task.TenantDeletionProgress) error {
+	archive, err := w.s3Client.StartArchive(ctx, taskId)
+	if err != nil {
+		return fmt.Errorf("s3 upload failed: %w", err)
+	}
+
+	err = w.eachPage(ctx, tenantId, func(logs []log.Log) error {
+		if err := archive.Write(ctx, logs); err != nil {
+			return fmt.Errorf("s3 upload failed: %w", err)
+		}
+		progress.ArchivedLogs += len(logs)
+		return nil
+	})
+	if err == nil {
+		if err = archive.Complete(ctx); err != nil {
+			err = fmt.Errorf("s3 upload failed: %w", err)
+		}
+	}
+	if err != nil {
+		if abortErr := archive.Abort(context.WithoutCancel(ctx)); abortErr != nil {
+			logger.FromContext(ctx).WithField("error", abortErr).Warn("failed to abort the archive upload")
+		}
+		return err
+	}
+	return nil
+}
+
+// eachPage calls fn with the logs of the tenant a page at a time
+func (w *TenantDeletionWorker) eachPage(ctx context.Context, tenantId string, fn func(logs []log.Log) error) error {
+	var after *log.Log
+	for {
+		logs, err := w.logRepo.FindTenantLogsAfter(ctx, tenantId, after, tenantLogPageSize)
+		if err != nil {
+			return fmt.Errorf("log query failed: %w", err)
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+		if err := fn(logs); err != nil {
+			return err
+		}
+		if len(logs) < tenantLogPageSize {
+			return nil
+		}
+		after = &logs[len(logs)-1]
+	}
+}
+
+func (w *TenantDeletionWorker) completeStep(ctx context.Context, taskId string, progress *async_task.TenantDeletionProgress, step string) error {
+	progress.CompletedSteps = append(progress.CompletedSteps, step)
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	if err := w.taskRepo.UpdateProgress(ctx, taskId, datatypes.JSON(data)); err != nil {
+		return fmt.Errorf("progress update failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
+
+	"github.com/Haevnen/audit-logging-api/internal/entity/async_task"
+	"github.com/Haevnen/audit-logging-api/internal/entity/log"
+	interactorMocks "github.com/Haevnen/audit-logging-api/internal/interactor/mocks"
+	repoMocks "github.com/Haevnen/audit-logging-api/internal/repository/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/service"
+	serviceMocks "github.com/Haevnen/audit-logging-api/internal/service/mocks"
+	"github.com/Haevnen/audit-logging-api/internal/worker"
+	"github.com/Haevnen/audit-logging-api/pkg/utils"
+)
+
+type tenantDeletionMocks struct {
+	taskRepo   *repoMocks.MockAsyncTaskRepository
+	tenantRepo *repoMocks.MockTenantRepository
+	logRepo    *repoMocks.MockLogRepository
+	s3         *serviceMocks.MockS3Publisher
+	openSearch *serviceMocks.MockOpenSearchPublisher
+	pubsub     *serviceMocks.MockPubSub
+}
+
+func newTenantDeletionWorker(ctrl *gomock.Controller) (*worker.TenantDeletionWorker, tenantDeletionMocks) {
+	m := tenantDeletionMocks{
+		taskRepo:   repoMocks.NewMockAsyncTaskRepository(ctrl),
+		tenantRepo: repoMocks.NewMockTenantRepository(ctrl),
+		logRepo:    repoMocks.NewMockLogRepository(ctrl),
+		s3:         serviceMocks.NewMockS3Publisher(ctrl),
+		openSearch: serviceMocks.NewMockOpenSearchPublisher(ctrl),
+		pubsub:     serviceMocks.NewMockPubSub(ctrl),
+	}
+	tx := interactorMocks.NewMockTxManager(ctrl)
+	tx.EXPECT().TransactionExec(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+	tx.EXPECT().GetTx(gomock.Any()).Return(nil).AnyTimes()
+
+	w := worker.NewTenantDeletionWorker(serviceMocks.NewMockSQSPublisher(ctrl), m.taskRepo, m.tenantRepo, m.logRepo, m.s3, m.openSearch, m.pubsub, tx, "tenant-q")
+	return w, m
+}
+
+func TestTenantDeletionWorker_HandleMessage_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newTenantDeletionWorker(ctrl)
+	logs := []log.Log{{ID: "l1", TenantID: "t1"}, {ID: "l2", TenantID: "t1", System: true}}
+	archive := serviceMocks.NewMockLogArchive(ctrl)
+
+	var progress []async_task.TenantDeletionProgress
+	gomock.InOrder(
+		m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").
+			Return(&async_task.AsyncTask{TaskID: "task-1", Status: async_task.StatusPending, TenantUID: utils.Ptr("t1")}, nil),
+		m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil),
+		m.s3.EXPECT().StartArchive(gomock.Any(), "task-1").Return(archive, nil),
+		m.logRepo.EXPECT().FindTenantLogsAfter(gomock.Any(), "t1", nil, gomock.Any()).Return(logs, nil),
+		archive.EXPECT().Write(gomock.Any(), logs).Return(nil),
+		archive.EXPECT().Complete(gomock.Any()).Return(nil),
+		m.logRepo.EXPECT().FindTenantLogsAfter(gomock.Any(), "t1", nil, gomock.Any()).Return(logs, nil),
+		m.openSearch.EXPECT().DeleteLogsBulk(gomock.Any(), []string{"l1", "l2"}).Return(nil),
+		m.pubsub.EXPECT().CloseTenantChannel(gomock.Any(), "t1").Return(int64(3), nil),
+		m.logRepo.EXPECT().DeleteTenantLogs(gomock.Any(), gomock.Any(), "t1").Return(int64(2), nil),
+		m.tenantRepo.EXPECT().Delete(gomock.Any(), gomock.Any(), "t1").Return(nil),
+		m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusSucceeded, nil).Return(nil),
+	)
+	m.taskRepo.EXPECT().UpdateProgress(gomock.Any(), "task-1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, data datatypes.JSON) error {
+			var p async_task.TenantDeletionProgress
+			require.NoError(t, json.Unmarshal(data, &p))
+			progress = append(progress, p)
+			return nil
+		}).Times(4)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.NoError(t, err)
+
+	require.Len(t, progress, 4)
+	assert.Equal(t, []string{async_task.StepArchiveLogs}, progress[0].CompletedSteps)
+	assert.Equal(t, 2, progress[0].ArchivedLogs)
+	assert.Equal(t, async_task.TenantDeletionProgress{
+		CompletedSteps:    []string{async_task.StepArchiveLogs, async_task.StepDeleteDocuments, async_task.StepPurgeChannels, async_task.StepDeleteRows},
+		ArchivedLogs:      2,
+		DeletedDocuments:  2,
+		ClosedSubscribers: 3,
+		DeletedLogs:       2,
+	}, progress[3])
+}
+
+func TestTenantDeletionWorker_HandleMessage_FailedStepKeepsTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newTenantDeletionWorker(ctrl)
+	logs := []log.Log{{ID: "l1", TenantID: "t1"}}
+	archive := serviceMocks.NewMockLogArchive(ctrl)
+
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").
+		Return(&async_task.AsyncTask{TaskID: "task-1", Status: async_task.StatusPending, TenantUID: utils.Ptr("t1")}, nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.s3.EXPECT().StartArchive(gomock.Any(), "task-1").Return(archive, nil)
+	m.logRepo.EXPECT().FindTenantLogsAfter(gomock.Any(), "t1", nil, gomock.Any()).Return(logs, nil).Times(2)
+	archive.EXPECT().Write(gomock.Any(), logs).Return(nil)
+	archive.EXPECT().Complete(gomock.Any()).Return(nil)
+	m.taskRepo.EXPECT().UpdateProgress(gomock.Any(), "task-1", gomock.Any()).Return(nil)
+	m.openSearch.EXPECT().DeleteLogsBulk(gomock.Any(), []string{"l1"}).Return(assert.AnError)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusFailed, gomock.Not(gomock.Nil())).Return(nil)
+	// no row is deleted, the tenant stays in the deleting status and can be retried
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestTenantDeletionWorker_HandleMessage_Pages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newTenantDeletionWorker(ctrl)
+	archive := serviceMocks.NewMockLogArchive(ctrl)
+
+	// a full page is followed by the one after its last log, a short page is the last one
+	var full []log.Log
+	var fullIds []string
+	for i := 0; i < 1000; i++ {
+		full = append(full, log.Log{ID: fmt.Sprintf("l%d", i), TenantID: "t1"})
+		fullIds = append(fullIds, full[i].ID)
+	}
+	last := []log.Log{{ID: "l1000", TenantID: "t1"}}
+	pages := func() {
+		m.logRepo.EXPECT().FindTenantLogsAfter(gomock.Any(), "t1", nil, 1000).Return(full, nil)
+		m.logRepo.EXPECT().FindTenantLogsAfter(gomock.Any(), "t1", &full[999], 1000).Return(last, nil)
+	}
+
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").
+		Return(&async_task.AsyncTask{TaskID: "task-1", Status: async_task.StatusPending, TenantUID: utils.Ptr("t1")}, nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.s3.EXPECT().StartArchive(gomock.Any(), "task-1").Return(archive, nil)
+	pages()
+	gomock.InOrder(
+		archive.EXPECT().Write(gomock.Any(), full).Return(nil),
+		archive.EXPECT().Write(gomock.Any(), last).Return(nil),
+		archive.EXPECT().Complete(gomock.Any()).Return(nil),
+	)
+	pages()
+	m.openSearch.EXPECT().DeleteLogsBulk(gomock.Any(), fullIds).Return(nil)
+	m.openSearch.EXPECT().DeleteLogsBulk(gomock.Any(), []string{"l1000"}).Return(nil)
+	m.pubsub.EXPECT().CloseTenantChannel(gomock.Any(), "t1").Return(int64(0), nil)
+	m.logRepo.EXPECT().DeleteTenantLogs(gomock.Any(), gomock.Any(), "t1").Return(int64(1001), nil)
+	m.tenantRepo.EXPECT().Delete(gomock.Any(), gomock.Any(), "t1").Return(nil)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusSucceeded, nil).Return(nil)
+
+	var progress async_task.TenantDeletionProgress
+	m.taskRepo.EXPECT().UpdateProgress(gomock.Any(), "task-1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, data datatypes.JSON) error {
+			return json.Unmarshal(data, &progress)
+		}).Times(4)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1001, progress.ArchivedLogs)
+	assert.Equal(t, 1001, progress.DeletedDocuments)
+}
+
+func TestTenantDeletionWorker_HandleMessage_ArchiveFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newTenantDeletionWorker(ctrl)
+	logs := []log.Log{{ID: "l1", TenantID: "t1"}}
+	archive := serviceMocks.NewMockLogArchive(ctrl)
+
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").
+		Return(&async_task.AsyncTask{TaskID: "task-1", Status: async_task.StatusPending, TenantUID: utils.Ptr("t1")}, nil)
+	m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil)
+	m.s3.EXPECT().StartArchive(gomock.Any(), "task-1").Return(archive, nil)
+	m.logRepo.EXPECT().FindTenantLogsAfter(gomock.Any(), "t1", nil, gomock.Any()).Return(logs, nil)
+	archive.EXPECT().Write(gomock.Any(), logs).Return(assert.AnError)
+	// the parts uploaded so far are dropped, nothing else is touched
+	archive.EXPECT().Abort(gomock.Any()).Return(nil)
+	m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusFailed, gomock.Not(gomock.Nil())).Return(nil)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestTenantDeletionWorker_HandleMessage_AlreadyProcessed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newTenantDeletionWorker(ctrl)
+	m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").
+		Return(&async_task.AsyncTask{TaskID: "task-1", Status: async_task.StatusSucceeded, TenantUID: utils.Ptr("t1")}, nil)
+
+	err := w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.NoError(t, err)
+}
+
+func TestTenantDeletionWorker_HandleMessage_TakesOverFromGoneWorker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, m := newTenantDeletionWorker(ctrl)
+	stored, err := json.Marshal(async_task.TenantDeletionProgress{
+		CompletedSteps:   []string{async_task.StepArchiveLogs, async_task.StepDeleteDocuments},
+		ArchivedLogs:     2,
+		DeletedDocuments: 2,
+	})
+	require.NoError(t, err)
+	previous := datatypes.JSON(stored)
+
+	// the archive and the documents are done, the archive isn't overwritten
+	var last async_task.TenantDeletionProgress
+	gomock.InOrder(
+		m.taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").
+			Return(&async_task.AsyncTask{TaskID: "task-1", Status: async_task.StatusRunning, TenantUID: utils.Ptr("t1"), Progress: &previous}, nil),
+		m.taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(true, nil),
+		m.pubsub.EXPECT().CloseTenantChannel(gomock.Any(), "t1").Return(int64(1), nil),
+		m.logRepo.EXPECT().DeleteTenantLogs(gomock.Any(), gomock.Any(), "t1").Return(int64(2), nil),
+		m.tenantRepo.EXPECT().Delete(gomock.Any(), gomock.Any(), "t1").Return(nil),
+		m.taskRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), "task-1", async_task.StatusSucceeded, nil).Return(nil),
+	)
+	m.taskRepo.EXPECT().UpdateProgress(gomock.Any(), "task-1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, data datatypes.JSON) error {
+			return json.Unmarshal(data, &last)
+		}).Times(2)
+
+	err = w.HandleMessage(context.Background(), service.ReceiveMessage{Message: service.Message{ID: "task-1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{async_task.StepArchiveLogs, async_task.StepDeleteDocuments, async_task.StepPurgeChannels, async_task.StepDeleteRows}, last.CompletedSteps)
+	assert.Equal(t, 2, last.ArchivedLogs)
+}
+
+func TestTenantDeletionWorker_Start_TaskHeldByAnotherWorker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sqs := serviceMocks.NewMockSQSPublisher(ctrl)
+	taskRepo := repoMocks.NewMockAsyncTaskRepository(ctrl)
+	w := worker.NewTenantDeletionWorker(sqs, taskRepo, repoMocks.NewMockTenantRepository(ctrl), repoMocks.NewMockLogRepository(ctrl),
+		serviceMocks.NewMockS3Publisher(ctrl), serviceMocks.NewMockOpenSearchPublisher(ctrl), serviceMocks.NewMockPubSub(ctrl),
+		interactorMocks.NewMockTxManager(ctrl), "tenant-q")
+
+	msg := service.ReceiveMessage{Message: service.Message{ID: "task-1"}, ReceiveHandle: utils.Ptr("handle-1")}
+	sqs.EXPECT().ReceiveMessages(gomock.Any(), "tenant-q", int32(5), int32(20)).Return([]service.ReceiveMessage{msg}, nil).AnyTimes()
+	taskRepo.EXPECT().GetByID(gomock.Any(), "task-1").
+		Return(&async_task.AsyncTask{TaskID: "task-1", Status: async_task.StatusRunning, TenantUID: utils.Ptr("t1")}, nil).AnyTimes()
+	// the worker running it still touches the task, the message comes back once it is done or gone
+	taskRepo.EXPECT().Claim(gomock.Any(), "task-1", gomock.Any()).Return(false, nil).AnyTimes()
+	sqs.EXPECT().DeleteMessage(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	w.Start(ctx)
+}
@@ -20,6 +20,21 @@ awslocal sqs create-queue \
   --attributes VisibilityTimeout=300,MessageRetentionPeriod=86400,DelaySeconds=0,ReceiveMessageWaitTimeSeconds=20
 echo "SQS queue 'index-queue' created!"
 
+awslocal sqs create-queue \
+  --queue-name tenant-deletion-queue \
+  --attributes VisibilityTimeout=900,MessageRetentionPeriod=86400,DelaySeconds=0,ReceiveMessageWaitTimeSeconds=20
+echo "SQS queue 'tenant-deletion-queue' created!"
+
+awslocal sqs create-queue \
+  --queue-name reindex-queue \
+  --attributes VisibilityTimeout=900,MessageRetentionPeriod=86400,DelaySeconds=0,ReceiveMessageWaitTimeSeconds=20
+echo "SQS queue 'reindex-queue' created!"
+
+awslocal sqs create-queue \
+  --queue-name index-rebuild-queue \
+  --attributes VisibilityTimeout=900,MessageRetentionPeriod=86400,DelaySeconds=0,ReceiveMessageWaitTimeSeconds=20
+echo "SQS queue 'index-rebuild-queue' created!"
+
 # Create S3 Bucket for log archiving before deleting
 awslocal s3 mb s3://log-archive
 echo "S3 bucket 'log-archive' created!"
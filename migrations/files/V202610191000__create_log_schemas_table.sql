@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS log_schemas (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    resource TEXT,
+    version INT NOT NULL,
+    enforcement TEXT NOT NULL DEFAULT 'reject',
+    metadata_schema JSONB,
+    before_state_schema JSONB,
+    after_state_schema JSONB,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One row per version of a (tenant, resource) schema
+CREATE UNIQUE INDEX IF NOT EXISTS idx_log_schemas_tenant_resource_version
+    ON log_schemas (tenant_id, COALESCE(resource, ''), version);
+
+-- Logs keep a reference to the schema version they were validated against
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS schema_id UUID;
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS schema_violations JSONB;
@@ -0,0 +1,5 @@
+-- Field-level diff between before_state and after_state, computed at ingestion
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS diff JSONB;
+
+-- Supports containment lookups such as diff @> '[{"path": "plan.tier"}]'
+CREATE INDEX IF NOT EXISTS idx_logs_diff ON logs USING GIN (diff jsonb_path_ops);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS redaction_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    pattern TEXT,
+    path TEXT,
+    action TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT redaction_rules_target_check CHECK (
+        (kind = 'regex' AND pattern IS NOT NULL) OR (kind = 'field' AND path IS NOT NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_redaction_rules_tenant ON redaction_rules (tenant_id);
+
+-- What was redacted from a log (rule, field, action, count), never the raw value
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS redactions JSONB;
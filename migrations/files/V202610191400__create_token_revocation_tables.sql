@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    tenant_id UUID,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_by TEXT NOT NULL,
+    revoked_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
+
+-- every token of the subject issued before revoked_before is rejected
+CREATE TABLE IF NOT EXISTS session_revocations (
+    subject_type TEXT NOT NULL,
+    subject_id TEXT NOT NULL,
+    revoked_before TIMESTAMPTZ NOT NULL,
+    revoked_by TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (subject_type, subject_id),
+    CONSTRAINT session_revocations_subject_check CHECK (subject_type IN ('user', 'tenant'))
+);
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS roles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID REFERENCES tenants(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT,
+    permissions JSONB NOT NULL DEFAULT '[]',
+    built_in BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- global roles have no tenant, names are unique among them and within each tenant
+CREATE UNIQUE INDEX IF NOT EXISTS idx_roles_tenant_name
+    ON roles (COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), name);
+
+CREATE TABLE IF NOT EXISTS role_bindings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_role_bindings_subject_role
+    ON role_bindings (COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), user_id, role_id);
+
+-- built-in roles, kept in sync with auth.DefaultPermissions
+INSERT INTO roles (tenant_id, name, description, permissions, built_in) VALUES
+    (NULL, 'admin', 'Full access to every tenant',
+     '["logs:read","logs:write","logs:export","logs:cleanup","schemas:read","schemas:write","redaction:read","redaction:write","tenants:manage","api_keys:manage","roles:manage","sessions:revoke"]', TRUE),
+    (NULL, 'auditor', 'Read and export the logs of a tenant',
+     '["logs:read","logs:export","schemas:read","redaction:read"]', TRUE),
+    (NULL, 'user', 'Write and read the logs of a tenant',
+     '["logs:read","logs:write","schemas:read","schemas:write","redaction:read","redaction:write"]', TRUE)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS access_grants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    grantee_id TEXT NOT NULL,
+    tenant_ids JSONB NOT NULL,
+    reason TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT access_grants_window_check CHECK (expires_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_grants_grantee ON access_grants (grantee_id, expires_at);
+CREATE INDEX IF NOT EXISTS idx_access_grants_tenant_ids ON access_grants USING GIN (tenant_ids);
+
+UPDATE roles SET permissions = permissions || '["access_grants:manage"]'
+WHERE built_in AND name = 'admin' AND NOT permissions ? 'access_grants:manage';
@@ -0,0 +1,35 @@
+-- flyway: transactional=false
+
+-- Audit trail of the service itself, kept apart from the tenant's own logs
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS system BOOLEAN NOT NULL DEFAULT false;
+
+-- Reserved tenant for platform actions that don't target a tenant
+INSERT INTO tenants (id, name) VALUES
+    ('00000000-0000-0000-0000-000000000000', 'system')
+ON CONFLICT (id) DO NOTHING;
+
+-- Tenant statistics only count the tenant's own logs
+DROP MATERIALIZED VIEW IF EXISTS log_stats_daily;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS log_stats_daily
+WITH (timescaledb.continuous) AS
+SELECT
+    tenant_id,
+    time_bucket('1 day', event_timestamp) AS day,
+    action,
+    severity,
+    COUNT(*) AS log_count
+FROM logs
+WHERE NOT system
+GROUP BY tenant_id, day, action, severity
+WITH NO DATA;
+
+CREATE INDEX IF NOT EXISTS idx_log_stats_daily_tenant_day
+    ON log_stats_daily (tenant_id, day);
+
+SELECT add_continuous_aggregate_policy('log_stats_daily',
+    start_offset => INTERVAL '90 days',
+    end_offset   => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '5 minutes');
+
+CALL refresh_continuous_aggregate('log_stats_daily', NULL, NULL);
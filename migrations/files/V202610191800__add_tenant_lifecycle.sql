@@ -0,0 +1,12 @@
+-- flyway: transactional=false
+
+-- Suspended tenants can't authenticate nor ingest, deleting tenants are being removed by an async task
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'active';
+ALTER TABLE tenants ADD CONSTRAINT tenants_status_check CHECK (status IN ('active', 'suspended', 'deleting'));
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS settings JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+-- ADD VALUE can't run inside a transaction block
+ALTER TYPE async_task_type ADD VALUE IF NOT EXISTS 'tenant_deletion';
+
+-- Steps completed by long running tasks, e.g. the tenant deletion
+ALTER TABLE async_tasks ADD COLUMN IF NOT EXISTS progress JSONB;
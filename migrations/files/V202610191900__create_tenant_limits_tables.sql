@@ -0,0 +1,25 @@
+-- Per-tenant overrides of the configured rate limits and daily quotas, NULL keeps the default
+CREATE TABLE IF NOT EXISTS tenant_limits (
+    tenant_id UUID PRIMARY KEY REFERENCES tenants(id) ON DELETE CASCADE,
+    read_rps INT CHECK (read_rps > 0),
+    read_burst INT CHECK (read_burst > 0),
+    write_rps INT CHECK (write_rps > 0),
+    write_burst INT CHECK (write_burst > 0),
+    export_rps INT CHECK (export_rps > 0),
+    export_burst INT CHECK (export_burst > 0),
+    daily_event_quota BIGINT CHECK (daily_event_quota >= 0),
+    daily_byte_quota BIGINT CHECK (daily_byte_quota >= 0),
+    updated_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Events and bytes ingested per tenant and UTC day, checked against the daily quotas
+CREATE TABLE IF NOT EXISTS tenant_usage_daily (
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    events BIGINT NOT NULL DEFAULT 0,
+    bytes BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, day)
+);
@@ -0,0 +1,7 @@
+-- Searches and exports are metered next to the ingestion, for the billing of the tenants
+ALTER TABLE tenant_usage_daily
+    ADD COLUMN IF NOT EXISTS searches BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS exports BIGINT NOT NULL DEFAULT 0;
+
+-- The monthly report reads every tenant of a month
+CREATE INDEX IF NOT EXISTS idx_tenant_usage_daily_day ON tenant_usage_daily (day);
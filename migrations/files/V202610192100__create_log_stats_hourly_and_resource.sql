@@ -0,0 +1,51 @@
+-- flyway: transactional=false
+
+-- Hourly counts, with the resource so that every grouping but users can be served per hour
+CREATE MATERIALIZED VIEW IF NOT EXISTS log_stats_hourly
+WITH (timescaledb.continuous) AS
+SELECT
+    tenant_id,
+    time_bucket('1 hour', event_timestamp) AS bucket,
+    action,
+    severity,
+    resource,
+    COUNT(*) AS log_count
+FROM logs
+WHERE NOT system
+GROUP BY tenant_id, bucket, action, severity, resource
+WITH NO DATA;
+
+CREATE INDEX IF NOT EXISTS idx_log_stats_hourly_tenant_bucket
+    ON log_stats_hourly (tenant_id, bucket);
+
+SELECT add_continuous_aggregate_policy('log_stats_hourly',
+    start_offset => INTERVAL '90 days',
+    end_offset   => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '5 minutes');
+
+CALL refresh_continuous_aggregate('log_stats_hourly', NULL, NULL);
+
+-- Daily counts per resource, log_stats_daily stays the smaller table for the other groupings
+CREATE MATERIALIZED VIEW IF NOT EXISTS log_stats_resource_daily
+WITH (timescaledb.continuous) AS
+SELECT
+    tenant_id,
+    time_bucket('1 day', event_timestamp) AS day,
+    resource,
+    action,
+    severity,
+    COUNT(*) AS log_count
+FROM logs
+WHERE NOT system
+GROUP BY tenant_id, day, resource, action, severity
+WITH NO DATA;
+
+CREATE INDEX IF NOT EXISTS idx_log_stats_resource_daily_tenant_day
+    ON log_stats_resource_daily (tenant_id, day, resource);
+
+SELECT add_continuous_aggregate_policy('log_stats_resource_daily',
+    start_offset => INTERVAL '90 days',
+    end_offset   => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '5 minutes');
+
+CALL refresh_continuous_aggregate('log_stats_resource_daily', NULL, NULL);
@@ -0,0 +1,53 @@
+-- flyway: transactional=false
+
+-- Hourly counts per user, serves the top users, the activity baselines and the stats grouped by user
+CREATE MATERIALIZED VIEW IF NOT EXISTS log_user_activity_hourly
+WITH (timescaledb.continuous) AS
+SELECT
+    tenant_id,
+    time_bucket('1 hour', event_timestamp) AS bucket,
+    user_id,
+    action,
+    severity,
+    COUNT(*) AS log_count
+FROM logs
+WHERE NOT system
+GROUP BY tenant_id, bucket, user_id, action, severity
+WITH NO DATA;
+
+CREATE INDEX IF NOT EXISTS idx_log_user_activity_hourly_tenant_bucket
+    ON log_user_activity_hourly (tenant_id, bucket);
+
+SELECT add_continuous_aggregate_policy('log_user_activity_hourly',
+    start_offset => INTERVAL '90 days',
+    end_offset   => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '5 minutes');
+
+CALL refresh_continuous_aggregate('log_user_activity_hourly', NULL, NULL);
+
+-- Daily counts per user and IP address, with the first and last time the pair was seen
+CREATE MATERIALIZED VIEW IF NOT EXISTS log_user_ips_daily
+WITH (timescaledb.continuous) AS
+SELECT
+    tenant_id,
+    time_bucket('1 day', event_timestamp) AS day,
+    user_id,
+    ip_address,
+    action,
+    COUNT(*) AS log_count,
+    MIN(event_timestamp) AS first_seen,
+    MAX(event_timestamp) AS last_seen
+FROM logs
+WHERE NOT system AND ip_address IS NOT NULL
+GROUP BY tenant_id, day, user_id, ip_address, action
+WITH NO DATA;
+
+CREATE INDEX IF NOT EXISTS idx_log_user_ips_daily_tenant_day
+    ON log_user_ips_daily (tenant_id, day, user_id);
+
+SELECT add_continuous_aggregate_policy('log_user_ips_daily',
+    start_offset => INTERVAL '90 days',
+    end_offset   => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '5 minutes');
+
+CALL refresh_continuous_aggregate('log_user_ips_daily', NULL, NULL);
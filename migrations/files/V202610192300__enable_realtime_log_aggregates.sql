@@ -0,0 +1,7 @@
+-- Real-time aggregation: the buckets newer than the last refresh are computed from the logs at query time,
+-- so that the stats include the current hour
+ALTER MATERIALIZED VIEW log_stats_daily SET (timescaledb.materialized_only = false);
+ALTER MATERIALIZED VIEW log_stats_hourly SET (timescaledb.materialized_only = false);
+ALTER MATERIALIZED VIEW log_stats_resource_daily SET (timescaledb.materialized_only = false);
+ALTER MATERIALIZED VIEW log_user_activity_hourly SET (timescaledb.materialized_only = false);
+ALTER MATERIALIZED VIEW log_user_ips_daily SET (timescaledb.materialized_only = false);
@@ -0,0 +1,6 @@
+-- The operations dashboard counts the tasks not succeeded by type and status, succeeded tasks are most of the table
+CREATE INDEX IF NOT EXISTS idx_async_tasks_open ON async_tasks (task_type, status, created_at)
+WHERE status <> 'succeeded';
+
+UPDATE roles SET permissions = permissions || '["ops:read"]'
+WHERE built_in AND name = 'admin' AND NOT permissions ? 'ops:read';
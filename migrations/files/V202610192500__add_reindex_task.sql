@@ -0,0 +1,8 @@
+-- flyway: transactional=false
+
+-- Logs written by the API are indexed by 'index' tasks, 'reindex' tasks reconcile OpenSearch with Postgres
+-- over a range. ADD VALUE can't run inside a transaction block.
+ALTER TYPE async_task_type ADD VALUE IF NOT EXISTS 'index';
+
+UPDATE roles SET permissions = permissions || '["ops:manage"]'
+WHERE built_in AND name = 'admin' AND NOT permissions ? 'ops:manage';
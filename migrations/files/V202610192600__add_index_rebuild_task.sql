@@ -0,0 +1,5 @@
+-- flyway: transactional=false
+
+-- 'index_rebuild' tasks rebuild the search index into a new version of the mapping. ADD VALUE can't run inside
+-- a transaction block.
+ALTER TYPE async_task_type ADD VALUE IF NOT EXISTS 'index_rebuild';
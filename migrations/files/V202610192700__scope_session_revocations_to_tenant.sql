@@ -0,0 +1,7 @@
+-- user ids are only unique within a tenant, a user revocation applies to the tokens of its tenant. Empty for a
+-- tenant and for the users without a tenant (admins), the user revocations stored so far keep applying to those
+ALTER TABLE session_revocations ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE session_revocations
+    DROP CONSTRAINT IF EXISTS session_revocations_pkey,
+    ADD PRIMARY KEY (subject_type, tenant_id, subject_id);
@@ -0,0 +1,6 @@
+-- user ids are only unique within a tenant, a grant applies to the grantee of one home tenant. The grants created
+-- so far are left without one and no longer match any token, they have to be created again
+ALTER TABLE access_grants ADD COLUMN IF NOT EXISTS grantee_tenant_id TEXT NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS idx_access_grants_grantee;
+CREATE INDEX IF NOT EXISTS idx_access_grants_grantee ON access_grants (grantee_tenant_id, grantee_id, expires_at);
@@ -0,0 +1,3 @@
+-- bytes is the size of the logs received, counted against the daily byte quota before they are redacted.
+-- stored_bytes is the size of the logs once redacted, as they are kept
+ALTER TABLE tenant_usage_daily ADD COLUMN IF NOT EXISTS stored_bytes BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+-- schemas and redaction rules are managed by admins and the users bound to data_steward, not every user
+UPDATE roles SET permissions = permissions - 'schemas:write' - 'redaction:write'
+WHERE built_in AND name = 'user';
+
+INSERT INTO roles (tenant_id, name, description, permissions, built_in) VALUES
+    (NULL, 'data_steward', 'Write and read the logs of a tenant, manage its schemas and redaction rules',
+     '["logs:read","logs:write","schemas:read","schemas:write","redaction:read","redaction:write"]', TRUE)
+ON CONFLICT DO NOTHING;
@@ -6,6 +6,8 @@ import (
 
 	"github.com/sirupsen/logrus"
 	gormLogger "gorm.io/gorm/logger"
+
+	customLogger "github.com/Haevnen/audit-logging-api/pkg/logger"
 )
 
 type GormLogger struct {
@@ -25,19 +27,19 @@ func (l *GormLogger) LogMode(level gormLogger.LogLevel) gormLogger.Interface {
 
 func (l *GormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
 	if l.LogLevel >= gormLogger.Info {
-		l.base.Infof(msg, data...)
+		l.entry(ctx).Infof(msg, data...)
 	}
 }
 
 func (l *GormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
 	if l.LogLevel >= gormLogger.Warn {
-		l.base.Warnf(msg, data...)
+		l.entry(ctx).Warnf(msg, data...)
 	}
 }
 
 func (l *GormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
 	if l.LogLevel >= gormLogger.Error {
-		l.base.Errorf(msg, data...)
+		l.entry(ctx).Errorf(msg, data...)
 	}
 }
 
@@ -51,8 +53,13 @@ func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (stri
 
 	switch {
 	case err != nil && l.LogLevel >= gormLogger.Error:
-		l.base.WithError(err).Errorf("%s [%s] rows:%d", sql, elapsed, rows)
+		l.entry(ctx).WithError(err).Errorf("%s [%s] rows:%d", sql, elapsed, rows)
 	case l.LogLevel >= gormLogger.Info:
-		l.base.Infof("%s [%s] rows:%d", sql, elapsed, rows)
+		l.entry(ctx).Infof("%s [%s] rows:%d", sql, elapsed, rows)
 	}
 }
+
+// entry carries the request and task ids of ctx, so statements can be tied to the request that ran them
+func (l *GormLogger) entry(ctx context.Context) *logrus.Entry {
+	return l.base.WithFields(customLogger.Fields(ctx))
+}
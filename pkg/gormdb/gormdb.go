@@ -13,6 +13,7 @@ import (
 	"gorm.io/gorm/logger"
 
 	customLogger "github.com/Haevnen/audit-logging-api/pkg/logger"
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
 )
 
 const (
@@ -54,6 +55,10 @@ func New(cfg *Config) (db *gorm.DB, close func() error, err error) {
 	conn.SetConnMaxLifetime(time.Duration(cfg.MaxLifetimeSecond) * time.Second)
 
 	logfile := customLogger.GetLogger()
+	if err := metrics.RegisterDBStats(conn, cfg.DBName); err != nil {
+		logfile.WithField("error", err).Warn("failed to register db pool metrics")
+	}
+
 	// Open GORM with Postgres driver
 	gormDB, err := gorm.Open(
 		postgres.New(postgres.Config{Conn: conn}),
@@ -62,6 +67,9 @@ func New(cfg *Config) (db *gorm.DB, close func() error, err error) {
 			Logger:      NewGormLogger(logger.Info, logfile),
 		},
 	)
+	if err == nil {
+		err = registerTracing(gormDB)
+	}
 	if err != nil {
 		if closeErr := conn.Close(); closeErr != nil {
 			err = multierr.Append(err, closeErr)
@@ -0,0 +1,129 @@
+// Package health probes the dependencies of a process and reports the state of its long running loops
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Check probes one dependency, a nil error means it is usable
+type Check struct {
+	Name  string
+	Probe func(ctx context.Context) error
+}
+
+type CheckResult struct {
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	DurationMs int64      `json:"duration_ms,omitempty"`
+	LastPoll   *time.Time `json:"last_poll,omitempty"`
+}
+
+// Report is ok when every check is
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+func newReport(checks map[string]CheckResult) Report {
+	r := Report{Status: StatusOK, Checks: checks}
+	for _, c := range checks {
+		if c.Status != StatusOK {
+			r.Status = StatusFail
+		}
+	}
+	return r
+}
+
+// Alive reports a process able to serve, it checks nothing else
+func Alive(context.Context) Report {
+	return newReport(map[string]CheckResult{})
+}
+
+type Checker struct {
+	checks  []Check
+	timeout time.Duration
+}
+
+// NewChecker runs the checks with timeout each, a check still running then fails
+func NewChecker(timeout time.Duration, checks ...Check) *Checker {
+	return &Checker{checks: checks, timeout: timeout}
+}
+
+// Run probes every dependency at once
+func (c *Checker) Run(ctx context.Context) Report {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]CheckResult, len(c.checks))
+	for _, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := c.probe(ctx, check)
+			mu.Lock()
+			defer mu.Unlock()
+			results[check.Name] = result
+		}()
+	}
+	wg.Wait()
+	return newReport(results)
+}
+
+func (c *Checker) probe(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- check.Probe(ctx) }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		// the probe may ignore its context, it is not waited for
+		err = ctx.Err()
+	}
+
+	result := CheckResult{Status: StatusOK, DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Handler serves the report as JSON, with 200 when it is ok and 503 otherwise
+func Handler(report func(ctx context.Context) Report) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rep := report(r.Context())
+		status := http.StatusOK
+		if rep.Status != StatusOK {
+			status = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(rep)
+	}
+}
+
+// Serve exposes /livez and /readyz on addr for the processes without an HTTP server, it returns the server to
+// shut it down
+func Serve(addr string, live, ready func(ctx context.Context) Report) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/livez", Handler(live))
+	mux.Handle("/readyz", Handler(ready))
+	s := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		_ = s.ListenAndServe()
+	}()
+	return s
+}
@@ -0,0 +1,127 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Haevnen/audit-logging-api/pkg/health"
+)
+
+func ok(context.Context) error { return nil }
+
+func TestChecker_Run(t *testing.T) {
+	checker := health.NewChecker(50*time.Millisecond,
+		health.Check{Name: "postgres", Probe: ok},
+		health.Check{Name: "redis", Probe: func(context.Context) error { return errors.New("connection refused") }},
+		health.Check{Name: "sqs", Probe: func(context.Context) error {
+			// ignores its context
+			time.Sleep(time.Second)
+			return nil
+		}},
+	)
+
+	start := time.Now()
+	report := checker.Run(context.Background())
+
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, health.StatusFail, report.Status)
+	assert.Equal(t, health.StatusOK, report.Checks["postgres"].Status)
+	assert.Equal(t, health.CheckResult{Status: health.StatusFail, Error: "connection refused", DurationMs: report.Checks["redis"].DurationMs}, report.Checks["redis"])
+	assert.Equal(t, health.StatusFail, report.Checks["sqs"].Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["sqs"].Error)
+}
+
+func TestChecker_RunAllOK(t *testing.T) {
+	report := health.NewChecker(time.Second, health.Check{Name: "postgres", Probe: ok}).Run(context.Background())
+	assert.Equal(t, health.StatusOK, report.Status)
+}
+
+func TestHandler(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		probe  func(context.Context) error
+		status int
+	}{
+		{"ok", ok, http.StatusOK},
+		{"fail", func(context.Context) error { return errors.New("down") }, http.StatusServiceUnavailable},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := health.NewChecker(time.Second, health.Check{Name: "postgres", Probe: tc.probe})
+			w := httptest.NewRecorder()
+			health.Handler(checker.Run)(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			var report health.Report
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+			assert.Equal(t, tc.name, report.Status)
+			assert.Contains(t, report.Checks, "postgres")
+		})
+	}
+}
+
+func TestHeartbeats_Report(t *testing.T) {
+	h := health.NewHeartbeats()
+	h.Beat("REINDEX")
+
+	report := h.Report(time.Hour)
+	assert.Equal(t, health.StatusOK, report.Status)
+	require.NotNil(t, report.Checks["REINDEX"].LastPoll)
+	assert.WithinDuration(t, time.Now(), *report.Checks["REINDEX"].LastPoll, time.Second)
+
+	time.Sleep(5 * time.Millisecond)
+	report = h.Report(time.Millisecond)
+	assert.Equal(t, health.StatusFail, report.Status)
+	assert.Equal(t, health.StatusFail, report.Checks["REINDEX"].Status)
+	assert.Contains(t, report.Checks["REINDEX"].Error, "no successful poll")
+}
+
+func TestRedis(t *testing.T) {
+	mr := miniredis.RunT(t)
+	check := health.Redis(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	assert.Equal(t, "redis", check.Name)
+	assert.NoError(t, check.Probe(context.Background()))
+
+	mr.Close()
+	assert.Error(t, check.Probe(context.Background()))
+}
+
+func TestOpenSearch(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		code    int
+		body    string
+		healthy bool
+	}{
+		{"green", http.StatusOK, `{"status":"green"}`, true},
+		{"yellow", http.StatusOK, `{"status":"yellow"}`, true},
+		{"red", http.StatusOK, `{"status":"red"}`, false},
+		{"unavailable", http.StatusServiceUnavailable, `{}`, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/_cluster/health", r.URL.Path)
+				w.WriteHeader(tc.code)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			err := health.OpenSearch(srv.URL).Probe(context.Background())
+			if tc.healthy {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
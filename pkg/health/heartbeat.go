@@ -0,0 +1,42 @@
+package health
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Heartbeats keeps the last successful poll of each loop of a process
+type Heartbeats struct {
+	mu   sync.Mutex
+	last map[string]time.Time
+}
+
+func NewHeartbeats() *Heartbeats {
+	return &Heartbeats{last: map[string]time.Time{}}
+}
+
+// Beat records a successful poll of the loop, loops beat once when they start too
+func (h *Heartbeats) Beat(loop string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.last[loop] = time.Now()
+}
+
+// Report fails the loops that haven't polled for staleAfter, they are stuck or gone
+func (h *Heartbeats) Report(staleAfter time.Duration) Report {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	results := make(map[string]CheckResult, len(h.last))
+	for loop, last := range h.last {
+		result := CheckResult{Status: StatusOK, LastPoll: &last}
+		if since := now.Sub(last); since > staleAfter {
+			result.Status = StatusFail
+			result.Error = fmt.Sprintf("no successful poll for %s", since.Truncate(time.Second))
+		}
+		results[loop] = result
+	}
+	return newReport(results)
+}
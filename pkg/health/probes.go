@@ -0,0 +1,70 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/redis/go-redis/v9"
+)
+
+func Postgres(db *sql.DB) Check {
+	return Check{Name: "postgres", Probe: db.PingContext}
+}
+
+func Redis(client *redis.Client) Check {
+	return Check{Name: "redis", Probe: func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}}
+}
+
+// OpenSearch fails while the cluster is red, a yellow cluster still serves every index
+func OpenSearch(baseURL string) Check {
+	return Check{Name: "opensearch", Probe: func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(baseURL, "/")+"/_cluster/health", nil)
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("cluster health returned %d", res.StatusCode)
+		}
+
+		var body struct {
+			Status string `json:"status"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+			return fmt.Errorf("decode cluster health: %w", err)
+		}
+		if body.Status == "red" {
+			return fmt.Errorf("cluster is red")
+		}
+		return nil
+	}}
+}
+
+// SQS checks every queue can be read
+func SQS(client *sqs.Client, queueURLs ...string) Check {
+	return Check{Name: "sqs", Probe: func(ctx context.Context) error {
+		for _, queueURL := range queueURLs {
+			if _, err := client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+				QueueUrl:       aws.String(queueURL),
+				AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameApproximateNumberOfMessages},
+			}); err != nil {
+				return fmt.Errorf("queue %s: %w", path.Base(queueURL), err)
+			}
+		}
+		return nil
+	}}
+}
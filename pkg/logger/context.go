@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"context"
+	"maps"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Fields every line logged while serving a request or a task may carry
+const (
+	KeyRequestID = "request_id"
+	KeyTenantID  = "tenant_id"
+	KeyUserID    = "user_id"
+	KeyTaskID    = "task_id"
+	KeyTraceID   = "trace_id"
+	KeySpanID    = "span_id"
+)
+
+type fieldsKey struct{}
+
+// NewRequestID returns a new id for a request that came without one
+func NewRequestID() string {
+	return uuid.New().String()
+}
+
+// With returns a copy of ctx whose log lines carry key=value, empty values are left out
+func With(ctx context.Context, key, value string) context.Context {
+	if len(value) == 0 {
+		return ctx
+	}
+	fields := maps.Clone(fieldsOf(ctx))
+	if fields == nil {
+		fields = map[string]string{}
+	}
+	fields[key] = value
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
+
+// RequestID returns the id of the request ctx serves, or of the request that queued the task
+func RequestID(ctx context.Context) string {
+	return fieldsOf(ctx)[KeyRequestID]
+}
+
+// Fields returns the fields set on ctx with With, plus the ids of its span when it is traced
+func Fields(ctx context.Context) log.Fields {
+	fields := log.Fields{}
+	for k, v := range fieldsOf(ctx) {
+		fields[k] = v
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		fields[KeyTraceID] = sc.TraceID().String()
+		fields[KeySpanID] = sc.SpanID().String()
+	}
+	return fields
+}
+
+// FromContext returns the global logger carrying the fields of ctx
+func FromContext(ctx context.Context) *log.Entry {
+	return GetLogger().WithFields(Fields(ctx))
+}
+
+func fieldsOf(ctx context.Context) map[string]string {
+	fields, _ := ctx.Value(fieldsKey{}).(map[string]string)
+	return fields
+}
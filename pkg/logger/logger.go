@@ -1,10 +1,13 @@
 package logger
 
 import (
+	"io"
 	"os"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 var (
@@ -13,39 +16,58 @@ var (
 	logMux  sync.Mutex
 )
 
+// Config tells where the log lines go and when the log file is rotated
+type Config struct {
+	// debug or info
+	Level string
+	// stderr when empty
+	File string
+	// the file is rotated once it reaches MaxSizeMB
+	MaxSizeMB int
+	// rotated files are removed after MaxAgeDays, or when more than MaxBackups are kept. Zero keeps them.
+	MaxAgeDays int
+	MaxBackups int
+}
+
+func newLogger(out io.Writer, level log.Level) *log.Logger {
+	l := log.New()
+	l.SetFormatter(&log.JSONFormatter{TimestampFormat: time.RFC3339Nano})
+	l.SetLevel(level)
+	l.SetOutput(out)
+	return l
+}
+
 func GetLogger() *log.Logger {
 	logMux.Lock()
 	defer logMux.Unlock()
 	if logger == nil {
-		logger = log.New()
-		logger.SetFormatter(&log.TextFormatter{})
-		logger.SetLevel(log.InfoLevel)
-		logger.SetOutput(os.Stderr)
+		logger = newLogger(os.Stderr, log.InfoLevel)
 	}
 	return logger
 }
 
-// GetLogger returns the global logger
-func InitLogger(logLevel string, logFileName string) *log.Logger {
+// InitLogger sets up the global logger, it writes JSON lines to the file of cfg, keeping the lines written
+// before the start
+func InitLogger(cfg Config) *log.Logger {
 	logMux.Lock()
 	defer logMux.Unlock()
 
 	logOnce.Do(func() {
-		logger = log.New()
-		logger.SetFormatter(&log.TextFormatter{})
-
-		if logLevel == "debug" {
-			logger.SetLevel(log.DebugLevel)
-		} else {
-			logger.SetLevel(log.InfoLevel)
+		level := log.InfoLevel
+		if cfg.Level == "debug" {
+			level = log.DebugLevel
 		}
 
-		file, err := os.OpenFile(logFileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
-		if err == nil {
-			logger.SetOutput(file)
-		} else {
-			logger.Info("Failed to log to file, using default stderr", err)
+		var out io.Writer = os.Stderr
+		if len(cfg.File) > 0 {
+			out = &lumberjack.Logger{
+				Filename:   cfg.File,
+				MaxSize:    cfg.MaxSizeMB,
+				MaxAge:     cfg.MaxAgeDays,
+				MaxBackups: cfg.MaxBackups,
+			}
 		}
+		logger = newLogger(out, level)
 	})
 
 	return logger
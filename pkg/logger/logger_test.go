@@ -0,0 +1,73 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+func TestWith_AddsFields(t *testing.T) {
+	ctx := logger.With(context.Background(), logger.KeyRequestID, "req-1")
+	child := logger.With(ctx, logger.KeyTenantID, "tenant-1")
+	child = logger.With(child, logger.KeyUserID, "")
+
+	assert.Equal(t, "req-1", logger.RequestID(child))
+	assert.Equal(t, map[string]interface{}{
+		logger.KeyRequestID: "req-1",
+		logger.KeyTenantID:  "tenant-1",
+	}, map[string]interface{}(logger.Fields(child)))
+	// the parent context is left as it was
+	assert.NotContains(t, logger.Fields(ctx), logger.KeyTenantID)
+	assert.Empty(t, logger.RequestID(context.Background()))
+}
+
+func TestFields_CarriesSpan(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	fields := logger.Fields(ctx)
+	assert.Equal(t, sc.TraceID().String(), fields[logger.KeyTraceID])
+	assert.Equal(t, sc.SpanID().String(), fields[logger.KeySpanID])
+}
+
+func TestFromContext_WritesJSON(t *testing.T) {
+	var buf bytes.Buffer
+	l := logger.GetLogger()
+	out := l.Out
+	l.SetOutput(&buf)
+	defer l.SetOutput(out)
+
+	ctx := logger.With(context.Background(), logger.KeyTaskID, "task-1")
+	logger.FromContext(ctx).Info("task done")
+
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "task done", line["msg"])
+	assert.Equal(t, "info", line["level"])
+	assert.Equal(t, "task-1", line[logger.KeyTaskID])
+}
+
+func TestInitLogger_KeepsPreviousLines(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "running_log")
+	require.NoError(t, os.WriteFile(file, []byte("{\"msg\":\"before restart\"}\n"), 0600))
+
+	l := logger.InitLogger(logger.Config{File: file, MaxSizeMB: 1})
+	l.Info("after restart")
+
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "before restart")
+	assert.Contains(t, string(content), "after restart")
+}
@@ -0,0 +1,235 @@
+// Package metrics holds the Prometheus metrics shared by the API and the workers
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/Haevnen/audit-logging-api/pkg/logger"
+)
+
+const namespace = "audit"
+
+const (
+	// DefaultMaxTenantLabels is how many tenants get their own series, the others are counted together
+	DefaultMaxTenantLabels = 100
+	// OtherTenants is the tenant label of the tenants over the cap
+	OtherTenants = "other"
+)
+
+const (
+	OutcomeSucceeded = "succeeded"
+	OutcomeFailed    = "failed"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests served, by route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of the HTTP requests, by route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	ingestedEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ingested_events_total",
+		Help:      "Logs ingested, by tenant.",
+	}, []string{"tenant"})
+
+	ingestedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ingested_bytes_total",
+		Help:      "Size of the logs ingested, by tenant.",
+	}, []string{"tenant"})
+
+	sqsMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sqs_messages_total",
+		Help:      "SQS messages sent, received and deleted, by queue.",
+	}, []string{"queue", "operation"})
+
+	sqsErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sqs_errors_total",
+		Help:      "Failed SQS calls, by queue.",
+	}, []string{"queue", "operation"})
+
+	tasks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "async_tasks_total",
+		Help:      "Async tasks handled by the workers, by type and outcome.",
+	}, []string{"type", "outcome"})
+
+	taskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "async_task_duration_seconds",
+		Help:      "Time spent handling an async task, by type.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"type"})
+
+	openSearchDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "opensearch_request_duration_seconds",
+		Help:      "Latency of the OpenSearch requests, by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	openSearchErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "opensearch_errors_total",
+		Help:      "Failed OpenSearch requests, by operation.",
+	}, []string{"operation"})
+
+	redisPublishFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "redis_publish_failures_total",
+		Help:      "Failed Redis publishes, by channel prefix.",
+	}, []string{"channel"})
+
+	tenantLabels = newLabelCap(DefaultMaxTenantLabels)
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests, httpDuration,
+		ingestedEvents, ingestedBytes,
+		sqsMessages, sqsErrors,
+		tasks, taskDuration,
+		openSearchDuration, openSearchErrors,
+		redisPublishFailures,
+	)
+}
+
+// Handler serves every metric in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Serve exposes /metrics on addr, a listener apart from the public API, it returns the server to shut it down.
+// An address that can't be listened on fails right away, the server failing later is logged.
+func Serve(addr string) (*http.Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	s := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		if err := s.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.GetLogger().WithField("error", err).WithField("addr", addr).Error("metrics server stopped")
+		}
+	}()
+	return s, nil
+}
+
+// RegisterDBStats exposes the pool stats of db, registering the same database twice is a no-op
+func RegisterDBStats(db *sql.DB, name string) error {
+	err := registry.Register(collectors.NewDBStatsCollector(db, name))
+	var registered prometheus.AlreadyRegisteredError
+	if errors.As(err, &registered) {
+		return nil
+	}
+	return err
+}
+
+// SetMaxTenantLabels changes how many tenants get their own series
+func SetMaxTenantLabels(n int) {
+	tenantLabels.setMax(n)
+}
+
+func ObserveHTTPRequest(method, route string, status int, d time.Duration) {
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(method, route, code).Inc()
+	httpDuration.WithLabelValues(method, route, code).Observe(d.Seconds())
+}
+
+func ObserveIngestion(tenantId string, events, bytes int64) {
+	tenant := tenantLabels.label(tenantId)
+	ingestedEvents.WithLabelValues(tenant).Add(float64(events))
+	ingestedBytes.WithLabelValues(tenant).Add(float64(bytes))
+}
+
+// ObserveSQS counts n messages of the operation (send, receive, delete) on the queue, or its failure
+func ObserveSQS(queueURL, operation string, n int, err error) {
+	queue := path.Base(queueURL)
+	if err != nil {
+		sqsErrors.WithLabelValues(queue, operation).Inc()
+		return
+	}
+	sqsMessages.WithLabelValues(queue, operation).Add(float64(n))
+}
+
+func ObserveTask(taskType string, start time.Time, err error) {
+	outcome := OutcomeSucceeded
+	if err != nil {
+		outcome = OutcomeFailed
+	}
+	tasks.WithLabelValues(taskType, outcome).Inc()
+	taskDuration.WithLabelValues(taskType).Observe(time.Since(start).Seconds())
+}
+
+func ObserveOpenSearch(operation string, start time.Time, err error) {
+	openSearchDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		openSearchErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// RedisPublishFailed counts a failed publish, the channel is labelled by its prefix to leave tenant ids out
+func RedisPublishFailed(channel string) {
+	prefix, _, _ := strings.Cut(channel, ":")
+	redisPublishFailures.WithLabelValues(prefix).Inc()
+}
+
+// labelCap hands out a label per value until max values were seen, later values share OtherTenants
+type labelCap struct {
+	mu   sync.Mutex
+	max  int
+	seen map[string]struct{}
+}
+
+func newLabelCap(max int) *labelCap {
+	return &labelCap{max: max, seen: map[string]struct{}{}}
+}
+
+func (l *labelCap) setMax(max int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.max = max
+}
+
+func (l *labelCap) label(value string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.seen[value]; ok {
+		return value
+	}
+	if len(l.seen) >= l.max {
+		return OtherTenants
+	}
+	l.seen[value] = struct{}{}
+	return value
+}
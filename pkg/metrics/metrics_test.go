@@ -0,0 +1,67 @@
+package metrics_test
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Haevnen/audit-logging-api/pkg/metrics"
+)
+
+func scrape(t *testing.T) string {
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	return w.Body.String()
+}
+
+func TestObserveIngestion_CapsTenants(t *testing.T) {
+	metrics.SetMaxTenantLabels(2)
+	defer metrics.SetMaxTenantLabels(metrics.DefaultMaxTenantLabels)
+
+	metrics.ObserveIngestion("tenant-a", 2, 100)
+	metrics.ObserveIngestion("tenant-b", 1, 10)
+	metrics.ObserveIngestion("tenant-c", 3, 30)
+	metrics.ObserveIngestion("tenant-d", 1, 5)
+	// tenants seen before the cap keep their series
+	metrics.ObserveIngestion("tenant-a", 1, 50)
+
+	body := scrape(t)
+	assert.Contains(t, body, `audit_ingested_events_total{tenant="tenant-a"} 3`)
+	assert.Contains(t, body, `audit_ingested_bytes_total{tenant="tenant-a"} 150`)
+	assert.Contains(t, body, `audit_ingested_events_total{tenant="tenant-b"} 1`)
+	assert.Contains(t, body, `audit_ingested_events_total{tenant="other"} 4`)
+	assert.NotContains(t, body, "tenant-c")
+}
+
+func TestObserveTaskAndDependencies(t *testing.T) {
+	start := time.Now()
+	metrics.ObserveTask("archive", start, nil)
+	metrics.ObserveTask("archive", start, errors.New("s3 down"))
+	metrics.ObserveSQS("http://localhost:4566/000000000000/index-queue", "receive", 5, nil)
+	metrics.ObserveSQS("http://localhost:4566/000000000000/index-queue", "delete", 0, errors.New("gone"))
+	metrics.ObserveOpenSearch("bulk_index", start, errors.New("status 500"))
+	metrics.RedisPublishFailed("logs:tenant-1")
+
+	body := scrape(t)
+	assert.Contains(t, body, `audit_async_tasks_total{outcome="succeeded",type="archive"} 1`)
+	assert.Contains(t, body, `audit_async_tasks_total{outcome="failed",type="archive"} 1`)
+	assert.Contains(t, body, `audit_sqs_messages_total{operation="receive",queue="index-queue"} 5`)
+	assert.Contains(t, body, `audit_sqs_errors_total{operation="delete",queue="index-queue"} 1`)
+	assert.Contains(t, body, `audit_opensearch_errors_total{operation="bulk_index"} 1`)
+	assert.Contains(t, body, `audit_redis_publish_failures_total{channel="logs"} 1`)
+}
+
+func TestServe_AddressInUse(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer ln.Close()
+
+	_, err = metrics.Serve(ln.Addr().String())
+	assert.Error(t, err)
+}
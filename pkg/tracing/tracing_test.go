@@ -0,0 +1,77 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Haevnen/audit-logging-api/pkg/tracing"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	shutdown, err := tracing.Init(context.Background(), tracing.Config{Exporter: tracing.ExporterNone})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = shutdown(context.Background()) })
+
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestInjectExtract_ContinuesTrace(t *testing.T) {
+	recorder := recordSpans(t)
+
+	ctx, producer := tracing.Start(context.Background(), "sqs.send")
+	carrier := map[string]string{}
+	tracing.Inject(ctx, carrier)
+	tracing.End(producer, nil)
+	assert.Contains(t, carrier, "traceparent")
+
+	// the consumer only has the message attributes
+	_, consumer := tracing.Start(tracing.Extract(context.Background(), carrier), "process REINDEX")
+	tracing.End(consumer, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+}
+
+func TestExtract_WithoutTraceContext(t *testing.T) {
+	recorder := recordSpans(t)
+
+	_, span := tracing.Start(tracing.Extract(context.Background(), nil), "process ARCHIVE")
+	tracing.End(span, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.False(t, spans[0].Parent().IsValid())
+}
+
+func TestEnd_RecordsError(t *testing.T) {
+	recorder := recordSpans(t)
+
+	_, span := tracing.Start(context.Background(), "CreateLogUseCase.Execute")
+	tracing.End(span, errors.New("boom"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "boom", spans[0].Status().Description)
+	require.Len(t, spans[0].Events(), 1)
+	assert.Equal(t, "exception", spans[0].Events()[0].Name)
+}
+
+func TestInit_UnknownExporter(t *testing.T) {
+	_, err := tracing.Init(context.Background(), tracing.Config{Exporter: "jaeger"})
+	assert.Error(t, err)
+}